@@ -185,12 +185,13 @@ func NewAppKeepers(
 	// Initialize terra module keepers
 	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec, appKeepers.keys[oracletypes.StoreKey], appKeepers.GetSubspace(oracletypes.ModuleName),
-		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.DistrKeeper, &stakingKeeper, distrtypes.ModuleName,
+		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.DistrKeeper, &stakingKeeper, &appKeepers.MarketKeeper, distrtypes.ModuleName,
 	)
 	appKeepers.MarketKeeper = marketkeeper.NewKeeper(
 		appCodec, appKeepers.keys[markettypes.StoreKey],
 		appKeepers.GetSubspace(markettypes.ModuleName),
 		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.OracleKeeper,
+		appKeepers.DistrKeeper, appKeepers.TransferKeeper, appKeepers.IBCKeeper.ChannelKeeper,
 	)
 	appKeepers.TreasuryKeeper = treasurykeeper.NewKeeper(
 		appCodec, appKeepers.keys[treasurytypes.StoreKey],
@@ -7,6 +7,10 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
 
+	"github.com/classic-terra/core/x/market"
+	markettypes "github.com/classic-terra/core/x/market/types"
+	"github.com/classic-terra/core/x/oracle"
+	oracletypes "github.com/classic-terra/core/x/oracle/types"
 	"github.com/classic-terra/core/x/treasury"
 	treasurytypes "github.com/classic-terra/core/x/treasury/types"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
@@ -26,7 +30,9 @@ func (appKeepers *AppKeepers) getGovRouter() govtypes.Router {
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(appKeepers.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(appKeepers.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(appKeepers.IBCKeeper.ClientKeeper)).
-		AddRoute(treasurytypes.RouterKey, treasury.NewProposalHandler(appKeepers.TreasuryKeeper))
+		AddRoute(treasurytypes.RouterKey, treasury.NewProposalHandler(appKeepers.TreasuryKeeper)).
+		AddRoute(oracletypes.RouterKey, oracle.NewProposalHandler(appKeepers.OracleKeeper)).
+		AddRoute(markettypes.RouterKey, market.NewProposalHandler(appKeepers.MarketKeeper))
 
 	return govRouter
 }
@@ -60,8 +60,10 @@ import (
 	customupgrade "github.com/classic-terra/core/custom/upgrade"
 
 	"github.com/classic-terra/core/x/market"
+	marketclient "github.com/classic-terra/core/x/market/client"
 	markettypes "github.com/classic-terra/core/x/market/types"
 	"github.com/classic-terra/core/x/oracle"
+	oracleclient "github.com/classic-terra/core/x/oracle/client"
 	oracletypes "github.com/classic-terra/core/x/oracle/types"
 	"github.com/classic-terra/core/x/treasury"
 	treasuryclient "github.com/classic-terra/core/x/treasury/client"
@@ -96,6 +98,17 @@ var (
 			ibcclientclient.UpgradeProposalHandler,
 			treasuryclient.ProposalAddBurnTaxExemptionAddressHandler,
 			treasuryclient.ProposalRemoveBurnTaxExemptionAddressHandler,
+			treasuryclient.ProposalAddBurnTaxExemptionZoneHandler,
+			treasuryclient.ProposalModifyBurnTaxExemptionZoneHandler,
+			treasuryclient.ProposalRemoveBurnTaxExemptionZoneHandler,
+			oracleclient.ProposalResumeDenomHandler,
+			oracleclient.ProposalAddOracleDenomHandler,
+			oracleclient.ProposalRemoveOracleDenomHandler,
+			oracleclient.ProposalUpdateTobinTaxHandler,
+			marketclient.ProposalUpdateTerraSwapFeeHandler,
+			marketclient.ProposalRemoveTerraSwapFeeHandler,
+			marketclient.ProposalUpdateIBCDenomMappingHandler,
+			marketclient.ProposalRemoveIBCDenomMappingHandler,
 		),
 		customparams.AppModuleBasic{},
 		customcrisis.AppModuleBasic{},
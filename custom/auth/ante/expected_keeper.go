@@ -10,9 +10,12 @@ import (
 type TreasuryKeeper interface {
 	RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins)
 	GetTaxRate(ctx sdk.Context) (taxRate sdk.Dec)
+	GetTaxRateMultiplier(ctx sdk.Context, msgTypeURL string) sdk.Dec
+	GetDenomTaxRateOverride(ctx sdk.Context, denom string) (sdk.Dec, bool)
 	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
 	GetBurnSplitRate(ctx sdk.Context) sdk.Dec
-	HasBurnTaxExemptionAddress(ctx sdk.Context, addresses ...string) bool
+	FilterBurnTaxExemptCoins(ctx sdk.Context, coins sdk.Coins, addresses ...string) sdk.Coins
+	IsBurnTaxExemptZoneTransfer(ctx sdk.Context, sender, recipient string) bool
 	GetMinInitialDepositRatio(ctx sdk.Context) sdk.Dec
 }
 
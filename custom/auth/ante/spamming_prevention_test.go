@@ -18,9 +18,9 @@ func (suite *AnteTestSuite) TestOracleSpamming() {
 	priv2, _, addr2 := testdata.KeyTestPubAddr()
 
 	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
-		feeders: map[string]string{
-			sdk.ValAddress(addr1).String(): addr1.String(),
-			sdk.ValAddress(addr2).String(): addr2.String(),
+		feeders: map[string][]string{
+			sdk.ValAddress(addr1).String(): {addr1.String()},
+			sdk.ValAddress(addr2).String(): {addr2.String()},
 		},
 	})
 	antehandler := sdk.ChainAnteDecorators(spd)
@@ -75,13 +75,119 @@ func (suite *AnteTestSuite) TestOracleSpamming() {
 	suite.Require().Error(err)
 }
 
+func (suite *AnteTestSuite) TestOracleSpammingMultipleFeeders() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr1)
+
+	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
+		feeders: map[string][]string{
+			valAddr.String(): {addr1.String(), addr2.String()},
+		},
+	})
+	antehandler := sdk.ChainAnteDecorators(spd)
+
+	// Set IsCheckTx to true
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+
+	// the primary feeder submits the prevote
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, valAddr),
+	))
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// the standby feeder is blocked at the same height
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr2, valAddr),
+	))
+	tx, err = suite.CreateTestTx([]cryptotypes.PrivKey{priv2}, []uint64{1}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// but takes over at the next height
+	suite.ctx = suite.ctx.WithBlockHeight(101)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
+
+func (suite *AnteTestSuite) TestOracleSpammingCombinedVote() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr1)
+
+	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
+		feeders: map[string][]string{
+			valAddr.String(): {addr1.String()},
+		},
+	})
+	antehandler := sdk.ChainAnteDecorators(spd)
+
+	// Set IsCheckTx to true
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// combined vote is ok
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateCombinedVote("", "", oracletypes.AggregateVoteHash{}, addr1, valAddr),
+	))
+	combinedTx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, combinedTx, false)
+	suite.Require().NoError(err)
+
+	// prevote is blocked at the same height
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, valAddr),
+	))
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// vote is blocked at the same height
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, valAddr),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// combined vote is blocked after a vote at the same height
+	suite.ctx = suite.ctx.WithBlockHeight(101)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, combinedTx, false)
+	suite.Require().Error(err)
+}
+
 type dummyOracleKeeper struct {
-	feeders map[string]string
+	feeders map[string][]string
 }
 
 func (ok dummyOracleKeeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
-	if val, ok := ok.feeders[validatorAddr.String()]; ok && val == feederAddr.String() {
-		return nil
+	for _, feeder := range ok.feeders[validatorAddr.String()] {
+		if feeder == feederAddr.String() {
+			return nil
+		}
 	}
 
 	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "cannot ensure feeder right")
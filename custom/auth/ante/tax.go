@@ -107,46 +107,57 @@ func EnsureSufficientMempoolFees(ctx sdk.Context, gas uint64, feeCoins sdk.Coins
 	return nil
 }
 
-// FilterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
+// FilterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend,
+// the tax rate of each denom being scaled by the tax rate multiplier of the msg type.
 func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs ...sdk.Msg) sdk.Coins {
 	taxes := sdk.Coins{}
 
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
 		case *banktypes.MsgSend:
-			if !tk.HasBurnTaxExemptionAddress(ctx, msg.FromAddress, msg.ToAddress) {
-				taxes = taxes.Add(computeTax(ctx, tk, msg.Amount)...)
+			if !tk.IsBurnTaxExemptZoneTransfer(ctx, msg.FromAddress, msg.ToAddress) {
+				taxes = taxes.Add(computeTax(ctx, tk, sdk.MsgTypeURL(msg), tk.FilterBurnTaxExemptCoins(ctx, msg.Amount, msg.FromAddress, msg.ToAddress))...)
 			}
 
 		case *banktypes.MsgMultiSend:
-			tainted := 0
+			if isBurnTaxExemptZoneMultiSend(ctx, tk, msg) {
+				continue
+			}
+
+			addresses := make([]string, 0, len(msg.Inputs)+len(msg.Outputs))
 
 			for _, input := range msg.Inputs {
-				if tk.HasBurnTaxExemptionAddress(ctx, input.Address) {
-					tainted++
-				}
+				addresses = append(addresses, input.Address)
 			}
 
 			for _, output := range msg.Outputs {
-				if tk.HasBurnTaxExemptionAddress(ctx, output.Address) {
-					tainted++
-				}
+				addresses = append(addresses, output.Address)
 			}
 
-			if tainted != len(msg.Inputs)+len(msg.Outputs) {
-				for _, input := range msg.Inputs {
-					taxes = taxes.Add(computeTax(ctx, tk, input.Coins)...)
-				}
+			for _, input := range msg.Inputs {
+				taxes = taxes.Add(computeTax(ctx, tk, sdk.MsgTypeURL(msg), tk.FilterBurnTaxExemptCoins(ctx, input.Coins, addresses...))...)
 			}
 
 		case *marketexported.MsgSwapSend:
-			taxes = taxes.Add(computeTax(ctx, tk, sdk.NewCoins(msg.OfferCoin))...)
+			taxes = taxes.Add(computeTax(ctx, tk, sdk.MsgTypeURL(msg), sdk.NewCoins(msg.OfferCoin))...)
+
+		case *marketexported.MsgSwapRoute:
+			// taxed once like MsgSwapSend, only when sending to another account
+			if msg.Receiver != "" && msg.Receiver != msg.Trader {
+				taxes = taxes.Add(computeTax(ctx, tk, sdk.MsgTypeURL(msg), sdk.NewCoins(msg.OfferCoin))...)
+			}
+
+		case *marketexported.MsgSwapExactOut:
+			// the offer is only known at execution, so the ask coin sent to the receiver is taxed
+			if msg.Receiver != "" && msg.Receiver != msg.Trader {
+				taxes = taxes.Add(computeTax(ctx, tk, sdk.MsgTypeURL(msg), sdk.NewCoins(msg.AskCoin))...)
+			}
 
 		case *wasmexported.MsgInstantiateContract:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.InitCoins)...)
+			taxes = taxes.Add(computeTax(ctx, tk, sdk.MsgTypeURL(msg), msg.InitCoins)...)
 
 		case *wasmexported.MsgExecuteContract:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Coins)...)
+			taxes = taxes.Add(computeTax(ctx, tk, sdk.MsgTypeURL(msg), msg.Coins)...)
 
 		case *authz.MsgExec:
 			messages, err := msg.GetMessages()
@@ -161,14 +172,29 @@ func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs ...sdk.Msg)
 	return taxes
 }
 
-// computes the stability tax according to tax-rate and tax-cap
-func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) sdk.Coins {
+// isBurnTaxExemptZoneMultiSend returns true when the zones exempt the transfers from every input to every output
+func isBurnTaxExemptZoneMultiSend(ctx sdk.Context, tk TreasuryKeeper, msg *banktypes.MsgMultiSend) bool {
+	for _, input := range msg.Inputs {
+		for _, output := range msg.Outputs {
+			if !tk.IsBurnTaxExemptZoneTransfer(ctx, input.Address, output.Address) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// computes the stability tax according to the tax-rate of the msg type and denom and the tax-cap
+func computeTax(ctx sdk.Context, tk TreasuryKeeper, msgTypeURL string, principal sdk.Coins) sdk.Coins {
 	currHeight := ctx.BlockHeight()
-	taxRate := tk.GetTaxRate(ctx)
-	if taxRate.Equal(sdk.ZeroDec()) {
+	multiplier := tk.GetTaxRateMultiplier(ctx, msgTypeURL)
+	if multiplier.IsZero() {
 		return sdk.Coins{}
 	}
 
+	defaultTaxRate := tk.GetTaxRate(ctx)
+
 	taxes := sdk.Coins{}
 	for _, coin := range principal {
 		// Originally only a stability tax on UST.  Changed to tax Luna as well after TaxPowerUpgradeHeight
@@ -179,6 +205,17 @@ func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) sdk.Coi
 			continue
 		}
 
+		taxRate := defaultTaxRate
+		if denomTaxRate, found := tk.GetDenomTaxRateOverride(ctx, coin.Denom); found {
+			taxRate = denomTaxRate
+		}
+
+		// the params do not bound the scaled tax rate, so it is capped here at the whole principal
+		taxRate = sdk.MinDec(taxRate.Mul(multiplier), sdk.OneDec())
+		if taxRate.IsZero() {
+			continue
+		}
+
 		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
 
 		// If tax due is greater than the tax cap, cap!
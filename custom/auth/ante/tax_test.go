@@ -15,6 +15,7 @@ import (
 	"github.com/classic-terra/core/custom/auth/ante"
 	core "github.com/classic-terra/core/types"
 	markettypes "github.com/classic-terra/core/x/market/types"
+	treasurytypes "github.com/classic-terra/core/x/treasury/types"
 	wasmtypes "github.com/classic-terra/core/x/wasm/types"
 )
 
@@ -172,6 +173,61 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSwapSend() {
 	suite.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestEnsureMempoolFeesSwapRoute() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	sendAmount := int64(1000000)
+	sendCoin := sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount)
+	route := []string{core.MicroLunaDenom, core.MicroKRWDenom}
+
+	tk := suite.app.TreasuryKeeper
+	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+		expectedTax = taxCap
+	}
+
+	// swap route to the trader is not taxed
+	msg := markettypes.NewMsgSwapRoute(addr1, sendCoin, route)
+	taxes := ante.FilterMsgAndComputeTax(suite.ctx, tk, msg)
+	suite.Require().True(taxes.IsZero())
+
+	// swap route to another account is taxed once on the offer coin
+	msg.Receiver = addr2.String()
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, msg)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)), taxes)
+}
+
+func (suite *AnteTestSuite) TestEnsureMempoolFeesSwapExactOut() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	askAmount := int64(1000000)
+	askCoin := sdk.NewInt64Coin(core.MicroSDRDenom, askAmount)
+
+	tk := suite.app.TreasuryKeeper
+	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(askAmount).TruncateInt()
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+		expectedTax = taxCap
+	}
+
+	// exact output swap to the trader is not taxed
+	msg := markettypes.NewMsgSwapExactOut(addr1, askCoin, core.MicroKRWDenom, sdk.NewInt(askAmount*2))
+	taxes := ante.FilterMsgAndComputeTax(suite.ctx, tk, msg)
+	suite.Require().True(taxes.IsZero())
+
+	// exact output swap to another account is taxed on the ask coin
+	msg.Receiver = addr2.String()
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, msg)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)), taxes)
+}
+
 func (suite *AnteTestSuite) TestEnsureMempoolFeesMultiSend() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -902,3 +958,160 @@ func (suite *AnteTestSuite) TestTaxExemption() {
 		require.Equal(amountFee, sdk.NewCoin("usdr", sdk.NewInt(c.expectedFeeAmount)))
 	}
 }
+
+// go test -v -run ^TestAnteTestSuite/TestTaxExemptionScope$ github.com/classic-terra/core/custom/auth/ante
+func (suite *AnteTestSuite) TestTaxExemptionScope() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	sendAmount := int64(1000000)
+	sdrCoin := sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount)
+	krwCoin := sdk.NewInt64Coin(core.MicroKRWDenom, sendAmount)
+
+	tk := suite.app.TreasuryKeeper
+	expectedTax := func(denom string) sdk.Coin {
+		tax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
+		if taxCap := tk.GetTaxCap(suite.ctx, denom); tax.GT(taxCap) {
+			tax = taxCap
+		}
+		return sdk.NewCoin(denom, tax)
+	}
+
+	// both addresses are exempted for usdr only, until the next block
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	tk.SetBurnTaxExemption(suite.ctx, treasurytypes.NewBurnTaxExemption(addr1.String(), 11, nil, []string{core.MicroSDRDenom}))
+	tk.SetBurnTaxExemption(suite.ctx, treasurytypes.NewBurnTaxExemption(addr2.String(), 11, nil, []string{core.MicroSDRDenom}))
+
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdrCoin, krwCoin))
+	taxes := ante.FilterMsgAndComputeTax(suite.ctx, tk, msg)
+	suite.Require().Equal(sdk.NewCoins(expectedTax(core.MicroKRWDenom)), taxes)
+
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(addr1, sdk.NewCoins(sdrCoin, krwCoin))},
+		[]banktypes.Output{banktypes.NewOutput(addr2, sdk.NewCoins(sdrCoin, krwCoin))},
+	)
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, multiSend)
+	suite.Require().Equal(sdk.NewCoins(expectedTax(core.MicroKRWDenom)), taxes)
+
+	// expired exemptions are taxed again
+	suite.ctx = suite.ctx.WithBlockHeight(11)
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, msg)
+	suite.Require().Equal(sdk.NewCoins(expectedTax(core.MicroSDRDenom), expectedTax(core.MicroKRWDenom)), taxes)
+}
+
+// go test -v -run ^TestAnteTestSuite/TestTaxExemptionZone$ github.com/classic-terra/core/custom/auth/ante
+func (suite *AnteTestSuite) TestTaxExemptionZone() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	_, _, hotWallet := testdata.KeyTestPubAddr()
+	_, _, coldWallet := testdata.KeyTestPubAddr()
+	_, _, otherExchange := testdata.KeyTestPubAddr()
+	_, _, user := testdata.KeyTestPubAddr()
+
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000))
+
+	tk := suite.app.TreasuryKeeper
+	tk.SetBurnTaxExemptionZone(suite.ctx, treasurytypes.NewBurnTaxExemptionZone("exchange", true))
+	tk.SetBurnTaxExemptionZone(suite.ctx, treasurytypes.NewBurnTaxExemptionZone("other", true))
+	suite.Require().NoError(tk.AddBurnTaxExemptionZoneAddress(suite.ctx, "exchange", hotWallet.String()))
+	suite.Require().NoError(tk.AddBurnTaxExemptionZoneAddress(suite.ctx, "exchange", coldWallet.String()))
+	suite.Require().NoError(tk.AddBurnTaxExemptionZoneAddress(suite.ctx, "other", otherExchange.String()))
+
+	// exchange-internal transfers are exempted
+	taxes := ante.FilterMsgAndComputeTax(suite.ctx, tk, banktypes.NewMsgSend(hotWallet, coldWallet, sendCoins))
+	suite.Require().True(taxes.IsZero())
+
+	// transfers to other zones or outside are taxed
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, banktypes.NewMsgSend(hotWallet, otherExchange, sendCoins))
+	suite.Require().False(taxes.IsZero())
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, banktypes.NewMsgSend(hotWallet, user, sendCoins))
+	suite.Require().False(taxes.IsZero())
+
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(hotWallet, sendCoins.Add(sendCoins...))},
+		[]banktypes.Output{banktypes.NewOutput(coldWallet, sendCoins), banktypes.NewOutput(user, sendCoins)},
+	)
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, multiSend)
+	suite.Require().False(taxes.IsZero())
+
+	multiSend = banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(hotWallet, sendCoins)},
+		[]banktypes.Output{banktypes.NewOutput(coldWallet, sendCoins)},
+	)
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, multiSend)
+	suite.Require().True(taxes.IsZero())
+
+	// listed zone members are only exempted with the members of their zone
+	for _, address := range []sdk.AccAddress{hotWallet, coldWallet, otherExchange, user} {
+		tk.AddBurnTaxExemptionAddress(suite.ctx, address.String())
+	}
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, banktypes.NewMsgSend(hotWallet, otherExchange, sendCoins))
+	suite.Require().False(taxes.IsZero())
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, banktypes.NewMsgSend(hotWallet, user, sendCoins))
+	suite.Require().False(taxes.IsZero())
+
+	// a disabled zone taxes its internal transfers, listed or not
+	tk.SetBurnTaxExemptionZone(suite.ctx, treasurytypes.NewBurnTaxExemptionZone("exchange", false))
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, banktypes.NewMsgSend(hotWallet, coldWallet, sendCoins))
+	suite.Require().False(taxes.IsZero())
+}
+
+// go test -v -run ^TestAnteTestSuite/TestTaxRateSchedule$ github.com/classic-terra/core/custom/auth/ante
+func (suite *AnteTestSuite) TestTaxRateSchedule() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000), sdk.NewInt64Coin(core.MicroKRWDenom, 1000000))
+
+	tk := suite.app.TreasuryKeeper
+	tk.SetTaxRate(suite.ctx, sdk.NewDecWithPrec(1, 3))
+	tk.SetTaxCap(suite.ctx, core.MicroSDRDenom, sdk.NewInt(1000000))
+	tk.SetTaxCap(suite.ctx, core.MicroKRWDenom, sdk.NewInt(1000000))
+
+	send := banktypes.NewMsgSend(addr1, addr2, sendCoins)
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(addr1, sendCoins)},
+		[]banktypes.Output{banktypes.NewOutput(addr2, sendCoins)},
+	)
+	exec := authz.NewMsgExec(addr1, []sdk.Msg{send})
+
+	// every msg type is taxed at the tax rate by default
+	taxes := ante.FilterMsgAndComputeTax(suite.ctx, tk, send)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000), sdk.NewInt64Coin(core.MicroKRWDenom, 1000)), taxes)
+
+	// the multiplier of the msg type scales the tax rate, nested msgs using their own multiplier
+	tk.SetTaxRateMultipliers(suite.ctx, []treasurytypes.TaxRateMultiplier{
+		{MsgTypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), Multiplier: sdk.NewDecWithPrec(5, 1)},
+		{MsgTypeURL: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), Multiplier: sdk.ZeroDec()},
+	})
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, send)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500), sdk.NewInt64Coin(core.MicroKRWDenom, 500)), taxes)
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, &exec)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500), sdk.NewInt64Coin(core.MicroKRWDenom, 500)), taxes)
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, multiSend)
+	suite.Require().True(taxes.IsZero())
+
+	// the denom tax rate overrides the tax rate before the multiplier
+	tk.SetDenomTaxRates(suite.ctx, []treasurytypes.DenomTaxRate{
+		{Denom: core.MicroKRWDenom, TaxRate: sdk.NewDecWithPrec(4, 3)},
+	})
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, send)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500), sdk.NewInt64Coin(core.MicroKRWDenom, 2000)), taxes)
+	suite.Require().Equal(sdk.NewDecWithPrec(2, 3), tk.GetMsgTaxRate(suite.ctx, sdk.MsgTypeURL(send), core.MicroKRWDenom))
+
+	// a scaled tax rate above one is capped at one
+	tk.SetTaxRateMultipliers(suite.ctx, []treasurytypes.TaxRateMultiplier{
+		{MsgTypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), Multiplier: sdk.NewDec(500)},
+	})
+	tk.SetTaxCap(suite.ctx, core.MicroKRWDenom, sdk.NewInt(10000000))
+	taxes = ante.FilterMsgAndComputeTax(suite.ctx, tk, send)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500000), sdk.NewInt64Coin(core.MicroKRWDenom, 1000000)), taxes)
+	suite.Require().Equal(sdk.OneDec(), tk.GetMsgTaxRate(suite.ctx, sdk.MsgTypeURL(send), core.MicroKRWDenom))
+}
@@ -13,13 +13,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-
-	marketexported "github.com/classic-terra/core/x/market/exported"
-	treasuryexported "github.com/classic-terra/core/x/treasury/exported"
-	wasmexported "github.com/classic-terra/core/x/wasm/exported"
+	customtx "github.com/classic-terra/core/custom/auth/tx"
 )
 
 type (
@@ -190,119 +185,26 @@ func ComputeFeesWithCmd(
 	}, nil
 }
 
-// FilterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
+// FilterMsgAndComputeTax computes the stability tax of the msgs through the ComputeTax tx service,
+// which applies the tax rate multipliers, denom tax rates and burn tax exemptions of the chain.
 func FilterMsgAndComputeTax(clientCtx client.Context, msgs ...sdk.Msg) (taxes sdk.Coins, err error) {
-	taxRate, err := queryTaxRate(clientCtx)
-	if err != nil {
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
 		return nil, err
 	}
 
-	for _, msg := range msgs {
-		switch msg := msg.(type) {
-		case *banktypes.MsgSend:
-			tax, err := computeTax(clientCtx, taxRate, msg.Amount)
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-
-		case *banktypes.MsgMultiSend:
-			for _, input := range msg.Inputs {
-				tax, err := computeTax(clientCtx, taxRate, input.Coins)
-				if err != nil {
-					return nil, err
-				}
-
-				taxes = taxes.Add(tax...)
-			}
-
-		case *authz.MsgExec:
-			messages, err := msg.GetMessages()
-			if err != nil {
-				panic(err)
-			}
-
-			tax, err := FilterMsgAndComputeTax(clientCtx, messages...)
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-
-		case *marketexported.MsgSwapSend:
-			tax, err := computeTax(clientCtx, taxRate, sdk.NewCoins(msg.OfferCoin))
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-
-		case *wasmexported.MsgInstantiateContract:
-			tax, err := computeTax(clientCtx, taxRate, msg.InitCoins)
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-
-		case *wasmexported.MsgExecuteContract:
-			tax, err := computeTax(clientCtx, taxRate, msg.Coins)
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-		}
-	}
-
-	return taxes, nil
-}
-
-// computes the stability tax according to tax-rate and tax-cap
-func computeTax(clientCtx client.Context, taxRate sdk.Dec, principal sdk.Coins) (taxes sdk.Coins, err error) {
-	for _, coin := range principal {
-
-		taxCap, err := queryTaxCap(clientCtx, coin.Denom)
-		if err != nil {
-			return nil, err
-		}
-
-		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
-
-		// If tax due is greater than the tax cap, cap!
-		if taxDue.GT(taxCap) {
-			taxDue = taxCap
-		}
-
-		if taxDue.Equal(sdk.ZeroInt()) {
-			continue
-		}
-
-		taxes = taxes.Add(sdk.NewCoin(coin.Denom, taxDue))
-	}
-
-	return
-}
-
-func queryTaxRate(clientCtx client.Context) (sdk.Dec, error) {
-	queryClient := treasuryexported.NewQueryClient(clientCtx)
-
-	res, err := queryClient.TaxRate(context.Background(), &treasuryexported.QueryTaxRateRequest{})
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
 	if err != nil {
-		return sdk.ZeroDec(), err
+		return nil, err
 	}
-	return res.TaxRate, err
-}
 
-func queryTaxCap(clientCtx client.Context, denom string) (sdk.Int, error) {
-	queryClient := treasuryexported.NewQueryClient(clientCtx)
-
-	res, err := queryClient.TaxCap(context.Background(), &treasuryexported.QueryTaxCapRequest{Denom: denom})
+	queryClient := customtx.NewServiceClient(clientCtx)
+	res, err := queryClient.ComputeTax(context.Background(), &customtx.ComputeTaxRequest{TxBytes: txBytes})
 	if err != nil {
-		return sdk.NewInt(0), err
+		return nil, err
 	}
-	return res.TaxCap, err
+
+	return res.TaxAmount, nil
 }
 
 // ParseFloat64 parses string to float64
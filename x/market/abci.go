@@ -3,11 +3,24 @@ package market
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	core "github.com/classic-terra/core/types"
 	"github.com/classic-terra/core/x/market/keeper"
 )
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// Clears the swaps queued in batch swap mode before the pools move
+	k.ClearBatchSwapOrders(ctx)
+
 	// Replenishes each pools towards equilibrium
 	k.ReplenishPools(ctx)
+
+	// Resets the swap volumes of the block, and of the epoch at its last block
+	k.ClearBlockSwapVolumes(ctx)
+	if core.IsPeriodLastBlock(ctx, k.SwapVolumeEpoch(ctx)) {
+		k.ClearEpochSwapVolumes(ctx)
+	}
+
+	// Drops the swap statistics of the periods beyond the retention
+	k.PruneSwapStatistics(ctx)
 }
@@ -3,7 +3,9 @@ package market
 import (
 	"testing"
 
+	core "github.com/classic-terra/core/types"
 	"github.com/classic-terra/core/x/market/keeper"
+	"github.com/classic-terra/core/x/market/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,3 +29,62 @@ func TestReplenishPools(t *testing.T) {
 		require.Equal(t, terraDelta.Sub(terraRegressionAmt), terraPoolDelta)
 	}
 }
+
+func TestResetSwapVolumes(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	swapVolumeEpoch := input.MarketKeeper.SwapVolumeEpoch(input.Ctx)
+	input.MarketKeeper.SetBlockSwapVolume(input.Ctx, core.MicroSDRDenom, sdk.NewInt(10))
+	input.MarketKeeper.SetEpochSwapVolume(input.Ctx, core.MicroSDRDenom, sdk.NewInt(10))
+
+	// block volumes are reset every block
+	input.Ctx = input.Ctx.WithBlockHeight(int64(swapVolumeEpoch) - 2)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+	require.True(t, input.MarketKeeper.GetBlockSwapVolume(input.Ctx, core.MicroSDRDenom).IsZero())
+	require.Equal(t, sdk.NewInt(10), input.MarketKeeper.GetEpochSwapVolume(input.Ctx, core.MicroSDRDenom))
+
+	// epoch volumes are reset at the last block of the epoch
+	input.Ctx = input.Ctx.WithBlockHeight(int64(swapVolumeEpoch) - 1)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+	require.True(t, input.MarketKeeper.GetEpochSwapVolume(input.Ctx, core.MicroSDRDenom).IsZero())
+}
+
+func TestPruneSwapStatistics(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	period := input.MarketKeeper.SwapStatisticsPeriod(input.Ctx)
+	retention := input.MarketKeeper.SwapStatisticsRetention(input.Ctx)
+	offerCoin := sdk.NewInt64Coin(core.MicroKRWDenom, 100)
+	swapCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 20)
+
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	input.MarketKeeper.RecordSwapStatistics(input.Ctx, offerCoin, swapCoin, sdk.NewInt64Coin(core.MicroLunaDenom, 0))
+
+	// retained until the period falls out of the retention
+	input.Ctx = input.Ctx.WithBlockHeight(int64(period*retention) - 1)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+	require.Len(t, input.MarketKeeper.AggregateSwapStatistics(input.Ctx, retention, "", ""), 1)
+
+	input.Ctx = input.Ctx.WithBlockHeight(int64(period * retention))
+	EndBlocker(input.Ctx, input.MarketKeeper)
+	require.Equal(t, sdk.ZeroInt(), input.MarketKeeper.GetSwapStatistics(input.Ctx, 0, core.MicroKRWDenom, core.MicroLunaDenom).OfferVolume)
+}
+
+func TestClearBatchSwapOrders(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.BatchSwapEnabled = true
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000))
+	_, err := h(input.Ctx, types.NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom))
+	require.NoError(t, err)
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroSDRDenom).IsZero())
+
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroSDRDenom).IsPositive())
+	_, found := input.MarketKeeper.GetBatchSwapOrder(input.Ctx, 1)
+	require.False(t, found)
+}
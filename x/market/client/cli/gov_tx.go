@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/classic-terra/core/x/market/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+func ProposalUpdateTerraSwapFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-terra-swap-fee [offer-denom] [ask-denom] [fee] --title [text] --description [text]",
+		Short: "Submit a proposal to set the swap fee of a terra denom pair",
+		Long: fmt.Sprintf(`Submit a proposal to set the spread charged on the swaps from the offer denom to the ask denom, in place of the oracle tobin tax.
+Example:
+$ %s tx gov submit-proposal update-terra-swap-fee ukrw uusd 0.003 --title "ukrw to uusd swap fee" --description "lower the ukrw to uusd swap fee"
+			`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				fee, err := sdk.NewDecFromStr(args[2])
+				if err != nil {
+					return nil, err
+				}
+
+				return types.NewUpdateTerraSwapFeeProposal(title, description, types.NewTerraSwapFee(args[0], args[1], fee)), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveTerraSwapFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-terra-swap-fee [offer-denom] [ask-denom] --title [text] --description [text]",
+		Short: "Submit a proposal to remove the swap fee of a terra denom pair",
+		Long: fmt.Sprintf(`Submit a proposal to remove the swap fee of a terra denom pair, so its swaps fall back to the oracle tobin tax.
+Example:
+$ %s tx gov submit-proposal remove-terra-swap-fee ukrw uusd --title "remove ukrw to uusd swap fee" --description "charge the tobin tax again"
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				return types.NewRemoveTerraSwapFeeProposal(title, description, args[0], args[1]), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUpdateIBCDenomMappingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-ibc-denom-mapping [ibc-denom] [native-denom] --title [text] --description [text]",
+		Short: "Submit a proposal to map an ibc denom to a native denom",
+		Long: fmt.Sprintf(`Submit a proposal to swap the ibc voucher of a terra asset bridged back as the native denom it traces back to.
+The denom trace of the ibc denom must be known to the ibc transfer module and trace back to the native denom.
+Example:
+$ %s tx gov submit-proposal update-ibc-denom-mapping ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 uusd --title "swap bridged uusd" --description "swap the uusd bridged back as uusd"
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				return types.NewUpdateIBCDenomMappingProposal(title, description, types.NewIBCDenomMapping(args[0], args[1])), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveIBCDenomMappingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-ibc-denom-mapping [ibc-denom] --title [text] --description [text]",
+		Short: "Submit a proposal to remove the mapping of an ibc denom",
+		Long: fmt.Sprintf(`Submit a proposal to remove the mapping of an ibc denom, so the ibc voucher can no longer be swapped.
+Example:
+$ %s tx gov submit-proposal remove-ibc-denom-mapping ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --title "stop swapping bridged uusd" --description "the channel is deprecated"
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				return types.NewRemoveIBCDenomMappingProposal(title, description, args[0]), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// submitProposal reads the proposal flags and broadcasts the proposal built with them
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) (govtypes.Content, error)) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return fmt.Errorf("proposal title: %s", err)
+	}
+	proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+	if err != nil {
+		return fmt.Errorf("proposal description: %s", err)
+	}
+	depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositArg)
+	if err != nil {
+		return err
+	}
+
+	content, err := newContent(proposalTitle, proposalDescr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+}
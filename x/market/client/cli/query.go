@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -25,6 +26,16 @@ func GetQueryCmd() *cobra.Command {
 
 	marketQueryCmd.AddCommand(
 		GetCmdQuerySwap(),
+		GetCmdQuerySwapRoute(),
+		GetCmdQuerySwapExactOut(),
+		GetCmdQuerySwapVolume(),
+		GetCmdQuerySwapStatistics(),
+		GetCmdQueryTerraSwapFee(),
+		GetCmdQueryTerraSwapFees(),
+		GetCmdQuerySwapFeeAccounting(),
+		GetCmdQueryBatchSwapOrders(),
+		GetCmdQueryIBCDenomMapping(),
+		GetCmdQueryIBCDenomMappings(),
 		GetCmdQueryTerraPoolDelta(),
 		GetCmdQueryParams(),
 	)
@@ -74,6 +85,393 @@ $ terrad query swap 5000000uluna usdr
 	return cmd
 }
 
+// GetCmdQuerySwapRoute implements the query swap route simulation result command.
+func GetCmdQuerySwapRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route [offer-coin] [ask-denoms]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a quote for a swap through an ordered list of denoms",
+		Long: strings.TrimSpace(`
+Query a quote for how many coins can be received in a swap through the comma separated ask-denoms,
+along with the spread and fee of each hop. Note; rates are dynamic and can quickly change.
+
+$ terrad query market swap-route 5000000ukrw uluna,usdr
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// parse offerCoin
+			offerCoinStr := args[0]
+			_, err = sdk.ParseCoinNormalized(offerCoinStr)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SwapRoute(context.Background(),
+				&types.QuerySwapRouteRequest{OfferCoin: offerCoinStr, AskDenoms: strings.Split(args[1], ",")},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySwapExactOut implements the query exact output swap simulation result command.
+func GetCmdQuerySwapExactOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-out [ask-coin] [offer-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a quote for the offer needed to receive an exact amount",
+		Long: strings.TrimSpace(`
+Query a quote for how many coins must be offered to receive the ask-coin in a swap operation,
+including the spread. Note; rates are dynamic and can quickly change.
+
+$ terrad query market swap-exact-out 5000000usdr uluna
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// parse askCoin
+			askCoinStr := args[0]
+			_, err = sdk.ParseCoinNormalized(askCoinStr)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SwapExactOut(context.Background(),
+				&types.QuerySwapExactOutRequest{AskCoin: askCoinStr, OfferDenom: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySwapVolume implements the query swap volume command.
+func GetCmdQuerySwapVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-volume [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the swap volume and the remaining capacity of a denom",
+		Long: strings.TrimSpace(`
+Query the net amount of the denom minted by swaps, negative when burned, in the current block
+and swap volume epoch, along with the amount swaps can still mint and burn under the caps.
+
+$ terrad query market swap-volume uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SwapVolume(context.Background(),
+				&types.QuerySwapVolumeRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySwapStatistics implements the query swap statistics command.
+func GetCmdQuerySwapStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-statistics [window] [offer-denom] [ask-denom]",
+		Args:  cobra.RangeArgs(1, 3),
+		Short: "Query the swap statistics of each denom pair over the last periods",
+		Long: strings.TrimSpace(`
+Query the volume offered, the volume received, the swap fees and the number of swaps of each
+denom pair, summed over the last [window] swap statistics periods including the current one.
+
+$ terrad query market swap-statistics 7
+
+Or, only the swaps from ukrw, or from ukrw to uluna
+
+$ terrad query market swap-statistics 7 ukrw
+$ terrad query market swap-statistics 7 ukrw uluna
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySwapStatisticsRequest{Window: window}
+			if len(args) > 1 {
+				req.OfferDenom = args[1]
+			}
+			if len(args) > 2 {
+				req.AskDenom = args[2]
+			}
+
+			res, err := queryClient.SwapStatistics(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTerraSwapFee implements the query terra swap fee command.
+func GetCmdQueryTerraSwapFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terra-swap-fee [offer-denom] [ask-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the swap fee charged on the swaps of a terra denom pair",
+		Long: strings.TrimSpace(`
+Query the spread charged on the swaps from the offer denom to the ask denom, and whether
+it falls back to the oracle tobin tax as no swap fee is set for the pair.
+
+$ terrad query market terra-swap-fee ukrw uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TerraSwapFee(context.Background(),
+				&types.QueryTerraSwapFeeRequest{OfferDenom: args[0], AskDenom: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTerraSwapFees implements the query terra swap fees command.
+func GetCmdQueryTerraSwapFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terra-swap-fees",
+		Args:  cobra.NoArgs,
+		Short: "Query the swap fees set for the terra denom pairs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TerraSwapFees(context.Background(),
+				&types.QueryTerraSwapFeesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "terra swap fees")
+	return cmd
+}
+
+// GetCmdQuerySwapFeeAccounting implements the query swap fee accounting command.
+func GetCmdQuerySwapFeeAccounting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-fee-accounting [destination]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the cumulative swap fees routed to each destination",
+		Long: strings.TrimSpace(`
+Query the cumulative swap fees burned, sent to the oracle reward pool and sent to the community pool.
+The destination, one of burn, oracle or community_pool, can be given to query a single destination.
+
+$ terrad query market swap-fee-accounting
+$ terrad query market swap-fee-accounting community_pool
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var destination string
+			if len(args) > 0 {
+				destination = args[0]
+			}
+
+			res, err := queryClient.SwapFeeAccounting(context.Background(),
+				&types.QuerySwapFeeAccountingRequest{Destination: destination},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBatchSwapOrders implements the query batch swap orders command.
+func GetCmdQueryBatchSwapOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-swap-orders [trader]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the swaps queued for the batch of the current block",
+		Long: strings.TrimSpace(`
+Query the swaps queued in batch swap mode, to be cleared at the end of the current block.
+The trader address can be given to query the orders of a single trader.
+
+$ terrad query market batch-swap-orders
+$ terrad query market batch-swap-orders terra1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var trader string
+			if len(args) > 0 {
+				trader = args[0]
+			}
+
+			res, err := queryClient.BatchSwapOrders(context.Background(),
+				&types.QueryBatchSwapOrdersRequest{Trader: trader, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch swap orders")
+	return cmd
+}
+
+// GetCmdQueryIBCDenomMapping implements the query ibc denom mapping command.
+func GetCmdQueryIBCDenomMapping() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-denom-mapping [ibc-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the native denom an ibc denom is mapped to",
+		Long: strings.TrimSpace(`
+Query the native denom an ibc voucher is swapped as.
+
+$ terrad query market ibc-denom-mapping ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IBCDenomMapping(context.Background(),
+				&types.QueryIBCDenomMappingRequest{IBCDenom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIBCDenomMappings implements the query ibc denom mappings command.
+func GetCmdQueryIBCDenomMappings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-denom-mappings",
+		Args:  cobra.NoArgs,
+		Short: "Query the native denoms the ibc denoms are mapped to",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.IBCDenomMappings(context.Background(),
+				&types.QueryIBCDenomMappingsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ibc denom mappings")
+	return cmd
+}
+
 // GetCmdQueryTerraPoolDelta implements the query mint pool delta command.
 func GetCmdQueryTerraPoolDelta() *cobra.Command {
 	cmd := &cobra.Command{
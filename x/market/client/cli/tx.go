@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,6 +15,11 @@ import (
 	"github.com/classic-terra/core/x/market/types"
 )
 
+const (
+	flagMinReceiveAmount = "min-receive-amount"
+	flagMaxSpread        = "max-spread"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	marketTxCmd := &cobra.Command{
@@ -26,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 
 	marketTxCmd.AddCommand(
 		GetSwapCmd(),
+		GetSwapRouteCmd(),
+		GetSwapExactOutCmd(),
 	)
 
 	return marketTxCmd
@@ -45,6 +53,11 @@ $ terrad market swap "1000ukrw" "uusd"
 The to-address can be specified. A default to-address is trader.
 
 $ terrad market swap "1000ukrw" "uusd" "terra1..."
+
+The swap can be protected against slippage by the minimum amount to receive
+and the maximum spread the trader accepts.
+
+$ terrad market swap "1000ukrw" "uusd" --min-receive-amount "700" --max-spread "0.02"
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -64,6 +77,11 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 			askDenom := args[1]
 			fromAddress := clientCtx.GetFromAddress()
 
+			minReceiveAmount, maxSpread, err := parseSlippageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			var msg sdk.Msg
 			if len(args) == 3 {
 				toAddress, err := sdk.AccAddressFromBech32(args[2])
@@ -71,7 +89,11 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 					return err
 				}
 
-				msg = types.NewMsgSwapSend(fromAddress, toAddress, offerCoin, askDenom)
+				swapSendMsg := types.NewMsgSwapSend(fromAddress, toAddress, offerCoin, askDenom)
+				swapSendMsg.MinReceiveAmount = minReceiveAmount
+				swapSendMsg.MaxSpread = maxSpread
+
+				msg = swapSendMsg
 				if err = msg.ValidateBasic(); err != nil {
 					return err
 				}
@@ -91,7 +113,11 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 						WithGasPrices("")
 				}
 			} else {
-				msg = types.NewMsgSwap(fromAddress, offerCoin, askDenom)
+				swapMsg := types.NewMsgSwap(fromAddress, offerCoin, askDenom)
+				swapMsg.MinReceiveAmount = minReceiveAmount
+				swapMsg.MaxSpread = maxSpread
+
+				msg = swapMsg
 				if err = msg.ValidateBasic(); err != nil {
 					return err
 				}
@@ -102,7 +128,213 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 		},
 	}
 
+	cmd.Flags().String(flagMinReceiveAmount, "", "Minimum amount of the ask denom to receive")
+	cmd.Flags().String(flagMaxSpread, "", "Maximum spread ratio to accept")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSwapRouteCmd will create and send a MsgSwapRoute
+func GetSwapRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route [offer-coin] [ask-denoms] [to-address]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Atomically swap currencies through an ordered list of denoms",
+		Long: strings.TrimSpace(`
+Swap the offer-coin through each of the comma separated ask-denoms in order, in a single transaction.
+The last ask-denom is the currency to receive.
+
+$ terrad market swap-route "1000ukrw" "uluna,uusd"
+
+The to-address can be specified. A default to-address is trader.
+
+$ terrad market swap-route "1000ukrw" "uluna,uusd" "terra1..."
+
+The swap can be protected against slippage by the minimum amount of the last ask-denom to receive.
+
+$ terrad market swap-route "1000ukrw" "uluna,uusd" --min-receive-amount "700"
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Generate transaction factory for gas simulation
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			askDenoms := strings.Split(args[1], ",")
+			fromAddress := clientCtx.GetFromAddress()
+
+			minReceiveAmount, err := parseMinReceiveAmountFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapRoute(fromAddress, offerCoin, askDenoms)
+			msg.MinReceiveAmount = minReceiveAmount
+
+			if len(args) == 3 {
+				toAddress, err := sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+
+				msg.Receiver = toAddress.String()
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if msg.Receiver != "" && !clientCtx.GenerateOnly && txf.Fees().IsZero() {
+				// estimate tax and gas
+				stdFee, err := feeutils.ComputeFeesWithCmd(clientCtx, cmd.Flags(), msg)
+				if err != nil {
+					return err
+				}
+
+				// override gas and fees
+				txf = txf.
+					WithFees(stdFee.Amount.String()).
+					WithGas(stdFee.Gas).
+					WithSimulateAndExecute(false).
+					WithGasPrices("")
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(flagMinReceiveAmount, "", "Minimum amount of the last ask denom to receive")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSwapExactOutCmd will create and send a MsgSwapExactOut
+func GetSwapExactOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-out [ask-coin] [offer-denom] [max-offer-amount] [to-address]",
+		Args:  cobra.RangeArgs(3, 4),
+		Short: "Atomically swap currencies to receive an exact amount",
+		Long: strings.TrimSpace(`
+Swap the offer-denom currency to receive the ask-coin at the oracle's effective exchange rate.
+The swap fails when it needs more than max-offer-amount of the offer-denom.
+
+$ terrad market swap-exact-out "1000uusd" "ukrw" "1500000"
+
+The to-address can be specified. A default to-address is trader.
+
+$ terrad market swap-exact-out "1000uusd" "ukrw" "1500000" "terra1..."
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Generate transaction factory for gas simulation
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			askCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			offerDenom := args[1]
+			maxOfferAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid max offer amount: %s", args[2])
+			}
+
+			msg := types.NewMsgSwapExactOut(clientCtx.GetFromAddress(), askCoin, offerDenom, maxOfferAmount)
+			if len(args) == 4 {
+				toAddress, err := sdk.AccAddressFromBech32(args[3])
+				if err != nil {
+					return err
+				}
+
+				msg.Receiver = toAddress.String()
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if msg.Receiver != "" && !clientCtx.GenerateOnly && txf.Fees().IsZero() {
+				// estimate tax and gas
+				stdFee, err := feeutils.ComputeFeesWithCmd(clientCtx, cmd.Flags(), msg)
+				if err != nil {
+					return err
+				}
+
+				// override gas and fees
+				txf = txf.
+					WithFees(stdFee.Amount.String()).
+					WithGas(stdFee.Gas).
+					WithSimulateAndExecute(false).
+					WithGasPrices("")
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseMinReceiveAmountFlag reads the optional minimum amount to receive from a swap
+func parseMinReceiveAmountFlag(cmd *cobra.Command) (*sdk.Int, error) {
+	minReceiveAmountStr, err := cmd.Flags().GetString(flagMinReceiveAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if minReceiveAmountStr == "" {
+		return nil, nil
+	}
+
+	amount, ok := sdk.NewIntFromString(minReceiveAmountStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid min receive amount: %s", minReceiveAmountStr)
+	}
+
+	return &amount, nil
+}
+
+// parseSlippageFlags reads the optional slippage protection of a swap
+func parseSlippageFlags(cmd *cobra.Command) (*sdk.Int, *sdk.Dec, error) {
+	var maxSpread *sdk.Dec
+
+	minReceiveAmount, err := parseMinReceiveAmountFlag(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxSpreadStr, err := cmd.Flags().GetString(flagMaxSpread)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if maxSpreadStr != "" {
+		spread, err := sdk.NewDecFromStr(maxSpreadStr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		maxSpread = &spread
+	}
+
+	return minReceiveAmount, maxSpread, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"github.com/classic-terra/core/x/market/client/cli"
+	"github.com/classic-terra/core/x/market/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	ProposalUpdateTerraSwapFeeHandler = govclient.NewProposalHandler(cli.ProposalUpdateTerraSwapFeeCmd, rest.UpdateTerraSwapFeeProposalRESTHandler)
+	ProposalRemoveTerraSwapFeeHandler = govclient.NewProposalHandler(cli.ProposalRemoveTerraSwapFeeCmd, rest.RemoveTerraSwapFeeProposalRESTHandler)
+
+	ProposalUpdateIBCDenomMappingHandler = govclient.NewProposalHandler(cli.ProposalUpdateIBCDenomMappingCmd, rest.UpdateIBCDenomMappingProposalRESTHandler)
+	ProposalRemoveIBCDenomMappingHandler = govclient.NewProposalHandler(cli.ProposalRemoveIBCDenomMappingCmd, rest.RemoveIBCDenomMappingProposalRESTHandler)
+)
@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/classic-terra/core/x/market/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type (
+	updateTerraSwapFeeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		OfferDenom  string         `json:"offer_denom" yaml:"offer_denom"`
+		AskDenom    string         `json:"ask_denom" yaml:"ask_denom"`
+		Fee         sdk.Dec        `json:"fee" yaml:"fee"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	removeTerraSwapFeeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		OfferDenom  string         `json:"offer_denom" yaml:"offer_denom"`
+		AskDenom    string         `json:"ask_denom" yaml:"ask_denom"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	updateIBCDenomMappingProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		IBCDenom    string         `json:"ibc_denom" yaml:"ibc_denom"`
+		NativeDenom string         `json:"native_denom" yaml:"native_denom"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	removeIBCDenomMappingProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		IBCDenom    string         `json:"ibc_denom" yaml:"ibc_denom"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// UpdateTerraSwapFeeProposalRESTHandler returns a ProposalRESTHandler that exposes the update terra swap fee REST handler with a given sub-route.
+func UpdateTerraSwapFeeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_terra_swap_fee",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req updateTerraSwapFeeProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			fee := types.NewTerraSwapFee(req.OfferDenom, req.AskDenom, req.Fee)
+			content := types.NewUpdateTerraSwapFeeProposal(req.Title, req.Description, fee)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// RemoveTerraSwapFeeProposalRESTHandler returns a ProposalRESTHandler that exposes the remove terra swap fee REST handler with a given sub-route.
+func RemoveTerraSwapFeeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_terra_swap_fee",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req removeTerraSwapFeeProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewRemoveTerraSwapFeeProposal(req.Title, req.Description, req.OfferDenom, req.AskDenom)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// UpdateIBCDenomMappingProposalRESTHandler returns a ProposalRESTHandler that exposes the update ibc denom mapping REST handler with a given sub-route.
+func UpdateIBCDenomMappingProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_ibc_denom_mapping",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req updateIBCDenomMappingProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			mapping := types.NewIBCDenomMapping(req.IBCDenom, req.NativeDenom)
+			content := types.NewUpdateIBCDenomMappingProposal(req.Title, req.Description, mapping)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// RemoveIBCDenomMappingProposalRESTHandler returns a ProposalRESTHandler that exposes the remove ibc denom mapping REST handler with a given sub-route.
+func RemoveIBCDenomMappingProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_ibc_denom_mapping",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req removeIBCDenomMappingProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewRemoveIBCDenomMappingProposal(req.Title, req.Description, req.IBCDenom)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func writeProposalResponse(clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
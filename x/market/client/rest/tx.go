@@ -17,6 +17,8 @@ import (
 
 func registerTxHandlers(clientCtx client.Context, rtr *mux.Router) {
 	rtr.HandleFunc("/market/swap", submitSwapHandlerFn(clientCtx)).Methods("POST")
+	rtr.HandleFunc("/market/swap_route", submitSwapRouteHandlerFn(clientCtx)).Methods("POST")
+	rtr.HandleFunc("/market/swap_exact_out", submitSwapExactOutHandlerFn(clientCtx)).Methods("POST")
 }
 
 type (
@@ -25,6 +27,25 @@ type (
 		OfferCoin sdk.Coin     `json:"offer_coin"`
 		AskDenom  string       `json:"ask_denom"`
 		Receiver  string       `json:"receiver,omitempty"`
+
+		MinReceiveAmount *sdk.Int `json:"min_receive_amount,omitempty"`
+		MaxSpread        *sdk.Dec `json:"max_spread,omitempty"`
+	}
+
+	swapRouteReq struct {
+		BaseReq          rest.BaseReq `json:"base_req"`
+		OfferCoin        sdk.Coin     `json:"offer_coin"`
+		AskDenoms        []string     `json:"ask_denoms"`
+		Receiver         string       `json:"receiver,omitempty"`
+		MinReceiveAmount *sdk.Int     `json:"min_receive_amount,omitempty"`
+	}
+
+	swapExactOutReq struct {
+		BaseReq        rest.BaseReq `json:"base_req"`
+		AskCoin        sdk.Coin     `json:"ask_coin"`
+		OfferDenom     string       `json:"offer_denom"`
+		MaxOfferAmount sdk.Int      `json:"max_offer_amount"`
+		Receiver       string       `json:"receiver,omitempty"`
 	}
 )
 
@@ -49,7 +70,11 @@ func submitSwapHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		// create the message depends on the toAddress existence
 		var msg sdk.Msg
 		if req.Receiver == "" {
-			msg = types.NewMsgSwap(fromAddress, req.OfferCoin, req.AskDenom)
+			swapMsg := types.NewMsgSwap(fromAddress, req.OfferCoin, req.AskDenom)
+			swapMsg.MinReceiveAmount = req.MinReceiveAmount
+			swapMsg.MaxSpread = req.MaxSpread
+
+			msg = swapMsg
 			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 				return
 			}
@@ -59,7 +84,11 @@ func submitSwapHandlerFn(clientCtx client.Context) http.HandlerFunc {
 				return
 			}
 
-			msg := types.NewMsgSwapSend(fromAddress, toAddress, req.OfferCoin, req.AskDenom)
+			swapSendMsg := types.NewMsgSwapSend(fromAddress, toAddress, req.OfferCoin, req.AskDenom)
+			swapSendMsg.MinReceiveAmount = req.MinReceiveAmount
+			swapSendMsg.MaxSpread = req.MaxSpread
+
+			msg = swapSendMsg
 			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 				return
 			}
@@ -80,3 +109,84 @@ func submitSwapHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// submitSwapRouteHandlerFn handles a POST swap route request
+func submitSwapRouteHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req swapRouteReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgSwapRoute(fromAddress, req.OfferCoin, req.AskDenoms)
+		msg.Receiver = req.Receiver
+		msg.MinReceiveAmount = req.MinReceiveAmount
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		if req.Receiver != "" && req.BaseReq.Fees.IsZero() {
+			stdFee, err := feeutils.ComputeFeesWithBaseReq(clientCtx, req.BaseReq, msg)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			// override gas and fees
+			req.BaseReq.Gas = strconv.FormatUint(stdFee.Gas, 10)
+			req.BaseReq.Fees = stdFee.Amount
+			req.BaseReq.GasPrices = sdk.DecCoins{}
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// submitSwapExactOutHandlerFn handles a POST exact output swap request
+func submitSwapExactOutHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req swapExactOutReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgSwapExactOut(fromAddress, req.AskCoin, req.OfferDenom, req.MaxOfferAmount)
+		msg.Receiver = req.Receiver
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		if req.Receiver != "" && req.BaseReq.Fees.IsZero() {
+			stdFee, err := feeutils.ComputeFeesWithBaseReq(clientCtx, req.BaseReq, msg)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			// override gas and fees
+			req.BaseReq.Gas = strconv.FormatUint(stdFee.Gas, 10)
+			req.BaseReq.Fees = stdFee.Amount
+			req.BaseReq.GasPrices = sdk.DecCoins{}
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -4,6 +4,8 @@ package exported
 import "github.com/classic-terra/core/x/market/types"
 
 type (
-	MsgSwap     = types.MsgSwap
-	MsgSwapSend = types.MsgSwapSend
+	MsgSwap         = types.MsgSwap
+	MsgSwapSend     = types.MsgSwapSend
+	MsgSwapRoute    = types.MsgSwapRoute
+	MsgSwapExactOut = types.MsgSwapExactOut
 )
@@ -15,6 +15,34 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	keeper.SetParams(ctx, data.Params)
 	keeper.SetTerraPoolDelta(ctx, data.TerraPoolDelta)
 
+	for _, volume := range data.EpochSwapVolumes {
+		keeper.SetEpochSwapVolume(ctx, volume.Denom, volume.Volume)
+	}
+
+	for _, record := range data.SwapStatistics {
+		keeper.SetSwapStatistics(ctx, record.Period, record.Statistics)
+	}
+
+	for _, fee := range data.TerraSwapFees {
+		keeper.SetTerraSwapFee(ctx, fee)
+	}
+
+	for _, total := range data.SwapFeeTotals {
+		keeper.SetSwapFeeTotal(ctx, total)
+	}
+
+	for _, order := range data.BatchSwapOrders {
+		keeper.SetBatchSwapOrder(ctx, order)
+	}
+
+	if data.NextBatchSwapOrderID > 0 {
+		keeper.SetNextBatchSwapOrderID(ctx, data.NextBatchSwapOrderID)
+	}
+
+	for _, mapping := range data.IBCDenomMappings {
+		keeper.SetIBCDenomMapping(ctx, mapping)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetMarketAccount(ctx)
 	if moduleAcc == nil {
@@ -29,5 +57,45 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	params := keeper.GetParams(ctx)
 	terraPoolDelta := keeper.GetTerraPoolDelta(ctx)
 
-	return types.NewGenesisState(terraPoolDelta, params)
+	epochSwapVolumes := []types.SwapVolume{}
+	keeper.IterateEpochSwapVolumes(ctx, func(denom string, volume sdk.Int) (stop bool) {
+		epochSwapVolumes = append(epochSwapVolumes, types.NewSwapVolume(denom, volume))
+		return false
+	})
+
+	swapStatistics := []types.SwapStatisticsRecord{}
+	keeper.IterateSwapStatistics(ctx, func(period uint64, statistics types.SwapStatistics) (stop bool) {
+		swapStatistics = append(swapStatistics, types.NewSwapStatisticsRecord(period, statistics))
+		return false
+	})
+
+	terraSwapFees := []types.TerraSwapFee{}
+	keeper.IterateTerraSwapFees(ctx, func(fee types.TerraSwapFee) (stop bool) {
+		terraSwapFees = append(terraSwapFees, fee)
+		return false
+	})
+
+	swapFeeTotals := []types.SwapFeeTotal{}
+	for _, total := range keeper.GetSwapFeeTotals(ctx) {
+		if !total.Amount.IsZero() {
+			swapFeeTotals = append(swapFeeTotals, total)
+		}
+	}
+
+	batchSwapOrders := []types.BatchSwapOrder{}
+	keeper.IterateBatchSwapOrders(ctx, func(order types.BatchSwapOrder) (stop bool) {
+		batchSwapOrders = append(batchSwapOrders, order)
+		return false
+	})
+
+	ibcDenomMappings := []types.IBCDenomMapping{}
+	keeper.IterateIBCDenomMappings(ctx, func(mapping types.IBCDenomMapping) (stop bool) {
+		ibcDenomMappings = append(ibcDenomMappings, mapping)
+		return false
+	})
+
+	return types.NewGenesisState(
+		terraPoolDelta, params, epochSwapVolumes, swapStatistics, terraSwapFees, swapFeeTotals,
+		batchSwapOrders, keeper.GetNextBatchSwapOrderID(ctx), ibcDenomMappings,
+	)
 }
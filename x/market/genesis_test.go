@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/classic-terra/core/x/market/keeper"
+	"github.com/classic-terra/core/x/market/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,13 @@ import (
 func TestExportInitGenesis(t *testing.T) {
 	input := keeper.CreateTestInput(t)
 	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, sdk.NewDec(1123))
+	input.MarketKeeper.SetEpochSwapVolume(input.Ctx, "usdr", sdk.NewInt(-1234))
+	input.MarketKeeper.SetEpochSwapVolume(input.Ctx, "ukrw", sdk.NewInt(5678))
+	input.MarketKeeper.RecordSwapStatistics(input.Ctx, sdk.NewInt64Coin("ukrw", 100), sdk.NewInt64Coin("uluna", 20), sdk.NewInt64Coin("uluna", 1))
+	input.MarketKeeper.SetTerraSwapFee(input.Ctx, types.NewTerraSwapFee("ukrw", "uusd", sdk.NewDecWithPrec(3, 3)))
+	input.MarketKeeper.SetSwapFeeTotal(input.Ctx, types.NewSwapFeeTotal(types.SwapFeeDestinationOracle, sdk.NewCoins(sdk.NewInt64Coin("uusd", 100))))
+	input.MarketKeeper.QueueBatchSwapOrder(input.Ctx, types.NewBatchSwapOrder(keeper.Addrs[0], keeper.Addrs[1], sdk.NewInt64Coin("uluna", 1000), "uusd", nil, nil))
+	input.MarketKeeper.SetIBCDenomMapping(input.Ctx, types.NewIBCDenomMapping("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "uusd"))
 	genesis := ExportGenesis(input.Ctx, input.MarketKeeper)
 
 	newInput := keeper.CreateTestInput(t)
@@ -19,4 +27,11 @@ func TestExportInitGenesis(t *testing.T) {
 	newGenesis := ExportGenesis(newInput.Ctx, newInput.MarketKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+	require.Len(t, newGenesis.EpochSwapVolumes, 2)
+	require.Len(t, newGenesis.SwapStatistics, 1)
+	require.Len(t, newGenesis.TerraSwapFees, 1)
+	require.Len(t, newGenesis.SwapFeeTotals, 1)
+	require.Len(t, newGenesis.BatchSwapOrders, 1)
+	require.Equal(t, uint64(2), newGenesis.NextBatchSwapOrderID)
+	require.Len(t, newGenesis.IBCDenomMappings, 1)
 }
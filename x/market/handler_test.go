@@ -11,6 +11,7 @@ import (
 	core "github.com/classic-terra/core/types"
 	"github.com/classic-terra/core/x/market/keeper"
 	"github.com/classic-terra/core/x/market/types"
+	oracletypes "github.com/classic-terra/core/x/oracle/types"
 )
 
 func TestMarketFilters(t *testing.T) {
@@ -104,3 +105,213 @@ func TestSwapSendMsg(t *testing.T) {
 	balance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[1], core.MicroSDRDenom)
 	require.Equal(t, expectedAmt, balance.Amount)
 }
+
+func TestSwapMsgSlippage(t *testing.T) {
+	input, h := setup(t)
+
+	amt := sdk.NewInt(10)
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, amt)
+	retCoin, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+
+	expectedAmt := retCoin.Amount.Mul(sdk.OneDec().Sub(spread)).TruncateInt()
+
+	// spread greater than the max spread fails
+	maxSpread := spread.Sub(sdk.NewDecWithPrec(1, 4))
+	swapMsg := types.NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	swapMsg.MaxSpread = &maxSpread
+	_, err = h(input.Ctx, swapMsg)
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	// return amount less than the min receive amount fails
+	minReceiveAmount := expectedAmt.AddRaw(1)
+	swapSendMsg := types.NewMsgSwapSend(keeper.Addrs[0], keeper.Addrs[1], offerCoin, core.MicroSDRDenom)
+	swapSendMsg.MinReceiveAmount = &minReceiveAmount
+	_, err = h(input.Ctx, swapSendMsg)
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	balance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[1], core.MicroSDRDenom)
+	require.True(t, balance.Amount.IsZero())
+
+	// swap within the slippage bounds succeeds
+	maxSpread = spread
+	minReceiveAmount = expectedAmt
+	swapSendMsg.MinReceiveAmount = &minReceiveAmount
+	swapSendMsg.MaxSpread = &maxSpread
+	_, err = h(input.Ctx, swapSendMsg)
+	require.NoError(t, err)
+
+	balance = input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[1], core.MicroSDRDenom)
+	require.Equal(t, expectedAmt, balance.Amount)
+}
+
+func TestSwapRouteMsg(t *testing.T) {
+	input, h := setup(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000))
+	route := []string{core.MicroSDRDenom, core.MicroKRWDenom}
+
+	// simulation matches the execution
+	querier := keeper.NewQuerier(input.MarketKeeper)
+	res, err := querier.SwapRoute(sdk.WrapSDKContext(input.Ctx), &types.QuerySwapRouteRequest{
+		OfferCoin: offerCoin.String(),
+		AskDenoms: route,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Hops, 2)
+
+	// first hop is a plain swap, the second offers the result of the first
+	retCoin, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, spread, res.Hops[0].Spread)
+	require.Equal(t, retCoin.Amount.Mul(sdk.OneDec().Sub(spread)).TruncateInt(), res.Hops[0].SwapCoin.Amount)
+	require.Equal(t, res.Hops[0].SwapCoin, res.Hops[1].OfferCoin)
+	require.Equal(t, res.Hops[1].SwapCoin, res.ReturnCoin)
+
+	// return amount less than the min receive amount fails
+	minReceiveAmount := res.ReturnCoin.Amount.AddRaw(1)
+	swapRouteMsg := types.NewMsgSwapRoute(keeper.Addrs[0], offerCoin, route)
+	swapRouteMsg.Receiver = keeper.Addrs[1].String()
+	swapRouteMsg.MinReceiveAmount = &minReceiveAmount
+	_, err = h(input.Ctx, swapRouteMsg)
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	minReceiveAmount = res.ReturnCoin.Amount
+	result, err := h(input.Ctx, swapRouteMsg)
+	require.NoError(t, err)
+
+	hopEvents := 0
+	for _, event := range result.Events {
+		if event.Type == types.EventSwapHop {
+			hopEvents++
+		}
+	}
+	require.Equal(t, 2, hopEvents)
+
+	// the receiver gets only the last denom of the route
+	balances := input.BankKeeper.GetAllBalances(input.Ctx, keeper.Addrs[1])
+	require.Equal(t, res.ReturnCoin.Amount, balances.AmountOf(core.MicroKRWDenom))
+	require.True(t, balances.AmountOf(core.MicroSDRDenom).IsZero())
+
+	// the fee of every hop goes to the oracle account
+	oracleBalances := input.BankKeeper.GetAllBalances(input.Ctx, input.AccountKeeper.GetModuleAddress(oracletypes.ModuleName))
+	require.Equal(t, res.Hops[0].SwapFee.Amount, oracleBalances.AmountOf(core.MicroSDRDenom))
+	require.Equal(t, res.Hops[1].SwapFee.Amount, oracleBalances.AmountOf(core.MicroKRWDenom))
+
+	// invalid recursive route
+	swapRouteMsg = types.NewMsgSwapRoute(keeper.Addrs[0], offerCoin, []string{core.MicroSDRDenom, core.MicroSDRDenom})
+	_, err = h(input.Ctx, swapRouteMsg)
+	require.ErrorIs(t, err, types.ErrRecursiveSwap)
+}
+
+func TestSwapExactOutMsg(t *testing.T) {
+	input, h := setup(t)
+
+	askCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1000000))
+
+	querier := keeper.NewQuerier(input.MarketKeeper)
+	res, err := querier.SwapExactOut(sdk.WrapSDKContext(input.Ctx), &types.QuerySwapExactOutRequest{
+		AskCoin:    askCoin.String(),
+		OfferDenom: core.MicroLunaDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, core.MicroLunaDenom, res.OfferCoin.Denom)
+
+	// offer greater than the max offer amount fails
+	swapMsg := types.NewMsgSwapExactOut(keeper.Addrs[0], askCoin, core.MicroLunaDenom, res.OfferCoin.Amount.SubRaw(1))
+	swapMsg.Receiver = keeper.Addrs[1].String()
+	_, err = h(input.Ctx, swapMsg)
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	beforeBalance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroLunaDenom)
+
+	swapMsg.MaxOfferAmount = res.OfferCoin.Amount
+	_, err = h(input.Ctx, swapMsg)
+	require.NoError(t, err)
+
+	// the trader pays the quoted offer and the receiver gets at least the ask coin
+	afterBalance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroLunaDenom)
+	require.Equal(t, res.OfferCoin, beforeBalance.Sub(afterBalance))
+
+	balance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[1], core.MicroSDRDenom)
+	require.True(t, balance.Amount.GTE(askCoin.Amount))
+}
+
+func TestSwapMsgVolumeLimit(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapVolumeLimits = types.SwapVolumeLimits{
+		types.NewSwapVolumeLimit(core.MicroLunaDenom, sdk.NewInt(15), sdk.ZeroInt()),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	swapMsg := types.NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	_, err := h(input.Ctx, swapMsg)
+	require.NoError(t, err)
+
+	// burning 20uluna in a block goes beyond the cap
+	_, err = h(input.Ctx, swapMsg)
+	require.ErrorIs(t, err, types.ErrSwapVolumeLimit)
+
+	// the cap is lifted in the next block
+	EndBlocker(input.Ctx, input.MarketKeeper)
+	_, err = h(input.Ctx, swapMsg)
+	require.NoError(t, err)
+}
+
+func TestSwapMsgStatistics(t *testing.T) {
+	input, h := setup(t)
+	msgServer := keeper.NewMsgServerImpl(input.MarketKeeper)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	swapMsg := types.NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	swapRes, err := msgServer.Swap(sdk.WrapSDKContext(input.Ctx), swapMsg)
+	require.NoError(t, err)
+
+	statistics := input.MarketKeeper.AggregateSwapStatistics(input.Ctx, 1, core.MicroLunaDenom, core.MicroSDRDenom)
+	require.Equal(t, []types.SwapStatistics{{
+		OfferDenom:  core.MicroLunaDenom,
+		AskDenom:    core.MicroSDRDenom,
+		OfferVolume: offerCoin.Amount,
+		AskVolume:   swapRes.SwapCoin.Amount,
+		SwapFee:     swapRes.SwapFee.Amount,
+		Count:       1,
+	}}, statistics)
+
+	// every hop of a route is recorded under its own pair
+	routeMsg := types.NewMsgSwapRoute(keeper.Addrs[0], offerCoin, []string{core.MicroSDRDenom, core.MicroKRWDenom})
+	_, err = h(input.Ctx, routeMsg)
+	require.NoError(t, err)
+
+	statistics = input.MarketKeeper.AggregateSwapStatistics(input.Ctx, 1, "", "")
+	require.Len(t, statistics, 2)
+	require.Equal(t, uint64(2), statistics[0].Count)
+	require.Equal(t, core.MicroSDRDenom, statistics[1].OfferDenom)
+	require.Equal(t, core.MicroKRWDenom, statistics[1].AskDenom)
+	require.Equal(t, uint64(1), statistics[1].Count)
+}
+
+func TestSwapMsgFeeSplit(t *testing.T) {
+	input, _ := setup(t)
+	msgServer := keeper.NewMsgServerImpl(input.MarketKeeper)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapFeeBurnRate = sdk.NewDecWithPrec(5, 1)
+	params.SwapFeeCommunityPoolRate = sdk.NewDecWithPrec(5, 1)
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000))
+	swapMsg := types.NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	swapRes, err := msgServer.Swap(sdk.WrapSDKContext(input.Ctx), swapMsg)
+	require.NoError(t, err)
+	require.True(t, swapRes.SwapFee.IsPositive())
+
+	// no swap fee reaches the oracle reward pool when burned and sent to the community pool
+	communityPoolCoins := input.DistrKeeper.GetFeePoolCommunityCoins(input.Ctx)
+	burnCoins := input.MarketKeeper.GetSwapFeeTotal(input.Ctx, types.SwapFeeDestinationBurn)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(input.MarketKeeper.GetSwapFeeTotal(input.Ctx, types.SwapFeeDestinationCommunityPool)...), communityPoolCoins)
+	require.Equal(t, sdk.NewCoins(swapRes.SwapFee), burnCoins.Add(input.MarketKeeper.GetSwapFeeTotal(input.Ctx, types.SwapFeeDestinationCommunityPool)...))
+	require.True(t, input.MarketKeeper.GetSwapFeeTotal(input.Ctx, types.SwapFeeDestinationOracle).IsZero())
+}
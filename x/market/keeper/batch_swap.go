@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+)
+
+// GetNextBatchSwapOrderID returns the id of the next queued batch swap order
+func (k Keeper) GetNextBatchSwapOrderID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextBatchSwapOrderIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextBatchSwapOrderID updates the id of the next queued batch swap order
+func (k Keeper) SetNextBatchSwapOrderID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextBatchSwapOrderIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetBatchSwapOrder returns the queued batch swap order, and false when none is queued with the id
+func (k Keeper) GetBatchSwapOrder(ctx sdk.Context, id uint64) (types.BatchSwapOrder, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBatchSwapOrderKey(id))
+	if bz == nil {
+		return types.BatchSwapOrder{}, false
+	}
+
+	var order types.BatchSwapOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, true
+}
+
+// SetBatchSwapOrder updates the queued batch swap order
+func (k Keeper) SetBatchSwapOrder(ctx sdk.Context, order types.BatchSwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&order)
+	store.Set(types.GetBatchSwapOrderKey(order.ID), bz)
+}
+
+// DeleteBatchSwapOrder removes the queued batch swap order
+func (k Keeper) DeleteBatchSwapOrder(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBatchSwapOrderKey(id))
+}
+
+// IterateBatchSwapOrders iterates over the queued batch swap orders in the order they were queued
+func (k Keeper) IterateBatchSwapOrders(ctx sdk.Context, handler func(order types.BatchSwapOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BatchSwapOrderKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var order types.BatchSwapOrder
+		k.cdc.MustUnmarshal(iter.Value(), &order)
+		if handler(order) {
+			break
+		}
+	}
+}
+
+// QueueBatchSwapOrder assigns the next id to the order and queues it for the batch of the block
+func (k Keeper) QueueBatchSwapOrder(ctx sdk.Context, order types.BatchSwapOrder) uint64 {
+	order.ID = k.GetNextBatchSwapOrderID(ctx)
+	k.SetNextBatchSwapOrderID(ctx, order.ID+1)
+	k.SetBatchSwapOrder(ctx, order)
+	return order.ID
+}
+
+// batchSwapPair holds the orders of a denom pair cleared together at a uniform price
+type batchSwapPair struct {
+	offerDenom string
+	askDenom   string
+	orders     []types.BatchSwapOrder
+
+	// price is the amount of the ask denom per unit of the offer denom before the spread
+	price  sdk.Dec
+	spread sdk.Dec
+}
+
+// swapOf returns the swap coin and the fee coin of the order at the price and spread of the pair
+func (p batchSwapPair) swapOf(order types.BatchSwapOrder) (swapDecCoin sdk.DecCoin, feeDecCoin sdk.DecCoin) {
+	swapDecCoin = sdk.NewDecCoinFromDec(p.askDenom, p.price.MulInt(order.OfferCoin.Amount))
+	feeDecCoin = sdk.NewDecCoin(p.askDenom, sdk.ZeroInt())
+	if p.spread.IsPositive() {
+		feeDecCoin = sdk.NewDecCoinFromDec(p.askDenom, p.spread.Mul(swapDecCoin.Amount))
+	}
+
+	swapDecCoin.Amount = swapDecCoin.Amount.Sub(feeDecCoin.Amount)
+	return swapDecCoin, feeDecCoin
+}
+
+// checkSlippage refuses the order charging more spread, or returning less, than the trader accepts
+func (p batchSwapPair) checkSlippage(order types.BatchSwapOrder) error {
+	if order.MaxSpread != nil && p.spread.GT(*order.MaxSpread) {
+		return sdkerrors.Wrapf(types.ErrSlippageExceeded, "spread %s is greater than the max spread %s", p.spread, order.MaxSpread)
+	}
+
+	swapDecCoin, _ := p.swapOf(order)
+	if order.MinReceiveAmount != nil && swapDecCoin.Amount.TruncateInt().LT(*order.MinReceiveAmount) {
+		return sdkerrors.Wrapf(types.ErrSlippageExceeded, "swap amount %s is less than the min receive amount %s", swapDecCoin.Amount.TruncateInt(), order.MinReceiveAmount)
+	}
+
+	return nil
+}
+
+// ClearBatchSwapOrders executes the swaps queued for the batch of the block. The orders of each
+// denom pair are priced together at a uniform price and spread, computed from the pool state before
+// any order of the batch executes. Orders refused by their slippage bounds or failing to execute
+// are refunded to the trader.
+func (k Keeper) ClearBatchSwapOrders(ctx sdk.Context) {
+	var ids []uint64
+	var pairs []*batchSwapPair
+	pairIndex := make(map[[2]string]*batchSwapPair)
+	k.IterateBatchSwapOrders(ctx, func(order types.BatchSwapOrder) (stop bool) {
+		ids = append(ids, order.ID)
+
+		// The ibc vouchers mapped to a native denom are cleared with the native denom
+		offerDenom := k.NativeDenom(ctx, order.OfferCoin.Denom)
+		key := [2]string{offerDenom, order.AskDenom}
+		pair, ok := pairIndex[key]
+		if !ok {
+			pair = &batchSwapPair{offerDenom: offerDenom, askDenom: order.AskDenom}
+			pairIndex[key] = pair
+			pairs = append(pairs, pair)
+		}
+
+		pair.orders = append(pair.orders, order)
+		return false
+	})
+
+	for _, id := range ids {
+		k.DeleteBatchSwapOrder(ctx, id)
+	}
+
+	// Price every pair before any order executes
+	for _, pair := range pairs {
+		k.priceBatchSwapPair(ctx, pair)
+	}
+
+	for _, pair := range pairs {
+		if len(pair.orders) == 0 {
+			continue
+		}
+
+		total := sdk.ZeroInt()
+		for _, order := range pair.orders {
+			total = total.Add(order.OfferCoin.Amount)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventBatchSwapClear,
+				sdk.NewAttribute(types.AttributeKeyOffer, sdk.NewCoin(pair.offerDenom, total).String()),
+				sdk.NewAttribute(types.AttributeKeyAskDenom, pair.askDenom),
+				sdk.NewAttribute(types.AttributeKeyPrice, pair.price.String()),
+				sdk.NewAttribute(types.AttributeKeySpread, pair.spread.String()),
+			),
+		)
+
+		for _, order := range pair.orders {
+			// Execute each order on its own so that a failing order leaves no state behind
+			cacheCtx, writeCache := ctx.CacheContext()
+			swapCoin, feeCoin, err := k.executeBatchSwapOrder(cacheCtx, pair, order)
+			if err != nil {
+				k.refundBatchSwapOrder(ctx, order, err)
+				continue
+			}
+
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventBatchSwap,
+					sdk.NewAttribute(types.AttributeKeyOrderID, strconv.FormatUint(order.ID, 10)),
+					sdk.NewAttribute(types.AttributeKeyOffer, order.OfferCoin.String()),
+					sdk.NewAttribute(types.AttributeKeyTrader, order.Trader),
+					sdk.NewAttribute(types.AttributeKeyRecipient, order.Receiver),
+					sdk.NewAttribute(types.AttributeKeySwapCoin, swapCoin.String()),
+					sdk.NewAttribute(types.AttributeKeySwapFee, feeCoin.String()),
+				),
+			)
+		}
+	}
+}
+
+// priceBatchSwapPair computes the uniform price and spread of the pair, refunding the orders refused
+// by their slippage bounds. Refunded orders lower the total offer, which never worsens the price of
+// the others, so the pair is repriced until every remaining order accepts it.
+func (k Keeper) priceBatchSwapPair(ctx sdk.Context, pair *batchSwapPair) {
+	for len(pair.orders) > 0 {
+		total := sdk.ZeroInt()
+		for _, order := range pair.orders {
+			total = total.Add(order.OfferCoin.Amount)
+		}
+
+		retDecCoin, spread, err := k.ComputeSwap(ctx, sdk.NewCoin(pair.offerDenom, total), pair.askDenom)
+		if err != nil {
+			for _, order := range pair.orders {
+				k.refundBatchSwapOrder(ctx, order, err)
+			}
+			pair.orders = nil
+			return
+		}
+
+		pair.price = retDecCoin.Amount.QuoInt(total)
+		pair.spread = spread
+
+		accepted := make([]types.BatchSwapOrder, 0, len(pair.orders))
+		for _, order := range pair.orders {
+			if err := pair.checkSlippage(order); err != nil {
+				k.refundBatchSwapOrder(ctx, order, err)
+				continue
+			}
+
+			accepted = append(accepted, order)
+		}
+
+		if len(accepted) == len(pair.orders) {
+			return
+		}
+
+		pair.orders = accepted
+	}
+}
+
+// executeBatchSwapOrder swaps the offer coin escrowed by the order at the price of the pair
+func (k Keeper) executeBatchSwapOrder(ctx sdk.Context, pair *batchSwapPair, order types.BatchSwapOrder) (swapCoin sdk.Coin, feeCoin sdk.Coin, err error) {
+	receiver, err := sdk.AccAddressFromBech32(order.Receiver)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	swapDecCoin, feeDecCoin := pair.swapOf(order)
+	nativeOfferCoin := sdk.NewCoin(pair.offerDenom, order.OfferCoin.Amount)
+
+	// Update pool delta
+	err = k.ApplySwapToPool(ctx, nativeOfferCoin, swapDecCoin)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Burn the escrowed offer coins
+	err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(order.OfferCoin))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	swapCoin, decimalCoin := swapDecCoin.TruncateDecimal()
+
+	// Ensure to fail the swap when zero swap coin
+	if ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() >= core.SwapDisableForkHeight {
+		if !swapCoin.IsPositive() {
+			return sdk.Coin{}, sdk.Coin{}, types.ErrZeroSwapCoin
+		}
+	}
+
+	feeDecCoin = feeDecCoin.Add(decimalCoin) // add truncated decimalCoin to swapFee
+	feeCoin, _ = feeDecCoin.TruncateDecimal()
+
+	// Refuse the swap going beyond the swap volume limits
+	mintCoins := sdk.NewCoins(swapCoin.Add(feeCoin))
+	err = k.ApplySwapVolume(ctx, sdk.NewCoins(nativeOfferCoin), mintCoins)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	err = k.BankKeeper.MintCoins(ctx, types.ModuleName, mintCoins)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Send swap coin to the receiver
+	err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(swapCoin))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Route swap fee by the swap fee split
+	err = k.DistributeSwapFees(ctx, sdk.NewCoins(feeCoin))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	k.RecordSwapStatistics(ctx, nativeOfferCoin, swapCoin, feeCoin)
+
+	return swapCoin, feeCoin, nil
+}
+
+// refundBatchSwapOrder returns the escrowed offer coin of the order to the trader
+func (k Keeper) refundBatchSwapOrder(ctx sdk.Context, order types.BatchSwapOrder, reason error) {
+	trader, err := sdk.AccAddressFromBech32(order.Trader)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, trader, sdk.NewCoins(order.OfferCoin))
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventBatchSwapRefund,
+			sdk.NewAttribute(types.AttributeKeyOrderID, strconv.FormatUint(order.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOffer, order.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTrader, order.Trader),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+		),
+	)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func setupBatchSwap(t *testing.T) (TestInput, types.MsgServer) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.BatchSwapEnabled = true
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	return input, NewMsgServerImpl(input.MarketKeeper)
+}
+
+func TestBatchSwapQueue(t *testing.T) {
+	input, msgServer := setupBatchSwap(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	offerCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000)
+	res, err := msgServer.Swap(ctx, types.NewMsgSwap(Addrs[0], offerCoin, core.MicroSDRDenom))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.BatchOrderID)
+	require.True(t, res.SwapCoin.IsZero())
+
+	// the offer coin is escrowed and the pool is untouched until the end block
+	require.Equal(t, InitTokens.Sub(offerCoin.Amount), input.BankKeeper.GetBalance(input.Ctx, Addrs[0], core.MicroLunaDenom).Amount)
+	require.Equal(t, sdk.ZeroDec(), input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	order, found := input.MarketKeeper.GetBatchSwapOrder(input.Ctx, res.BatchOrderID)
+	require.True(t, found)
+	require.Equal(t, Addrs[0].String(), order.Trader)
+	require.Equal(t, offerCoin, order.OfferCoin)
+
+	// unpriced denoms are refused on queueing
+	_, err = msgServer.Swap(ctx, types.NewMsgSwap(Addrs[0], offerCoin, core.MicroKRWDenom))
+	require.Error(t, err)
+
+	// routes and exact output swaps run in transaction order only
+	_, err = msgServer.SwapRoute(ctx, types.NewMsgSwapRoute(Addrs[0], offerCoin, []string{core.MicroSDRDenom}))
+	require.ErrorIs(t, err, types.ErrBatchSwapMode)
+
+	_, err = msgServer.SwapExactOut(ctx, types.NewMsgSwapExactOut(Addrs[0], sdk.NewInt64Coin(core.MicroSDRDenom, 100), core.MicroLunaDenom, sdk.NewInt(1000)))
+	require.ErrorIs(t, err, types.ErrBatchSwapMode)
+}
+
+func TestClearBatchSwapOrders(t *testing.T) {
+	input, msgServer := setupBatchSwap(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	offerCoins := []sdk.Coin{sdk.NewInt64Coin(core.MicroLunaDenom, 1000), sdk.NewInt64Coin(core.MicroLunaDenom, 3000)}
+	for i, offerCoin := range offerCoins {
+		_, err := msgServer.Swap(ctx, types.NewMsgSwap(Addrs[i], offerCoin, core.MicroSDRDenom))
+		require.NoError(t, err)
+	}
+
+	// the batch is priced as a single swap of the total offer
+	_, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewInt64Coin(core.MicroLunaDenom, 4000), core.MicroSDRDenom)
+	require.NoError(t, err)
+
+	input.MarketKeeper.ClearBatchSwapOrders(input.Ctx)
+
+	var swapAmounts []sdk.Int
+	for i := range offerCoins {
+		swapAmounts = append(swapAmounts, input.BankKeeper.GetBalance(input.Ctx, Addrs[i], core.MicroSDRDenom).Amount)
+
+		expected := sdk.NewDecWithPrec(17, 1).MulInt(offerCoins[i].Amount)
+		expected = expected.Sub(expected.Mul(spread))
+		require.Equal(t, expected.TruncateInt(), swapAmounts[i])
+	}
+
+	// both orders are cleared at the same price
+	require.Equal(t, swapAmounts[0].MulRaw(3), swapAmounts[1])
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsNegative())
+
+	_, found := input.MarketKeeper.GetBatchSwapOrder(input.Ctx, 1)
+	require.False(t, found)
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestClearBatchSwapOrdersRefund(t *testing.T) {
+	input, msgServer := setupBatchSwap(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	offerCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000)
+	_, err := msgServer.Swap(ctx, types.NewMsgSwap(Addrs[0], offerCoin, core.MicroSDRDenom))
+	require.NoError(t, err)
+
+	// the min stability spread is above the max spread of the order
+	maxSpread := sdk.NewDecWithPrec(1, 3)
+	refundMsg := types.NewMsgSwap(Addrs[1], offerCoin, core.MicroSDRDenom)
+	refundMsg.MaxSpread = &maxSpread
+	_, err = msgServer.Swap(ctx, refundMsg)
+	require.NoError(t, err)
+
+	input.MarketKeeper.ClearBatchSwapOrders(input.Ctx)
+
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, Addrs[0], core.MicroSDRDenom).IsPositive())
+	require.Equal(t, InitTokens, input.BankKeeper.GetBalance(input.Ctx, Addrs[1], core.MicroLunaDenom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, Addrs[1], core.MicroSDRDenom).IsZero())
+
+	refunds := 0
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventBatchSwapRefund {
+			refunds++
+		}
+	}
+	require.Equal(t, 1, refunds)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/classic-terra/core/x/market/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func HandleUpdateTerraSwapFeeProposal(ctx sdk.Context, k Keeper, p *types.UpdateTerraSwapFeeProposal) error {
+	k.SetTerraSwapFee(ctx, p.TerraSwapFee())
+
+	return nil
+}
+
+func HandleRemoveTerraSwapFeeProposal(ctx sdk.Context, k Keeper, p *types.RemoveTerraSwapFeeProposal) error {
+	if _, ok := k.GetTerraSwapFee(ctx, p.OfferDenom, p.AskDenom); !ok {
+		return types.ErrNoTerraSwapFee.Wrapf("%s to %s", p.OfferDenom, p.AskDenom)
+	}
+
+	k.DeleteTerraSwapFee(ctx, p.OfferDenom, p.AskDenom)
+
+	return nil
+}
+
+func HandleUpdateIBCDenomMappingProposal(ctx sdk.Context, k Keeper, p *types.UpdateIBCDenomMappingProposal) error {
+	mapping := p.IBCDenomMapping()
+	if err := k.ValidateIBCDenomTrace(ctx, mapping); err != nil {
+		return err
+	}
+
+	k.SetIBCDenomMapping(ctx, mapping)
+
+	return nil
+}
+
+func HandleRemoveIBCDenomMappingProposal(ctx sdk.Context, k Keeper, p *types.RemoveIBCDenomMappingProposal) error {
+	if _, ok := k.GetIBCDenomMapping(ctx, p.IBCDenom); !ok {
+		return types.ErrNoIBCDenomMapping.Wrap(p.IBCDenom)
+	}
+
+	k.DeleteIBCDenomMapping(ctx, p.IBCDenom)
+
+	return nil
+}
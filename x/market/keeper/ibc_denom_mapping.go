@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	"github.com/classic-terra/core/x/market/types"
+)
+
+// GetIBCDenomMapping returns the native denom the ibc denom is mapped to, and false when none is set
+func (k Keeper) GetIBCDenomMapping(ctx sdk.Context, ibcDenom string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIBCDenomMappingKey(ibcDenom))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetIBCDenomMapping updates the native denom the ibc denom is mapped to
+func (k Keeper) SetIBCDenomMapping(ctx sdk.Context, mapping types.IBCDenomMapping) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetIBCDenomMappingKey(mapping.IBCDenom), []byte(mapping.NativeDenom))
+}
+
+// DeleteIBCDenomMapping removes the mapping of the ibc denom
+func (k Keeper) DeleteIBCDenomMapping(ctx sdk.Context, ibcDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIBCDenomMappingKey(ibcDenom))
+}
+
+// IterateIBCDenomMappings iterates over the mappings of the ibc denoms
+func (k Keeper) IterateIBCDenomMappings(ctx sdk.Context, handler func(mapping types.IBCDenomMapping) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.IBCDenomMappingKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		ibcDenom := string(iter.Key()[len(types.IBCDenomMappingKeyPrefix):])
+		if handler(types.NewIBCDenomMapping(ibcDenom, string(iter.Value()))) {
+			break
+		}
+	}
+}
+
+// ValidateIBCDenomTrace checks the ibc denom of the mapping has a denom trace on the ibc transfer
+// module, tracing back to the native denom of the mapping through a channel of this chain. The
+// voucher of a single hop trace was minted for a coin of the counterparty chain, so only a two hop
+// trace whose origin hop is the counterparty of one of our transfer channels, on the chain the
+// voucher was received from, is a bridged back Terra asset.
+func (k Keeper) ValidateIBCDenomTrace(ctx sdk.Context, mapping types.IBCDenomMapping) error {
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(mapping.IBCDenom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return types.ErrInvalidDenomTrace.Wrapf("%s: %s", mapping.IBCDenom, err)
+	}
+
+	trace, found := k.TransferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return types.ErrInvalidDenomTrace.Wrapf("no denom trace found for %s", mapping.IBCDenom)
+	}
+
+	if trace.BaseDenom != mapping.NativeDenom {
+		return types.ErrInvalidDenomTrace.Wrapf("%s traces back to %s, not %s", mapping.IBCDenom, trace.BaseDenom, mapping.NativeDenom)
+	}
+
+	// the origin hop of a longer trace is on a chain this chain has no connection to
+	identifiers := strings.Split(trace.Path, "/")
+	if len(identifiers) != 4 {
+		return types.ErrInvalidDenomTrace.Wrapf("%s is not bridged back through a chain connected to this chain", mapping.IBCDenom)
+	}
+
+	if !k.isCounterpartyTransferChannel(ctx, identifiers[0], identifiers[1], identifiers[2], identifiers[3]) {
+		return types.ErrInvalidDenomTrace.Wrapf("%s does not originate from a channel of this chain", mapping.IBCDenom)
+	}
+
+	return nil
+}
+
+// isCounterpartyTransferChannel returns true when the origin port and channel are the counterparty
+// of one of the transfer channels of this chain sharing the connection of the receiving channel.
+// Channel identifiers are only unique within a chain, so the origin channel is only looked up on
+// the chain the voucher was received from.
+func (k Keeper) isCounterpartyTransferChannel(ctx sdk.Context, receivingPortID, receivingChannelID, originPortID, originChannelID string) (found bool) {
+	receivingChannel, ok := k.ChannelKeeper.GetChannel(ctx, receivingPortID, receivingChannelID)
+	if !ok || len(receivingChannel.ConnectionHops) == 0 {
+		return false
+	}
+
+	connectionID := receivingChannel.ConnectionHops[0]
+	k.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) (stop bool) {
+		found = channel.PortId == ibctransfertypes.PortID &&
+			len(channel.ConnectionHops) != 0 && channel.ConnectionHops[0] == connectionID &&
+			channel.Counterparty.PortId == originPortID &&
+			channel.Counterparty.ChannelId == originChannelID
+		return found
+	})
+
+	return found
+}
+
+// NativeDenom returns the native denom the ibc denom is mapped to, or the denom itself when it is not mapped
+func (k Keeper) NativeDenom(ctx sdk.Context, denom string) string {
+	if !types.IsIBCDenom(denom) {
+		return denom
+	}
+
+	if nativeDenom, ok := k.GetIBCDenomMapping(ctx, denom); ok {
+		return nativeDenom
+	}
+
+	return denom
+}
+
+// NativeCoin returns the coin in the native denom the ibc denom of the coin is mapped to,
+// or the coin itself when its denom is not mapped
+func (k Keeper) NativeCoin(ctx sdk.Context, coin sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(k.NativeDenom(ctx, coin.Denom), coin.Amount)
+}
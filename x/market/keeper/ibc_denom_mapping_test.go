@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+)
+
+// bridgedSDRTrace is the trace of usdr sent out and bridged back through another chain
+var bridgedSDRTrace = ibctransfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-0", BaseDenom: core.MicroSDRDenom}
+
+// bridgeChannel returns a transfer channel of the chain on the connection, whose counterparty is the transfer channel
+func bridgeChannel(channelID, connectionID, counterpartyChannelID string) channeltypes.IdentifiedChannel {
+	counterparty := channeltypes.NewCounterparty(ibctransfertypes.PortID, counterpartyChannelID)
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, counterparty, []string{connectionID}, ibctransfertypes.Version)
+	return channeltypes.NewIdentifiedChannel(ibctransfertypes.PortID, channelID, channel)
+}
+
+func TestIBCDenomMapping(t *testing.T) {
+	input := CreateTestInput(t)
+	ibcDenom := bridgedSDRTrace.IBCDenom()
+
+	_, ok := input.MarketKeeper.GetIBCDenomMapping(input.Ctx, ibcDenom)
+	require.False(t, ok)
+	require.Equal(t, ibcDenom, input.MarketKeeper.NativeDenom(input.Ctx, ibcDenom))
+
+	mapping := types.NewIBCDenomMapping(ibcDenom, core.MicroSDRDenom)
+	input.MarketKeeper.SetIBCDenomMapping(input.Ctx, mapping)
+
+	nativeDenom, ok := input.MarketKeeper.GetIBCDenomMapping(input.Ctx, ibcDenom)
+	require.True(t, ok)
+	require.Equal(t, core.MicroSDRDenom, nativeDenom)
+	require.Equal(t, sdk.NewInt64Coin(core.MicroSDRDenom, 10), input.MarketKeeper.NativeCoin(input.Ctx, sdk.NewInt64Coin(ibcDenom, 10)))
+	require.Equal(t, core.MicroKRWDenom, input.MarketKeeper.NativeDenom(input.Ctx, core.MicroKRWDenom))
+
+	var mappings []types.IBCDenomMapping
+	input.MarketKeeper.IterateIBCDenomMappings(input.Ctx, func(mapping types.IBCDenomMapping) (stop bool) {
+		mappings = append(mappings, mapping)
+		return false
+	})
+	require.Equal(t, []types.IBCDenomMapping{mapping}, mappings)
+
+	input.MarketKeeper.DeleteIBCDenomMapping(input.Ctx, ibcDenom)
+	_, ok = input.MarketKeeper.GetIBCDenomMapping(input.Ctx, ibcDenom)
+	require.False(t, ok)
+}
+
+func TestValidateIBCDenomTrace(t *testing.T) {
+	input := CreateTestInput(t)
+	mapping := types.NewIBCDenomMapping(bridgedSDRTrace.IBCDenom(), core.MicroSDRDenom)
+
+	// unknown to the transfer module
+	require.ErrorIs(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping), types.ErrInvalidDenomTrace)
+
+	input.TransferKeeper.SetDenomTrace(input.Ctx, bridgedSDRTrace)
+
+	// the voucher was received through channel-1 on connection-0
+	receivingChannel := bridgeChannel("channel-1", "connection-0", "channel-9")
+
+	// the origin hop is no counterparty of our channels
+	input.ChannelKeeper.Channels = []channeltypes.IdentifiedChannel{receivingChannel, bridgeChannel("channel-7", "connection-0", "channel-5")}
+	require.ErrorIs(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping), types.ErrInvalidDenomTrace)
+
+	// the origin hop is the counterparty of a channel to another chain
+	input.ChannelKeeper.Channels = append(input.ChannelKeeper.Channels, bridgeChannel("channel-8", "connection-1", "channel-0"))
+	require.ErrorIs(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping), types.ErrInvalidDenomTrace)
+
+	input.ChannelKeeper.Channels = append(input.ChannelKeeper.Channels, bridgeChannel("channel-7", "connection-0", "channel-0"))
+	require.NoError(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping))
+
+	// the receiving channel is unknown
+	input.ChannelKeeper.Channels = input.ChannelKeeper.Channels[1:]
+	require.ErrorIs(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping), types.ErrInvalidDenomTrace)
+	input.ChannelKeeper.Channels = append(input.ChannelKeeper.Channels, receivingChannel)
+
+	// traces back to another denom
+	mapping.NativeDenom = core.MicroKRWDenom
+	require.ErrorIs(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping), types.ErrInvalidDenomTrace)
+
+	// the single hop voucher of the usdr of the counterparty chain
+	foreignTrace := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: core.MicroSDRDenom}
+	input.TransferKeeper.SetDenomTrace(input.Ctx, foreignTrace)
+	mapping = types.NewIBCDenomMapping(foreignTrace.IBCDenom(), core.MicroSDRDenom)
+	require.ErrorIs(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping), types.ErrInvalidDenomTrace)
+
+	// the origin hop of a three hop trace is on a chain without connection to this chain
+	relayedTrace := ibctransfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-3/transfer/channel-0", BaseDenom: core.MicroSDRDenom}
+	input.TransferKeeper.SetDenomTrace(input.Ctx, relayedTrace)
+	mapping = types.NewIBCDenomMapping(relayedTrace.IBCDenom(), core.MicroSDRDenom)
+	require.ErrorIs(t, input.MarketKeeper.ValidateIBCDenomTrace(input.Ctx, mapping), types.ErrInvalidDenomTrace)
+}
+
+func TestSwapIBCDenom(t *testing.T) {
+	input := CreateTestInput(t)
+	msgServer := NewMsgServerImpl(input.MarketKeeper)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+
+	ibcDenom := bridgedSDRTrace.IBCDenom()
+	offerCoin := sdk.NewInt64Coin(ibcDenom, 10000)
+	require.NoError(t, FundAccount(input, Addrs[0], sdk.NewCoins(offerCoin)))
+
+	// unmapped vouchers have no price
+	_, err := msgServer.Swap(ctx, types.NewMsgSwap(Addrs[0], offerCoin, core.MicroLunaDenom))
+	require.ErrorIs(t, err, types.ErrNoEffectivePrice)
+
+	input.MarketKeeper.SetIBCDenomMapping(input.Ctx, types.NewIBCDenomMapping(ibcDenom, core.MicroSDRDenom))
+
+	// swapping the voucher to its native denom is recursive
+	_, err = msgServer.Swap(ctx, types.NewMsgSwap(Addrs[0], offerCoin, core.MicroSDRDenom))
+	require.ErrorIs(t, err, types.ErrRecursiveSwap)
+
+	// the voucher is priced as the native denom
+	expected, err := input.MarketKeeper.simulateSwap(input.Ctx, sdk.NewCoin(core.MicroSDRDenom, offerCoin.Amount), core.MicroLunaDenom)
+	require.NoError(t, err)
+
+	res, err := msgServer.Swap(ctx, types.NewMsgSwap(Addrs[0], offerCoin, core.MicroLunaDenom))
+	require.NoError(t, err)
+	require.Equal(t, expected, res.SwapCoin)
+
+	// the voucher is burned and the pool moves as for the native denom
+	marketAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, marketAddr, ibcDenom).IsZero())
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, Addrs[0], ibcDenom).IsZero())
+	require.True(t, input.BankKeeper.GetSupply(input.Ctx, ibcDenom).IsZero())
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsPositive())
+}
+
+func TestClearBatchSwapOrdersIBCDenom(t *testing.T) {
+	input, msgServer := setupBatchSwap(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	ibcDenom := bridgedSDRTrace.IBCDenom()
+	input.MarketKeeper.SetIBCDenomMapping(input.Ctx, types.NewIBCDenomMapping(ibcDenom, core.MicroSDRDenom))
+
+	offerCoins := []sdk.Coin{sdk.NewInt64Coin(ibcDenom, 10000), sdk.NewInt64Coin(core.MicroSDRDenom, 10000)}
+	for i, offerCoin := range offerCoins {
+		require.NoError(t, FundAccount(input, Addrs[i], sdk.NewCoins(offerCoin)))
+		_, err := msgServer.Swap(ctx, types.NewMsgSwap(Addrs[i], offerCoin, core.MicroLunaDenom))
+		require.NoError(t, err)
+	}
+
+	input.MarketKeeper.ClearBatchSwapOrders(input.Ctx)
+
+	// the voucher is cleared with the native denom at the same price
+	lunaBalances := []sdk.Int{
+		input.BankKeeper.GetBalance(input.Ctx, Addrs[0], core.MicroLunaDenom).Amount,
+		input.BankKeeper.GetBalance(input.Ctx, Addrs[1], core.MicroLunaDenom).Amount,
+	}
+	require.True(t, lunaBalances[0].GT(InitTokens))
+	require.Equal(t, lunaBalances[0], lunaBalances[1])
+
+	marketAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, marketAddr).IsZero())
+	require.True(t, input.BankKeeper.GetSupply(input.Ctx, ibcDenom).IsZero())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -18,9 +19,12 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	paramSpace paramstypes.Subspace
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
-	OracleKeeper  types.OracleKeeper
+	AccountKeeper  types.AccountKeeper
+	BankKeeper     types.BankKeeper
+	OracleKeeper   types.OracleKeeper
+	DistrKeeper    types.DistributionKeeper
+	TransferKeeper types.TransferKeeper
+	ChannelKeeper  types.ChannelKeeper
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -31,6 +35,9 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	oracleKeeper types.OracleKeeper,
+	distrKeeper types.DistributionKeeper,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
 ) Keeper {
 	// ensure market module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -43,12 +50,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramSpace:    paramstore,
-		AccountKeeper: accountKeeper,
-		BankKeeper:    bankKeeper,
-		OracleKeeper:  oracleKeeper,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		paramSpace:     paramstore,
+		AccountKeeper:  accountKeeper,
+		BankKeeper:     bankKeeper,
+		OracleKeeper:   oracleKeeper,
+		DistrKeeper:    distrKeeper,
+		TransferKeeper: transferKeeper,
+		ChannelKeeper:  channelKeeper,
 	}
 }
 
@@ -77,16 +87,71 @@ func (k Keeper) SetTerraPoolDelta(ctx sdk.Context, delta sdk.Dec) {
 	store.Set(types.TerraPoolDeltaKey, bz)
 }
 
+// GetLastReplenishTime returns the block time of the last pool replenishment, and false when never replenished
+func (k Keeper) GetLastReplenishTime(ctx sdk.Context) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastReplenishTimeKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return t, true
+}
+
+// SetLastReplenishTime updates the block time of the last pool replenishment
+func (k Keeper) SetLastReplenishTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastReplenishTimeKey, sdk.FormatTimeBytes(t))
+}
+
 // ReplenishPools replenishes each pool(Terra,Luna) to BasePool
 func (k Keeper) ReplenishPools(ctx sdk.Context) {
 	poolDelta := k.GetTerraPoolDelta(ctx)
 
-	poolRecoveryPeriod := int64(k.PoolRecoveryPeriod(ctx))
-	poolRegressionAmt := poolDelta.QuoInt64(poolRecoveryPeriod)
-
-	// Replenish pools towards each base pool
-	// regressionAmt cannot make delta zero
-	poolDelta = poolDelta.Sub(poolRegressionAmt)
+	switch k.PoolReplenishMode(ctx) {
+	case types.ReplenishModeLinear:
+		// Move the delta towards zero by BasePool every PoolRecoveryTime
+		poolRegressionAmt := k.BasePool(ctx).Mul(k.elapsedSinceReplenish(ctx)).QuoInt64(int64(k.PoolRecoveryTime(ctx)))
+		if poolRegressionAmt.GTE(poolDelta.Abs()) {
+			poolDelta = sdk.ZeroDec()
+		} else if poolDelta.IsPositive() {
+			poolDelta = poolDelta.Sub(poolRegressionAmt)
+		} else {
+			poolDelta = poolDelta.Add(poolRegressionAmt)
+		}
+	case types.ReplenishModeExponential:
+		// Halve the delta every PoolHalfLife
+		halfLives := k.elapsedSinceReplenish(ctx).QuoInt64(int64(k.PoolHalfLife(ctx)))
+		poolDelta = poolDelta.Mul(types.HalfLifeDecayFactor(halfLives))
+	default:
+		poolRecoveryPeriod := int64(k.PoolRecoveryPeriod(ctx))
+		poolRegressionAmt := poolDelta.QuoInt64(poolRecoveryPeriod)
+
+		// Replenish pools towards each base pool
+		// regressionAmt cannot make delta zero
+		poolDelta = poolDelta.Sub(poolRegressionAmt)
+	}
 
 	k.SetTerraPoolDelta(ctx, poolDelta)
+
+	// The time is kept in every mode, so switching to a time based mode
+	// does not replenish the time elapsed before the switch
+	k.SetLastReplenishTime(ctx, ctx.BlockTime())
+}
+
+// elapsedSinceReplenish returns the seconds elapsed since the last pool replenishment,
+// zero when never replenished
+func (k Keeper) elapsedSinceReplenish(ctx sdk.Context) sdk.Dec {
+	lastReplenishTime, ok := k.GetLastReplenishTime(ctx)
+	if !ok || !ctx.BlockTime().After(lastReplenishTime) {
+		return sdk.ZeroDec()
+	}
+
+	elapsed := ctx.BlockTime().Sub(lastReplenishTime)
+	return sdk.NewDecWithPrec(elapsed.Nanoseconds(), 9)
 }
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -55,3 +57,61 @@ func TestReplenishPools(t *testing.T) {
 	expectedDelta = diff.Sub(replenishAmt)
 	require.Equal(t, expectedDelta, terraPoolDelta)
 }
+
+func TestReplenishPoolsLinear(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.PoolReplenishMode = types.ReplenishModeLinear
+	params.PoolRecoveryTime = 1000
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	basePool := input.MarketKeeper.BasePool(input.Ctx)
+	diff := basePool.QuoInt64(2)
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, diff)
+
+	// nothing is replenished before the first replenish time is known
+	now := time.Unix(1600000000, 0).UTC()
+	input.Ctx = input.Ctx.WithBlockTime(now)
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+	require.Equal(t, diff, input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	// a tenth of the recovery time replenishes a tenth of the base pool
+	input.Ctx = input.Ctx.WithBlockTime(now.Add(100 * time.Second))
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+	require.Equal(t, diff.Sub(basePool.QuoInt64(10)), input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	// negative delta reaches zero without overshooting
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, diff.Neg())
+	input.Ctx = input.Ctx.WithBlockTime(now.Add(700 * time.Second))
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+}
+
+func TestReplenishPoolsExponential(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.PoolReplenishMode = types.ReplenishModeExponential
+	params.PoolHalfLife = 600
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	diff := sdk.NewDec(-1000)
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, diff)
+
+	now := time.Unix(1600000000, 0).UTC()
+	input.MarketKeeper.SetLastReplenishTime(input.Ctx, now)
+
+	// two half-lives leave a quarter of the delta
+	input.Ctx = input.Ctx.WithBlockTime(now.Add(1200 * time.Second))
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+	require.Equal(t, sdk.NewDec(-250), input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	lastReplenishTime, ok := input.MarketKeeper.GetLastReplenishTime(input.Ctx)
+	require.True(t, ok)
+	require.Equal(t, now.Add(1200*time.Second), lastReplenishTime)
+
+	// no time elapsed, nothing replenished
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+	require.Equal(t, sdk.NewDec(-250), input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+}
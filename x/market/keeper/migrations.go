@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeySwapVolumeLimits, types.DefaultSwapVolumeLimits)
+	m.keeper.paramSpace.Set(ctx, types.KeySwapVolumeEpoch, types.DefaultSwapVolumeEpoch)
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeySwapStatisticsPeriod, types.DefaultSwapStatisticsPeriod)
+	m.keeper.paramSpace.Set(ctx, types.KeySwapStatisticsRetention, types.DefaultSwapStatisticsRetention)
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyPoolReplenishMode, types.DefaultPoolReplenishMode)
+	m.keeper.paramSpace.Set(ctx, types.KeyPoolRecoveryTime, types.DefaultPoolRecoveryTime)
+	m.keeper.paramSpace.Set(ctx, types.KeyPoolHalfLife, types.DefaultPoolHalfLife)
+
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// It seeds the swap fee of every terra denom pair with the highest tobin tax of both denoms.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	var denoms []string
+	tobinTaxes := make(map[string]sdk.Dec)
+	m.keeper.OracleKeeper.IterateTobinTaxes(ctx, func(denom string, tobinTax sdk.Dec) (stop bool) {
+		if denom != core.MicroLunaDenom {
+			denoms = append(denoms, denom)
+			tobinTaxes[denom] = tobinTax
+		}
+		return false
+	})
+
+	for _, offerDenom := range denoms {
+		for _, askDenom := range denoms {
+			if offerDenom == askDenom {
+				continue
+			}
+
+			fee := sdk.MaxDec(tobinTaxes[offerDenom], tobinTaxes[askDenom])
+			m.keeper.SetTerraSwapFee(ctx, types.NewTerraSwapFee(offerDenom, askDenom, fee))
+		}
+	}
+
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeySwapFeeBurnRate, types.DefaultSwapFeeBurnRate)
+	m.keeper.paramSpace.Set(ctx, types.KeySwapFeeCommunityPoolRate, types.DefaultSwapFeeCommunityPoolRate)
+
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyBatchSwapEnabled, types.DefaultBatchSwapEnabled)
+
+	return nil
+}
@@ -2,12 +2,13 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	core "github.com/classic-terra/core/types"
 	"github.com/classic-terra/core/x/market/types"
-	oracletypes "github.com/classic-terra/core/x/oracle/types"
 )
 
 type msgServer struct {
@@ -29,7 +30,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		return nil, err
 	}
 
-	return k.handleSwapRequest(ctx, addr, addr, msg.OfferCoin, msg.AskDenom)
+	return k.handleSwapRequest(ctx, addr, addr, msg.OfferCoin, msg.AskDenom, msg.MinReceiveAmount, msg.MaxSpread)
 }
 
 func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*types.MsgSwapSendResponse, error) {
@@ -44,14 +45,171 @@ func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*typ
 		return nil, err
 	}
 
-	res, err := k.handleSwapRequest(ctx, fromAddr, toAddr, msg.OfferCoin, msg.AskDenom)
+	res, err := k.handleSwapRequest(ctx, fromAddr, toAddr, msg.OfferCoin, msg.AskDenom, msg.MinReceiveAmount, msg.MaxSpread)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSwapSendResponse{
-		SwapCoin: res.SwapCoin,
-		SwapFee:  res.SwapFee,
+		SwapCoin:     res.SwapCoin,
+		SwapFee:      res.SwapFee,
+		BatchOrderID: res.BatchOrderID,
+	}, nil
+}
+
+func (k msgServer) SwapRoute(goCtx context.Context, msg *types.MsgSwapRoute) (*types.MsgSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.BatchSwapEnabled(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrBatchSwapMode, sdk.MsgTypeURL(msg))
+	}
+
+	trader, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver := trader
+	if msg.Receiver != "" {
+		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Swap through every hop; the pool is updated hop by hop
+	nativeOfferCoin := k.NativeCoin(ctx, msg.OfferCoin)
+	hops, err := k.ComputeSwapRoute(ctx, nativeOfferCoin, msg.AskDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refuse the swap returning less than the trader accepts
+	swapCoin := hops[len(hops)-1].SwapCoin
+	if msg.MinReceiveAmount != nil && swapCoin.Amount.LT(*msg.MinReceiveAmount) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "swap amount %s is less than the min receive amount %s", swapCoin.Amount, msg.MinReceiveAmount)
+	}
+
+	// Burn offered coins; the intermediate coins are never minted
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, sdk.NewCoins(msg.OfferCoin))
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(msg.OfferCoin))
+	if err != nil {
+		return nil, err
+	}
+
+	feeCoins := sdk.NewCoins()
+	for _, hop := range hops {
+		feeCoins = feeCoins.Add(hop.SwapFee)
+	}
+
+	// Refuse the swap going beyond the swap volume limits
+	swapCoins := sdk.NewCoins(swapCoin)
+	err = k.ApplySwapVolume(ctx, sdk.NewCoins(nativeOfferCoin), swapCoins.Add(feeCoins...))
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.BankKeeper.MintCoins(ctx, types.ModuleName, swapCoins.Add(feeCoins...))
+	if err != nil {
+		return nil, err
+	}
+
+	// Send swap coin to the receiver
+	err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, swapCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	// Route swap fees of every hop by the swap fee split
+	err = k.DistributeSwapFees(ctx, feeCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the statistics of every hop under its own denom pair
+	for _, hop := range hops {
+		k.RecordSwapStatistics(ctx, hop.OfferCoin, hop.SwapCoin, hop.SwapFee)
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventSwapRoute,
+			sdk.NewAttribute(types.AttributeKeyOffer, msg.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeySwapCoin, swapCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapFee, feeCoins.String()),
+		),
+	}
+
+	for i, hop := range hops {
+		events = append(events, sdk.NewEvent(
+			types.EventSwapHop,
+			sdk.NewAttribute(types.AttributeKeyHop, strconv.Itoa(i)),
+			sdk.NewAttribute(types.AttributeKeyOffer, hop.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapCoin, hop.SwapCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapFee, hop.SwapFee.String()),
+			sdk.NewAttribute(types.AttributeKeySpread, hop.Spread.String()),
+		))
+	}
+
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+	))
+
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgSwapRouteResponse{
+		SwapCoin: swapCoin,
+		Hops:     hops,
+	}, nil
+}
+
+func (k msgServer) SwapExactOut(goCtx context.Context, msg *types.MsgSwapExactOut) (*types.MsgSwapExactOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.BatchSwapEnabled(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrBatchSwapMode, sdk.MsgTypeURL(msg))
+	}
+
+	trader, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver := trader
+	if msg.Receiver != "" {
+		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Compute the offer needed to receive the ask coin, in the offer denom of the trader
+	nativeOfferCoin, _, err := k.ComputeReverseSwap(ctx, msg.AskCoin, k.NativeDenom(ctx, msg.OfferDenom))
+	if err != nil {
+		return nil, err
+	}
+
+	offerCoin := sdk.NewCoin(msg.OfferDenom, nativeOfferCoin.Amount)
+
+	// Refuse the swap costing more than the trader accepts
+	if offerCoin.Amount.GT(msg.MaxOfferAmount) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "offer amount %s is greater than the max offer amount %s", offerCoin.Amount, msg.MaxOfferAmount)
+	}
+
+	res, err := k.handleSwapRequest(ctx, trader, receiver, offerCoin, msg.AskCoin.Denom, &msg.AskCoin.Amount, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactOutResponse{
+		OfferCoin: offerCoin,
+		SwapCoin:  res.SwapCoin,
+		SwapFee:   res.SwapFee,
 	}, nil
 }
 
@@ -61,13 +219,27 @@ func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*typ
 func (k msgServer) handleSwapRequest(ctx sdk.Context,
 	trader sdk.AccAddress, receiver sdk.AccAddress,
 	offerCoin sdk.Coin, askDenom string,
+	minReceiveAmount *sdk.Int, maxSpread *sdk.Dec,
 ) (*types.MsgSwapResponse, error) {
+	// Queue the swap for the batch of the block in batch swap mode
+	if k.BatchSwapEnabled(ctx) {
+		return k.queueSwapRequest(ctx, trader, receiver, offerCoin, askDenom, minReceiveAmount, maxSpread)
+	}
+
+	// Swap the ibc vouchers mapped to a native denom as the native denom
+	nativeOfferCoin := k.NativeCoin(ctx, offerCoin)
+
 	// Compute exchange rates between the ask and offer
-	swapDecCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
+	swapDecCoin, spread, err := k.ComputeSwap(ctx, nativeOfferCoin, askDenom)
 	if err != nil {
 		return nil, err
 	}
 
+	// Refuse the swap charging more spread than the trader accepts
+	if maxSpread != nil && spread.GT(*maxSpread) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "spread %s is greater than the max spread %s", spread, maxSpread)
+	}
+
 	// Charge a spread if applicable; the spread is burned
 	var feeDecCoin sdk.DecCoin
 	if spread.IsPositive() {
@@ -79,21 +251,25 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 	// Subtract fee from the swap coin
 	swapDecCoin.Amount = swapDecCoin.Amount.Sub(feeDecCoin.Amount)
 
+	// Refuse the swap returning less than the trader accepts
+	if minReceiveAmount != nil && swapDecCoin.Amount.TruncateInt().LT(*minReceiveAmount) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "swap amount %s is less than the min receive amount %s", swapDecCoin.Amount.TruncateInt(), minReceiveAmount)
+	}
+
 	// Update pool delta
-	err = k.ApplySwapToPool(ctx, offerCoin, swapDecCoin)
+	err = k.ApplySwapToPool(ctx, nativeOfferCoin, swapDecCoin)
 	if err != nil {
 		return nil, err
 	}
 
 	// Send offer coins to module account
-	offerCoins := sdk.NewCoins(offerCoin)
-	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, offerCoins)
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, sdk.NewCoins(offerCoin))
 	if err != nil {
 		return nil, err
 	}
 
 	// Burn offered coins and subtract from the trader's account
-	err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, offerCoins)
+	err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(offerCoin))
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +287,13 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 	feeDecCoin = feeDecCoin.Add(decimalCoin) // add truncated decimalCoin to swapFee
 	feeCoin, _ := feeDecCoin.TruncateDecimal()
 
+	// Refuse the swap going beyond the swap volume limits
 	mintCoins := sdk.NewCoins(swapCoin.Add(feeCoin))
+	err = k.ApplySwapVolume(ctx, sdk.NewCoins(nativeOfferCoin), mintCoins)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.BankKeeper.MintCoins(ctx, types.ModuleName, mintCoins)
 	if err != nil {
 		return nil, err
@@ -124,15 +306,14 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 		return nil, err
 	}
 
-	// Send swap fee to oracle account
-	if feeCoin.IsPositive() {
-		feeCoins := sdk.NewCoins(feeCoin)
-		err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, feeCoins)
-		if err != nil {
-			return nil, err
-		}
+	// Route swap fee by the swap fee split
+	err = k.DistributeSwapFees(ctx, sdk.NewCoins(feeCoin))
+	if err != nil {
+		return nil, err
 	}
 
+	k.RecordSwapStatistics(ctx, nativeOfferCoin, swapCoin, feeCoin)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventSwap,
@@ -153,3 +334,46 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 		SwapFee:  feeCoin,
 	}, nil
 }
+
+// queueSwapRequest escrows the offer coin in the market module and queues the swap,
+// to be cleared with the other swaps of the block by the EndBlocker
+func (k msgServer) queueSwapRequest(ctx sdk.Context,
+	trader sdk.AccAddress, receiver sdk.AccAddress,
+	offerCoin sdk.Coin, askDenom string,
+	minReceiveAmount *sdk.Int, maxSpread *sdk.Dec,
+) (*types.MsgSwapResponse, error) {
+	// Refuse the swap without exchange rates to price it
+	_, _, err := k.ComputeSwap(ctx, k.NativeCoin(ctx, offerCoin), askDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, sdk.NewCoins(offerCoin))
+	if err != nil {
+		return nil, err
+	}
+
+	orderID := k.QueueBatchSwapOrder(ctx, types.NewBatchSwapOrder(trader, receiver, offerCoin, askDenom, minReceiveAmount, maxSpread))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventBatchSwapQueued,
+			sdk.NewAttribute(types.AttributeKeyOrderID, strconv.FormatUint(orderID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOffer, offerCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAskDenom, askDenom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	zeroCoin := sdk.NewCoin(askDenom, sdk.ZeroInt())
+	return &types.MsgSwapResponse{
+		SwapCoin:     zeroCoin,
+		SwapFee:      zeroCoin,
+		BatchOrderID: orderID,
+	}, nil
+}
@@ -25,6 +25,66 @@ func (k Keeper) PoolRecoveryPeriod(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// SwapVolumeLimits are the caps on the net amount of each denom minted or burned by swaps
+func (k Keeper) SwapVolumeLimits(ctx sdk.Context) (res types.SwapVolumeLimits) {
+	k.paramSpace.Get(ctx, types.KeySwapVolumeLimits, &res)
+	return
+}
+
+// SwapVolumeEpoch is the period after which the epoch swap volumes are reset
+func (k Keeper) SwapVolumeEpoch(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeySwapVolumeEpoch, &res)
+	return
+}
+
+// SwapStatisticsPeriod is the number of blocks aggregated into each swap statistics period
+func (k Keeper) SwapStatisticsPeriod(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeySwapStatisticsPeriod, &res)
+	return
+}
+
+// SwapStatisticsRetention is the number of swap statistics periods retained in the store
+func (k Keeper) SwapStatisticsRetention(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeySwapStatisticsRetention, &res)
+	return
+}
+
+// PoolReplenishMode is the way the terra pool delta is replenished
+func (k Keeper) PoolReplenishMode(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.KeyPoolReplenishMode, &res)
+	return
+}
+
+// PoolRecoveryTime is the seconds required to recover BasePool in the linear replenish mode
+func (k Keeper) PoolRecoveryTime(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyPoolRecoveryTime, &res)
+	return
+}
+
+// PoolHalfLife is the seconds required to recover half of the delta in the exponential replenish mode
+func (k Keeper) PoolHalfLife(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyPoolHalfLife, &res)
+	return
+}
+
+// SwapFeeBurnRate is the portion of the swap fees burned
+func (k Keeper) SwapFeeBurnRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySwapFeeBurnRate, &res)
+	return
+}
+
+// SwapFeeCommunityPoolRate is the portion of the swap fees sent to the community pool
+func (k Keeper) SwapFeeCommunityPoolRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySwapFeeCommunityPoolRate, &res)
+	return
+}
+
+// BatchSwapEnabled is whether the swaps are queued and cleared in batch at the end block
+func (k Keeper) BatchSwapEnabled(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyBatchSwapEnabled, &res)
+	return
+}
+
 // GetParams returns the total set of market parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
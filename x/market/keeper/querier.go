@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/classic-terra/core/x/market/types"
 )
@@ -54,6 +56,263 @@ func (q querier) Swap(c context.Context, req *types.QuerySwapRequest) (*types.Qu
 	return &types.QuerySwapResponse{ReturnCoin: retCoin}, nil
 }
 
+// SwapRoute queries for swap route simulation
+func (q querier) SwapRoute(c context.Context, req *types.QuerySwapRouteRequest) (*types.QuerySwapRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	offerCoin, err := sdk.ParseCoinNormalized(req.OfferCoin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateSwapRoute(offerCoin.Denom, req.AskDenoms); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retCoin, hops, err := q.simulateSwapRoute(ctx, offerCoin, req.AskDenoms)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySwapRouteResponse{ReturnCoin: retCoin, Hops: hops}, nil
+}
+
+// SwapExactOut queries for exact output swap simulation
+func (q querier) SwapExactOut(c context.Context, req *types.QuerySwapExactOutRequest) (*types.QuerySwapExactOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.OfferDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid offer denom")
+	}
+
+	askCoin, err := sdk.ParseCoinNormalized(req.AskCoin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !askCoin.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "ask coin must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offerCoin, err := q.simulateSwapExactOut(ctx, askCoin, req.OfferDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySwapExactOutResponse{OfferCoin: offerCoin}, nil
+}
+
+// SwapVolume queries the swap volume and the remaining capacity of a denom
+func (q querier) SwapVolume(c context.Context, req *types.QuerySwapVolumeRequest) (*types.QuerySwapVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	limit, ok := q.SwapVolumeLimits(ctx).LimitOf(req.Denom)
+	if !ok {
+		limit = types.NewSwapVolumeLimit(req.Denom, sdk.ZeroInt(), sdk.ZeroInt())
+	}
+
+	return &types.QuerySwapVolumeResponse{
+		Block: types.NewSwapVolumeCapacity(q.GetBlockSwapVolume(ctx, req.Denom), limit.BlockLimit),
+		Epoch: types.NewSwapVolumeCapacity(q.GetEpochSwapVolume(ctx, req.Denom), limit.EpochLimit),
+	}, nil
+}
+
+// SwapStatistics queries the swap statistics of each denom pair over the last periods
+func (q querier) SwapStatistics(c context.Context, req *types.QuerySwapStatisticsRequest) (*types.QuerySwapStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.OfferDenom != "" {
+		if err := sdk.ValidateDenom(req.OfferDenom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid offer denom")
+		}
+	}
+
+	if req.AskDenom != "" {
+		if err := sdk.ValidateDenom(req.AskDenom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid ask denom")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retention := q.SwapStatisticsRetention(ctx)
+	if req.Window == 0 || req.Window > retention {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be between 1 and %d", retention)
+	}
+
+	return &types.QuerySwapStatisticsResponse{
+		Statistics: q.AggregateSwapStatistics(ctx, req.Window, req.OfferDenom, req.AskDenom),
+	}, nil
+}
+
+// TerraSwapFee queries the swap fee charged on the swaps of a terra denom pair
+func (q querier) TerraSwapFee(c context.Context, req *types.QueryTerraSwapFeeRequest) (*types.QueryTerraSwapFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := types.ValidateTerraSwapFeePair(req.OfferDenom, req.AskDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fee, tobinTaxFallback, err := q.ComputeTerraSwapFee(ctx, req.OfferDenom, req.AskDenom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTerraSwapFeeResponse{Fee: fee, TobinTaxFallback: tobinTaxFallback}, nil
+}
+
+// TerraSwapFees queries the swap fees set for the terra denom pairs
+func (q querier) TerraSwapFees(c context.Context, req *types.QueryTerraSwapFeesRequest) (*types.QueryTerraSwapFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.TerraSwapFeeKeyPrefix)
+
+	var fees []types.TerraSwapFee
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var dp sdk.DecProto
+		if err := q.cdc.Unmarshal(value, &dp); err != nil {
+			return err
+		}
+
+		offerDenom, askDenom := types.ParseTerraSwapFeeKey(append(types.TerraSwapFeeKeyPrefix, key...))
+		fees = append(fees, types.NewTerraSwapFee(offerDenom, askDenom, dp.Dec))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTerraSwapFeesResponse{
+		TerraSwapFees: fees,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// SwapFeeAccounting queries the cumulative swap fees routed to each destination
+func (q querier) SwapFeeAccounting(c context.Context, req *types.QuerySwapFeeAccountingRequest) (*types.QuerySwapFeeAccountingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Destination == "" {
+		return &types.QuerySwapFeeAccountingResponse{Totals: q.GetSwapFeeTotals(ctx)}, nil
+	}
+
+	if err := types.ValidateSwapFeeDestination(req.Destination); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySwapFeeAccountingResponse{
+		Totals: []types.SwapFeeTotal{types.NewSwapFeeTotal(req.Destination, q.GetSwapFeeTotal(ctx, req.Destination))},
+	}, nil
+}
+
+// BatchSwapOrders queries the swaps queued for the batch of the current block
+func (q querier) BatchSwapOrders(c context.Context, req *types.QueryBatchSwapOrdersRequest) (*types.QueryBatchSwapOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Trader != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Trader); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid trader address")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.BatchSwapOrderKeyPrefix)
+
+	var orders []types.BatchSwapOrder
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var order types.BatchSwapOrder
+		if err := q.cdc.Unmarshal(value, &order); err != nil {
+			return false, err
+		}
+
+		if req.Trader != "" && order.Trader != req.Trader {
+			return false, nil
+		}
+
+		if accumulate {
+			orders = append(orders, order)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBatchSwapOrdersResponse{
+		Orders:     orders,
+		Pagination: pageRes,
+	}, nil
+}
+
+// IBCDenomMapping queries the native denom an ibc denom is mapped to
+func (q querier) IBCDenomMapping(c context.Context, req *types.QueryIBCDenomMappingRequest) (*types.QueryIBCDenomMappingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := types.ValidateIBCDenom(req.IBCDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	nativeDenom, ok := q.GetIBCDenomMapping(ctx, req.IBCDenom)
+	if !ok {
+		return nil, status.Error(codes.NotFound, types.ErrNoIBCDenomMapping.Wrap(req.IBCDenom).Error())
+	}
+
+	return &types.QueryIBCDenomMappingResponse{Mapping: types.NewIBCDenomMapping(req.IBCDenom, nativeDenom)}, nil
+}
+
+// IBCDenomMappings queries the native denoms the ibc denoms are mapped to
+func (q querier) IBCDenomMappings(c context.Context, req *types.QueryIBCDenomMappingsRequest) (*types.QueryIBCDenomMappingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.IBCDenomMappingKeyPrefix)
+
+	var mappings []types.IBCDenomMapping
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		mappings = append(mappings, types.NewIBCDenomMapping(string(key), string(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIBCDenomMappingsResponse{
+		Mappings:   mappings,
+		Pagination: pageRes,
+	}, nil
+}
+
 // TerraPoolDelta queries terra pool delta
 func (q querier) TerraPoolDelta(c context.Context, req *types.QueryTerraPoolDeltaRequest) (*types.QueryTerraPoolDeltaResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -63,6 +63,255 @@ func TestQuerySwap(t *testing.T) {
 	require.True(t, res.ReturnCoin.Amount.IsPositive())
 }
 
+func TestQuerySwapRoute(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	price := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, price)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, price)
+
+	var err error
+
+	// nil request cause error
+	_, err = querier.SwapRoute(ctx, nil)
+	require.Error(t, err)
+
+	// empty route cause error
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000)).String()
+	_, err = querier.SwapRoute(ctx, &types.QuerySwapRouteRequest{OfferCoin: offerCoin})
+	require.Error(t, err)
+
+	// recursive route cause error
+	_, err = querier.SwapRoute(ctx, &types.QuerySwapRouteRequest{OfferCoin: offerCoin, AskDenoms: []string{core.MicroSDRDenom, core.MicroSDRDenom}})
+	require.Error(t, err)
+
+	// valid query
+	beforeTerraPoolDelta := input.MarketKeeper.GetTerraPoolDelta(input.Ctx)
+	res, err := querier.SwapRoute(ctx, &types.QuerySwapRouteRequest{OfferCoin: offerCoin, AskDenoms: []string{core.MicroSDRDenom, core.MicroKRWDenom}})
+	require.NoError(t, err)
+
+	require.Len(t, res.Hops, 2)
+	require.Equal(t, core.MicroKRWDenom, res.ReturnCoin.Denom)
+	require.True(t, res.ReturnCoin.Amount.IsPositive())
+
+	// simulation leaves the pool untouched
+	require.Equal(t, beforeTerraPoolDelta, input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+}
+
+func TestQuerySwapExactOut(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	price := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, price)
+
+	var err error
+
+	// nil request cause error
+	_, err = querier.SwapExactOut(ctx, nil)
+	require.Error(t, err)
+
+	// empty offer denom cause error
+	askCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(17)).String()
+	_, err = querier.SwapExactOut(ctx, &types.QuerySwapExactOutRequest{AskCoin: askCoin})
+	require.Error(t, err)
+
+	// empty ask coin cause error
+	_, err = querier.SwapExactOut(ctx, &types.QuerySwapExactOutRequest{OfferDenom: core.MicroLunaDenom})
+	require.Error(t, err)
+
+	// recursive query
+	_, err = querier.SwapExactOut(ctx, &types.QuerySwapExactOutRequest{AskCoin: askCoin, OfferDenom: core.MicroSDRDenom})
+	require.Error(t, err)
+
+	// valid query
+	res, err := querier.SwapExactOut(ctx, &types.QuerySwapExactOutRequest{AskCoin: askCoin, OfferDenom: core.MicroLunaDenom})
+	require.NoError(t, err)
+
+	require.Equal(t, core.MicroLunaDenom, res.OfferCoin.Denom)
+	require.True(t, res.OfferCoin.Amount.GTE(sdk.NewInt(10)))
+}
+
+func TestQuerySwapVolume(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapVolumeLimits = types.SwapVolumeLimits{
+		types.NewSwapVolumeLimit(core.MicroSDRDenom, sdk.NewInt(100), sdk.ZeroInt()),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+	input.MarketKeeper.SetBlockSwapVolume(input.Ctx, core.MicroSDRDenom, sdk.NewInt(30))
+	input.MarketKeeper.SetEpochSwapVolume(input.Ctx, core.MicroSDRDenom, sdk.NewInt(-40))
+
+	// invalid denom cause error
+	_, err := querier.SwapVolume(ctx, &types.QuerySwapVolumeRequest{})
+	require.Error(t, err)
+
+	res, err := querier.SwapVolume(ctx, &types.QuerySwapVolumeRequest{Denom: core.MicroSDRDenom})
+	require.NoError(t, err)
+
+	mintRemaining, burnRemaining := sdk.NewInt(70), sdk.NewInt(130)
+	require.Equal(t, types.SwapVolumeCapacity{
+		Volume:        sdk.NewInt(30),
+		Limit:         sdk.NewInt(100),
+		MintRemaining: &mintRemaining,
+		BurnRemaining: &burnRemaining,
+	}, res.Block)
+
+	// no remaining capacity without cap
+	require.Equal(t, types.SwapVolumeCapacity{Volume: sdk.NewInt(-40), Limit: sdk.ZeroInt()}, res.Epoch)
+}
+
+func TestQuerySwapStatistics(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	input.MarketKeeper.RecordSwapStatistics(input.Ctx,
+		sdk.NewInt64Coin(core.MicroKRWDenom, 100),
+		sdk.NewInt64Coin(core.MicroLunaDenom, 20),
+		sdk.NewInt64Coin(core.MicroLunaDenom, 1),
+	)
+
+	// window out of the retention cause error
+	_, err := querier.SwapStatistics(ctx, &types.QuerySwapStatisticsRequest{})
+	require.Error(t, err)
+
+	retention := input.MarketKeeper.SwapStatisticsRetention(input.Ctx)
+	_, err = querier.SwapStatistics(ctx, &types.QuerySwapStatisticsRequest{Window: retention + 1})
+	require.Error(t, err)
+
+	// invalid denom cause error
+	_, err = querier.SwapStatistics(ctx, &types.QuerySwapStatisticsRequest{Window: 1, OfferDenom: "?"})
+	require.Error(t, err)
+
+	res, err := querier.SwapStatistics(ctx, &types.QuerySwapStatisticsRequest{Window: 1, OfferDenom: core.MicroKRWDenom})
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapStatistics{{
+		OfferDenom:  core.MicroKRWDenom,
+		AskDenom:    core.MicroLunaDenom,
+		OfferVolume: sdk.NewInt(100),
+		AskVolume:   sdk.NewInt(20),
+		SwapFee:     sdk.NewInt(1),
+		Count:       1,
+	}}, res.Statistics)
+
+	// no swap of the other pair
+	res, err = querier.SwapStatistics(ctx, &types.QuerySwapStatisticsRequest{Window: 1, OfferDenom: core.MicroLunaDenom})
+	require.NoError(t, err)
+	require.Empty(t, res.Statistics)
+}
+
+func TestQueryTerraSwapFees(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	tobinTax := sdk.NewDecWithPrec(2, 2)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, tobinTax)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, tobinTax)
+
+	// luna pair cause error
+	_, err := querier.TerraSwapFee(ctx, &types.QueryTerraSwapFeeRequest{OfferDenom: core.MicroLunaDenom, AskDenom: core.MicroSDRDenom})
+	require.Error(t, err)
+
+	res, err := querier.TerraSwapFee(ctx, &types.QueryTerraSwapFeeRequest{OfferDenom: core.MicroKRWDenom, AskDenom: core.MicroSDRDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.QueryTerraSwapFeeResponse{Fee: tobinTax, TobinTaxFallback: true}, *res)
+
+	fee := types.NewTerraSwapFee(core.MicroKRWDenom, core.MicroSDRDenom, sdk.NewDecWithPrec(1, 3))
+	input.MarketKeeper.SetTerraSwapFee(input.Ctx, fee)
+
+	res, err = querier.TerraSwapFee(ctx, &types.QueryTerraSwapFeeRequest{OfferDenom: core.MicroKRWDenom, AskDenom: core.MicroSDRDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.QueryTerraSwapFeeResponse{Fee: fee.Fee, TobinTaxFallback: false}, *res)
+
+	resFees, err := querier.TerraSwapFees(ctx, &types.QueryTerraSwapFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TerraSwapFee{fee}, resFees.TerraSwapFees)
+}
+
+func TestQuerySwapFeeAccounting(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	oracleCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100))
+	input.MarketKeeper.SetSwapFeeTotal(input.Ctx, types.NewSwapFeeTotal(types.SwapFeeDestinationOracle, oracleCoins))
+
+	// unknown destination cause error
+	_, err := querier.SwapFeeAccounting(ctx, &types.QuerySwapFeeAccountingRequest{Destination: "treasury"})
+	require.Error(t, err)
+
+	res, err := querier.SwapFeeAccounting(ctx, &types.QuerySwapFeeAccountingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapFeeTotal{
+		types.NewSwapFeeTotal(types.SwapFeeDestinationBurn, sdk.NewCoins()),
+		types.NewSwapFeeTotal(types.SwapFeeDestinationOracle, oracleCoins),
+		types.NewSwapFeeTotal(types.SwapFeeDestinationCommunityPool, sdk.NewCoins()),
+	}, res.Totals)
+
+	res, err = querier.SwapFeeAccounting(ctx, &types.QuerySwapFeeAccountingRequest{Destination: types.SwapFeeDestinationOracle})
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapFeeTotal{types.NewSwapFeeTotal(types.SwapFeeDestinationOracle, oracleCoins)}, res.Totals)
+}
+
+func TestQueryBatchSwapOrders(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	offerCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000)
+	for _, addr := range []sdk.AccAddress{Addrs[0], Addrs[1], Addrs[0]} {
+		input.MarketKeeper.QueueBatchSwapOrder(input.Ctx, types.NewBatchSwapOrder(addr, addr, offerCoin, core.MicroSDRDenom, nil, nil))
+	}
+
+	// invalid trader cause error
+	_, err := querier.BatchSwapOrders(ctx, &types.QueryBatchSwapOrdersRequest{Trader: "invalid"})
+	require.Error(t, err)
+
+	res, err := querier.BatchSwapOrders(ctx, &types.QueryBatchSwapOrdersRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 3)
+
+	res, err = querier.BatchSwapOrders(ctx, &types.QueryBatchSwapOrdersRequest{Trader: Addrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 2)
+	require.Equal(t, uint64(1), res.Orders[0].ID)
+	require.Equal(t, uint64(3), res.Orders[1].ID)
+}
+
+func TestQueryIBCDenomMappings(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	ibcDenom := bridgedSDRTrace.IBCDenom()
+
+	// native denom cause error
+	_, err := querier.IBCDenomMapping(ctx, &types.QueryIBCDenomMappingRequest{IBCDenom: core.MicroSDRDenom})
+	require.Error(t, err)
+
+	_, err = querier.IBCDenomMapping(ctx, &types.QueryIBCDenomMappingRequest{IBCDenom: ibcDenom})
+	require.Error(t, err)
+
+	mapping := types.NewIBCDenomMapping(ibcDenom, core.MicroSDRDenom)
+	input.MarketKeeper.SetIBCDenomMapping(input.Ctx, mapping)
+
+	res, err := querier.IBCDenomMapping(ctx, &types.QueryIBCDenomMappingRequest{IBCDenom: ibcDenom})
+	require.NoError(t, err)
+	require.Equal(t, mapping, res.Mapping)
+
+	resMappings, err := querier.IBCDenomMappings(ctx, &types.QueryIBCDenomMappingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.IBCDenomMapping{mapping}, resMappings.Mappings)
+}
+
 func TestQueryMintPoolDelta(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -1,14 +1,20 @@
 package keeper
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	core "github.com/classic-terra/core/types"
 	"github.com/classic-terra/core/x/market/types"
+	oracletypes "github.com/classic-terra/core/x/oracle/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// maxReverseSwapAdjustments bounds the unit steps ComputeReverseSwap takes to cover decimal errors
+const maxReverseSwapAdjustments = 3
+
 // ApplySwapToPool updates each pool with offerCoin and askCoin taken from swap operation,
 // OfferPool = OfferPool + offerAmt (Fills the swap pool with offerAmt)
 // AskPool = AskPool - askAmt       (Uses askAmt from the swap pool)
@@ -68,27 +74,13 @@ func (k Keeper) ComputeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string
 	}
 
 	// Terra => Terra swap
-	// Apply only tobin tax without constant product spread
+	// Apply only the terra swap fee without constant product spread
 	if offerCoin.Denom != core.MicroLunaDenom && askDenom != core.MicroLunaDenom {
-		var tobinTax sdk.Dec
-		offerTobinTax, err2 := k.OracleKeeper.GetTobinTax(ctx, offerCoin.Denom)
-		if err2 != nil {
-			return sdk.DecCoin{}, sdk.Dec{}, err2
-		}
-
-		askTobinTax, err2 := k.OracleKeeper.GetTobinTax(ctx, askDenom)
-		if err2 != nil {
-			return sdk.DecCoin{}, sdk.Dec{}, err2
-		}
-
-		// Apply highest tobin tax for the denoms in the swap operation
-		if askTobinTax.GT(offerTobinTax) {
-			tobinTax = askTobinTax
-		} else {
-			tobinTax = offerTobinTax
+		spread, _, err = k.ComputeTerraSwapFee(ctx, offerCoin.Denom, askDenom)
+		if err != nil {
+			return sdk.DecCoin{}, sdk.Dec{}, err
 		}
 
-		spread = tobinTax
 		return
 	}
 
@@ -130,6 +122,87 @@ func (k Keeper) ComputeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string
 	return retDecCoin, spread, nil
 }
 
+// ComputeReverseSwap returns the offer coin needed to receive askCoin after the spread is charged,
+// the inverse of ComputeSwap. The offer amount is rounded up, so swapping it returns at least askCoin.
+// Returns an Error if the swap is recursive, or the coins to be traded are unknown by the oracle, or askCoin
+// cannot be reached with the current pool.
+func (k Keeper) ComputeReverseSwap(ctx sdk.Context, askCoin sdk.Coin, offerDenom string) (offerCoin sdk.Coin, spread sdk.Dec, err error) {
+	// Return invalid recursive swap err
+	if offerDenom == askCoin.Denom {
+		return sdk.Coin{}, sdk.ZeroDec(), sdkerrors.Wrap(types.ErrRecursiveSwap, offerDenom)
+	}
+
+	// Swap ask coin to base denom for simplicity of swap process
+	baseAskDecCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(askCoin), core.MicroSDRDenom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	var baseOfferAmount sdk.Dec
+	if offerDenom != core.MicroLunaDenom && askCoin.Denom != core.MicroLunaDenom {
+		// Terra => Terra swap
+		// askBaseAmount = baseOfferAmount * (1 - swapFee)
+		swapFee, _, err := k.ComputeTerraSwapFee(ctx, offerDenom, askCoin.Denom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, err
+		}
+
+		if swapFee.GTE(sdk.OneDec()) {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrUnreachableAsk, "terra swap fee %s", swapFee)
+		}
+
+		baseOfferAmount = baseAskDecCoin.Amount.Quo(sdk.OneDec().Sub(swapFee))
+	} else {
+		basePool := k.BasePool(ctx)
+		minSpread := k.MinStabilitySpread(ctx)
+		if minSpread.GTE(sdk.OneDec()) {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrUnreachableAsk, "min stability spread %s", minSpread)
+		}
+
+		cp := basePool.Mul(basePool)
+		terraPool := basePool.Add(k.GetTerraPoolDelta(ctx))
+		lunaPool := cp.Quo(terraPool)
+
+		offerPool, askPool := lunaPool, terraPool
+		if offerDenom != core.MicroLunaDenom {
+			offerPool, askPool = terraPool, lunaPool
+		}
+
+		if baseAskDecCoin.Amount.GTE(askPool) {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrUnreachableAsk, "ask pool %s", askPool)
+		}
+
+		// The spread is the greater of the cp spread and min spread, so the offer must cover both:
+		// askBaseAmount = askPool - cp / (offerPool + baseOfferAmount)  =>  baseOfferAmount = offerPool * askBaseAmount / (askPool - askBaseAmount)
+		// askBaseAmount = baseOfferAmount * (1 - minSpread)             =>  baseOfferAmount = askBaseAmount / (1 - minSpread)
+		cpOfferAmount := offerPool.Mul(baseAskDecCoin.Amount).Quo(askPool.Sub(baseAskDecCoin.Amount))
+		minSpreadOfferAmount := baseAskDecCoin.Amount.Quo(sdk.OneDec().Sub(minSpread))
+		baseOfferAmount = sdk.MaxDec(cpOfferAmount, minSpreadOfferAmount)
+	}
+
+	offerDecCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromDec(core.MicroSDRDenom, baseOfferAmount), offerDenom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	// Round the offer up and make sure the decimal errors of the inverse do not fall short of askCoin
+	offerCoin = sdk.NewCoin(offerDenom, offerDecCoin.Amount.Ceil().TruncateInt())
+	for i := 0; i < maxReverseSwapAdjustments; i++ {
+		retDecCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askCoin.Denom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, err
+		}
+
+		if retDecCoin.Amount.Mul(sdk.OneDec().Sub(spread)).GTE(sdk.NewDecFromInt(askCoin.Amount)) {
+			return offerCoin, spread, nil
+		}
+
+		offerCoin.Amount = offerCoin.Amount.AddRaw(1)
+	}
+
+	return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrap(types.ErrUnreachableAsk, askCoin.String())
+}
+
 // ComputeInternalSwap returns the amount of asked DecCoin should be returned for a given offerCoin at the effective
 // exchange rate registered with the oracle.
 // Different from ComputeSwap, ComputeInternalSwap does not charge a spread as its use is system internal.
@@ -138,13 +211,18 @@ func (k Keeper) ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askD
 		return offerCoin, nil
 	}
 
+	// Refuse the swap of the denoms halted by the oracle circuit breaker
 	offerRate, err := k.OracleKeeper.GetLunaExchangeRate(ctx, offerCoin.Denom)
-	if err != nil {
+	if errors.Is(err, oracletypes.ErrDenomHalted) {
+		return sdk.DecCoin{}, err
+	} else if err != nil {
 		return sdk.DecCoin{}, sdkerrors.Wrap(types.ErrNoEffectivePrice, offerCoin.Denom)
 	}
 
 	askRate, err := k.OracleKeeper.GetLunaExchangeRate(ctx, askDenom)
-	if err != nil {
+	if errors.Is(err, oracletypes.ErrDenomHalted) {
+		return sdk.DecCoin{}, err
+	} else if err != nil {
 		return sdk.DecCoin{}, sdkerrors.Wrap(types.ErrNoEffectivePrice, askDenom)
 	}
 
@@ -166,7 +244,7 @@ func (k Keeper) simulateSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom strin
 		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, offerCoin.String())
 	}
 
-	swapCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
+	swapCoin, spread, err := k.ComputeSwap(ctx, k.NativeCoin(ctx, offerCoin), askDenom)
 	if err != nil {
 		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrPanic, err.Error())
 	}
@@ -182,3 +260,88 @@ func (k Keeper) simulateSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom strin
 	retCoin, _ := swapCoin.TruncateDecimal()
 	return retCoin, nil
 }
+
+// ComputeSwapRoute swaps the offer coin through each ask denom of the route in order.
+// Every hop is applied to the pool, so later hops are priced against the pool left by
+// the earlier ones; callers that only simulate the route must pass a cached context.
+func (k Keeper) ComputeSwapRoute(ctx sdk.Context, offerCoin sdk.Coin, route []string) ([]types.SwapHop, error) {
+	hops := make([]types.SwapHop, 0, len(route))
+	for _, askDenom := range route {
+		if askDenom == offerCoin.Denom {
+			return nil, sdkerrors.Wrap(types.ErrRecursiveSwap, askDenom)
+		}
+
+		swapDecCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		// Charge a spread if applicable
+		feeDecCoin := sdk.NewDecCoin(swapDecCoin.Denom, sdk.ZeroInt())
+		if spread.IsPositive() {
+			feeDecCoin = sdk.NewDecCoinFromDec(swapDecCoin.Denom, spread.Mul(swapDecCoin.Amount))
+		}
+
+		swapDecCoin.Amount = swapDecCoin.Amount.Sub(feeDecCoin.Amount)
+
+		if err := k.ApplySwapToPool(ctx, offerCoin, swapDecCoin); err != nil {
+			return nil, err
+		}
+
+		// The next hop can only offer integer amount, so the decimal goes to the fee
+		swapCoin, decimalCoin := swapDecCoin.TruncateDecimal()
+		if !swapCoin.IsPositive() {
+			return nil, sdkerrors.Wrap(types.ErrZeroSwapCoin, askDenom)
+		}
+
+		feeCoin, _ := feeDecCoin.Add(decimalCoin).TruncateDecimal()
+		hops = append(hops, types.SwapHop{
+			OfferCoin: offerCoin,
+			SwapCoin:  swapCoin,
+			SwapFee:   feeCoin,
+			Spread:    spread,
+		})
+
+		offerCoin = swapCoin
+	}
+
+	return hops, nil
+}
+
+// simulateSwapRoute interface for simulate swap route
+func (k Keeper) simulateSwapRoute(ctx sdk.Context, offerCoin sdk.Coin, route []string) (sdk.Coin, []types.SwapHop, error) {
+	if err := types.ValidateSwapRoute(offerCoin.Denom, route); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	if offerCoin.Amount.BigInt().BitLen() > 100 {
+		return sdk.Coin{}, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, offerCoin.String())
+	}
+
+	// Discard the pool changes of the simulation
+	cacheCtx, _ := ctx.CacheContext()
+	hops, err := k.ComputeSwapRoute(cacheCtx, k.NativeCoin(ctx, offerCoin), route)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	return hops[len(hops)-1].SwapCoin, hops, nil
+}
+
+// simulateSwapExactOut interface for simulate exact output swap
+func (k Keeper) simulateSwapExactOut(ctx sdk.Context, askCoin sdk.Coin, offerDenom string) (sdk.Coin, error) {
+	if askCoin.Denom == offerDenom {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrRecursiveSwap, offerDenom)
+	}
+
+	if askCoin.Amount.BigInt().BitLen() > 100 {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, askCoin.String())
+	}
+
+	offerCoin, _, err := k.ComputeReverseSwap(ctx, askCoin, k.NativeDenom(ctx, offerDenom))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(offerDenom, offerCoin.Amount), nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/classic-terra/core/x/oracle/types"
+
+	"github.com/classic-terra/core/x/market/types"
+)
+
+// GetSwapFeeTotal returns the cumulative swap fees routed to the destination
+func (k Keeper) GetSwapFeeTotal(ctx sdk.Context, destination string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetSwapFeeTotalDestinationPrefix(destination)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	total := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(prefix):])
+
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &amount)
+		total = total.Add(sdk.NewCoin(denom, amount.Int))
+	}
+
+	return total
+}
+
+// SetSwapFeeTotal updates the cumulative swap fees routed to the destination
+func (k Keeper) SetSwapFeeTotal(ctx sdk.Context, total types.SwapFeeTotal) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range total.Amount {
+		bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+		store.Set(types.GetSwapFeeTotalKey(total.Destination, coin.Denom), bz)
+	}
+}
+
+// GetSwapFeeTotals returns the cumulative swap fees routed to each destination
+func (k Keeper) GetSwapFeeTotals(ctx sdk.Context) []types.SwapFeeTotal {
+	totals := make([]types.SwapFeeTotal, 0, len(types.SwapFeeDestinations))
+	for _, destination := range types.SwapFeeDestinations {
+		totals = append(totals, types.NewSwapFeeTotal(destination, k.GetSwapFeeTotal(ctx, destination)))
+	}
+
+	return totals
+}
+
+// SplitSwapFees splits the swap fees into the burned, oracle reward pool and community pool
+// portions; the community pool portion is capped by what the burned portion leaves
+func (k Keeper) SplitSwapFees(ctx sdk.Context, fees sdk.Coins) (burnCoins, oracleCoins, communityPoolCoins sdk.Coins) {
+	burnRate := k.SwapFeeBurnRate(ctx)
+	communityPoolRate := k.SwapFeeCommunityPoolRate(ctx)
+
+	burnCoins, communityPoolCoins = sdk.NewCoins(), sdk.NewCoins()
+	for _, fee := range fees {
+		burnAmount := burnRate.MulInt(fee.Amount).TruncateInt()
+		communityPoolAmount := sdk.MinInt(communityPoolRate.MulInt(fee.Amount).TruncateInt(), fee.Amount.Sub(burnAmount))
+
+		burnCoins = burnCoins.Add(sdk.NewCoin(fee.Denom, burnAmount))
+		communityPoolCoins = communityPoolCoins.Add(sdk.NewCoin(fee.Denom, communityPoolAmount))
+	}
+
+	// The truncated remainders go to the oracle reward pool
+	oracleCoins = fees.Sub(burnCoins).Sub(communityPoolCoins)
+	return burnCoins, oracleCoins, communityPoolCoins
+}
+
+// DistributeSwapFees routes the swap fees held by the market module to their destinations
+// by the swap fee split, and records the amounts routed to each destination
+func (k Keeper) DistributeSwapFees(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	burnCoins, oracleCoins, communityPoolCoins := k.SplitSwapFees(ctx, fees)
+
+	if !burnCoins.IsZero() {
+		if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return err
+		}
+		k.recordSwapFees(ctx, types.SwapFeeDestinationBurn, burnCoins)
+	}
+
+	if !oracleCoins.IsZero() {
+		if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, oracleCoins); err != nil {
+			return err
+		}
+		k.recordSwapFees(ctx, types.SwapFeeDestinationOracle, oracleCoins)
+	}
+
+	if !communityPoolCoins.IsZero() {
+		if err := k.DistrKeeper.FundCommunityPool(ctx, communityPoolCoins, k.AccountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+		k.recordSwapFees(ctx, types.SwapFeeDestinationCommunityPool, communityPoolCoins)
+	}
+
+	return nil
+}
+
+// recordSwapFees adds the swap fees to the cumulative total of the destination and emits the split event
+func (k Keeper) recordSwapFees(ctx sdk.Context, destination string, fees sdk.Coins) {
+	k.SetSwapFeeTotal(ctx, types.NewSwapFeeTotal(destination, k.GetSwapFeeTotal(ctx, destination).Add(fees...)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventSwapFeeSplit,
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+		),
+	)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+	oracletypes "github.com/classic-terra/core/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSplitSwapFees(t *testing.T) {
+	input := CreateTestInput(t)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000), sdk.NewInt64Coin(core.MicroKRWDenom, 9))
+
+	// all swap fees go to the oracle reward pool by default
+	burnCoins, oracleCoins, communityPoolCoins := input.MarketKeeper.SplitSwapFees(input.Ctx, fees)
+	require.True(t, burnCoins.IsZero())
+	require.Equal(t, fees, oracleCoins)
+	require.True(t, communityPoolCoins.IsZero())
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapFeeBurnRate = sdk.NewDecWithPrec(5, 1)
+	params.SwapFeeCommunityPoolRate = sdk.NewDecWithPrec(2, 1)
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	// truncated remainders go to the oracle reward pool
+	burnCoins, oracleCoins, communityPoolCoins = input.MarketKeeper.SplitSwapFees(input.Ctx, fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500), sdk.NewInt64Coin(core.MicroKRWDenom, 4)), burnCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 300), sdk.NewInt64Coin(core.MicroKRWDenom, 4)), oracleCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 200), sdk.NewInt64Coin(core.MicroKRWDenom, 1)), communityPoolCoins)
+	require.Equal(t, fees, burnCoins.Add(oracleCoins...).Add(communityPoolCoins...))
+}
+
+func TestDistributeSwapFees(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapFeeBurnRate = sdk.NewDecWithPrec(5, 1)
+	params.SwapFeeCommunityPoolRate = sdk.NewDecWithPrec(2, 1)
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	err := input.BankKeeper.MintCoins(input.Ctx, types.ModuleName, fees)
+	require.NoError(t, err)
+
+	supply := input.BankKeeper.GetSupply(input.Ctx, core.MicroSDRDenom)
+	err = input.MarketKeeper.DistributeSwapFees(input.Ctx, fees)
+	require.NoError(t, err)
+
+	burnCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500))
+	oracleCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 300))
+	communityPoolCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 200))
+
+	require.Equal(t, supply.Sub(burnCoins[0]), input.BankKeeper.GetSupply(input.Ctx, core.MicroSDRDenom))
+	require.Equal(t, oracleCoins, input.BankKeeper.GetAllBalances(input.Ctx, input.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(communityPoolCoins...), input.DistrKeeper.GetFeePoolCommunityCoins(input.Ctx))
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	// each destination records its cumulative swap fees and emits a split event
+	require.Equal(t, []types.SwapFeeTotal{
+		types.NewSwapFeeTotal(types.SwapFeeDestinationBurn, burnCoins),
+		types.NewSwapFeeTotal(types.SwapFeeDestinationOracle, oracleCoins),
+		types.NewSwapFeeTotal(types.SwapFeeDestinationCommunityPool, communityPoolCoins),
+	}, input.MarketKeeper.GetSwapFeeTotals(input.Ctx))
+
+	splitEvents := 0
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventSwapFeeSplit {
+			splitEvents++
+		}
+	}
+	require.Equal(t, 3, splitEvents)
+
+	err = input.BankKeeper.MintCoins(input.Ctx, types.ModuleName, fees)
+	require.NoError(t, err)
+	err = input.MarketKeeper.DistributeSwapFees(input.Ctx, fees)
+	require.NoError(t, err)
+	require.Equal(t, burnCoins.Add(burnCoins...), input.MarketKeeper.GetSwapFeeTotal(input.Ctx, types.SwapFeeDestinationBurn))
+}
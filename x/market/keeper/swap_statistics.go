@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/market/types"
+)
+
+// GetSwapStatisticsPeriod returns the swap statistics period of the current block
+func (k Keeper) GetSwapStatisticsPeriod(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockHeight()) / k.SwapStatisticsPeriod(ctx)
+}
+
+// GetSwapStatistics returns the swap statistics of the denom pair in the period
+func (k Keeper) GetSwapStatistics(ctx sdk.Context, period uint64, offerDenom, askDenom string) types.SwapStatistics {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapStatisticsKey(period, offerDenom, askDenom))
+	if bz == nil {
+		return types.NewSwapStatistics(offerDenom, askDenom)
+	}
+
+	statistics := types.SwapStatistics{}
+	k.cdc.MustUnmarshal(bz, &statistics)
+	return statistics
+}
+
+// SetSwapStatistics updates the swap statistics of the denom pair in the period
+func (k Keeper) SetSwapStatistics(ctx sdk.Context, period uint64, statistics types.SwapStatistics) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&statistics)
+	store.Set(types.GetSwapStatisticsKey(period, statistics.OfferDenom, statistics.AskDenom), bz)
+}
+
+// RecordSwapStatistics adds a swap to the statistics of its denom pair in the current period
+func (k Keeper) RecordSwapStatistics(ctx sdk.Context, offerCoin, swapCoin, feeCoin sdk.Coin) {
+	period := k.GetSwapStatisticsPeriod(ctx)
+	statistics := k.GetSwapStatistics(ctx, period, offerCoin.Denom, swapCoin.Denom)
+	statistics = statistics.Add(types.SwapStatistics{
+		OfferVolume: offerCoin.Amount,
+		AskVolume:   swapCoin.Amount,
+		SwapFee:     feeCoin.Amount,
+		Count:       1,
+	})
+
+	k.SetSwapStatistics(ctx, period, statistics)
+}
+
+// IterateSwapStatistics iterates over the swap statistics of every retained period
+func (k Keeper) IterateSwapStatistics(ctx sdk.Context, handler func(period uint64, statistics types.SwapStatistics) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SwapStatisticsKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		period, _, _ := types.ParseSwapStatisticsKey(iter.Key())
+
+		var statistics types.SwapStatistics
+		k.cdc.MustUnmarshal(iter.Value(), &statistics)
+		if handler(period, statistics) {
+			break
+		}
+	}
+}
+
+// AggregateSwapStatistics returns the swap statistics of each denom pair summed over the
+// last window periods, including the current one. Empty denoms match every denom.
+func (k Keeper) AggregateSwapStatistics(ctx sdk.Context, window uint64, offerDenom, askDenom string) []types.SwapStatistics {
+	current := k.GetSwapStatisticsPeriod(ctx)
+	start := uint64(0)
+	if current+1 > window {
+		start = current + 1 - window
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetSwapStatisticsPeriodPrefix(start), types.GetSwapStatisticsPeriodPrefix(current+1))
+	defer iter.Close()
+
+	aggregated := make(map[string]types.SwapStatistics)
+	for ; iter.Valid(); iter.Next() {
+		_, offer, ask := types.ParseSwapStatisticsKey(iter.Key())
+		if (offerDenom != "" && offer != offerDenom) || (askDenom != "" && ask != askDenom) {
+			continue
+		}
+
+		var statistics types.SwapStatistics
+		k.cdc.MustUnmarshal(iter.Value(), &statistics)
+
+		pair := offer + "/" + ask
+		if sum, ok := aggregated[pair]; ok {
+			aggregated[pair] = sum.Add(statistics)
+		} else {
+			aggregated[pair] = statistics
+		}
+	}
+
+	res := make([]types.SwapStatistics, 0, len(aggregated))
+	for _, statistics := range aggregated {
+		res = append(res, statistics)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].OfferDenom != res[j].OfferDenom {
+			return res[i].OfferDenom < res[j].OfferDenom
+		}
+		return res[i].AskDenom < res[j].AskDenom
+	})
+
+	return res
+}
+
+// PruneSwapStatistics deletes the swap statistics of the periods beyond the retention
+func (k Keeper) PruneSwapStatistics(ctx sdk.Context) {
+	current := k.GetSwapStatisticsPeriod(ctx)
+	retention := k.SwapStatisticsRetention(ctx)
+	if current+1 <= retention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.SwapStatisticsKeyPrefix, types.GetSwapStatisticsPeriodPrefix(current+1-retention))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
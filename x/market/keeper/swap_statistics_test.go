@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAggregateSwapStatistics(t *testing.T) {
+	input := CreateTestInput(t)
+
+	period := input.MarketKeeper.SwapStatisticsPeriod(input.Ctx)
+	record := func(offerDenom, askDenom string, amount int64) {
+		input.MarketKeeper.RecordSwapStatistics(input.Ctx,
+			sdk.NewInt64Coin(offerDenom, amount),
+			sdk.NewInt64Coin(askDenom, amount*2),
+			sdk.NewInt64Coin(askDenom, 1),
+		)
+	}
+
+	// two swaps in the first period, one in the second
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	record(core.MicroKRWDenom, core.MicroLunaDenom, 100)
+	record(core.MicroKRWDenom, core.MicroLunaDenom, 50)
+	record(core.MicroSDRDenom, core.MicroLunaDenom, 10)
+
+	input.Ctx = input.Ctx.WithBlockHeight(int64(period) + 1)
+	record(core.MicroKRWDenom, core.MicroLunaDenom, 30)
+	require.Equal(t, uint64(1), input.MarketKeeper.GetSwapStatisticsPeriod(input.Ctx))
+
+	// the current period only
+	res := input.MarketKeeper.AggregateSwapStatistics(input.Ctx, 1, core.MicroKRWDenom, core.MicroLunaDenom)
+	require.Equal(t, []types.SwapStatistics{{
+		OfferDenom:  core.MicroKRWDenom,
+		AskDenom:    core.MicroLunaDenom,
+		OfferVolume: sdk.NewInt(30),
+		AskVolume:   sdk.NewInt(60),
+		SwapFee:     sdk.NewInt(1),
+		Count:       1,
+	}}, res)
+
+	// both periods
+	res = input.MarketKeeper.AggregateSwapStatistics(input.Ctx, 2, core.MicroKRWDenom, core.MicroLunaDenom)
+	require.Equal(t, []types.SwapStatistics{{
+		OfferDenom:  core.MicroKRWDenom,
+		AskDenom:    core.MicroLunaDenom,
+		OfferVolume: sdk.NewInt(180),
+		AskVolume:   sdk.NewInt(360),
+		SwapFee:     sdk.NewInt(3),
+		Count:       3,
+	}}, res)
+
+	// every pair, sorted by offer and ask denom
+	res = input.MarketKeeper.AggregateSwapStatistics(input.Ctx, 2, "", core.MicroLunaDenom)
+	require.Len(t, res, 2)
+	require.Equal(t, core.MicroKRWDenom, res[0].OfferDenom)
+	require.Equal(t, core.MicroSDRDenom, res[1].OfferDenom)
+
+	// the first period is dropped once beyond the retention
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapStatisticsRetention = 1
+	input.MarketKeeper.SetParams(input.Ctx, params)
+	input.MarketKeeper.PruneSwapStatistics(input.Ctx)
+
+	count := 0
+	input.MarketKeeper.IterateSwapStatistics(input.Ctx, func(period uint64, _ types.SwapStatistics) (stop bool) {
+		require.Equal(t, uint64(1), period)
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+	oraclekeeper "github.com/classic-terra/core/x/oracle/keeper"
+	oracletypes "github.com/classic-terra/core/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -66,6 +69,61 @@ func TestComputeSwap(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestComputeReverseSwap(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Set Oracle Price
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	lunaPriceInKRW := sdk.NewDecWithPrec(2113, 0)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, lunaPriceInKRW)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(3, 3))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(5, 3))
+
+	receivedAmount := func(offerCoin sdk.Coin, askDenom string) sdk.Dec {
+		retCoin, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, askDenom)
+		require.NoError(t, err)
+		return retCoin.Amount.Mul(sdk.OneDec().Sub(spread))
+	}
+
+	basePool := input.MarketKeeper.BasePool(input.Ctx)
+	pairs := [][2]string{
+		{core.MicroLunaDenom, core.MicroSDRDenom},
+		{core.MicroSDRDenom, core.MicroLunaDenom},
+		{core.MicroKRWDenom, core.MicroLunaDenom},
+		{core.MicroKRWDenom, core.MicroSDRDenom},
+	}
+
+	for i := 0; i < 100; i++ {
+		pair := pairs[i%len(pairs)]
+
+		// small asks are charged the min spread, large asks the constant product spread
+		askAmount := sdk.NewInt(rand.Int63()%1000000 + 1000)
+		if i%2 == 1 {
+			askAmount = basePool.QuoInt64(rand.Int63()%10 + 2).TruncateInt()
+		}
+
+		askCoin := sdk.NewCoin(pair[1], askAmount)
+		offerCoin, spread, err := input.MarketKeeper.ComputeReverseSwap(input.Ctx, askCoin, pair[0])
+		require.NoError(t, err)
+		require.Equal(t, pair[0], offerCoin.Denom)
+		require.True(t, spread.IsPositive())
+
+		// the offer is the least amount returning the ask coin
+		require.True(t, receivedAmount(offerCoin, askCoin.Denom).GTE(sdk.NewDecFromInt(askAmount)))
+		require.True(t, receivedAmount(offerCoin.SubAmount(sdk.OneInt()), askCoin.Denom).LT(sdk.NewDecFromInt(askAmount)))
+	}
+
+	// recursive swap
+	_, _, err := input.MarketKeeper.ComputeReverseSwap(input.Ctx, sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt()), core.MicroSDRDenom)
+	require.ErrorIs(t, err, types.ErrRecursiveSwap)
+
+	// ask coin beyond the pool
+	askCoin := sdk.NewCoin(core.MicroSDRDenom, basePool.MulInt64(2).TruncateInt())
+	_, _, err = input.MarketKeeper.ComputeReverseSwap(input.Ctx, askCoin, core.MicroLunaDenom)
+	require.ErrorIs(t, err, types.ErrUnreachableAsk)
+}
+
 func TestComputeInternalSwap(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -85,6 +143,25 @@ func TestComputeInternalSwap(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestComputeInternalSwapHaltedDenom(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Set Oracle Price
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+
+	// Halt the denom by the oracle circuit breaker
+	oracleKeeper := input.OracleKeeper.(oraclekeeper.Keeper)
+	oracleKeeper.SetHaltedDenom(input.Ctx, oracletypes.NewHaltedDenom(core.MicroSDRDenom, lunaPriceInSDR, lunaPriceInSDR.MulInt64(2), 1))
+
+	offerCoin := sdk.NewDecCoin(core.MicroSDRDenom, sdk.NewInt(1000000))
+	_, err := input.MarketKeeper.ComputeInternalSwap(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.ErrorIs(t, err, oracletypes.ErrDenomHalted)
+
+	_, _, err = input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000000)), core.MicroSDRDenom)
+	require.ErrorIs(t, err, oracletypes.ErrDenomHalted)
+}
+
 func TestIlliquidTobinTaxListParams(t *testing.T) {
 	input := CreateTestInput(t)
 
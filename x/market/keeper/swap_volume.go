@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/classic-terra/core/x/market/types"
+)
+
+// GetBlockSwapVolume returns the net amount of the denom minted by swaps in the current block
+func (k Keeper) GetBlockSwapVolume(ctx sdk.Context, denom string) sdk.Int {
+	return k.getSwapVolume(ctx, types.GetBlockSwapVolumeKey(denom))
+}
+
+// SetBlockSwapVolume updates the net amount of the denom minted by swaps in the current block
+func (k Keeper) SetBlockSwapVolume(ctx sdk.Context, denom string, volume sdk.Int) {
+	k.setSwapVolume(ctx, types.GetBlockSwapVolumeKey(denom), volume)
+}
+
+// GetEpochSwapVolume returns the net amount of the denom minted by swaps in the current epoch
+func (k Keeper) GetEpochSwapVolume(ctx sdk.Context, denom string) sdk.Int {
+	return k.getSwapVolume(ctx, types.GetEpochSwapVolumeKey(denom))
+}
+
+// SetEpochSwapVolume updates the net amount of the denom minted by swaps in the current epoch
+func (k Keeper) SetEpochSwapVolume(ctx sdk.Context, denom string, volume sdk.Int) {
+	k.setSwapVolume(ctx, types.GetEpochSwapVolumeKey(denom), volume)
+}
+
+// IterateEpochSwapVolumes iterates over the net swap volumes of the current epoch
+func (k Keeper) IterateEpochSwapVolumes(ctx sdk.Context, handler func(denom string, volume sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EpochSwapVolumeKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.EpochSwapVolumeKeyPrefix):])
+
+		var volume sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &volume)
+		if handler(denom, volume.Int) {
+			break
+		}
+	}
+}
+
+// ClearBlockSwapVolumes resets the net swap volumes of the current block
+func (k Keeper) ClearBlockSwapVolumes(ctx sdk.Context) {
+	k.clearSwapVolumes(ctx, types.BlockSwapVolumeKeyPrefix)
+}
+
+// ClearEpochSwapVolumes resets the net swap volumes of the current epoch
+func (k Keeper) ClearEpochSwapVolumes(ctx sdk.Context) {
+	k.clearSwapVolumes(ctx, types.EpochSwapVolumeKeyPrefix)
+}
+
+// ApplySwapVolume adds the coins minted and burned by a swap to the block and epoch volumes,
+// and returns ErrSwapVolumeLimit when a net volume goes beyond the cap set for its denom
+func (k Keeper) ApplySwapVolume(ctx sdk.Context, burnCoins, mintCoins sdk.Coins) error {
+	deltas := make(map[string]sdk.Int)
+	for _, coin := range mintCoins {
+		deltas[coin.Denom] = coin.Amount
+	}
+
+	for _, coin := range burnCoins {
+		if delta, ok := deltas[coin.Denom]; ok {
+			deltas[coin.Denom] = delta.Sub(coin.Amount)
+		} else {
+			deltas[coin.Denom] = coin.Amount.Neg()
+		}
+	}
+
+	denoms := make([]string, 0, len(deltas))
+	for denom := range deltas {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	// Check every denom before updating any volume
+	limits := k.SwapVolumeLimits(ctx)
+	blockVolumes := make([]sdk.Int, len(denoms))
+	epochVolumes := make([]sdk.Int, len(denoms))
+	for i, denom := range denoms {
+		blockVolumes[i] = k.GetBlockSwapVolume(ctx, denom).Add(deltas[denom])
+		epochVolumes[i] = k.GetEpochSwapVolume(ctx, denom).Add(deltas[denom])
+
+		limit, ok := limits.LimitOf(denom)
+		if !ok {
+			continue
+		}
+
+		if limit.BlockLimit.IsPositive() && blockVolumes[i].Abs().GT(limit.BlockLimit) {
+			return sdkerrors.Wrapf(types.ErrSwapVolumeLimit, "block volume of %s would be %s, the limit is %s", denom, blockVolumes[i], limit.BlockLimit)
+		}
+
+		if limit.EpochLimit.IsPositive() && epochVolumes[i].Abs().GT(limit.EpochLimit) {
+			return sdkerrors.Wrapf(types.ErrSwapVolumeLimit, "epoch volume of %s would be %s, the limit is %s", denom, epochVolumes[i], limit.EpochLimit)
+		}
+	}
+
+	for i, denom := range denoms {
+		k.SetBlockSwapVolume(ctx, denom, blockVolumes[i])
+		k.SetEpochSwapVolume(ctx, denom, epochVolumes[i])
+	}
+
+	return nil
+}
+
+func (k Keeper) getSwapVolume(ctx sdk.Context, key []byte) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	volume := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &volume)
+	return volume.Int
+}
+
+func (k Keeper) setSwapVolume(ctx sdk.Context, key []byte, volume sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: volume})
+	store.Set(key, bz)
+}
+
+func (k Keeper) clearSwapVolumes(ctx sdk.Context, keyPrefix []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestApplySwapVolume(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapVolumeLimits = types.SwapVolumeLimits{
+		types.NewSwapVolumeLimit(core.MicroSDRDenom, sdk.NewInt(100), sdk.NewInt(150)),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	lunaCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 10))
+	sdrCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, amount))
+	}
+
+	// mint up to the block limit
+	require.NoError(t, input.MarketKeeper.ApplySwapVolume(input.Ctx, lunaCoins, sdrCoins(100)))
+	require.Equal(t, sdk.NewInt(100), input.MarketKeeper.GetBlockSwapVolume(input.Ctx, core.MicroSDRDenom))
+	require.Equal(t, sdk.NewInt(100), input.MarketKeeper.GetEpochSwapVolume(input.Ctx, core.MicroSDRDenom))
+	require.Equal(t, sdk.NewInt(-10), input.MarketKeeper.GetEpochSwapVolume(input.Ctx, core.MicroLunaDenom))
+
+	// mint beyond the block limit fails and leaves the volumes untouched
+	err := input.MarketKeeper.ApplySwapVolume(input.Ctx, lunaCoins, sdrCoins(1))
+	require.ErrorIs(t, err, types.ErrSwapVolumeLimit)
+	require.Equal(t, sdk.NewInt(100), input.MarketKeeper.GetBlockSwapVolume(input.Ctx, core.MicroSDRDenom))
+
+	// burn offsets the net volume
+	require.NoError(t, input.MarketKeeper.ApplySwapVolume(input.Ctx, sdrCoins(50), lunaCoins))
+	require.Equal(t, sdk.NewInt(50), input.MarketKeeper.GetBlockSwapVolume(input.Ctx, core.MicroSDRDenom))
+
+	// next block only the epoch limit is left
+	input.MarketKeeper.ClearBlockSwapVolumes(input.Ctx)
+	require.True(t, input.MarketKeeper.GetBlockSwapVolume(input.Ctx, core.MicroSDRDenom).IsZero())
+
+	err = input.MarketKeeper.ApplySwapVolume(input.Ctx, lunaCoins, sdrCoins(101))
+	require.ErrorIs(t, err, types.ErrSwapVolumeLimit)
+	require.NoError(t, input.MarketKeeper.ApplySwapVolume(input.Ctx, lunaCoins, sdrCoins(100)))
+
+	// burn is capped like mint
+	input.MarketKeeper.ClearBlockSwapVolumes(input.Ctx)
+	input.MarketKeeper.ClearEpochSwapVolumes(input.Ctx)
+	err = input.MarketKeeper.ApplySwapVolume(input.Ctx, sdrCoins(101), lunaCoins)
+	require.ErrorIs(t, err, types.ErrSwapVolumeLimit)
+
+	// denom without limit is tracked but never capped
+	require.NoError(t, input.MarketKeeper.ApplySwapVolume(input.Ctx, sdrCoins(100), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000000))))
+	require.Equal(t, sdk.NewInt(1000000), input.MarketKeeper.GetEpochSwapVolume(input.Ctx, core.MicroLunaDenom))
+}
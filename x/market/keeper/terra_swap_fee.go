@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/market/types"
+)
+
+// GetTerraSwapFee returns the swap fee set for the terra denom pair, and false when none is set
+func (k Keeper) GetTerraSwapFee(ctx sdk.Context, offerDenom, askDenom string) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTerraSwapFeeKey(offerDenom, askDenom))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec, true
+}
+
+// SetTerraSwapFee updates the swap fee of the terra denom pair
+func (k Keeper) SetTerraSwapFee(ctx sdk.Context, fee types.TerraSwapFee) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: fee.Fee})
+	store.Set(types.GetTerraSwapFeeKey(fee.OfferDenom, fee.AskDenom), bz)
+}
+
+// DeleteTerraSwapFee removes the swap fee of the terra denom pair
+func (k Keeper) DeleteTerraSwapFee(ctx sdk.Context, offerDenom, askDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTerraSwapFeeKey(offerDenom, askDenom))
+}
+
+// IterateTerraSwapFees iterates over the swap fees of the terra denom pairs
+func (k Keeper) IterateTerraSwapFees(ctx sdk.Context, handler func(fee types.TerraSwapFee) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TerraSwapFeeKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		offerDenom, askDenom := types.ParseTerraSwapFeeKey(iter.Key())
+
+		var dp sdk.DecProto
+		k.cdc.MustUnmarshal(iter.Value(), &dp)
+		if handler(types.NewTerraSwapFee(offerDenom, askDenom, dp.Dec)) {
+			break
+		}
+	}
+}
+
+// ComputeTerraSwapFee returns the spread charged on a terra to terra swap; the swap fee set
+// for the pair, or the highest oracle tobin tax of both denoms when none is set
+func (k Keeper) ComputeTerraSwapFee(ctx sdk.Context, offerDenom, askDenom string) (fee sdk.Dec, tobinTaxFallback bool, err error) {
+	if fee, ok := k.GetTerraSwapFee(ctx, offerDenom, askDenom); ok {
+		return fee, false, nil
+	}
+
+	offerTobinTax, err := k.OracleKeeper.GetTobinTax(ctx, offerDenom)
+	if err != nil {
+		return sdk.Dec{}, true, err
+	}
+
+	askTobinTax, err := k.OracleKeeper.GetTobinTax(ctx, askDenom)
+	if err != nil {
+		return sdk.Dec{}, true, err
+	}
+
+	return sdk.MaxDec(offerTobinTax, askTobinTax), true, nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestComputeTerraSwapFee(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(2, 2))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(1, 2))
+
+	// highest tobin tax without a swap fee
+	fee, fallback, err := input.MarketKeeper.ComputeTerraSwapFee(input.Ctx, core.MicroSDRDenom, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.True(t, fallback)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), fee)
+
+	// swap fee applies to its direction only
+	input.MarketKeeper.SetTerraSwapFee(input.Ctx, types.NewTerraSwapFee(core.MicroSDRDenom, core.MicroKRWDenom, sdk.NewDecWithPrec(5, 3)))
+
+	fee, fallback, err = input.MarketKeeper.ComputeTerraSwapFee(input.Ctx, core.MicroSDRDenom, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.False(t, fallback)
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), fee)
+
+	fee, fallback, err = input.MarketKeeper.ComputeTerraSwapFee(input.Ctx, core.MicroKRWDenom, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.True(t, fallback)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), fee)
+
+	// swaps charge the swap fee
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.OneDec())
+	_, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewInt64Coin(core.MicroSDRDenom, 1000), core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), spread)
+
+	// a removed swap fee falls back to the tobin tax
+	input.MarketKeeper.DeleteTerraSwapFee(input.Ctx, core.MicroSDRDenom, core.MicroKRWDenom)
+	_, ok := input.MarketKeeper.GetTerraSwapFee(input.Ctx, core.MicroSDRDenom, core.MicroKRWDenom)
+	require.False(t, ok)
+
+	// unknown denom without a swap fee
+	_, _, err = input.MarketKeeper.ComputeTerraSwapFee(input.Ctx, core.MicroSDRDenom, "ufoo")
+	require.Error(t, err)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	input := CreateTestInput(t)
+
+	var denoms []string
+	input.OracleKeeper.IterateTobinTaxes(input.Ctx, func(denom string, tobinTax sdk.Dec) (stop bool) {
+		denoms = append(denoms, denom)
+		return false
+	})
+	require.GreaterOrEqual(t, len(denoms), 2)
+
+	input.OracleKeeper.SetTobinTax(input.Ctx, denoms[0], sdk.NewDecWithPrec(2, 2))
+	require.NoError(t, NewMigrator(input.MarketKeeper).Migrate4to5(input.Ctx))
+
+	count := 0
+	input.MarketKeeper.IterateTerraSwapFees(input.Ctx, func(fee types.TerraSwapFee) (stop bool) {
+		offerTobinTax, err := input.OracleKeeper.GetTobinTax(input.Ctx, fee.OfferDenom)
+		require.NoError(t, err)
+		askTobinTax, err := input.OracleKeeper.GetTobinTax(input.Ctx, fee.AskDenom)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MaxDec(offerTobinTax, askTobinTax), fee.Fee)
+
+		count++
+		return false
+	})
+	require.Equal(t, len(denoms)*(len(denoms)-1), count)
+
+	// tobin tax changes no longer affect the seeded swap fees
+	input.OracleKeeper.SetTobinTax(input.Ctx, denoms[1], sdk.NewDecWithPrec(3, 2))
+	fee, fallback, err := input.MarketKeeper.ComputeTerraSwapFee(input.Ctx, denoms[0], denoms[1])
+	require.NoError(t, err)
+	require.False(t, fallback)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), fee)
+}
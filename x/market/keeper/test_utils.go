@@ -38,12 +38,16 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 )
 
 const faucetAccountName = "faucet"
@@ -112,12 +116,15 @@ var (
 
 // TestInput nolint
 type TestInput struct {
-	Ctx           sdk.Context
-	Cdc           *codec.LegacyAmino
-	AccountKeeper authkeeper.AccountKeeper
-	BankKeeper    bankkeeper.Keeper
-	OracleKeeper  types.OracleKeeper
-	MarketKeeper  Keeper
+	Ctx            sdk.Context
+	Cdc            *codec.LegacyAmino
+	AccountKeeper  authkeeper.AccountKeeper
+	BankKeeper     bankkeeper.Keeper
+	OracleKeeper   types.OracleKeeper
+	DistrKeeper    distrkeeper.Keeper
+	TransferKeeper ibctransferkeeper.Keeper
+	ChannelKeeper  *DummyChannelKeeper
+	MarketKeeper   Keeper
 }
 
 // CreateTestInput nolint
@@ -129,6 +136,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
+	keyTransfer := sdk.NewKVStoreKey(ibctransfertypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -144,6 +152,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTransfer, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
@@ -163,6 +172,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		distrtypes.ModuleName:          nil,
 		oracletypes.ModuleName:         nil,
+		ibctransfertypes.ModuleName:    {authtypes.Burner, authtypes.Minter},
 		types.ModuleName:               {authtypes.Burner, authtypes.Minter},
 	}
 
@@ -205,6 +215,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	bondPool := authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName, authtypes.Burner, authtypes.Staking)
 	distrAcc := authtypes.NewEmptyModuleAccount(distrtypes.ModuleName)
 	oracleAcc := authtypes.NewEmptyModuleAccount(oracletypes.ModuleName)
+	transferAcc := authtypes.NewEmptyModuleAccount(ibctransfertypes.ModuleName, authtypes.Burner, authtypes.Minter)
 	marketAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner, authtypes.Minter)
 
 	err = bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, InitTokens.MulRaw(int64(len(Addrs))))))
@@ -215,6 +226,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	accountKeeper.SetModuleAccount(ctx, notBondedPool)
 	accountKeeper.SetModuleAccount(ctx, distrAcc)
 	accountKeeper.SetModuleAccount(ctx, oracleAcc)
+	accountKeeper.SetModuleAccount(ctx, transferAcc)
 	accountKeeper.SetModuleAccount(ctx, marketAcc)
 
 	for _, addr := range Addrs {
@@ -232,6 +244,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		nil,
 		distrtypes.ModuleName,
 	)
 	oracleDefaultParams := oracletypes.DefaultParams()
@@ -241,16 +254,55 @@ func CreateTestInput(t *testing.T) TestInput {
 		oracleKeeper.SetTobinTax(ctx, denom.Name, denom.TobinTax)
 	}
 
+	// Only the denom traces of the transfer keeper are used, so the ibc core keepers are left out
+	transferKeeper := ibctransferkeeper.NewKeeper(
+		appCodec,
+		keyTransfer, paramsKeeper.Subspace(ibctransfertypes.ModuleName),
+		nil, nil,
+		accountKeeper,
+		bankKeeper,
+		capabilitykeeper.ScopedKeeper{},
+	)
+
+	channelKeeper := &DummyChannelKeeper{}
 	keeper := NewKeeper(
 		appCodec,
 		keyMarket, paramsKeeper.Subspace(types.ModuleName),
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
+		distrKeeper,
+		transferKeeper,
+		channelKeeper,
 	)
 	keeper.SetParams(ctx, types.DefaultParams())
 
-	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, oracleKeeper, keeper}
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, oracleKeeper, distrKeeper, transferKeeper, channelKeeper, keeper}
+}
+
+// DummyChannelKeeper is a channel keeper holding the channels in memory
+type DummyChannelKeeper struct {
+	Channels []channeltypes.IdentifiedChannel
+}
+
+// GetChannel nolint
+func (k DummyChannelKeeper) GetChannel(_ sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	for _, channel := range k.Channels {
+		if channel.PortId == portID && channel.ChannelId == channelID {
+			return channeltypes.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version), true
+		}
+	}
+
+	return channeltypes.Channel{}, false
+}
+
+// IterateChannels nolint
+func (k DummyChannelKeeper) IterateChannels(_ sdk.Context, cb func(channeltypes.IdentifiedChannel) bool) {
+	for _, channel := range k.Channels {
+		if cb(channel) {
+			break
+		}
+	}
 }
 
 // FundAccount is a utility function that funds an account by minting and
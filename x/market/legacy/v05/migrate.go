@@ -21,6 +21,28 @@ func Migrate(
 			BasePool:           marketGenState.Params.BasePool,
 			PoolRecoveryPeriod: uint64(marketGenState.Params.PoolRecoveryPeriod),
 			MinStabilitySpread: marketGenState.Params.MinStabilitySpread,
+			SwapVolumeLimits:   v05market.DefaultSwapVolumeLimits,
+			SwapVolumeEpoch:    v05market.DefaultSwapVolumeEpoch,
+
+			SwapStatisticsPeriod:    v05market.DefaultSwapStatisticsPeriod,
+			SwapStatisticsRetention: v05market.DefaultSwapStatisticsRetention,
+
+			PoolReplenishMode: v05market.DefaultPoolReplenishMode,
+			PoolRecoveryTime:  v05market.DefaultPoolRecoveryTime,
+			PoolHalfLife:      v05market.DefaultPoolHalfLife,
+
+			SwapFeeBurnRate:          v05market.DefaultSwapFeeBurnRate,
+			SwapFeeCommunityPoolRate: v05market.DefaultSwapFeeCommunityPoolRate,
+
+			BatchSwapEnabled: v05market.DefaultBatchSwapEnabled,
 		},
+		EpochSwapVolumes: []v05market.SwapVolume{},
+		SwapStatistics:   []v05market.SwapStatisticsRecord{},
+		TerraSwapFees:    []v05market.TerraSwapFee{},
+		SwapFeeTotals:    []v05market.SwapFeeTotal{},
+
+		BatchSwapOrders:      []v05market.BatchSwapOrder{},
+		NextBatchSwapOrderID: 1,
+		IBCDenomMappings:     []v05market.IBCDenomMapping{},
 	}
 }
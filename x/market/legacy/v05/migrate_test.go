@@ -52,10 +52,27 @@ func TestMigrate(t *testing.T) {
 	expected := `{
 	"params": {
 		"base_pool": "1000000.000000000000000000",
+		"batch_swap_enabled": false,
 		"min_stability_spread": "0.020000000000000000",
-		"pool_recovery_period": "10000"
+		"pool_half_life": "43200",
+		"pool_recovery_period": "10000",
+		"pool_recovery_time": "86400",
+		"pool_replenish_mode": "block",
+		"swap_fee_burn_rate": "0.000000000000000000",
+		"swap_fee_community_pool_rate": "0.000000000000000000",
+		"swap_statistics_period": "14400",
+		"swap_statistics_retention": "30",
+		"swap_volume_epoch": "14400",
+		"swap_volume_limits": []
 	},
-	"terra_pool_delta": "0.000000000000000000"
+	"terra_pool_delta": "0.000000000000000000",
+	"epoch_swap_volumes": [],
+	"swap_statistics": [],
+	"terra_swap_fees": [],
+	"swap_fee_totals": [],
+	"batch_swap_orders": [],
+	"next_batch_swap_order_id": "1",
+	"ibc_denom_mappings": []
 }`
 
 	assert.JSONEq(t, expected, string(indentedBz))
@@ -0,0 +1,42 @@
+package market
+
+import (
+	"github.com/classic-terra/core/x/market/keeper"
+	"github.com/classic-terra/core/x/market/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateTerraSwapFeeProposal:
+			return handleUpdateTerraSwapFeeProposal(ctx, k, c)
+		case *types.RemoveTerraSwapFeeProposal:
+			return handleRemoveTerraSwapFeeProposal(ctx, k, c)
+		case *types.UpdateIBCDenomMappingProposal:
+			return handleUpdateIBCDenomMappingProposal(ctx, k, c)
+		case *types.RemoveIBCDenomMappingProposal:
+			return handleRemoveIBCDenomMappingProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized market proposal content type: %T", c)
+		}
+	}
+}
+
+func handleUpdateTerraSwapFeeProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateTerraSwapFeeProposal) error {
+	return keeper.HandleUpdateTerraSwapFeeProposal(ctx, k, p)
+}
+
+func handleRemoveTerraSwapFeeProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveTerraSwapFeeProposal) error {
+	return keeper.HandleRemoveTerraSwapFeeProposal(ctx, k, p)
+}
+
+func handleUpdateIBCDenomMappingProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateIBCDenomMappingProposal) error {
+	return keeper.HandleUpdateIBCDenomMappingProposal(ctx, k, p)
+}
+
+func handleRemoveIBCDenomMappingProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveIBCDenomMappingProposal) error {
+	return keeper.HandleRemoveIBCDenomMappingProposal(ctx, k, p)
+}
@@ -0,0 +1,86 @@
+package market_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/market"
+	"github.com/classic-terra/core/x/market/keeper"
+	"github.com/classic-terra/core/x/market/types"
+)
+
+func TestTerraSwapFeeProposals(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	h := market.NewProposalHandler(input.MarketKeeper)
+
+	fee := types.NewTerraSwapFee(core.MicroKRWDenom, core.MicroSDRDenom, sdk.NewDecWithPrec(1, 3))
+	update := types.NewUpdateTerraSwapFeeProposal("title", "description", fee)
+	remove := types.NewRemoveTerraSwapFeeProposal("title", "description", core.MicroKRWDenom, core.MicroSDRDenom)
+	require.NoError(t, update.ValidateBasic())
+	require.NoError(t, remove.ValidateBasic())
+
+	// luna pair and out of range fee are rejected
+	require.Error(t, types.NewUpdateTerraSwapFeeProposal("title", "description", types.NewTerraSwapFee(core.MicroLunaDenom, core.MicroSDRDenom, fee.Fee)).ValidateBasic())
+	require.Error(t, types.NewUpdateTerraSwapFeeProposal("title", "description", types.NewTerraSwapFee(core.MicroKRWDenom, core.MicroSDRDenom, sdk.OneDec())).ValidateBasic())
+
+	// nothing to remove
+	require.ErrorIs(t, h(input.Ctx, remove), types.ErrNoTerraSwapFee)
+
+	require.NoError(t, h(input.Ctx, update))
+	res, ok := input.MarketKeeper.GetTerraSwapFee(input.Ctx, core.MicroKRWDenom, core.MicroSDRDenom)
+	require.True(t, ok)
+	require.Equal(t, fee.Fee, res)
+
+	// the oracle whitelist does not touch the swap fee
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(5, 2))
+	res, fallback, err := input.MarketKeeper.ComputeTerraSwapFee(input.Ctx, core.MicroKRWDenom, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.False(t, fallback)
+	require.Equal(t, fee.Fee, res)
+
+	require.NoError(t, h(input.Ctx, remove))
+	_, ok = input.MarketKeeper.GetTerraSwapFee(input.Ctx, core.MicroKRWDenom, core.MicroSDRDenom)
+	require.False(t, ok)
+}
+
+func TestIBCDenomMappingProposals(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	h := market.NewProposalHandler(input.MarketKeeper)
+
+	trace := ibctransfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-0", BaseDenom: core.MicroSDRDenom}
+	mapping := types.NewIBCDenomMapping(trace.IBCDenom(), core.MicroSDRDenom)
+	update := types.NewUpdateIBCDenomMappingProposal("title", "description", mapping)
+	remove := types.NewRemoveIBCDenomMappingProposal("title", "description", trace.IBCDenom())
+	require.NoError(t, update.ValidateBasic())
+	require.NoError(t, remove.ValidateBasic())
+
+	// native denom cannot be mapped
+	require.Error(t, types.NewUpdateIBCDenomMappingProposal("title", "description", types.NewIBCDenomMapping(core.MicroKRWDenom, core.MicroSDRDenom)).ValidateBasic())
+
+	// nothing to remove
+	require.ErrorIs(t, h(input.Ctx, remove), types.ErrNoIBCDenomMapping)
+
+	// the denom trace must be known to the transfer module
+	require.ErrorIs(t, h(input.Ctx, update), types.ErrInvalidDenomTrace)
+
+	input.TransferKeeper.SetDenomTrace(input.Ctx, trace)
+	for channelID, counterpartyChannelID := range map[string]string{"channel-1": "channel-9", "channel-7": "channel-0"} {
+		counterparty := channeltypes.NewCounterparty(ibctransfertypes.PortID, counterpartyChannelID)
+		channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, counterparty, []string{"connection-0"}, ibctransfertypes.Version)
+		input.ChannelKeeper.Channels = append(input.ChannelKeeper.Channels, channeltypes.NewIdentifiedChannel(ibctransfertypes.PortID, channelID, channel))
+	}
+	require.NoError(t, h(input.Ctx, update))
+	nativeDenom, ok := input.MarketKeeper.GetIBCDenomMapping(input.Ctx, trace.IBCDenom())
+	require.True(t, ok)
+	require.Equal(t, core.MicroSDRDenom, nativeDenom)
+
+	require.NoError(t, h(input.Ctx, remove))
+	_, ok = input.MarketKeeper.GetIBCDenomMapping(input.Ctx, trace.IBCDenom())
+	require.False(t, ok)
+}
@@ -16,11 +16,43 @@ import (
 func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
-		case bytes.Equal(kvA.Key[:1], types.TerraPoolDeltaKey):
+		case bytes.Equal(kvA.Key[:1], types.TerraPoolDeltaKey),
+			bytes.Equal(kvA.Key[:1], types.TerraSwapFeeKeyPrefix):
 			var deltaA, deltaB sdk.DecProto
 			cdc.MustUnmarshal(kvA.Value, &deltaA)
 			cdc.MustUnmarshal(kvB.Value, &deltaB)
 			return fmt.Sprintf("%v\n%v", deltaA, deltaB)
+		case bytes.Equal(kvA.Key[:1], types.BlockSwapVolumeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.EpochSwapVolumeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.SwapFeeTotalKeyPrefix):
+			var volumeA, volumeB sdk.IntProto
+			cdc.MustUnmarshal(kvA.Value, &volumeA)
+			cdc.MustUnmarshal(kvB.Value, &volumeB)
+			return fmt.Sprintf("%v\n%v", volumeA, volumeB)
+		case bytes.Equal(kvA.Key[:1], types.SwapStatisticsKeyPrefix):
+			var statisticsA, statisticsB types.SwapStatistics
+			cdc.MustUnmarshal(kvA.Value, &statisticsA)
+			cdc.MustUnmarshal(kvB.Value, &statisticsB)
+			return fmt.Sprintf("%v\n%v", statisticsA, statisticsB)
+		case bytes.Equal(kvA.Key[:1], types.BatchSwapOrderKeyPrefix):
+			var orderA, orderB types.BatchSwapOrder
+			cdc.MustUnmarshal(kvA.Value, &orderA)
+			cdc.MustUnmarshal(kvB.Value, &orderB)
+			return fmt.Sprintf("%v\n%v", orderA, orderB)
+		case bytes.Equal(kvA.Key[:1], types.NextBatchSwapOrderIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.IBCDenomMappingKeyPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.LastReplenishTimeKey):
+			timeA, err := sdk.ParseTimeBytes(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			timeB, err := sdk.ParseTimeBytes(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
 		default:
 			panic(fmt.Sprintf("invalid market key prefix %X", kvA.Key[:1]))
 		}
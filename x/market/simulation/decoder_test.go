@@ -3,6 +3,7 @@ package simulation
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -18,10 +19,25 @@ func TestDecodeDistributionStore(t *testing.T) {
 	dec := NewDecodeStore(cdc)
 
 	terraDelta := sdk.NewDecWithPrec(12, 2)
+	swapVolume := sdk.NewInt(-1234)
+	swapStatistics := types.NewSwapStatistics("ukrw", "uluna")
+	replenishTime := time.Unix(1600000000, 0).UTC()
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	batchSwapOrder := types.NewBatchSwapOrder(addr, addr, sdk.NewInt64Coin("uluna", 1000), "uusd", nil, nil)
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.TerraPoolDeltaKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: terraDelta})},
+			{Key: types.GetBlockSwapVolumeKey("usdr"), Value: cdc.MustMarshal(&sdk.IntProto{Int: swapVolume})},
+			{Key: types.GetEpochSwapVolumeKey("usdr"), Value: cdc.MustMarshal(&sdk.IntProto{Int: swapVolume})},
+			{Key: types.GetSwapStatisticsKey(1, "ukrw", "uluna"), Value: cdc.MustMarshal(&swapStatistics)},
+			{Key: types.LastReplenishTimeKey, Value: sdk.FormatTimeBytes(replenishTime)},
+			{Key: types.GetTerraSwapFeeKey("ukrw", "uusd"), Value: cdc.MustMarshal(&sdk.DecProto{Dec: terraDelta})},
+			{Key: types.GetSwapFeeTotalKey(types.SwapFeeDestinationOracle, "usdr"), Value: cdc.MustMarshal(&sdk.IntProto{Int: swapVolume})},
+			{Key: types.GetBatchSwapOrderKey(1), Value: cdc.MustMarshal(&batchSwapOrder)},
+			{Key: types.NextBatchSwapOrderIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.GetIBCDenomMappingKey(ibcDenom), Value: []byte("uusd")},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +47,15 @@ func TestDecodeDistributionStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"TerraPoolDelta", fmt.Sprintf("%v\n%v", terraDelta, terraDelta)},
+		{"BlockSwapVolume", fmt.Sprintf("%v\n%v", swapVolume, swapVolume)},
+		{"EpochSwapVolume", fmt.Sprintf("%v\n%v", swapVolume, swapVolume)},
+		{"SwapStatistics", fmt.Sprintf("%v\n%v", swapStatistics, swapStatistics)},
+		{"LastReplenishTime", fmt.Sprintf("%v\n%v", replenishTime, replenishTime)},
+		{"TerraSwapFee", fmt.Sprintf("%v\n%v", terraDelta, terraDelta)},
+		{"SwapFeeTotal", fmt.Sprintf("%v\n%v", swapVolume, swapVolume)},
+		{"BatchSwapOrder", fmt.Sprintf("%v\n%v", batchSwapOrder, batchSwapOrder)},
+		{"NextBatchSwapOrderID", "2\n2"},
+		{"IBCDenomMapping", "uusd\nuusd"},
 		{"other", ""},
 	}
 
@@ -18,6 +18,19 @@ const (
 	basePoolKey           = "base_pool"
 	poolRecoveryPeriodKey = "pool_recovery_period"
 	minStabilitySpreadKey = "min_spread"
+	swapVolumeEpochKey    = "swap_volume_epoch"
+
+	swapStatisticsPeriodKey    = "swap_statistics_period"
+	swapStatisticsRetentionKey = "swap_statistics_retention"
+
+	poolReplenishModeKey = "pool_replenish_mode"
+	poolRecoveryTimeKey  = "pool_recovery_time"
+	poolHalfLifeKey      = "pool_half_life"
+
+	swapFeeBurnRateKey          = "swap_fee_burn_rate"
+	swapFeeCommunityPoolRateKey = "swap_fee_community_pool_rate"
+
+	batchSwapEnabledKey = "batch_swap_enabled"
 )
 
 // GenBasePool randomized MintBasePool
@@ -35,6 +48,52 @@ func GenMinSpread(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
 }
 
+// GenSwapVolumeEpoch randomized SwapVolumeEpoch
+func GenSwapVolumeEpoch(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(100000))
+}
+
+// GenSwapStatisticsPeriod randomized SwapStatisticsPeriod
+func GenSwapStatisticsPeriod(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(100000))
+}
+
+// GenSwapStatisticsRetention randomized SwapStatisticsRetention
+func GenSwapStatisticsRetention(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(100))
+}
+
+// GenPoolReplenishMode randomized PoolReplenishMode
+func GenPoolReplenishMode(r *rand.Rand) string {
+	modes := []string{types.ReplenishModeBlock, types.ReplenishModeLinear, types.ReplenishModeExponential}
+	return modes[r.Intn(len(modes))]
+}
+
+// GenPoolRecoveryTime randomized PoolRecoveryTime
+func GenPoolRecoveryTime(r *rand.Rand) uint64 {
+	return uint64(600 + r.Intn(1000000))
+}
+
+// GenPoolHalfLife randomized PoolHalfLife
+func GenPoolHalfLife(r *rand.Rand) uint64 {
+	return uint64(600 + r.Intn(1000000))
+}
+
+// GenSwapFeeBurnRate randomized SwapFeeBurnRate
+func GenSwapFeeBurnRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
+// GenSwapFeeCommunityPoolRate randomized SwapFeeCommunityPoolRate
+func GenSwapFeeCommunityPoolRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
+// GenBatchSwapEnabled randomized BatchSwapEnabled
+func GenBatchSwapEnabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	var basePool sdk.Dec
@@ -55,13 +114,88 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minStabilitySpread = GenMinSpread(r) },
 	)
 
+	var swapVolumeEpoch uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapVolumeEpochKey, &swapVolumeEpoch, simState.Rand,
+		func(r *rand.Rand) { swapVolumeEpoch = GenSwapVolumeEpoch(r) },
+	)
+
+	var swapStatisticsPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapStatisticsPeriodKey, &swapStatisticsPeriod, simState.Rand,
+		func(r *rand.Rand) { swapStatisticsPeriod = GenSwapStatisticsPeriod(r) },
+	)
+
+	var swapStatisticsRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapStatisticsRetentionKey, &swapStatisticsRetention, simState.Rand,
+		func(r *rand.Rand) { swapStatisticsRetention = GenSwapStatisticsRetention(r) },
+	)
+
+	var poolReplenishMode string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, poolReplenishModeKey, &poolReplenishMode, simState.Rand,
+		func(r *rand.Rand) { poolReplenishMode = GenPoolReplenishMode(r) },
+	)
+
+	var poolRecoveryTime uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, poolRecoveryTimeKey, &poolRecoveryTime, simState.Rand,
+		func(r *rand.Rand) { poolRecoveryTime = GenPoolRecoveryTime(r) },
+	)
+
+	var poolHalfLife uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, poolHalfLifeKey, &poolHalfLife, simState.Rand,
+		func(r *rand.Rand) { poolHalfLife = GenPoolHalfLife(r) },
+	)
+
+	var swapFeeBurnRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapFeeBurnRateKey, &swapFeeBurnRate, simState.Rand,
+		func(r *rand.Rand) { swapFeeBurnRate = GenSwapFeeBurnRate(r) },
+	)
+
+	var swapFeeCommunityPoolRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapFeeCommunityPoolRateKey, &swapFeeCommunityPoolRate, simState.Rand,
+		func(r *rand.Rand) { swapFeeCommunityPoolRate = GenSwapFeeCommunityPoolRate(r) },
+	)
+
+	var batchSwapEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, batchSwapEnabledKey, &batchSwapEnabled, simState.Rand,
+		func(r *rand.Rand) { batchSwapEnabled = GenBatchSwapEnabled(r) },
+	)
+
 	marketGenesis := types.NewGenesisState(
 		sdk.ZeroDec(),
 		types.Params{
 			BasePool:           basePool,
 			PoolRecoveryPeriod: poolRecoveryPeriod,
 			MinStabilitySpread: minStabilitySpread,
+			SwapVolumeLimits:   types.SwapVolumeLimits{},
+			SwapVolumeEpoch:    swapVolumeEpoch,
+
+			SwapStatisticsPeriod:    swapStatisticsPeriod,
+			SwapStatisticsRetention: swapStatisticsRetention,
+
+			PoolReplenishMode: poolReplenishMode,
+			PoolRecoveryTime:  poolRecoveryTime,
+			PoolHalfLife:      poolHalfLife,
+
+			SwapFeeBurnRate:          swapFeeBurnRate,
+			SwapFeeCommunityPoolRate: swapFeeCommunityPoolRate,
+
+			BatchSwapEnabled: batchSwapEnabled,
 		},
+		[]types.SwapVolume{},
+		[]types.SwapStatisticsRecord{},
+		[]types.TerraSwapFee{},
+		[]types.SwapFeeTotal{},
+		[]types.BatchSwapOrder{},
+		1,
+		[]types.IBCDenomMapping{},
 	)
 
 	bz, err := json.MarshalIndent(&marketGenesis.Params, "", " ")
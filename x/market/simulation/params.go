@@ -31,5 +31,45 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenMinSpread(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySwapVolumeEpoch),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSwapVolumeEpoch(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySwapStatisticsRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSwapStatisticsRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPoolReplenishMode),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenPoolReplenishMode(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPoolRecoveryTime),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenPoolRecoveryTime(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPoolHalfLife),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenPoolHalfLife(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySwapFeeBurnRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSwapFeeBurnRate(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySwapFeeCommunityPoolRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSwapFeeCommunityPoolRate(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBatchSwapEnabled),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenBatchSwapEnabled(r))
+			},
+		),
 	}
 }
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBatchSwapOrder creates a BatchSwapOrder instance, the id is assigned when queued
+func NewBatchSwapOrder(
+	trader, receiver sdk.AccAddress,
+	offerCoin sdk.Coin, askDenom string,
+	minReceiveAmount *sdk.Int, maxSpread *sdk.Dec,
+) BatchSwapOrder {
+	return BatchSwapOrder{
+		Trader:           trader.String(),
+		Receiver:         receiver.String(),
+		OfferCoin:        offerCoin,
+		AskDenom:         askDenom,
+		MinReceiveAmount: minReceiveAmount,
+		MaxSpread:        maxSpread,
+	}
+}
+
+// Validate checks the order has valid addresses, a positive offer coin and a distinct ask denom
+func (o BatchSwapOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Trader); err != nil {
+		return fmt.Errorf("invalid trader address of batch swap order %d: %w", o.ID, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(o.Receiver); err != nil {
+		return fmt.Errorf("invalid receiver address of batch swap order %d: %w", o.ID, err)
+	}
+
+	if !o.OfferCoin.IsValid() || !o.OfferCoin.IsPositive() {
+		return fmt.Errorf("invalid offer coin of batch swap order %d: %s", o.ID, o.OfferCoin)
+	}
+
+	if err := sdk.ValidateDenom(o.AskDenom); err != nil {
+		return err
+	}
+
+	if o.OfferCoin.Denom == o.AskDenom {
+		return fmt.Errorf("batch swap order %d swaps %s to itself", o.ID, o.AskDenom)
+	}
+
+	return nil
+}
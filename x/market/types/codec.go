@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/market interfaces and concrete types
@@ -13,6 +14,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwap{}, "market/MsgSwap", nil)
 	cdc.RegisterConcrete(&MsgSwapSend{}, "market/MsgSwapSend", nil)
+	cdc.RegisterConcrete(&MsgSwapRoute{}, "market/MsgSwapRoute", nil)
+	cdc.RegisterConcrete(&MsgSwapExactOut{}, "market/MsgSwapExactOut", nil)
+	cdc.RegisterConcrete(&UpdateTerraSwapFeeProposal{}, "market/UpdateTerraSwapFeeProposal", nil)
+	cdc.RegisterConcrete(&RemoveTerraSwapFeeProposal{}, "market/RemoveTerraSwapFeeProposal", nil)
+	cdc.RegisterConcrete(&UpdateIBCDenomMappingProposal{}, "market/UpdateIBCDenomMappingProposal", nil)
+	cdc.RegisterConcrete(&RemoveIBCDenomMappingProposal{}, "market/RemoveIBCDenomMappingProposal", nil)
 }
 
 // RegisterInterfaces registers the x/market interfaces types with the interface registry
@@ -20,6 +27,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSwap{},
 		&MsgSwapSend{},
+		&MsgSwapRoute{},
+		&MsgSwapExactOut{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateTerraSwapFeeProposal{},
+		&RemoveTerraSwapFeeProposal{},
+		&UpdateIBCDenomMappingProposal{},
+		&RemoveIBCDenomMappingProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -6,7 +6,14 @@ import (
 
 // Market errors
 var (
-	ErrRecursiveSwap    = sdkerrors.Register(ModuleName, 2, "recursive swap")
-	ErrNoEffectivePrice = sdkerrors.Register(ModuleName, 3, "no price registered with oracle")
-	ErrZeroSwapCoin     = sdkerrors.Register(ModuleName, 4, "zero swap coin")
+	ErrRecursiveSwap     = sdkerrors.Register(ModuleName, 2, "recursive swap")
+	ErrNoEffectivePrice  = sdkerrors.Register(ModuleName, 3, "no price registered with oracle")
+	ErrZeroSwapCoin      = sdkerrors.Register(ModuleName, 4, "zero swap coin")
+	ErrSlippageExceeded  = sdkerrors.Register(ModuleName, 5, "slippage exceeded")
+	ErrUnreachableAsk    = sdkerrors.Register(ModuleName, 6, "ask amount cannot be reached by the swap pool")
+	ErrSwapVolumeLimit   = sdkerrors.Register(ModuleName, 7, "swap volume limit exceeded")
+	ErrNoTerraSwapFee    = sdkerrors.Register(ModuleName, 8, "no terra swap fee set for the denom pair")
+	ErrBatchSwapMode     = sdkerrors.Register(ModuleName, 9, "message not supported in batch swap mode")
+	ErrNoIBCDenomMapping = sdkerrors.Register(ModuleName, 10, "no native denom mapped to the ibc denom")
+	ErrInvalidDenomTrace = sdkerrors.Register(ModuleName, 11, "ibc denom does not trace back to the native denom")
 )
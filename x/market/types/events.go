@@ -2,13 +2,31 @@ package types
 
 // Market module event types
 const (
-	EventSwap = "swap"
+	EventSwap      = "swap"
+	EventSwapRoute = "swap_route"
+	EventSwapHop   = "swap_hop"
+
+	EventSwapFeeSplit = "swap_fee_split"
+
+	EventBatchSwapQueued = "batch_swap_queued"
+	EventBatchSwapClear  = "batch_swap_clear"
+	EventBatchSwap       = "batch_swap"
+	EventBatchSwapRefund = "batch_swap_refund"
 
 	AttributeKeyOffer     = "offer"
 	AttributeKeyTrader    = "trader"
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySwapCoin  = "swap_coin"
 	AttributeKeySwapFee   = "swap_fee"
+	AttributeKeyHop       = "hop"
+	AttributeKeySpread    = "spread"
+
+	AttributeKeyDestination = "destination"
+
+	AttributeKeyOrderID  = "order_id"
+	AttributeKeyAskDenom = "ask_denom"
+	AttributeKeyPrice    = "price"
+	AttributeKeyReason   = "reason"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,29 +2,135 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(terraPoolDelta sdk.Dec, params Params) *GenesisState {
+func NewGenesisState(
+	terraPoolDelta sdk.Dec, params Params,
+	epochSwapVolumes []SwapVolume, swapStatistics []SwapStatisticsRecord,
+	terraSwapFees []TerraSwapFee, swapFeeTotals []SwapFeeTotal,
+	batchSwapOrders []BatchSwapOrder, nextBatchSwapOrderID uint64,
+	ibcDenomMappings []IBCDenomMapping,
+) *GenesisState {
 	return &GenesisState{
-		TerraPoolDelta: terraPoolDelta,
-		Params:         params,
+		TerraPoolDelta:   terraPoolDelta,
+		Params:           params,
+		EpochSwapVolumes: epochSwapVolumes,
+		SwapStatistics:   swapStatistics,
+		TerraSwapFees:    terraSwapFees,
+		SwapFeeTotals:    swapFeeTotals,
+
+		BatchSwapOrders:      batchSwapOrders,
+		NextBatchSwapOrderID: nextBatchSwapOrderID,
+		IBCDenomMappings:     ibcDenomMappings,
 	}
 }
 
 // DefaultGenesisState returns raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		TerraPoolDelta: sdk.ZeroDec(),
-		Params:         DefaultParams(),
+		TerraPoolDelta:   sdk.ZeroDec(),
+		Params:           DefaultParams(),
+		EpochSwapVolumes: []SwapVolume{},
+		SwapStatistics:   []SwapStatisticsRecord{},
+		TerraSwapFees:    []TerraSwapFee{},
+		SwapFeeTotals:    []SwapFeeTotal{},
+
+		BatchSwapOrders:      []BatchSwapOrder{},
+		NextBatchSwapOrderID: 1,
+		IBCDenomMappings:     []IBCDenomMapping{},
 	}
 }
 
 // ValidateGenesis validates the provided market genesis state
 func ValidateGenesis(data *GenesisState) error {
+	seen := make(map[string]bool, len(data.EpochSwapVolumes))
+	for _, volume := range data.EpochSwapVolumes {
+		if err := sdk.ValidateDenom(volume.Denom); err != nil {
+			return err
+		}
+
+		if seen[volume.Denom] {
+			return fmt.Errorf("duplicate epoch swap volume for %s", volume.Denom)
+		}
+		seen[volume.Denom] = true
+
+		if volume.Volume.IsNil() {
+			return fmt.Errorf("empty epoch swap volume for %s", volume.Denom)
+		}
+	}
+
+	seenStatistics := make(map[string]bool, len(data.SwapStatistics))
+	for _, record := range data.SwapStatistics {
+		if err := record.Statistics.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetSwapStatisticsKey(record.Period, record.Statistics.OfferDenom, record.Statistics.AskDenom))
+		if seenStatistics[key] {
+			return fmt.Errorf("duplicate swap statistics of %s to %s in period %d",
+				record.Statistics.OfferDenom, record.Statistics.AskDenom, record.Period)
+		}
+		seenStatistics[key] = true
+	}
+
+	seenFees := make(map[string]bool, len(data.TerraSwapFees))
+	for _, fee := range data.TerraSwapFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetTerraSwapFeeKey(fee.OfferDenom, fee.AskDenom))
+		if seenFees[key] {
+			return fmt.Errorf("duplicate terra swap fee of %s to %s", fee.OfferDenom, fee.AskDenom)
+		}
+		seenFees[key] = true
+	}
+
+	seenTotals := make(map[string]bool, len(data.SwapFeeTotals))
+	for _, total := range data.SwapFeeTotals {
+		if err := total.Validate(); err != nil {
+			return err
+		}
+
+		if seenTotals[total.Destination] {
+			return fmt.Errorf("duplicate swap fee total for %s", total.Destination)
+		}
+		seenTotals[total.Destination] = true
+	}
+
+	seenOrders := make(map[uint64]bool, len(data.BatchSwapOrders))
+	for _, order := range data.BatchSwapOrders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+
+		if seenOrders[order.ID] {
+			return fmt.Errorf("duplicate batch swap order %d", order.ID)
+		}
+		seenOrders[order.ID] = true
+
+		if order.ID >= data.NextBatchSwapOrderID {
+			return fmt.Errorf("batch swap order %d is not below the next batch swap order id %d", order.ID, data.NextBatchSwapOrderID)
+		}
+	}
+
+	seenMappings := make(map[string]bool, len(data.IBCDenomMappings))
+	for _, mapping := range data.IBCDenomMappings {
+		if err := mapping.Validate(); err != nil {
+			return err
+		}
+
+		if seenMappings[mapping.IBCDenom] {
+			return fmt.Errorf("duplicate ibc denom mapping for %s", mapping.IBCDenom)
+		}
+		seenMappings[mapping.IBCDenom] = true
+	}
+
 	return data.Params.Validate()
 }
 
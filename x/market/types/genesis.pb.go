@@ -33,6 +33,20 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// the gap between the TerraPool and the BasePool
 	TerraPoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=terra_pool_delta,json=terraPoolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta"`
+	// the net swap volume of each denom in the current swap volume epoch
+	EpochSwapVolumes []SwapVolume `protobuf:"bytes,3,rep,name=epoch_swap_volumes,json=epochSwapVolumes,proto3" json:"epoch_swap_volumes"`
+	// the swap statistics of each retained swap statistics period
+	SwapStatistics []SwapStatisticsRecord `protobuf:"bytes,4,rep,name=swap_statistics,json=swapStatistics,proto3" json:"swap_statistics"`
+	// the swap fees of the terra denom pairs
+	TerraSwapFees []TerraSwapFee `protobuf:"bytes,5,rep,name=terra_swap_fees,json=terraSwapFees,proto3" json:"terra_swap_fees"`
+	// the cumulative swap fees routed to each destination
+	SwapFeeTotals []SwapFeeTotal `protobuf:"bytes,6,rep,name=swap_fee_totals,json=swapFeeTotals,proto3" json:"swap_fee_totals"`
+	// the swaps queued for the batch of the current block
+	BatchSwapOrders []BatchSwapOrder `protobuf:"bytes,7,rep,name=batch_swap_orders,json=batchSwapOrders,proto3" json:"batch_swap_orders"`
+	// the id of the next queued batch swap order
+	NextBatchSwapOrderID uint64 `protobuf:"varint,8,opt,name=next_batch_swap_order_id,json=nextBatchSwapOrderId,proto3" json:"next_batch_swap_order_id,omitempty"`
+	// the native denoms the IBC vouchers are mapped to
+	IBCDenomMappings []IBCDenomMapping `protobuf:"bytes,9,rep,name=ibc_denom_mappings,json=ibcDenomMappings,proto3" json:"ibc_denom_mappings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +94,55 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEpochSwapVolumes() []SwapVolume {
+	if m != nil {
+		return m.EpochSwapVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapStatistics() []SwapStatisticsRecord {
+	if m != nil {
+		return m.SwapStatistics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTerraSwapFees() []TerraSwapFee {
+	if m != nil {
+		return m.TerraSwapFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapFeeTotals() []SwapFeeTotal {
+	if m != nil {
+		return m.SwapFeeTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchSwapOrders() []BatchSwapOrder {
+	if m != nil {
+		return m.BatchSwapOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextBatchSwapOrderID() uint64 {
+	if m != nil {
+		return m.NextBatchSwapOrderID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetIBCDenomMappings() []IBCDenomMapping {
+	if m != nil {
+		return m.IBCDenomMappings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.market.v1beta1.GenesisState")
 }
@@ -89,24 +152,39 @@ func init() {
 }
 
 var fileDescriptor_e30414b001901db3 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x49, 0x2d, 0x2a,
-	0x4a, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xab, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xd5, 0x0a, 0x56, 0xa2, 0xb4, 0x84,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x0b,
-	0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x10, 0x8a, 0xe0,
-	0x12, 0x00, 0x2b, 0x8e, 0x2f, 0xc8, 0xcf, 0xcf, 0x89, 0x4f, 0x49, 0xcd, 0x29, 0x49, 0x94, 0x60,
-	0x52, 0x60, 0xd4, 0xe0, 0x71, 0xd2, 0x03, 0xa9, 0xbb, 0x75, 0x4f, 0x5e, 0x2d, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x18, 0x4a, 0xe9,
-	0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0xb9, 0xa4, 0x26, 0x07, 0xf1, 0x81,
-	0xcd, 0x09, 0xc8, 0xcf, 0xcf, 0x71, 0x01, 0x99, 0xe2, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xc8, 0x26, 0xe6, 0x24, 0x16, 0x17, 0x67, 0x26, 0xeb, 0x42,
-	0xbc, 0x9d, 0x9c, 0x5f, 0x94, 0xaa, 0x5f, 0x01, 0xf3, 0x3d, 0xd8, 0xec, 0x24, 0x36, 0xb0, 0xaf,
-	0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x05, 0x65, 0x3d, 0x6a, 0x01, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x1b, 0x56, 0x0a, 0x64, 0x63, 0x2d, 0x56, 0x0f, 0xd6, 0x84, 0xd2, 0x52, 0x01, 0xaa,
+	0x10, 0x4b, 0xb4, 0x71, 0xe3, 0x18, 0xaa, 0xa1, 0x1d, 0x80, 0xaa, 0xad, 0x26, 0xe0, 0x12, 0x39,
+	0xce, 0x47, 0x17, 0x2d, 0x89, 0xa3, 0x7c, 0xde, 0x56, 0xfe, 0x05, 0xe2, 0x57, 0xed, 0xb8, 0x23,
+	0xe2, 0x50, 0xa1, 0xf4, 0x8f, 0x20, 0x3b, 0x09, 0xb4, 0x23, 0xec, 0x94, 0xe8, 0xf5, 0xe3, 0xc7,
+	0xaf, 0x2c, 0x7f, 0xe6, 0x40, 0x42, 0x96, 0x31, 0x27, 0x66, 0xd9, 0x19, 0x48, 0xe7, 0xe2, 0xc0,
+	0x07, 0xc9, 0x0e, 0x9c, 0x39, 0x24, 0x80, 0x21, 0xda, 0x69, 0x26, 0xa4, 0x20, 0x5d, 0xcd, 0xd8,
+	0x05, 0x63, 0x97, 0xcc, 0x5e, 0x77, 0x2e, 0xe6, 0x42, 0x03, 0x8e, 0xfa, 0x2b, 0xd8, 0xbd, 0x27,
+	0xb5, 0xbe, 0x72, 0xab, 0x46, 0x06, 0xdf, 0x5b, 0xe6, 0xce, 0xdb, 0xe2, 0x80, 0xa9, 0x64, 0x12,
+	0xc8, 0x6b, 0xb3, 0x95, 0xb2, 0x8c, 0xc5, 0x48, 0x8d, 0xbe, 0x31, 0xdc, 0x3e, 0x7c, 0x6c, 0xd7,
+	0x1d, 0x68, 0x8f, 0x35, 0xe3, 0x36, 0xaf, 0x96, 0xbd, 0xc6, 0xa4, 0xdc, 0x41, 0x3e, 0x9a, 0x1d,
+	0x0d, 0x7b, 0xa9, 0x10, 0x91, 0x17, 0x40, 0x24, 0x19, 0xbd, 0xd3, 0x37, 0x86, 0x3b, 0xae, 0xad,
+	0xb8, 0x9f, 0xcb, 0xde, 0xf3, 0x79, 0x28, 0x4f, 0xcf, 0x7d, 0x9b, 0x8b, 0xd8, 0xe1, 0x02, 0x63,
+	0x81, 0xe5, 0x67, 0x1f, 0x83, 0x33, 0x47, 0x7e, 0x4d, 0x01, 0xed, 0x11, 0xf0, 0xc9, 0xae, 0xf6,
+	0x8c, 0x85, 0x88, 0x46, 0xca, 0x42, 0x66, 0x26, 0x81, 0x54, 0xf0, 0x53, 0x0f, 0x2f, 0x59, 0xea,
+	0x5d, 0x88, 0xe8, 0x3c, 0x06, 0xa4, 0x5b, 0xfd, 0xad, 0xe1, 0xf6, 0x61, 0xbf, 0xbe, 0xe1, 0xf4,
+	0x92, 0xa5, 0x27, 0x1a, 0x2c, 0x5b, 0x76, 0xb4, 0xe1, 0x6f, 0x8c, 0xe4, 0x93, 0xd9, 0xd6, 0x3e,
+	0x94, 0x4c, 0x86, 0x28, 0x43, 0x8e, 0xb4, 0xa9, 0x95, 0x2f, 0xfe, 0xaf, 0x9c, 0xfe, 0x61, 0x27,
+	0xc0, 0x45, 0x16, 0x94, 0xf2, 0x5d, 0xdc, 0x58, 0x23, 0x63, 0xb3, 0x5d, 0x5c, 0x85, 0x3e, 0xe0,
+	0x0b, 0x00, 0xd2, 0xbb, 0x5a, 0x3d, 0xa8, 0x57, 0xcf, 0x54, 0xa8, 0xfc, 0x47, 0x50, 0xf5, 0x7d,
+	0x28, 0xd7, 0x32, 0x6d, 0xac, 0x5c, 0x9e, 0x14, 0x92, 0x45, 0x48, 0x5b, 0xb7, 0x19, 0xcb, 0x8d,
+	0x33, 0x85, 0x56, 0x46, 0x5c, 0xcb, 0x90, 0x9c, 0x98, 0x8f, 0x7c, 0x26, 0xab, 0x4b, 0x15, 0x59,
+	0x00, 0x19, 0xd2, 0x7b, 0xda, 0xf9, 0xb4, 0xde, 0xe9, 0x2a, 0x5c, 0x89, 0x3f, 0x28, 0xb8, 0xb4,
+	0xb6, 0xfd, 0x8d, 0x54, 0x35, 0xa5, 0x09, 0x2c, 0xa4, 0x77, 0x53, 0xee, 0x85, 0x01, 0xbd, 0xdf,
+	0x37, 0x86, 0x4d, 0x97, 0xe6, 0xcb, 0x5e, 0xf7, 0x3d, 0x2c, 0xe4, 0xa6, 0xf0, 0x78, 0x34, 0xe9,
+	0x26, 0xff, 0xa6, 0x01, 0x89, 0x4d, 0x12, 0xfa, 0xdc, 0x0b, 0x20, 0x11, 0xb1, 0x17, 0xb3, 0x34,
+	0x0d, 0x93, 0x39, 0xd2, 0x07, 0xba, 0xea, 0xb3, 0xfa, 0xaa, 0xc7, 0xee, 0x9b, 0x91, 0xc2, 0xdf,
+	0x15, 0xb4, 0x4b, 0x55, 0xd7, 0x7c, 0xd9, 0xeb, 0xdc, 0x58, 0xc0, 0x49, 0x27, 0xf4, 0xf9, 0x46,
+	0xe2, 0x1e, 0x5d, 0xe5, 0x96, 0x71, 0x9d, 0x5b, 0xc6, 0xaf, 0xdc, 0x32, 0xbe, 0xad, 0xac, 0xc6,
+	0xf5, 0xca, 0x6a, 0xfc, 0x58, 0x59, 0x8d, 0xcf, 0x2f, 0xd7, 0xdf, 0x6f, 0xc4, 0x10, 0x43, 0xbe,
+	0x5f, 0x0c, 0x19, 0x17, 0x19, 0x38, 0x8b, 0x6a, 0xd6, 0xf4, 0x4b, 0xf6, 0x5b, 0x7a, 0xc6, 0x5e,
+	0xfd, 0x1e, 0x00, 0x08, 0x73, 0xb1, 0x4d, 0xd8, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +207,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IBCDenomMappings) > 0 {
+		for iNdEx := len(m.IBCDenomMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IBCDenomMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextBatchSwapOrderID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextBatchSwapOrderID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BatchSwapOrders) > 0 {
+		for iNdEx := len(m.BatchSwapOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchSwapOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SwapFeeTotals) > 0 {
+		for iNdEx := len(m.SwapFeeTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFeeTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TerraSwapFees) > 0 {
+		for iNdEx := len(m.TerraSwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TerraSwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SwapStatistics) > 0 {
+		for iNdEx := len(m.SwapStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochSwapVolumes) > 0 {
+		for iNdEx := len(m.EpochSwapVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochSwapVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.TerraPoolDelta.Size()
 		i -= size
@@ -174,6 +341,45 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TerraPoolDelta.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EpochSwapVolumes) > 0 {
+		for _, e := range m.EpochSwapVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapStatistics) > 0 {
+		for _, e := range m.SwapStatistics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TerraSwapFees) > 0 {
+		for _, e := range m.TerraSwapFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapFeeTotals) > 0 {
+		for _, e := range m.SwapFeeTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchSwapOrders) > 0 {
+		for _, e := range m.BatchSwapOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextBatchSwapOrderID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextBatchSwapOrderID))
+	}
+	if len(m.IBCDenomMappings) > 0 {
+		for _, e := range m.IBCDenomMappings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +486,229 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSwapVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochSwapVolumes = append(m.EpochSwapVolumes, SwapVolume{})
+			if err := m.EpochSwapVolumes[len(m.EpochSwapVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapStatistics = append(m.SwapStatistics, SwapStatisticsRecord{})
+			if err := m.SwapStatistics[len(m.SwapStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraSwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerraSwapFees = append(m.TerraSwapFees, TerraSwapFee{})
+			if err := m.TerraSwapFees[len(m.TerraSwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFeeTotals = append(m.SwapFeeTotals, SwapFeeTotal{})
+			if err := m.SwapFeeTotals[len(m.SwapFeeTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSwapOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchSwapOrders = append(m.BatchSwapOrders, BatchSwapOrder{})
+			if err := m.BatchSwapOrders[len(m.BatchSwapOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBatchSwapOrderID", wireType)
+			}
+			m.NextBatchSwapOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBatchSwapOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenomMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCDenomMappings = append(m.IBCDenomMappings, IBCDenomMapping{})
+			if err := m.IBCDenomMappings[len(m.IBCDenomMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,4 +22,84 @@ func TestGenesisValidation(t *testing.T) {
 	genState = DefaultGenesisState()
 	genState.Params.MinStabilitySpread = sdk.NewDec(-1)
 	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
+	genState.EpochSwapVolumes = []SwapVolume{NewSwapVolume("usdr", sdk.NewInt(1)), NewSwapVolume("usdr", sdk.NewInt(-1))}
+	require.Error(t, ValidateGenesis(genState))
+
+	statistics := NewSwapStatistics("ukrw", "uluna")
+	genState = DefaultGenesisState()
+	genState.SwapStatistics = []SwapStatisticsRecord{NewSwapStatisticsRecord(1, statistics), NewSwapStatisticsRecord(2, statistics)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.SwapStatistics = append(genState.SwapStatistics, NewSwapStatisticsRecord(1, statistics))
+	require.Error(t, ValidateGenesis(genState))
+
+	statistics.OfferVolume = sdk.NewInt(-1)
+	genState.SwapStatistics = []SwapStatisticsRecord{NewSwapStatisticsRecord(1, statistics)}
+	require.Error(t, ValidateGenesis(genState))
+
+	fee := NewTerraSwapFee("ukrw", "uusd", sdk.NewDecWithPrec(3, 3))
+	genState = DefaultGenesisState()
+	genState.TerraSwapFees = []TerraSwapFee{fee, NewTerraSwapFee("uusd", "ukrw", sdk.NewDecWithPrec(3, 3))}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.TerraSwapFees = append(genState.TerraSwapFees, fee)
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.TerraSwapFees = []TerraSwapFee{NewTerraSwapFee("uluna", "uusd", sdk.NewDecWithPrec(3, 3))}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.TerraSwapFees = []TerraSwapFee{NewTerraSwapFee("ukrw", "uusd", sdk.OneDec())}
+	require.Error(t, ValidateGenesis(genState))
+
+	total := NewSwapFeeTotal(SwapFeeDestinationOracle, sdk.NewCoins(sdk.NewInt64Coin("uusd", 100)))
+	genState = DefaultGenesisState()
+	genState.SwapFeeTotals = []SwapFeeTotal{total, NewSwapFeeTotal(SwapFeeDestinationBurn, sdk.NewCoins())}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.SwapFeeTotals = append(genState.SwapFeeTotals, total)
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.SwapFeeTotals = []SwapFeeTotal{NewSwapFeeTotal("treasury", sdk.NewCoins())}
+	require.Error(t, ValidateGenesis(genState))
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	order := NewBatchSwapOrder(addr, addr, sdk.NewInt64Coin("uluna", 1000), "uusd", nil, nil)
+	order.ID = 1
+	genState = DefaultGenesisState()
+	genState.NextBatchSwapOrderID = 2
+	genState.BatchSwapOrders = []BatchSwapOrder{order}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.BatchSwapOrders = append(genState.BatchSwapOrders, order)
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.BatchSwapOrders = []BatchSwapOrder{order}
+	genState.NextBatchSwapOrderID = 1
+	require.Error(t, ValidateGenesis(genState))
+
+	order.AskDenom = "uluna"
+	genState.BatchSwapOrders = []BatchSwapOrder{order}
+	genState.NextBatchSwapOrderID = 2
+	require.Error(t, ValidateGenesis(genState))
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	mapping := NewIBCDenomMapping(ibcDenom, "uusd")
+	genState = DefaultGenesisState()
+	genState.IBCDenomMappings = []IBCDenomMapping{mapping}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.IBCDenomMappings = append(genState.IBCDenomMappings, mapping)
+	require.Error(t, ValidateGenesis(genState))
+
+	// only ibc denoms are mapped, and only to native denoms
+	genState.IBCDenomMappings = []IBCDenomMapping{NewIBCDenomMapping("ukrw", "uusd")}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.IBCDenomMappings = []IBCDenomMapping{NewIBCDenomMapping("ibc/invalid", "uusd")}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.IBCDenomMappings = []IBCDenomMapping{NewIBCDenomMapping(ibcDenom, ibcDenom)}
+	require.Error(t, ValidateGenesis(genState))
 }
@@ -0,0 +1,216 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdateTerraSwapFee = "UpdateTerraSwapFee"
+	ProposalTypeRemoveTerraSwapFee = "RemoveTerraSwapFee"
+
+	ProposalTypeUpdateIBCDenomMapping = "UpdateIBCDenomMapping"
+	ProposalTypeRemoveIBCDenomMapping = "RemoveIBCDenomMapping"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateTerraSwapFee)
+	govtypes.RegisterProposalTypeCodec(&UpdateTerraSwapFeeProposal{}, "market/UpdateTerraSwapFeeProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveTerraSwapFee)
+	govtypes.RegisterProposalTypeCodec(&RemoveTerraSwapFeeProposal{}, "market/RemoveTerraSwapFeeProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateIBCDenomMapping)
+	govtypes.RegisterProposalTypeCodec(&UpdateIBCDenomMappingProposal{}, "market/UpdateIBCDenomMappingProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveIBCDenomMapping)
+	govtypes.RegisterProposalTypeCodec(&RemoveIBCDenomMappingProposal{}, "market/RemoveIBCDenomMappingProposal")
+}
+
+var (
+	_ govtypes.Content = &UpdateTerraSwapFeeProposal{}
+	_ govtypes.Content = &RemoveTerraSwapFeeProposal{}
+	_ govtypes.Content = &UpdateIBCDenomMappingProposal{}
+	_ govtypes.Content = &RemoveIBCDenomMappingProposal{}
+)
+
+// ======UpdateTerraSwapFeeProposal======
+
+func NewUpdateTerraSwapFeeProposal(title, description string, fee TerraSwapFee) govtypes.Content {
+	return &UpdateTerraSwapFeeProposal{
+		Title:       title,
+		Description: description,
+		OfferDenom:  fee.OfferDenom,
+		AskDenom:    fee.AskDenom,
+		Fee:         fee.Fee,
+	}
+}
+
+func (p *UpdateTerraSwapFeeProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateTerraSwapFeeProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateTerraSwapFeeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateTerraSwapFeeProposal) ProposalType() string {
+	return ProposalTypeUpdateTerraSwapFee
+}
+
+func (p UpdateTerraSwapFeeProposal) String() string {
+	return fmt.Sprintf(`UpdateTerraSwapFeeProposal:
+	Title:       %s
+	Description: %s
+	OfferDenom:  %s
+	AskDenom:    %s
+	Fee:         %s
+  `, p.Title, p.Description, p.OfferDenom, p.AskDenom, p.Fee)
+}
+
+// TerraSwapFee returns the swap fee set by the proposal
+func (p *UpdateTerraSwapFeeProposal) TerraSwapFee() TerraSwapFee {
+	return NewTerraSwapFee(p.OfferDenom, p.AskDenom, p.Fee)
+}
+
+func (p *UpdateTerraSwapFeeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := p.TerraSwapFee().Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ======RemoveTerraSwapFeeProposal======
+
+func NewRemoveTerraSwapFeeProposal(title, description, offerDenom, askDenom string) govtypes.Content {
+	return &RemoveTerraSwapFeeProposal{
+		Title:       title,
+		Description: description,
+		OfferDenom:  offerDenom,
+		AskDenom:    askDenom,
+	}
+}
+
+func (p *RemoveTerraSwapFeeProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveTerraSwapFeeProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveTerraSwapFeeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveTerraSwapFeeProposal) ProposalType() string {
+	return ProposalTypeRemoveTerraSwapFee
+}
+
+func (p RemoveTerraSwapFeeProposal) String() string {
+	return fmt.Sprintf(`RemoveTerraSwapFeeProposal:
+	Title:       %s
+	Description: %s
+	OfferDenom:  %s
+	AskDenom:    %s
+  `, p.Title, p.Description, p.OfferDenom, p.AskDenom)
+}
+
+func (p *RemoveTerraSwapFeeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateTerraSwapFeePair(p.OfferDenom, p.AskDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ======UpdateIBCDenomMappingProposal======
+
+func NewUpdateIBCDenomMappingProposal(title, description string, mapping IBCDenomMapping) govtypes.Content {
+	return &UpdateIBCDenomMappingProposal{
+		Title:       title,
+		Description: description,
+		IBCDenom:    mapping.IBCDenom,
+		NativeDenom: mapping.NativeDenom,
+	}
+}
+
+func (p *UpdateIBCDenomMappingProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateIBCDenomMappingProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateIBCDenomMappingProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateIBCDenomMappingProposal) ProposalType() string {
+	return ProposalTypeUpdateIBCDenomMapping
+}
+
+func (p UpdateIBCDenomMappingProposal) String() string {
+	return fmt.Sprintf(`UpdateIBCDenomMappingProposal:
+	Title:       %s
+	Description: %s
+	IBCDenom:    %s
+	NativeDenom: %s
+  `, p.Title, p.Description, p.IBCDenom, p.NativeDenom)
+}
+
+// IBCDenomMapping returns the mapping set by the proposal
+func (p *UpdateIBCDenomMappingProposal) IBCDenomMapping() IBCDenomMapping {
+	return NewIBCDenomMapping(p.IBCDenom, p.NativeDenom)
+}
+
+func (p *UpdateIBCDenomMappingProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := p.IBCDenomMapping().Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ======RemoveIBCDenomMappingProposal======
+
+func NewRemoveIBCDenomMappingProposal(title, description, ibcDenom string) govtypes.Content {
+	return &RemoveIBCDenomMappingProposal{
+		Title:       title,
+		Description: description,
+		IBCDenom:    ibcDenom,
+	}
+}
+
+func (p *RemoveIBCDenomMappingProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveIBCDenomMappingProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveIBCDenomMappingProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveIBCDenomMappingProposal) ProposalType() string {
+	return ProposalTypeRemoveIBCDenomMapping
+}
+
+func (p RemoveIBCDenomMappingProposal) String() string {
+	return fmt.Sprintf(`RemoveIBCDenomMappingProposal:
+	Title:       %s
+	Description: %s
+	IBCDenom:    %s
+  `, p.Title, p.Description, p.IBCDenom)
+}
+
+func (p *RemoveIBCDenomMappingProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateIBCDenom(p.IBCDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
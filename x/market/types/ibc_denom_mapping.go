@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+)
+
+// NewIBCDenomMapping creates an IBCDenomMapping instance
+func NewIBCDenomMapping(ibcDenom, nativeDenom string) IBCDenomMapping {
+	return IBCDenomMapping{
+		IBCDenom:    ibcDenom,
+		NativeDenom: nativeDenom,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (m IBCDenomMapping) String() string {
+	out, _ := yaml.Marshal(m)
+	return string(out)
+}
+
+// Validate checks the mapping is set on an IBC voucher denom and maps it to a native denom
+func (m IBCDenomMapping) Validate() error {
+	if err := ValidateIBCDenom(m.IBCDenom); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(m.NativeDenom); err != nil {
+		return err
+	}
+
+	if IsIBCDenom(m.NativeDenom) {
+		return fmt.Errorf("ibc denom %s cannot be mapped to another ibc denom %s", m.IBCDenom, m.NativeDenom)
+	}
+
+	return nil
+}
+
+// ValidateIBCDenom checks the denom is an IBC voucher denom in the ibc/{hash} format
+func ValidateIBCDenom(denom string) error {
+	if !IsIBCDenom(denom) {
+		return fmt.Errorf("%s is not an ibc denom", denom)
+	}
+
+	return ibctransfertypes.ValidateIBCDenom(denom)
+}
+
+// IsIBCDenom returns true when the denom has the ibc/ prefix of the IBC vouchers
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/")
+}
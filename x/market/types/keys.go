@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the market module
 	ModuleName = "market"
@@ -18,7 +22,104 @@ const (
 // Items are stored with the following key: values
 //
 // - 0x01: sdk.Dec
+//
+// - 0x02<denom_Bytes>: sdk.Int
+//
+// - 0x03<denom_Bytes>: sdk.Int
+//
+// - 0x04<period_Bytes><offer_denom_length><offer_denom_Bytes><ask_denom_Bytes>: SwapStatistics
+//
+// - 0x05: time.Time
+//
+// - 0x06<offer_denom_length><offer_denom_Bytes><ask_denom_Bytes>: sdk.Dec
+//
+// - 0x07<destination_length><destination_Bytes><denom_Bytes>: sdk.Int
+//
+// - 0x08<order_id_Bytes>: BatchSwapOrder
+//
+// - 0x09: uint64
+//
+// - 0x0A<ibc_denom_Bytes>: native_denom_Bytes
 var (
 	// Keys for store prefixed
-	TerraPoolDeltaKey = []byte{0x01} // key for terra pool delta which gap between MintPool from BasePool
+	TerraPoolDeltaKey        = []byte{0x01} // key for terra pool delta which gap between MintPool from BasePool
+	BlockSwapVolumeKeyPrefix = []byte{0x02} // prefix for each key to a net swap volume of the current block
+	EpochSwapVolumeKeyPrefix = []byte{0x03} // prefix for each key to a net swap volume of the current epoch
+	SwapStatisticsKeyPrefix  = []byte{0x04} // prefix for each key to the swap statistics of a denom pair in a period
+	LastReplenishTimeKey     = []byte{0x05} // key for the block time of the last pool replenishment
+	TerraSwapFeeKeyPrefix    = []byte{0x06} // prefix for each key to the swap fee of a terra denom pair
+	SwapFeeTotalKeyPrefix    = []byte{0x07} // prefix for each key to the cumulative swap fees of a denom routed to a destination
+	BatchSwapOrderKeyPrefix  = []byte{0x08} // prefix for each key to a swap queued for the batch of the current block
+	NextBatchSwapOrderIDKey  = []byte{0x09} // key for the id of the next queued batch swap order
+	IBCDenomMappingKeyPrefix = []byte{0x0A} // prefix for each key to the native denom an ibc denom is mapped to
 )
+
+// GetBlockSwapVolumeKey - stored by *denom*
+func GetBlockSwapVolumeKey(denom string) []byte {
+	return append(BlockSwapVolumeKeyPrefix, []byte(denom)...)
+}
+
+// GetEpochSwapVolumeKey - stored by *denom*
+func GetEpochSwapVolumeKey(denom string) []byte {
+	return append(EpochSwapVolumeKeyPrefix, []byte(denom)...)
+}
+
+// GetSwapStatisticsPeriodPrefix - stored by *period*
+func GetSwapStatisticsPeriodPrefix(period uint64) []byte {
+	return append(SwapStatisticsKeyPrefix, sdk.Uint64ToBigEndian(period)...)
+}
+
+// GetSwapStatisticsKey - stored by *period*, *offer denom* and *ask denom*
+func GetSwapStatisticsKey(period uint64, offerDenom, askDenom string) []byte {
+	key := GetSwapStatisticsPeriodPrefix(period)
+	key = append(key, byte(len(offerDenom)))
+	key = append(key, []byte(offerDenom)...)
+	return append(key, []byte(askDenom)...)
+}
+
+// ParseSwapStatisticsKey returns the period, offer denom and ask denom of a swap statistics key
+func ParseSwapStatisticsKey(key []byte) (period uint64, offerDenom, askDenom string) {
+	key = key[len(SwapStatisticsKeyPrefix):]
+	period = sdk.BigEndianToUint64(key[:8])
+	offerDenomLen := int(key[8])
+	offerDenom = string(key[9 : 9+offerDenomLen])
+	askDenom = string(key[9+offerDenomLen:])
+	return
+}
+
+// GetTerraSwapFeeKey - stored by *offer denom* and *ask denom*
+func GetTerraSwapFeeKey(offerDenom, askDenom string) []byte {
+	key := append(TerraSwapFeeKeyPrefix, byte(len(offerDenom)))
+	key = append(key, []byte(offerDenom)...)
+	return append(key, []byte(askDenom)...)
+}
+
+// ParseTerraSwapFeeKey returns the offer denom and ask denom of a terra swap fee key
+func ParseTerraSwapFeeKey(key []byte) (offerDenom, askDenom string) {
+	key = key[len(TerraSwapFeeKeyPrefix):]
+	offerDenomLen := int(key[0])
+	offerDenom = string(key[1 : 1+offerDenomLen])
+	askDenom = string(key[1+offerDenomLen:])
+	return
+}
+
+// GetSwapFeeTotalDestinationPrefix - stored by *destination*
+func GetSwapFeeTotalDestinationPrefix(destination string) []byte {
+	key := append(SwapFeeTotalKeyPrefix, byte(len(destination)))
+	return append(key, []byte(destination)...)
+}
+
+// GetSwapFeeTotalKey - stored by *destination* and *denom*
+func GetSwapFeeTotalKey(destination, denom string) []byte {
+	return append(GetSwapFeeTotalDestinationPrefix(destination), []byte(denom)...)
+}
+
+// GetBatchSwapOrderKey - stored by *order id*
+func GetBatchSwapOrderKey(id uint64) []byte {
+	return append(BatchSwapOrderKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetIBCDenomMappingKey - stored by *ibc denom*
+func GetIBCDenomMappingKey(ibcDenom string) []byte {
+	return append(IBCDenomMappingKeyPrefix, []byte(ibcDenom)...)
+}
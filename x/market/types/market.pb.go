@@ -10,6 +10,7 @@ import (
 	math_bits "math/bits"
 
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
@@ -29,9 +30,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the market module.
 type Params struct {
-	BasePool           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool" yaml:"base_pool"`
-	PoolRecoveryPeriod uint64                                 `protobuf:"varint,2,opt,name=pool_recovery_period,json=poolRecoveryPeriod,proto3" json:"pool_recovery_period,omitempty" yaml:"pool_recovery_period"`
-	MinStabilitySpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_stability_spread,json=minStabilitySpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_spread" yaml:"min_stability_spread"`
+	BasePool                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool" yaml:"base_pool"`
+	PoolRecoveryPeriod      uint64                                 `protobuf:"varint,2,opt,name=pool_recovery_period,json=poolRecoveryPeriod,proto3" json:"pool_recovery_period,omitempty" yaml:"pool_recovery_period"`
+	MinStabilitySpread      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_stability_spread,json=minStabilitySpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_spread" yaml:"min_stability_spread"`
+	SwapVolumeLimits        SwapVolumeLimits                       `protobuf:"bytes,4,rep,name=swap_volume_limits,json=swapVolumeLimits,proto3,castrepeated=SwapVolumeLimits" json:"swap_volume_limits" yaml:"swap_volume_limits"`
+	SwapVolumeEpoch         uint64                                 `protobuf:"varint,5,opt,name=swap_volume_epoch,json=swapVolumeEpoch,proto3" json:"swap_volume_epoch,omitempty" yaml:"swap_volume_epoch"`
+	SwapStatisticsPeriod    uint64                                 `protobuf:"varint,6,opt,name=swap_statistics_period,json=swapStatisticsPeriod,proto3" json:"swap_statistics_period,omitempty" yaml:"swap_statistics_period"`
+	SwapStatisticsRetention uint64                                 `protobuf:"varint,7,opt,name=swap_statistics_retention,json=swapStatisticsRetention,proto3" json:"swap_statistics_retention,omitempty" yaml:"swap_statistics_retention"`
+	// pool_replenish_mode selects how the terra pool delta is replenished: block, linear or exponential
+	PoolReplenishMode string `protobuf:"bytes,8,opt,name=pool_replenish_mode,json=poolReplenishMode,proto3" json:"pool_replenish_mode,omitempty" yaml:"pool_replenish_mode"`
+	// pool_recovery_time is the seconds for the linear mode to replenish a delta of the base pool
+	PoolRecoveryTime uint64 `protobuf:"varint,9,opt,name=pool_recovery_time,json=poolRecoveryTime,proto3" json:"pool_recovery_time,omitempty" yaml:"pool_recovery_time"`
+	// pool_half_life is the seconds for the exponential mode to replenish half of the delta
+	PoolHalfLife uint64 `protobuf:"varint,10,opt,name=pool_half_life,json=poolHalfLife,proto3" json:"pool_half_life,omitempty" yaml:"pool_half_life"`
+	// swap_fee_burn_rate is the portion of the swap fees burned
+	SwapFeeBurnRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=swap_fee_burn_rate,json=swapFeeBurnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_burn_rate" yaml:"swap_fee_burn_rate"`
+	// swap_fee_community_pool_rate is the portion of the swap fees sent to the community pool,
+	// the rest of the swap fees is sent to the oracle reward pool
+	SwapFeeCommunityPoolRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=swap_fee_community_pool_rate,json=swapFeeCommunityPoolRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_community_pool_rate" yaml:"swap_fee_community_pool_rate"`
+	// batch_swap_enabled queues the swaps of each block and clears them at the end block
+	// at a uniform price per denom pair, instead of executing them in transaction order
+	BatchSwapEnabled bool `protobuf:"varint,13,opt,name=batch_swap_enabled,json=batchSwapEnabled,proto3" json:"batch_swap_enabled,omitempty" yaml:"batch_swap_enabled"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,153 +97,3028 @@ func (m *Params) GetPoolRecoveryPeriod() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "terra.market.v1beta1.Params")
+func (m *Params) GetSwapVolumeLimits() SwapVolumeLimits {
+	if m != nil {
+		return m.SwapVolumeLimits
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("terra/market/v1beta1/market.proto", fileDescriptor_114ea92c5ae3e66f) }
+func (m *Params) GetSwapVolumeEpoch() uint64 {
+	if m != nil {
+		return m.SwapVolumeEpoch
+	}
+	return 0
+}
 
-var fileDescriptor_114ea92c5ae3e66f = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x31, 0x4b, 0xf3, 0x40,
-	0x18, 0xc7, 0x73, 0x7d, 0x5f, 0x4a, 0x0d, 0x0e, 0x12, 0x32, 0x14, 0x85, 0x5c, 0xcd, 0x20, 0x1d,
-	0x6c, 0x42, 0x71, 0xeb, 0x18, 0xc4, 0x4d, 0xa8, 0xe9, 0xe6, 0x12, 0x2e, 0xe9, 0x51, 0x8f, 0xe6,
-	0xfa, 0x84, 0xbb, 0xb3, 0x98, 0xc9, 0xaf, 0xe0, 0xe8, 0xd8, 0x6f, 0x63, 0xc7, 0x8e, 0xe2, 0x10,
-	0xa4, 0x5d, 0x9c, 0xfb, 0x09, 0x24, 0xd7, 0xab, 0x38, 0x74, 0x71, 0xba, 0xe7, 0x7e, 0xfc, 0xee,
-	0xb9, 0x3f, 0xfc, 0xed, 0x73, 0x45, 0x85, 0x20, 0x21, 0x27, 0x62, 0x4a, 0x55, 0x38, 0xef, 0xa7,
-	0x54, 0x91, 0xbe, 0xb9, 0x06, 0x85, 0x00, 0x05, 0x8e, 0xab, 0x95, 0xc0, 0x30, 0xa3, 0x9c, 0xba,
-	0x13, 0x98, 0x80, 0x16, 0xc2, 0x7a, 0xda, 0xb9, 0xfe, 0x5b, 0xc3, 0x6e, 0x0e, 0x89, 0x20, 0x5c,
-	0x3a, 0x89, 0x7d, 0x94, 0x12, 0x49, 0x93, 0x02, 0x20, 0x6f, 0xa3, 0x0e, 0xea, 0x1e, 0x47, 0xd1,
-	0xb2, 0xc2, 0xd6, 0x47, 0x85, 0x2f, 0x26, 0x4c, 0x3d, 0x3c, 0xa6, 0x41, 0x06, 0x3c, 0xcc, 0x40,
-	0x72, 0x90, 0xe6, 0xe8, 0xc9, 0xf1, 0x34, 0x54, 0x65, 0x41, 0x65, 0x70, 0x4d, 0xb3, 0x6d, 0x85,
-	0x4f, 0x4a, 0xc2, 0xf3, 0x81, 0xff, 0xb3, 0xc8, 0x8f, 0x5b, 0xf5, 0x3c, 0x04, 0xc8, 0x9d, 0x3b,
-	0xdb, 0xad, 0x51, 0x22, 0x68, 0x06, 0x73, 0x2a, 0xca, 0xa4, 0xa0, 0x82, 0xc1, 0xb8, 0xdd, 0xe8,
-	0xa0, 0xee, 0xff, 0x08, 0x6f, 0x2b, 0x7c, 0xb6, 0x7b, 0x7d, 0xc8, 0xf2, 0x63, 0xa7, 0xc6, 0xb1,
-	0xa1, 0x43, 0x0d, 0x9d, 0x67, 0xdb, 0xe5, 0x6c, 0x96, 0x48, 0x45, 0x52, 0x96, 0x33, 0x55, 0x26,
-	0xb2, 0x10, 0x94, 0x8c, 0xdb, 0xff, 0x74, 0xfc, 0xdb, 0x3f, 0xc7, 0x37, 0x01, 0x0e, 0xed, 0xf4,
-	0x63, 0x87, 0xb3, 0xd9, 0x68, 0x4f, 0x47, 0x1a, 0x0e, 0x5a, 0xaf, 0x0b, 0x6c, 0x7d, 0x2d, 0x30,
-	0x8a, 0x6e, 0x96, 0x6b, 0x0f, 0xad, 0xd6, 0x1e, 0xfa, 0x5c, 0x7b, 0xe8, 0x65, 0xe3, 0x59, 0xab,
-	0x8d, 0x67, 0xbd, 0x6f, 0x3c, 0xeb, 0xfe, 0xf2, 0xf7, 0xf7, 0x39, 0x91, 0x92, 0x65, 0xbd, 0x5d,
-	0x8b, 0x19, 0x08, 0x1a, 0x3e, 0xed, 0xcb, 0xd4, 0x41, 0xd2, 0xa6, 0x2e, 0xe6, 0xea, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xb8, 0x9a, 0x90, 0xc3, 0xe9, 0x01, 0x00, 0x00,
+func (m *Params) GetSwapStatisticsPeriod() uint64 {
+	if m != nil {
+		return m.SwapStatisticsPeriod
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetSwapStatisticsRetention() uint64 {
+	if m != nil {
+		return m.SwapStatisticsRetention
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Params) GetPoolReplenishMode() string {
+	if m != nil {
+		return m.PoolReplenishMode
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Params) GetPoolRecoveryTime() uint64 {
+	if m != nil {
+		return m.PoolRecoveryTime
 	}
-	if !this.BasePool.Equal(that1.BasePool) {
-		return false
+	return 0
+}
+
+func (m *Params) GetPoolHalfLife() uint64 {
+	if m != nil {
+		return m.PoolHalfLife
 	}
-	if this.PoolRecoveryPeriod != that1.PoolRecoveryPeriod {
-		return false
+	return 0
+}
+
+func (m *Params) GetBatchSwapEnabled() bool {
+	if m != nil {
+		return m.BatchSwapEnabled
 	}
-	if !this.MinStabilitySpread.Equal(that1.MinStabilitySpread) {
-		return false
+	return false
+}
+
+// SwapVolumeLimit defines the caps on the net amount of a denom minted or burned by swaps.
+type SwapVolumeLimit struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// block_limit caps the net volume per block, zero disables the cap
+	BlockLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=block_limit,json=blockLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"block_limit" yaml:"block_limit"`
+	// epoch_limit caps the net volume per swap volume epoch, zero disables the cap
+	EpochLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=epoch_limit,json=epochLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_limit" yaml:"epoch_limit"`
+}
+
+func (m *SwapVolumeLimit) Reset()      { *m = SwapVolumeLimit{} }
+func (*SwapVolumeLimit) ProtoMessage() {}
+func (*SwapVolumeLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{1}
+}
+
+func (m *SwapVolumeLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SwapVolumeLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapVolumeLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SwapVolumeLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapVolumeLimit.Merge(m, src)
+}
+
+func (m *SwapVolumeLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SwapVolumeLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapVolumeLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapVolumeLimit proto.InternalMessageInfo
+
+// SwapVolume defines the net amount of a denom minted by swaps, negative when burned.
+type SwapVolume struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume" yaml:"volume"`
+}
+
+func (m *SwapVolume) Reset()         { *m = SwapVolume{} }
+func (m *SwapVolume) String() string { return proto.CompactTextString(m) }
+func (*SwapVolume) ProtoMessage()    {}
+func (*SwapVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{2}
+}
+
+func (m *SwapVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SwapVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SwapVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapVolume.Merge(m, src)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinStabilitySpread.Size()
-		i -= size
-		if _, err := m.MinStabilitySpread.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SwapVolume) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SwapVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapVolume proto.InternalMessageInfo
+
+// SwapHop defines the result of a single hop of a swap route.
+type SwapHop struct {
+	OfferCoin types.Coin                             `protobuf:"bytes,1,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
+	SwapCoin  types.Coin                             `protobuf:"bytes,2,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
+	SwapFee   types.Coin                             `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee" yaml:"swap_fee"`
+	Spread    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread" yaml:"spread"`
+}
+
+func (m *SwapHop) Reset()         { *m = SwapHop{} }
+func (m *SwapHop) String() string { return proto.CompactTextString(m) }
+func (*SwapHop) ProtoMessage()    {}
+func (*SwapHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{3}
+}
+
+func (m *SwapHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SwapHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.PoolRecoveryPeriod != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.PoolRecoveryPeriod))
-		i--
-		dAtA[i] = 0x10
+}
+
+func (m *SwapHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapHop.Merge(m, src)
+}
+
+func (m *SwapHop) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SwapHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapHop proto.InternalMessageInfo
+
+// SwapStatistics defines the aggregated swaps from the offer denom to the ask denom.
+type SwapStatistics struct {
+	OfferDenom string `protobuf:"bytes,1,opt,name=offer_denom,json=offerDenom,proto3" json:"offer_denom,omitempty" yaml:"offer_denom"`
+	AskDenom   string `protobuf:"bytes,2,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// offer_volume is the amount of the offer denom swapped
+	OfferVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=offer_volume,json=offerVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"offer_volume" yaml:"offer_volume"`
+	// ask_volume is the amount of the ask denom received
+	AskVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=ask_volume,json=askVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ask_volume" yaml:"ask_volume"`
+	// swap_fee is the amount of the ask denom charged as swap fee, before the swap fee split
+	// routes it to the burn, the oracle reward pool and the community pool
+	SwapFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"swap_fee" yaml:"swap_fee"`
+	Count   uint64                                 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+}
+
+func (m *SwapStatistics) Reset()         { *m = SwapStatistics{} }
+func (m *SwapStatistics) String() string { return proto.CompactTextString(m) }
+func (*SwapStatistics) ProtoMessage()    {}
+func (*SwapStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{4}
+}
+
+func (m *SwapStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SwapStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	{
-		size := m.BasePool.Size()
-		i -= size
-		if _, err := m.BasePool.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+
+func (m *SwapStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapStatistics.Merge(m, src)
+}
+
+func (m *SwapStatistics) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SwapStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapStatistics proto.InternalMessageInfo
+
+// SwapStatisticsRecord defines the swap statistics of a swap statistics period.
+type SwapStatisticsRecord struct {
+	Period     uint64         `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty" yaml:"period"`
+	Statistics SwapStatistics `protobuf:"bytes,2,opt,name=statistics,proto3" json:"statistics" yaml:"statistics"`
+}
+
+func (m *SwapStatisticsRecord) Reset()         { *m = SwapStatisticsRecord{} }
+func (m *SwapStatisticsRecord) String() string { return proto.CompactTextString(m) }
+func (*SwapStatisticsRecord) ProtoMessage()    {}
+func (*SwapStatisticsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{5}
+}
+
+func (m *SwapStatisticsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SwapStatisticsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapStatisticsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SwapStatisticsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapStatisticsRecord.Merge(m, src)
+}
+
+func (m *SwapStatisticsRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SwapStatisticsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapStatisticsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapStatisticsRecord proto.InternalMessageInfo
+
+// TerraSwapFee defines the spread charged on the swaps from the offer denom to the ask denom,
+// both terra denoms, in place of the oracle tobin tax.
+type TerraSwapFee struct {
+	OfferDenom string                                 `protobuf:"bytes,1,opt,name=offer_denom,json=offerDenom,proto3" json:"offer_denom,omitempty" yaml:"offer_denom"`
+	AskDenom   string                                 `protobuf:"bytes,2,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	Fee        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee" yaml:"fee"`
+}
+
+func (m *TerraSwapFee) Reset()      { *m = TerraSwapFee{} }
+func (*TerraSwapFee) ProtoMessage() {}
+func (*TerraSwapFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{6}
+}
+
+func (m *TerraSwapFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TerraSwapFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerraSwapFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TerraSwapFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraSwapFee.Merge(m, src)
+}
+
+func (m *TerraSwapFee) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TerraSwapFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraSwapFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraSwapFee proto.InternalMessageInfo
+
+// SwapFeeTotal defines the cumulative swap fees routed to a destination.
+type SwapFeeTotal struct {
+	// destination is one of burn, oracle or community_pool
+	Destination string                                   `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *SwapFeeTotal) Reset()         { *m = SwapFeeTotal{} }
+func (m *SwapFeeTotal) String() string { return proto.CompactTextString(m) }
+func (*SwapFeeTotal) ProtoMessage()    {}
+func (*SwapFeeTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{7}
+}
+
+func (m *SwapFeeTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SwapFeeTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapFeeTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SwapFeeTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapFeeTotal.Merge(m, src)
+}
+
+func (m *SwapFeeTotal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SwapFeeTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapFeeTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapFeeTotal proto.InternalMessageInfo
+
+// BatchSwapOrder defines a swap queued for the batch of the current block.
+type BatchSwapOrder struct {
+	ID        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Trader    string     `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty" yaml:"trader"`
+	Receiver  string     `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
+	OfferCoin types.Coin `protobuf:"bytes,4,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
+	AskDenom  string     `protobuf:"bytes,5,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// min_receive_amount is the minimum amount of the ask denom to receive, the order is refunded with less when set
+	MinReceiveAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_receive_amount,json=minReceiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_receive_amount,omitempty" yaml:"min_receive_amount,omitempty"`
+	// max_spread is the maximum spread to charge, the order is refunded with a higher spread when set
+	MaxSpread *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_spread,json=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread,omitempty" yaml:"max_spread,omitempty"`
+}
+
+func (m *BatchSwapOrder) Reset()         { *m = BatchSwapOrder{} }
+func (m *BatchSwapOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchSwapOrder) ProtoMessage()    {}
+func (*BatchSwapOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{8}
+}
+
+func (m *BatchSwapOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BatchSwapOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwapOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *BatchSwapOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwapOrder.Merge(m, src)
+}
+
+func (m *BatchSwapOrder) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BatchSwapOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwapOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwapOrder proto.InternalMessageInfo
+
+// IBCDenomMapping maps the IBC voucher of a terra asset bridged back to the native denom
+// it traces back to, so the voucher is swapped as the native denom.
+type IBCDenomMapping struct {
+	IBCDenom    string `protobuf:"bytes,1,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty" yaml:"ibc_denom"`
+	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+}
+
+func (m *IBCDenomMapping) Reset()      { *m = IBCDenomMapping{} }
+func (*IBCDenomMapping) ProtoMessage() {}
+func (*IBCDenomMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{9}
+}
+
+func (m *IBCDenomMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *IBCDenomMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCDenomMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *IBCDenomMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCDenomMapping.Merge(m, src)
+}
+
+func (m *IBCDenomMapping) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *IBCDenomMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCDenomMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCDenomMapping proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "terra.market.v1beta1.Params")
+	proto.RegisterType((*SwapVolumeLimit)(nil), "terra.market.v1beta1.SwapVolumeLimit")
+	proto.RegisterType((*SwapVolume)(nil), "terra.market.v1beta1.SwapVolume")
+	proto.RegisterType((*SwapHop)(nil), "terra.market.v1beta1.SwapHop")
+	proto.RegisterType((*SwapStatistics)(nil), "terra.market.v1beta1.SwapStatistics")
+	proto.RegisterType((*SwapStatisticsRecord)(nil), "terra.market.v1beta1.SwapStatisticsRecord")
+	proto.RegisterType((*TerraSwapFee)(nil), "terra.market.v1beta1.TerraSwapFee")
+	proto.RegisterType((*SwapFeeTotal)(nil), "terra.market.v1beta1.SwapFeeTotal")
+	proto.RegisterType((*BatchSwapOrder)(nil), "terra.market.v1beta1.BatchSwapOrder")
+	proto.RegisterType((*IBCDenomMapping)(nil), "terra.market.v1beta1.IBCDenomMapping")
+}
+
+func init() { proto.RegisterFile("terra/market/v1beta1/market.proto", fileDescriptor_114ea92c5ae3e66f) }
+
+var fileDescriptor_114ea92c5ae3e66f = []byte{
+	// 1432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x3a, 0xff, 0xec, 0xb1, 0x21, 0xce, 0x24, 0x85, 0x0d, 0x05, 0xaf, 0x19, 0x28, 0x4a,
+	0x25, 0xb0, 0x05, 0x55, 0xd5, 0x2a, 0x6a, 0x85, 0xd8, 0x04, 0x44, 0x04, 0xa1, 0x61, 0x4c, 0x8b,
+	0x54, 0x55, 0xda, 0x8e, 0xd7, 0x93, 0x78, 0x94, 0xdd, 0x1d, 0x6b, 0x77, 0x13, 0x92, 0x13, 0x57,
+	0xd4, 0x43, 0xd5, 0x0b, 0x6d, 0xd5, 0x13, 0xe7, 0xaa, 0x1f, 0x84, 0x23, 0x47, 0xc4, 0x61, 0x5b,
+	0x85, 0x4b, 0xcf, 0xfe, 0x04, 0xd5, 0xfc, 0x59, 0xef, 0xda, 0x09, 0x05, 0xb7, 0x55, 0x4f, 0xf6,
+	0xbc, 0xf7, 0xe6, 0xf7, 0xde, 0xbc, 0xf7, 0x7b, 0xf3, 0x76, 0xc0, 0xf9, 0x98, 0x86, 0x21, 0x69,
+	0xfa, 0x24, 0xdc, 0xa1, 0x71, 0x73, 0xef, 0x6a, 0x9b, 0xc6, 0xe4, 0xaa, 0x5e, 0x36, 0x7a, 0x21,
+	0x8f, 0x39, 0x5c, 0x94, 0x26, 0x0d, 0x2d, 0xd3, 0x26, 0x67, 0x16, 0xb7, 0xf9, 0x36, 0x97, 0x06,
+	0x4d, 0xf1, 0x4f, 0xd9, 0x9e, 0xa9, 0xb9, 0x3c, 0xf2, 0x79, 0xd4, 0x6c, 0x93, 0x88, 0x0e, 0xd0,
+	0x5c, 0xce, 0x02, 0xa5, 0x47, 0x3f, 0x02, 0x30, 0xb3, 0x49, 0x42, 0xe2, 0x47, 0xd0, 0x01, 0x25,
+	0x61, 0xe5, 0xf4, 0x38, 0xf7, 0x4c, 0xa3, 0x6e, 0x2c, 0x57, 0x6c, 0xfb, 0x79, 0x62, 0x4d, 0xbc,
+	0x4a, 0xac, 0x4b, 0xdb, 0x2c, 0xee, 0xee, 0xb6, 0x1b, 0x2e, 0xf7, 0x9b, 0x1a, 0x50, 0xfd, 0x5c,
+	0x89, 0x3a, 0x3b, 0xcd, 0xf8, 0xa0, 0x47, 0xa3, 0xc6, 0x1a, 0x75, 0xfb, 0x89, 0x55, 0x3d, 0x20,
+	0xbe, 0xb7, 0x82, 0x06, 0x40, 0x08, 0x17, 0xc5, 0xff, 0x4d, 0xce, 0x3d, 0x78, 0x1f, 0x2c, 0x0a,
+	0x91, 0x13, 0x52, 0x97, 0xef, 0xd1, 0xf0, 0xc0, 0xe9, 0xd1, 0x90, 0xf1, 0x8e, 0x59, 0xa8, 0x1b,
+	0xcb, 0x53, 0xb6, 0xd5, 0x4f, 0xac, 0xf7, 0xd5, 0xee, 0xe3, 0xac, 0x10, 0x86, 0x42, 0x8c, 0xb5,
+	0x74, 0x53, 0x0a, 0xe1, 0x63, 0xb0, 0xe8, 0xb3, 0xc0, 0x89, 0x62, 0xd2, 0x66, 0x1e, 0x8b, 0x0f,
+	0x9c, 0xa8, 0x17, 0x52, 0xd2, 0x31, 0x27, 0x65, 0xf8, 0x1b, 0x63, 0x87, 0xaf, 0x03, 0x38, 0x0e,
+	0x13, 0x61, 0xe8, 0xb3, 0xa0, 0x95, 0x4a, 0x5b, 0x52, 0x08, 0xbf, 0x33, 0x00, 0x8c, 0x1e, 0x91,
+	0x9e, 0xb3, 0xc7, 0xbd, 0x5d, 0x9f, 0x3a, 0x1e, 0xf3, 0x59, 0x1c, 0x99, 0x53, 0xf5, 0xc9, 0xe5,
+	0xf2, 0xb5, 0x0f, 0x1a, 0xc7, 0x55, 0xaa, 0xd1, 0x7a, 0x44, 0x7a, 0x5f, 0x49, 0xf3, 0xbb, 0xc2,
+	0xda, 0xfe, 0x58, 0x84, 0xd9, 0x4f, 0xac, 0x25, 0xe5, 0xfc, 0x28, 0x1c, 0xfa, 0xf5, 0x77, 0xab,
+	0x3a, 0xb2, 0x2b, 0xc2, 0xd5, 0x68, 0x44, 0x02, 0x6f, 0x83, 0xf9, 0xfc, 0x66, 0xda, 0xe3, 0x6e,
+	0xd7, 0x9c, 0x96, 0xd9, 0x3d, 0xdb, 0x4f, 0x2c, 0xf3, 0x28, 0xbe, 0x34, 0x41, 0x78, 0x2e, 0x83,
+	0xba, 0x29, 0x24, 0xf0, 0x21, 0x38, 0x25, 0xcd, 0xa2, 0x98, 0xc4, 0x2c, 0x8a, 0x99, 0x1b, 0xa5,
+	0xc5, 0x9a, 0x91, 0x70, 0xe7, 0xfb, 0x89, 0x75, 0x2e, 0x07, 0x77, 0xc4, 0x0e, 0xe1, 0x45, 0xa1,
+	0x68, 0x0d, 0xe4, 0xba, 0x60, 0xdf, 0x82, 0xa5, 0xd1, 0x0d, 0x21, 0x8d, 0x69, 0x10, 0x33, 0x1e,
+	0x98, 0xb3, 0x12, 0xfb, 0x62, 0x3f, 0xb1, 0xea, 0xc7, 0x63, 0x0f, 0x4c, 0x11, 0x3e, 0x3d, 0x0c,
+	0x8f, 0x53, 0x0d, 0xbc, 0x07, 0x16, 0x34, 0x7f, 0x7a, 0x1e, 0x0d, 0x58, 0xd4, 0x75, 0x7c, 0xde,
+	0xa1, 0x66, 0xb1, 0x6e, 0x2c, 0x97, 0xec, 0x5a, 0x3f, 0xb1, 0xce, 0x0c, 0x91, 0x2c, 0x6f, 0x84,
+	0xf0, 0xbc, 0xe2, 0x98, 0x16, 0x6e, 0xf0, 0x0e, 0x85, 0x77, 0x00, 0x1c, 0xe6, 0x63, 0xcc, 0x7c,
+	0x6a, 0x96, 0x64, 0xa8, 0xe7, 0xb2, 0xaa, 0x1d, 0xb5, 0x41, 0xb8, 0x9a, 0x67, 0xec, 0x03, 0xe6,
+	0x53, 0x78, 0x1d, 0x9c, 0x94, 0x86, 0x5d, 0xe2, 0x6d, 0x39, 0x1e, 0xdb, 0xa2, 0x26, 0x90, 0x40,
+	0x4b, 0xfd, 0xc4, 0x7a, 0x2f, 0x07, 0x34, 0xd0, 0x23, 0x5c, 0x11, 0x82, 0xdb, 0xc4, 0xdb, 0xba,
+	0xcb, 0xb6, 0x28, 0xdc, 0xd7, 0x74, 0xdb, 0xa2, 0xd4, 0x69, 0xef, 0x86, 0x81, 0x13, 0x92, 0x98,
+	0x9a, 0x65, 0x49, 0xf7, 0x3b, 0x63, 0xd3, 0x3d, 0xcf, 0xb8, 0x21, 0x44, 0x4d, 0x89, 0x5b, 0x94,
+	0xda, 0xbb, 0x61, 0x80, 0x49, 0x4c, 0xe1, 0x53, 0x03, 0x9c, 0x1d, 0x18, 0xba, 0xdc, 0xf7, 0x77,
+	0x03, 0xd1, 0x1c, 0xea, 0xdc, 0x22, 0x88, 0x8a, 0x0c, 0xe2, 0xcb, 0xb1, 0x83, 0xb8, 0x30, 0x12,
+	0xc4, 0x31, 0xd8, 0x08, 0x9b, 0x3a, 0x9c, 0xd5, 0x54, 0x29, 0x6e, 0x14, 0x19, 0xd7, 0x1d, 0x00,
+	0xdb, 0x24, 0x76, 0xbb, 0x8e, 0x04, 0xa0, 0x01, 0x69, 0x7b, 0xb4, 0x63, 0x9e, 0xa8, 0x1b, 0xcb,
+	0xc5, 0x7c, 0x7d, 0x8e, 0xda, 0x20, 0x5c, 0x95, 0x42, 0xd1, 0x58, 0x37, 0x95, 0x68, 0xa5, 0xf8,
+	0xf3, 0x33, 0x6b, 0xe2, 0xcf, 0x67, 0x96, 0x81, 0x9e, 0x16, 0xc0, 0xdc, 0x48, 0xcb, 0xc1, 0x4b,
+	0x60, 0xba, 0x43, 0x03, 0xee, 0xcb, 0xdb, 0xb1, 0x64, 0x57, 0xfb, 0x89, 0x55, 0x51, 0xe8, 0x52,
+	0x8c, 0xb0, 0x52, 0x43, 0x0a, 0xca, 0x6d, 0x8f, 0xbb, 0x3b, 0xaa, 0x7d, 0xe5, 0xfd, 0x56, 0xb2,
+	0xd7, 0xc6, 0x48, 0xcc, 0x7a, 0x10, 0xf7, 0x13, 0x0b, 0xea, 0xc8, 0x33, 0x28, 0x84, 0x81, 0x5c,
+	0xa9, 0x70, 0x28, 0x28, 0xcb, 0xfe, 0xd5, 0x6e, 0x26, 0xff, 0x9d, 0x9b, 0x1c, 0x14, 0xc2, 0x40,
+	0xae, 0xa4, 0x9b, 0x95, 0xca, 0x93, 0x67, 0xd6, 0xc4, 0x20, 0x2f, 0x3f, 0x19, 0x00, 0x64, 0x79,
+	0x79, 0xe7, 0x94, 0x3c, 0x04, 0x33, 0xea, 0xca, 0xd1, 0xd9, 0xb8, 0x3e, 0x76, 0x98, 0x27, 0x14,
+	0xac, 0x42, 0x41, 0x58, 0xc3, 0xad, 0x14, 0x9f, 0xa8, 0xc8, 0x26, 0xd0, 0x61, 0x01, 0xcc, 0x8a,
+	0xc8, 0x6e, 0xf3, 0x1e, 0x6c, 0x01, 0xc0, 0xb7, 0xb6, 0x68, 0xe8, 0x88, 0x51, 0x27, 0x63, 0x2b,
+	0x5f, 0x5b, 0x6a, 0x28, 0xe4, 0x86, 0x18, 0x48, 0x83, 0xcb, 0x78, 0x95, 0xb3, 0xc0, 0x5e, 0xd2,
+	0x37, 0xf0, 0xbc, 0xf2, 0x91, 0x6d, 0x45, 0xb8, 0x24, 0x17, 0xc2, 0x0a, 0x6e, 0x82, 0x92, 0xe4,
+	0x8f, 0xc4, 0x2c, 0xbc, 0x0d, 0xd3, 0xd4, 0x98, 0xd5, 0x1c, 0xbd, 0x15, 0x64, 0x51, 0xfc, 0x97,
+	0x88, 0x1b, 0xa0, 0x98, 0xd2, 0xde, 0x9c, 0x7c, 0x1b, 0xe0, 0x69, 0x0d, 0x38, 0x37, 0xdc, 0x2f,
+	0x08, 0xcf, 0xea, 0xde, 0x10, 0x49, 0xd6, 0xf3, 0x6f, 0x4a, 0xf6, 0xe2, 0xf5, 0xb1, 0x7b, 0x51,
+	0x27, 0x39, 0x9d, 0x78, 0x1a, 0x2e, 0x97, 0xe4, 0x57, 0x93, 0xe0, 0x64, 0x6b, 0xe8, 0xe6, 0x85,
+	0x9f, 0x80, 0xb2, 0x4a, 0x58, 0x9e, 0x08, 0xa7, 0x32, 0x62, 0xe5, 0x94, 0x08, 0xab, 0xb2, 0xac,
+	0x89, 0x05, 0xbc, 0x0a, 0x4a, 0x24, 0xda, 0xd1, 0xdb, 0x14, 0x2d, 0x16, 0xb3, 0x84, 0x0d, 0x54,
+	0x08, 0x17, 0x49, 0xb4, 0xa3, 0xb6, 0x74, 0x41, 0x45, 0xc1, 0x69, 0x32, 0x29, 0xce, 0xdf, 0x1c,
+	0x9b, 0x4c, 0x0b, 0xf9, 0xd0, 0x52, 0x4a, 0xa9, 0x63, 0x68, 0x62, 0xb7, 0x01, 0x10, 0x11, 0x68,
+	0x3f, 0x53, 0xd2, 0xcf, 0xea, 0xd8, 0x7e, 0xe6, 0xb3, 0xb3, 0xa4, 0x5e, 0xc4, 0x99, 0xb5, 0x8f,
+	0x6f, 0x72, 0xe5, 0x9f, 0x96, 0x1e, 0x6e, 0x8c, 0xed, 0xe1, 0xcd, 0x6c, 0xb8, 0x04, 0xa6, 0x5d,
+	0xbe, 0x1b, 0xc4, 0x7a, 0x64, 0xe7, 0x5a, 0x53, 0x8a, 0x11, 0x56, 0xea, 0x5c, 0x71, 0x7f, 0x33,
+	0xc0, 0x62, 0x6b, 0x64, 0xac, 0xba, 0x3c, 0xec, 0xc0, 0x0f, 0xc1, 0x8c, 0x1e, 0xff, 0x86, 0xc4,
+	0x9a, 0xcf, 0xa8, 0x92, 0x8e, 0x7b, 0x6d, 0x00, 0x1d, 0x00, 0xb2, 0x81, 0xad, 0xbb, 0xe4, 0xe2,
+	0x9b, 0xbf, 0x83, 0x32, 0x57, 0xa3, 0x4d, 0x98, 0xa1, 0x20, 0x9c, 0x83, 0xcc, 0x85, 0xfb, 0xd2,
+	0x00, 0x95, 0x07, 0x02, 0xb8, 0xa5, 0x4f, 0xfc, 0x7f, 0x32, 0xf1, 0x1e, 0x98, 0x4c, 0xbb, 0xb6,
+	0x64, 0x7f, 0x36, 0x76, 0xa3, 0x01, 0x05, 0x2d, 0x2b, 0x26, 0x80, 0x46, 0x6e, 0xd9, 0xe7, 0x06,
+	0xa8, 0xe8, 0x53, 0x3d, 0xe0, 0x31, 0xf1, 0xe0, 0xa7, 0xa0, 0xdc, 0xa1, 0x51, 0xcc, 0x02, 0x22,
+	0xbf, 0x94, 0x8e, 0x1c, 0x2d, 0xa7, 0x44, 0x38, 0x6f, 0x0a, 0x63, 0x30, 0x43, 0x7c, 0xc9, 0x83,
+	0x42, 0x7d, 0xf2, 0xef, 0x6f, 0x98, 0x1b, 0xba, 0x02, 0xba, 0xb4, 0x6a, 0x9b, 0xf8, 0xf8, 0x5c,
+	0x7e, 0x87, 0x73, 0x09, 0x84, 0x08, 0x6b, 0x5f, 0xb9, 0x2a, 0x7d, 0x3f, 0x05, 0x4e, 0xda, 0xe9,
+	0x9c, 0xfd, 0x22, 0xec, 0xd0, 0x10, 0x5e, 0x00, 0x05, 0x96, 0x52, 0x69, 0xe1, 0x30, 0xb1, 0x0a,
+	0xeb, 0x6b, 0xfd, 0xc4, 0x2a, 0x29, 0xaf, 0xac, 0x83, 0x70, 0x81, 0x49, 0xce, 0xc5, 0x21, 0xe9,
+	0xd0, 0x50, 0x17, 0x24, 0xc7, 0x39, 0x25, 0x47, 0x58, 0x1b, 0xc0, 0x26, 0x28, 0x86, 0xd4, 0xa5,
+	0x6c, 0x8f, 0x86, 0xba, 0x20, 0x0b, 0x59, 0x67, 0xa4, 0x1a, 0x84, 0x07, 0x46, 0x23, 0xe3, 0x61,
+	0xea, 0xbf, 0x19, 0x0f, 0x43, 0x24, 0x9a, 0x7e, 0x27, 0x12, 0x3d, 0x06, 0xe2, 0x4d, 0xe1, 0xe8,
+	0xb8, 0x1c, 0xe2, 0x0f, 0xfa, 0xb5, 0x64, 0xdf, 0x1f, 0xeb, 0x1a, 0xb8, 0x90, 0x3d, 0x5c, 0x86,
+	0xd1, 0x2e, 0x73, 0x9f, 0xc5, 0xd4, 0xef, 0xc5, 0x07, 0x08, 0x57, 0x7d, 0x16, 0x60, 0xa5, 0xbd,
+	0x21, 0x95, 0xb0, 0x0b, 0x80, 0x4f, 0xf6, 0xd3, 0x57, 0xd3, 0xac, 0x74, 0xbc, 0xfe, 0x8f, 0x5e,
+	0x4c, 0x03, 0x94, 0xbc, 0xc3, 0x92, 0x4f, 0xf6, 0x5b, 0xa3, 0x23, 0xe4, 0x17, 0x03, 0xcc, 0xad,
+	0xdb, 0xab, 0x32, 0x03, 0x1b, 0xa4, 0xd7, 0x63, 0xc1, 0x36, 0xfc, 0x1c, 0x94, 0x58, 0xdb, 0x1d,
+	0xea, 0xdb, 0xfa, 0x61, 0x62, 0x15, 0x53, 0xbb, 0x2c, 0x8f, 0x03, 0x33, 0x84, 0x8b, 0xac, 0xed,
+	0xaa, 0x3c, 0xae, 0x80, 0x8a, 0x60, 0xfb, 0x1e, 0x1d, 0x6a, 0xe1, 0xd3, 0xd9, 0x45, 0x9f, 0xd7,
+	0x22, 0x5c, 0x56, 0x4b, 0xb9, 0x77, 0xb8, 0xf1, 0xec, 0x5b, 0xcf, 0x0f, 0x6b, 0xc6, 0x8b, 0xc3,
+	0x9a, 0xf1, 0xc7, 0x61, 0xcd, 0xf8, 0xe1, 0x75, 0x6d, 0xe2, 0xc5, 0xeb, 0xda, 0xc4, 0xcb, 0xd7,
+	0xb5, 0x89, 0xaf, 0x2f, 0xe7, 0x53, 0xe2, 0x91, 0x28, 0x62, 0xee, 0x15, 0xf5, 0x56, 0x77, 0x79,
+	0x48, 0x9b, 0xfb, 0xe9, 0x93, 0x5d, 0x26, 0xa7, 0x3d, 0x23, 0x9f, 0xd7, 0x1f, 0xfd, 0x35, 0x00,
+	0x3c, 0x37, 0x6f, 0x79, 0xcf, 0x0f, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.BasePool.Equal(that1.BasePool) {
+		return false
+	}
+	if this.PoolRecoveryPeriod != that1.PoolRecoveryPeriod {
+		return false
+	}
+	if !this.MinStabilitySpread.Equal(that1.MinStabilitySpread) {
+		return false
+	}
+	if len(this.SwapVolumeLimits) != len(that1.SwapVolumeLimits) {
+		return false
+	}
+	for i := range this.SwapVolumeLimits {
+		if !this.SwapVolumeLimits[i].Equal(&that1.SwapVolumeLimits[i]) {
+			return false
+		}
+	}
+	if this.SwapVolumeEpoch != that1.SwapVolumeEpoch {
+		return false
+	}
+	if this.SwapStatisticsPeriod != that1.SwapStatisticsPeriod {
+		return false
+	}
+	if this.SwapStatisticsRetention != that1.SwapStatisticsRetention {
+		return false
+	}
+	if this.PoolReplenishMode != that1.PoolReplenishMode {
+		return false
+	}
+	if this.PoolRecoveryTime != that1.PoolRecoveryTime {
+		return false
+	}
+	if this.PoolHalfLife != that1.PoolHalfLife {
+		return false
+	}
+	if !this.SwapFeeBurnRate.Equal(that1.SwapFeeBurnRate) {
+		return false
+	}
+	if !this.SwapFeeCommunityPoolRate.Equal(that1.SwapFeeCommunityPoolRate) {
+		return false
+	}
+	if this.BatchSwapEnabled != that1.BatchSwapEnabled {
+		return false
+	}
+	return true
+}
+
+func (this *SwapVolumeLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapVolumeLimit)
+	if !ok {
+		that2, ok := that.(SwapVolumeLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.BlockLimit.Equal(that1.BlockLimit) {
+		return false
+	}
+	if !this.EpochLimit.Equal(that1.EpochLimit) {
+		return false
+	}
+	return true
+}
+
+func (this *TerraSwapFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TerraSwapFee)
+	if !ok {
+		that2, ok := that.(TerraSwapFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OfferDenom != that1.OfferDenom {
+		return false
+	}
+	if this.AskDenom != that1.AskDenom {
+		return false
+	}
+	if !this.Fee.Equal(that1.Fee) {
+		return false
+	}
+	return true
+}
+
+func (this *IBCDenomMapping) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCDenomMapping)
+	if !ok {
+		that2, ok := that.(IBCDenomMapping)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IBCDenom != that1.IBCDenom {
+		return false
+	}
+	if this.NativeDenom != that1.NativeDenom {
+		return false
+	}
+	return true
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchSwapEnabled {
+		i--
+		if m.BatchSwapEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.SwapFeeCommunityPoolRate.Size()
+		i -= size
+		if _, err := m.SwapFeeCommunityPoolRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.SwapFeeBurnRate.Size()
+		i -= size
+		if _, err := m.SwapFeeBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.PoolHalfLife != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PoolHalfLife))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PoolRecoveryTime != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PoolRecoveryTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PoolReplenishMode) > 0 {
+		i -= len(m.PoolReplenishMode)
+		copy(dAtA[i:], m.PoolReplenishMode)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.PoolReplenishMode)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SwapStatisticsRetention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SwapStatisticsRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SwapStatisticsPeriod != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SwapStatisticsPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SwapVolumeEpoch != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SwapVolumeEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SwapVolumeLimits) > 0 {
+		for iNdEx := len(m.SwapVolumeLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolumeLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MinStabilitySpread.Size()
+		i -= size
+		if _, err := m.MinStabilitySpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolRecoveryPeriod != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PoolRecoveryPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BasePool.Size()
+		i -= size
+		if _, err := m.BasePool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapVolumeLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapVolumeLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapVolumeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpochLimit.Size()
+		i -= size
+		if _, err := m.EpochLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BlockLimit.Size()
+		i -= size
+		if _, err := m.BlockLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spread.Size()
+		i -= size
+		if _, err := m.Spread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.SwapFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SwapCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AskVolume.Size()
+		i -= size
+		if _, err := m.AskVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OfferVolume.Size()
+		i -= size
+		if _, err := m.OfferVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OfferDenom) > 0 {
+		i -= len(m.OfferDenom)
+		copy(dAtA[i:], m.OfferDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.OfferDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapStatisticsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapStatisticsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapStatisticsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraSwapFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraSwapFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraSwapFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OfferDenom) > 0 {
+		i -= len(m.OfferDenom)
+		copy(dAtA[i:], m.OfferDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.OfferDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapFeeTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapFeeTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeeTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSwapOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSwapOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSwapOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSpread != nil {
+		{
+			size := m.MaxSpread.Size()
+			i -= size
+			if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MinReceiveAmount != nil {
+		{
+			size := m.MinReceiveAmount.Size()
+			i -= size
+			if _, err := m.MinReceiveAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCDenomMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCDenomMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCDenomMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IBCDenom) > 0 {
+		i -= len(m.IBCDenom)
+		copy(dAtA[i:], m.IBCDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.IBCDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BasePool.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.PoolRecoveryPeriod != 0 {
+		n += 1 + sovMarket(uint64(m.PoolRecoveryPeriod))
+	}
+	l = m.MinStabilitySpread.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if len(m.SwapVolumeLimits) > 0 {
+		for _, e := range m.SwapVolumeLimits {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.SwapVolumeEpoch != 0 {
+		n += 1 + sovMarket(uint64(m.SwapVolumeEpoch))
+	}
+	if m.SwapStatisticsPeriod != 0 {
+		n += 1 + sovMarket(uint64(m.SwapStatisticsPeriod))
+	}
+	if m.SwapStatisticsRetention != 0 {
+		n += 1 + sovMarket(uint64(m.SwapStatisticsRetention))
+	}
+	l = len(m.PoolReplenishMode)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.PoolRecoveryTime != 0 {
+		n += 1 + sovMarket(uint64(m.PoolRecoveryTime))
+	}
+	if m.PoolHalfLife != 0 {
+		n += 1 + sovMarket(uint64(m.PoolHalfLife))
+	}
+	l = m.SwapFeeBurnRate.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SwapFeeCommunityPoolRate.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.BatchSwapEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SwapVolumeLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.BlockLimit.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.EpochLimit.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *SwapVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *SwapHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SwapCoin.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Spread.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *SwapStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferDenom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.OfferVolume.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.AskVolume.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovMarket(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *SwapStatisticsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovMarket(uint64(m.Period))
+	}
+	l = m.Statistics.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *TerraSwapFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferDenom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *SwapFeeTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchSwapOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.MinReceiveAmount != nil {
+		l = m.MinReceiveAmount.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.MaxSpread != nil {
+		l = m.MaxSpread.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCDenomMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IBCDenom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func sovMarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozMarket(x uint64) (n int) {
+	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecoveryPeriod", wireType)
+			}
+			m.PoolRecoveryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolRecoveryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilitySpread", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStabilitySpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumeLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolumeLimits = append(m.SwapVolumeLimits, SwapVolumeLimit{})
+			if err := m.SwapVolumeLimits[len(m.SwapVolumeLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumeEpoch", wireType)
+			}
+			m.SwapVolumeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapVolumeEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapStatisticsPeriod", wireType)
+			}
+			m.SwapStatisticsPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapStatisticsPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapStatisticsRetention", wireType)
+			}
+			m.SwapStatisticsRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapStatisticsRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolReplenishMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolReplenishMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecoveryTime", wireType)
+			}
+			m.PoolRecoveryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolRecoveryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolHalfLife", wireType)
+			}
+			m.PoolHalfLife = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolHalfLife |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeBurnRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeCommunityPoolRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeCommunityPoolRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSwapEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchSwapEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SwapVolumeLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapVolumeLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapVolumeLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SwapVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SwapHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SwapStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SwapStatisticsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapStatisticsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapStatisticsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TerraSwapFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerraSwapFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerraSwapFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SwapFeeTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapFeeTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapFeeTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BasePool.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if m.PoolRecoveryPeriod != 0 {
-		n += 1 + sovMarket(uint64(m.PoolRecoveryPeriod))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinStabilitySpread.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	return n
-}
-
-func sovMarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozMarket(x uint64) (n int) {
-	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BatchSwapOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -247,17 +3141,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSwapOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSwapOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -267,30 +3180,94 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BasePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecoveryPeriod", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
 			}
-			m.PoolRecoveryPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -300,16 +3277,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolRecoveryPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilitySpread", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceiveAmount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -319,24 +3309,178 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinStabilitySpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinReceiveAmount = &v
+			if err := m.MinReceiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSpread = &v
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IBCDenomMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCDenomMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCDenomMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
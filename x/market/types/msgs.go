@@ -9,14 +9,21 @@ import (
 var (
 	_ sdk.Msg = &MsgSwap{}
 	_ sdk.Msg = &MsgSwapSend{}
+	_ sdk.Msg = &MsgSwapRoute{}
+	_ sdk.Msg = &MsgSwapExactOut{}
 )
 
 // market message types
 const (
-	TypeMsgSwap     = "swap"
-	TypeMsgSwapSend = "swap_send"
+	TypeMsgSwap         = "swap"
+	TypeMsgSwapSend     = "swap_send"
+	TypeMsgSwapRoute    = "swap_route"
+	TypeMsgSwapExactOut = "swap_exact_out"
 )
 
+// MaxSwapRouteLength is the maximum number of hops of a swap route
+const MaxSwapRouteLength = 4
+
 //--------------------------------------------------------
 //--------------------------------------------------------
 
@@ -65,7 +72,7 @@ func (msg MsgSwap) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrRecursiveSwap, msg.AskDenom)
 	}
 
-	return nil
+	return validateSlippage(msg.MinReceiveAmount, msg.MaxSpread)
 }
 
 // NewMsgSwapSend conducts market swap and send all the result coins to recipient
@@ -119,5 +126,160 @@ func (msg MsgSwapSend) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrRecursiveSwap, msg.AskDenom)
 	}
 
+	return validateSlippage(msg.MinReceiveAmount, msg.MaxSpread)
+}
+
+// NewMsgSwapRoute creates a MsgSwapRoute instance
+func NewMsgSwapRoute(traderAddress sdk.AccAddress, offerCoin sdk.Coin, askDenoms []string) *MsgSwapRoute {
+	return &MsgSwapRoute{
+		Trader:    traderAddress.String(),
+		OfferCoin: offerCoin,
+		AskDenoms: askDenoms,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSwapRoute) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSwapRoute) Type() string { return TypeMsgSwapRoute }
+
+// GetSignBytes Implements Msg
+func (msg MsgSwapRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgSwapRoute) GetSigners() []sdk.AccAddress {
+	trader, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgSwapRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid trader address (%s)", err)
+	}
+
+	if msg.Receiver != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", err)
+		}
+	}
+
+	if msg.OfferCoin.Amount.LTE(sdk.ZeroInt()) || msg.OfferCoin.Amount.BigInt().BitLen() > 100 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.OfferCoin.String())
+	}
+
+	if err := ValidateSwapRoute(msg.OfferCoin.Denom, msg.AskDenoms); err != nil {
+		return err
+	}
+
+	return validateSlippage(msg.MinReceiveAmount, nil)
+}
+
+// NewMsgSwapExactOut creates a MsgSwapExactOut instance
+func NewMsgSwapExactOut(traderAddress sdk.AccAddress, askCoin sdk.Coin, offerDenom string, maxOfferAmount sdk.Int) *MsgSwapExactOut {
+	return &MsgSwapExactOut{
+		Trader:         traderAddress.String(),
+		AskCoin:        askCoin,
+		OfferDenom:     offerDenom,
+		MaxOfferAmount: maxOfferAmount,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSwapExactOut) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSwapExactOut) Type() string { return TypeMsgSwapExactOut }
+
+// GetSignBytes Implements Msg
+func (msg MsgSwapExactOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgSwapExactOut) GetSigners() []sdk.AccAddress {
+	trader, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgSwapExactOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid trader address (%s)", err)
+	}
+
+	if msg.Receiver != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", err)
+		}
+	}
+
+	if msg.AskCoin.Amount.IsNil() || msg.AskCoin.Amount.LTE(sdk.ZeroInt()) || msg.AskCoin.Amount.BigInt().BitLen() > 100 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.AskCoin.String())
+	}
+
+	if msg.MaxOfferAmount.IsNil() || msg.MaxOfferAmount.LTE(sdk.ZeroInt()) || msg.MaxOfferAmount.BigInt().BitLen() > 100 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max offer amount (%s)", msg.MaxOfferAmount)
+	}
+
+	if err := sdk.ValidateDenom(msg.OfferDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.OfferDenom == msg.AskCoin.Denom {
+		return sdkerrors.Wrap(ErrRecursiveSwap, msg.OfferDenom)
+	}
+
+	return nil
+}
+
+// ValidateSwapRoute checks the route has between one and MaxSwapRouteLength valid denoms
+// and never swaps a denom to itself
+func ValidateSwapRoute(offerDenom string, route []string) error {
+	if len(route) == 0 || len(route) > MaxSwapRouteLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "route must have between 1 and %d denoms (%d)", MaxSwapRouteLength, len(route))
+	}
+
+	prevDenom := offerDenom
+	for _, askDenom := range route {
+		if err := sdk.ValidateDenom(askDenom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		if askDenom == prevDenom {
+			return sdkerrors.Wrap(ErrRecursiveSwap, askDenom)
+		}
+
+		prevDenom = askDenom
+	}
+
+	return nil
+}
+
+// validateSlippage checks the optional slippage protection of the swap
+func validateSlippage(minReceiveAmount *sdk.Int, maxSpread *sdk.Dec) error {
+	if minReceiveAmount != nil && (minReceiveAmount.IsNegative() || minReceiveAmount.BigInt().BitLen() > 100) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min receive amount (%s)", minReceiveAmount)
+	}
+
+	if maxSpread != nil && (maxSpread.IsNegative() || maxSpread.GT(sdk.OneDec())) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max spread must be between [0, 1] (%s)", maxSpread)
+	}
+
 	return nil
 }
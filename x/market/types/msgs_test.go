@@ -72,3 +72,126 @@ func TestMsgSwapSend(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSwapSlippage(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())
+	validAmount := sdk.NewInt(100)
+	negativeAmount := sdk.NewInt(-1)
+	validSpread := sdk.NewDecWithPrec(2, 2)
+	negativeSpread := sdk.NewDecWithPrec(-1, 2)
+	overflowSpread := sdk.NewDecWithPrec(11, 1)
+
+	tests := []struct {
+		minReceiveAmount *sdk.Int
+		maxSpread        *sdk.Dec
+		expectedErr      string
+	}{
+		{nil, nil, ""},
+		{&validAmount, &validSpread, ""},
+		{&negativeAmount, nil, "invalid min receive amount (-1): invalid request"},
+		{nil, &negativeSpread, "max spread must be between [0, 1] (-0.010000000000000000): invalid request"},
+		{nil, &overflowSpread, "max spread must be between [0, 1] (1.100000000000000000): invalid request"},
+	}
+
+	for _, tc := range tests {
+		swapMsg := NewMsgSwap(addrs[0], offerCoin, core.MicroSDRDenom)
+		swapMsg.MinReceiveAmount = tc.minReceiveAmount
+		swapMsg.MaxSpread = tc.maxSpread
+
+		swapSendMsg := NewMsgSwapSend(addrs[0], addrs[1], offerCoin, core.MicroSDRDenom)
+		swapSendMsg.MinReceiveAmount = tc.minReceiveAmount
+		swapSendMsg.MaxSpread = tc.maxSpread
+
+		if tc.expectedErr == "" {
+			require.Nil(t, swapMsg.ValidateBasic())
+			require.Nil(t, swapSendMsg.ValidateBasic())
+		} else {
+			require.EqualError(t, swapMsg.ValidateBasic(), tc.expectedErr)
+			require.EqualError(t, swapSendMsg.ValidateBasic(), tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSwapRoute(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())
+	negativeAmount := sdk.NewInt(-1)
+
+	tests := []struct {
+		trader           sdk.AccAddress
+		receiver         string
+		offerCoin        sdk.Coin
+		askDenoms        []string
+		minReceiveAmount *sdk.Int
+		expectedErr      string
+	}{
+		{addrs[0], "", offerCoin, []string{core.MicroSDRDenom, core.MicroKRWDenom}, nil, ""},
+		{addrs[0], addrs[0].String(), offerCoin, []string{core.MicroSDRDenom}, nil, ""},
+		{sdk.AccAddress{}, "", offerCoin, []string{core.MicroSDRDenom}, nil, "Invalid trader address (empty address string is not allowed): invalid address"},
+		{addrs[0], "invalid", offerCoin, []string{core.MicroSDRDenom}, nil, "Invalid receiver address (decoding bech32 failed: invalid bech32 string length 7): invalid address"},
+		{addrs[0], "", sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt()), []string{core.MicroSDRDenom}, nil, "0uluna: invalid coins"},
+		{addrs[0], "", offerCoin, []string{}, nil, "route must have between 1 and 4 denoms (0): invalid request"},
+		{addrs[0], "", offerCoin, []string{core.MicroSDRDenom, core.MicroKRWDenom, core.MicroUSDDenom, core.MicroLunaDenom, core.MicroSDRDenom}, nil, "route must have between 1 and 4 denoms (5): invalid request"},
+		{addrs[0], "", offerCoin, []string{core.MicroSDRDenom, "1"}, nil, "invalid denom: 1: invalid request"},
+		{addrs[0], "", offerCoin, []string{core.MicroLunaDenom}, nil, "uluna: recursive swap"},
+		{addrs[0], "", offerCoin, []string{core.MicroSDRDenom, core.MicroSDRDenom}, nil, "usdr: recursive swap"},
+		{addrs[0], "", offerCoin, []string{core.MicroSDRDenom}, &negativeAmount, "invalid min receive amount (-1): invalid request"},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSwapRoute(tc.trader, tc.offerCoin, tc.askDenoms)
+		msg.Receiver = tc.receiver
+		msg.MinReceiveAmount = tc.minReceiveAmount
+		if tc.expectedErr == "" {
+			require.Nil(t, msg.ValidateBasic())
+		} else {
+			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSwapExactOut(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	overflowAmt, _ := sdk.NewIntFromString("100000000000000000000000000000000000000000000000000000000")
+	askCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt())
+
+	tests := []struct {
+		trader         sdk.AccAddress
+		receiver       string
+		askCoin        sdk.Coin
+		offerDenom     string
+		maxOfferAmount sdk.Int
+		expectedErr    string
+	}{
+		{addrs[0], "", askCoin, core.MicroLunaDenom, sdk.OneInt(), ""},
+		{addrs[0], addrs[0].String(), askCoin, core.MicroLunaDenom, sdk.OneInt(), ""},
+		{sdk.AccAddress{}, "", askCoin, core.MicroLunaDenom, sdk.OneInt(), "Invalid trader address (empty address string is not allowed): invalid address"},
+		{addrs[0], "invalid", askCoin, core.MicroLunaDenom, sdk.OneInt(), "Invalid receiver address (decoding bech32 failed: invalid bech32 string length 7): invalid address"},
+		{addrs[0], "", sdk.NewCoin(core.MicroSDRDenom, sdk.ZeroInt()), core.MicroLunaDenom, sdk.OneInt(), "0usdr: invalid coins"},
+		{addrs[0], "", sdk.NewCoin(core.MicroSDRDenom, overflowAmt), core.MicroLunaDenom, sdk.OneInt(), "100000000000000000000000000000000000000000000000000000000usdr: invalid coins"},
+		{addrs[0], "", askCoin, core.MicroLunaDenom, sdk.ZeroInt(), "invalid max offer amount (0): invalid request"},
+		{addrs[0], "", askCoin, "1", sdk.OneInt(), "invalid denom: 1: invalid request"},
+		{addrs[0], "", askCoin, core.MicroSDRDenom, sdk.OneInt(), "usdr: recursive swap"},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSwapExactOut(tc.trader, tc.askCoin, tc.offerDenom, tc.maxOfferAmount)
+		msg.Receiver = tc.receiver
+		if tc.expectedErr == "" {
+			require.Nil(t, msg.ValidateBasic())
+		} else {
+			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
+		}
+	}
+}
@@ -19,13 +19,43 @@ var (
 	KeyPoolRecoveryPeriod = []byte("PoolRecoveryPeriod")
 	// Min spread
 	KeyMinStabilitySpread = []byte("MinStabilitySpread")
+	// Caps on the net swap volume of each denom
+	KeySwapVolumeLimits = []byte("SwapVolumeLimits")
+	// The period after which the epoch swap volumes are reset
+	KeySwapVolumeEpoch = []byte("SwapVolumeEpoch")
+	// The number of blocks aggregated into each swap statistics period
+	KeySwapStatisticsPeriod = []byte("SwapStatisticsPeriod")
+	// The number of swap statistics periods retained in the store
+	KeySwapStatisticsRetention = []byte("SwapStatisticsRetention")
+	// The way the terra pool delta is replenished
+	KeyPoolReplenishMode = []byte("PoolReplenishMode")
+	// The seconds required to recover BasePool in the linear replenish mode
+	KeyPoolRecoveryTime = []byte("PoolRecoveryTime")
+	// The seconds required to recover half of the delta in the exponential replenish mode
+	KeyPoolHalfLife = []byte("PoolHalfLife")
+	// The portion of the swap fees burned
+	KeySwapFeeBurnRate = []byte("SwapFeeBurnRate")
+	// The portion of the swap fees sent to the community pool
+	KeySwapFeeCommunityPoolRate = []byte("SwapFeeCommunityPoolRate")
+	// Whether the swaps are queued and cleared in batch at the end block
+	KeyBatchSwapEnabled = []byte("BatchSwapEnabled")
 )
 
 // Default parameter values
 var (
-	DefaultBasePool           = sdk.NewDec(1000000 * core.MicroUnit) // 1000,000sdr = 1000,000,000,000usdr
-	DefaultPoolRecoveryPeriod = core.BlocksPerDay                    // 14,400
-	DefaultMinStabilitySpread = sdk.NewDecWithPrec(2, 2)             // 2%
+	DefaultBasePool                 = sdk.NewDec(1000000 * core.MicroUnit) // 1000,000sdr = 1000,000,000,000usdr
+	DefaultPoolRecoveryPeriod       = core.BlocksPerDay                    // 14,400
+	DefaultMinStabilitySpread       = sdk.NewDecWithPrec(2, 2)             // 2%
+	DefaultSwapVolumeLimits         = SwapVolumeLimits{}
+	DefaultSwapVolumeEpoch          = core.BlocksPerDay // 14,400
+	DefaultSwapStatisticsPeriod     = core.BlocksPerDay // 14,400
+	DefaultSwapStatisticsRetention  = uint64(30)        // 30 periods
+	DefaultPoolReplenishMode        = ReplenishModeBlock
+	DefaultPoolRecoveryTime         = uint64(86400) // 1 day
+	DefaultPoolHalfLife             = uint64(43200) // 12 hours
+	DefaultSwapFeeBurnRate          = sdk.ZeroDec() // all swap fees go to the oracle reward pool
+	DefaultSwapFeeCommunityPoolRate = sdk.ZeroDec()
+	DefaultBatchSwapEnabled         = false // swaps execute in transaction order
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -36,6 +66,20 @@ func DefaultParams() Params {
 		BasePool:           DefaultBasePool,
 		PoolRecoveryPeriod: DefaultPoolRecoveryPeriod,
 		MinStabilitySpread: DefaultMinStabilitySpread,
+		SwapVolumeLimits:   DefaultSwapVolumeLimits,
+		SwapVolumeEpoch:    DefaultSwapVolumeEpoch,
+
+		SwapStatisticsPeriod:    DefaultSwapStatisticsPeriod,
+		SwapStatisticsRetention: DefaultSwapStatisticsRetention,
+
+		PoolReplenishMode: DefaultPoolReplenishMode,
+		PoolRecoveryTime:  DefaultPoolRecoveryTime,
+		PoolHalfLife:      DefaultPoolHalfLife,
+
+		SwapFeeBurnRate:          DefaultSwapFeeBurnRate,
+		SwapFeeCommunityPoolRate: DefaultSwapFeeCommunityPoolRate,
+
+		BatchSwapEnabled: DefaultBatchSwapEnabled,
 	}
 }
 
@@ -57,6 +101,16 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyBasePool, &p.BasePool, validateBasePool),
 		paramstypes.NewParamSetPair(KeyPoolRecoveryPeriod, &p.PoolRecoveryPeriod, validatePoolRecoveryPeriod),
 		paramstypes.NewParamSetPair(KeyMinStabilitySpread, &p.MinStabilitySpread, validateMinStabilitySpread),
+		paramstypes.NewParamSetPair(KeySwapVolumeLimits, &p.SwapVolumeLimits, validateSwapVolumeLimits),
+		paramstypes.NewParamSetPair(KeySwapVolumeEpoch, &p.SwapVolumeEpoch, validateSwapVolumeEpoch),
+		paramstypes.NewParamSetPair(KeySwapStatisticsPeriod, &p.SwapStatisticsPeriod, validateSwapStatisticsPeriod),
+		paramstypes.NewParamSetPair(KeySwapStatisticsRetention, &p.SwapStatisticsRetention, validateSwapStatisticsRetention),
+		paramstypes.NewParamSetPair(KeyPoolReplenishMode, &p.PoolReplenishMode, validatePoolReplenishMode),
+		paramstypes.NewParamSetPair(KeyPoolRecoveryTime, &p.PoolRecoveryTime, validatePoolRecoveryTime),
+		paramstypes.NewParamSetPair(KeyPoolHalfLife, &p.PoolHalfLife, validatePoolHalfLife),
+		paramstypes.NewParamSetPair(KeySwapFeeBurnRate, &p.SwapFeeBurnRate, validateSwapFeeBurnRate),
+		paramstypes.NewParamSetPair(KeySwapFeeCommunityPoolRate, &p.SwapFeeCommunityPoolRate, validateSwapFeeCommunityPoolRate),
+		paramstypes.NewParamSetPair(KeyBatchSwapEnabled, &p.BatchSwapEnabled, validateBatchSwapEnabled),
 	}
 }
 
@@ -71,6 +125,36 @@ func (p Params) Validate() error {
 	if p.MinStabilitySpread.IsNegative() || p.MinStabilitySpread.GT(sdk.OneDec()) {
 		return fmt.Errorf("market minimum stability spead should be a value between [0,1], is %s", p.MinStabilitySpread)
 	}
+	if err := p.SwapVolumeLimits.Validate(); err != nil {
+		return err
+	}
+	if p.SwapVolumeEpoch == 0 {
+		return fmt.Errorf("swap volume epoch should be positive, is %d", p.SwapVolumeEpoch)
+	}
+	if p.SwapStatisticsPeriod == 0 {
+		return fmt.Errorf("swap statistics period should be positive, is %d", p.SwapStatisticsPeriod)
+	}
+	if p.SwapStatisticsRetention == 0 {
+		return fmt.Errorf("swap statistics retention should be positive, is %d", p.SwapStatisticsRetention)
+	}
+	if err := ValidateReplenishMode(p.PoolReplenishMode); err != nil {
+		return err
+	}
+	if p.PoolRecoveryTime == 0 {
+		return fmt.Errorf("pool recovery time should be positive, is %d", p.PoolRecoveryTime)
+	}
+	if p.PoolHalfLife == 0 {
+		return fmt.Errorf("pool half life should be positive, is %d", p.PoolHalfLife)
+	}
+	if p.SwapFeeBurnRate.IsNegative() || p.SwapFeeBurnRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("swap fee burn rate should be a value between [0,1], is %s", p.SwapFeeBurnRate)
+	}
+	if p.SwapFeeCommunityPoolRate.IsNegative() || p.SwapFeeCommunityPoolRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("swap fee community pool rate should be a value between [0,1], is %s", p.SwapFeeCommunityPoolRate)
+	}
+	if p.SwapFeeBurnRate.Add(p.SwapFeeCommunityPoolRate).GT(sdk.OneDec()) {
+		return fmt.Errorf("sum of swap fee burn and community pool rates should not exceed 1, is %s", p.SwapFeeBurnRate.Add(p.SwapFeeCommunityPoolRate))
+	}
 
 	return nil
 }
@@ -117,3 +201,129 @@ func validateMinStabilitySpread(i interface{}) error {
 
 	return nil
 }
+
+func validateSwapVolumeLimits(i interface{}) error {
+	v, ok := i.(SwapVolumeLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateSwapVolumeEpoch(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("swap volume epoch must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSwapStatisticsPeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("swap statistics period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSwapStatisticsRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("swap statistics retention must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePoolReplenishMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateReplenishMode(v)
+}
+
+func validatePoolRecoveryTime(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("pool recovery time must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePoolHalfLife(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("pool half life must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSwapFeeBurnRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("swap fee burn rate must be positive or zero: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("swap fee burn rate is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateSwapFeeCommunityPoolRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("swap fee community pool rate must be positive or zero: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("swap fee community pool rate is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBatchSwapEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -30,6 +30,68 @@ func TestParamsEqual(t *testing.T) {
 	err = p4.Validate()
 	require.Error(t, err)
 
+	// invalid swap volume limits
+	p6 := DefaultParams()
+	p6.SwapVolumeLimits = SwapVolumeLimits{NewSwapVolumeLimit("usdr", sdk.NewInt(-1), sdk.ZeroInt())}
+	err = p6.Validate()
+	require.Error(t, err)
+
+	p7 := DefaultParams()
+	p7.SwapVolumeLimits = SwapVolumeLimits{
+		NewSwapVolumeLimit("usdr", sdk.OneInt(), sdk.OneInt()),
+		NewSwapVolumeLimit("usdr", sdk.OneInt(), sdk.OneInt()),
+	}
+	err = p7.Validate()
+	require.Error(t, err)
+
+	// invalid swap volume epoch
+	p8 := DefaultParams()
+	p8.SwapVolumeEpoch = 0
+	err = p8.Validate()
+	require.Error(t, err)
+
+	// invalid swap statistics period and retention
+	p9 := DefaultParams()
+	p9.SwapStatisticsPeriod = 0
+	err = p9.Validate()
+	require.Error(t, err)
+
+	p10 := DefaultParams()
+	p10.SwapStatisticsRetention = 0
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// invalid pool replenish mode, recovery time and half life
+	p11 := DefaultParams()
+	p11.PoolReplenishMode = "weekly"
+	err = p11.Validate()
+	require.Error(t, err)
+
+	p12 := DefaultParams()
+	p12.PoolRecoveryTime = 0
+	err = p12.Validate()
+	require.Error(t, err)
+
+	p13 := DefaultParams()
+	p13.PoolHalfLife = 0
+	err = p13.Validate()
+	require.Error(t, err)
+
+	p14 := DefaultParams()
+	p14.SwapFeeBurnRate = sdk.NewDecWithPrec(-1, 2)
+	err = p14.Validate()
+	require.Error(t, err)
+
+	p15 := DefaultParams()
+	p15.SwapFeeBurnRate = sdk.NewDecWithPrec(6, 1)
+	p15.SwapFeeCommunityPoolRate = sdk.NewDecWithPrec(5, 1)
+	err = p15.Validate()
+	require.Error(t, err)
+
+	p15.SwapFeeCommunityPoolRate = sdk.NewDecWithPrec(4, 1)
+	err = p15.Validate()
+	require.NoError(t, err)
+
 	p5 := DefaultParams()
 	require.NotNil(t, p5.ParamSetPairs())
 	require.NotNil(t, p5.String())
@@ -12,6 +12,7 @@ import (
 
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -131,23 +132,28 @@ func (m *QuerySwapResponse) GetReturnCoin() types.Coin {
 	return types.Coin{}
 }
 
-// QueryTerraPoolDeltaRequest is the request type for the Query/TerraPoolDelta RPC method.
-type QueryTerraPoolDeltaRequest struct{}
+// QuerySwapRouteRequest is the request type for the Query/SwapRoute RPC method.
+type QuerySwapRouteRequest struct {
+	// offer_coin defines the coin being offered (i.e. 1000000uluna)
+	OfferCoin string `protobuf:"bytes,1,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin,omitempty"`
+	// ask_denoms defines the ordered route of denoms to swap through
+	AskDenoms []string `protobuf:"bytes,2,rep,name=ask_denoms,json=askDenoms,proto3" json:"ask_denoms,omitempty"`
+}
 
-func (m *QueryTerraPoolDeltaRequest) Reset()         { *m = QueryTerraPoolDeltaRequest{} }
-func (m *QueryTerraPoolDeltaRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTerraPoolDeltaRequest) ProtoMessage()    {}
-func (*QueryTerraPoolDeltaRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySwapRouteRequest) Reset()         { *m = QuerySwapRouteRequest{} }
+func (m *QuerySwapRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapRouteRequest) ProtoMessage()    {}
+func (*QuerySwapRouteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c172d0f188bf2fb6, []int{2}
 }
 
-func (m *QueryTerraPoolDeltaRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySwapRouteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *QueryTerraPoolDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySwapRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTerraPoolDeltaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySwapRouteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -158,40 +164,42 @@ func (m *QueryTerraPoolDeltaRequest) XXX_Marshal(b []byte, deterministic bool) (
 	}
 }
 
-func (m *QueryTerraPoolDeltaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTerraPoolDeltaRequest.Merge(m, src)
+func (m *QuerySwapRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapRouteRequest.Merge(m, src)
 }
 
-func (m *QueryTerraPoolDeltaRequest) XXX_Size() int {
+func (m *QuerySwapRouteRequest) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *QueryTerraPoolDeltaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTerraPoolDeltaRequest.DiscardUnknown(m)
+func (m *QuerySwapRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapRouteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTerraPoolDeltaRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySwapRouteRequest proto.InternalMessageInfo
 
-// QueryTerraPoolDeltaResponse is the response type for the Query/TerraPoolDelta RPC method.
-type QueryTerraPoolDeltaResponse struct {
-	// terra_pool_delta defines the gap between the TerraPool and the TerraBasePool
-	TerraPoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=terra_pool_delta,json=terraPoolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta"`
+// QuerySwapRouteResponse is the response type for the Query/SwapRoute RPC method.
+type QuerySwapRouteResponse struct {
+	// return_coin defines the coin returned as a result of the swap simulation.
+	ReturnCoin types.Coin `protobuf:"bytes,1,opt,name=return_coin,json=returnCoin,proto3" json:"return_coin"`
+	// hops defines the result of each hop of the swap simulation.
+	Hops []SwapHop `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops"`
 }
 
-func (m *QueryTerraPoolDeltaResponse) Reset()         { *m = QueryTerraPoolDeltaResponse{} }
-func (m *QueryTerraPoolDeltaResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTerraPoolDeltaResponse) ProtoMessage()    {}
-func (*QueryTerraPoolDeltaResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySwapRouteResponse) Reset()         { *m = QuerySwapRouteResponse{} }
+func (m *QuerySwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapRouteResponse) ProtoMessage()    {}
+func (*QuerySwapRouteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c172d0f188bf2fb6, []int{3}
 }
 
-func (m *QueryTerraPoolDeltaResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySwapRouteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *QueryTerraPoolDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTerraPoolDeltaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySwapRouteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -202,37 +210,56 @@ func (m *QueryTerraPoolDeltaResponse) XXX_Marshal(b []byte, deterministic bool)
 	}
 }
 
-func (m *QueryTerraPoolDeltaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTerraPoolDeltaResponse.Merge(m, src)
+func (m *QuerySwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapRouteResponse.Merge(m, src)
 }
 
-func (m *QueryTerraPoolDeltaResponse) XXX_Size() int {
+func (m *QuerySwapRouteResponse) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *QueryTerraPoolDeltaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTerraPoolDeltaResponse.DiscardUnknown(m)
+func (m *QuerySwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapRouteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTerraPoolDeltaResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySwapRouteResponse proto.InternalMessageInfo
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct{}
+func (m *QuerySwapRouteResponse) GetReturnCoin() types.Coin {
+	if m != nil {
+		return m.ReturnCoin
+	}
+	return types.Coin{}
+}
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySwapRouteResponse) GetHops() []SwapHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+// QuerySwapExactOutRequest is the request type for the Query/SwapExactOut RPC method.
+type QuerySwapExactOutRequest struct {
+	// ask_coin defines the coin to receive (i.e. 1000000uusd)
+	AskCoin string `protobuf:"bytes,1,opt,name=ask_coin,json=askCoin,proto3" json:"ask_coin,omitempty"`
+	// offer_denom defines the denom of the coin to swap from
+	OfferDenom string `protobuf:"bytes,2,opt,name=offer_denom,json=offerDenom,proto3" json:"offer_denom,omitempty"`
+}
+
+func (m *QuerySwapExactOutRequest) Reset()         { *m = QuerySwapExactOutRequest{} }
+func (m *QuerySwapExactOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactOutRequest) ProtoMessage()    {}
+func (*QuerySwapExactOutRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c172d0f188bf2fb6, []int{4}
 }
 
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySwapExactOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySwapExactOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySwapExactOutRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -243,40 +270,40 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 }
 
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QuerySwapExactOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactOutRequest.Merge(m, src)
 }
 
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QuerySwapExactOutRequest) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QuerySwapExactOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactOutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySwapExactOutRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// QuerySwapExactOutResponse is the response type for the Query/SwapExactOut RPC method.
+type QuerySwapExactOutResponse struct {
+	// offer_coin defines the coin to offer to receive the ask coin.
+	OfferCoin types.Coin `protobuf:"bytes,1,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySwapExactOutResponse) Reset()         { *m = QuerySwapExactOutResponse{} }
+func (m *QuerySwapExactOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactOutResponse) ProtoMessage()    {}
+func (*QuerySwapExactOutResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c172d0f188bf2fb6, []int{5}
 }
 
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySwapExactOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySwapExactOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySwapExactOutResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -287,508 +314,5268 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 }
 
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QuerySwapExactOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactOutResponse.Merge(m, src)
 }
 
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QuerySwapExactOutResponse) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QuerySwapExactOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactOutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySwapExactOutResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QuerySwapExactOutResponse) GetOfferCoin() types.Coin {
 	if m != nil {
-		return m.Params
+		return m.OfferCoin
 	}
-	return Params{}
+	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QuerySwapRequest)(nil), "terra.market.v1beta1.QuerySwapRequest")
-	proto.RegisterType((*QuerySwapResponse)(nil), "terra.market.v1beta1.QuerySwapResponse")
-	proto.RegisterType((*QueryTerraPoolDeltaRequest)(nil), "terra.market.v1beta1.QueryTerraPoolDeltaRequest")
-	proto.RegisterType((*QueryTerraPoolDeltaResponse)(nil), "terra.market.v1beta1.QueryTerraPoolDeltaResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "terra.market.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "terra.market.v1beta1.QueryParamsResponse")
+// QuerySwapVolumeRequest is the request type for the Query/SwapVolume RPC method.
+type QuerySwapVolumeRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() { proto.RegisterFile("terra/market/v1beta1/query.proto", fileDescriptor_c172d0f188bf2fb6) }
+func (m *QuerySwapVolumeRequest) Reset()         { *m = QuerySwapVolumeRequest{} }
+func (m *QuerySwapVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapVolumeRequest) ProtoMessage()    {}
+func (*QuerySwapVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{6}
+}
 
-var fileDescriptor_c172d0f188bf2fb6 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x6f, 0x13, 0x41,
-	0x10, 0xbd, 0x0b, 0xc1, 0x8a, 0x37, 0x28, 0x0a, 0x8b, 0x8b, 0x70, 0x31, 0xe7, 0x70, 0x42, 0xc6,
-	0x48, 0x64, 0x17, 0x87, 0x2e, 0x15, 0x32, 0x16, 0x75, 0x62, 0x40, 0x8a, 0x68, 0xac, 0xf5, 0x79,
-	0x63, 0x4e, 0xf6, 0xdd, 0x5c, 0x76, 0xd7, 0x84, 0x88, 0x0e, 0x1a, 0x4a, 0x24, 0xfe, 0x40, 0x1a,
-	0xfe, 0x00, 0xbf, 0x22, 0x65, 0x24, 0x1a, 0x44, 0x11, 0x21, 0x9b, 0x82, 0x9f, 0x81, 0xf6, 0xc3,
-	0x10, 0x47, 0xa7, 0x00, 0x95, 0x7d, 0x33, 0x6f, 0xde, 0x7b, 0xf7, 0x66, 0x0e, 0x6d, 0x28, 0x2e,
-	0x04, 0xa3, 0x29, 0x13, 0x43, 0xae, 0xe8, 0xab, 0x66, 0x8f, 0x2b, 0xd6, 0xa4, 0x07, 0x63, 0x2e,
-	0x8e, 0x48, 0x2e, 0x40, 0x01, 0xae, 0x18, 0x04, 0xb1, 0x08, 0xe2, 0x10, 0x41, 0x65, 0x00, 0x03,
-	0x30, 0x00, 0xaa, 0xff, 0x59, 0x6c, 0x50, 0x1d, 0x00, 0x0c, 0x46, 0x9c, 0xb2, 0x3c, 0xa1, 0x2c,
-	0xcb, 0x40, 0x31, 0x95, 0x40, 0x26, 0x5d, 0xf7, 0x76, 0xa1, 0x96, 0x23, 0xb6, 0x90, 0x30, 0x06,
-	0x99, 0x82, 0xa4, 0x3d, 0x26, 0xf9, 0x6f, 0x44, 0x0c, 0x49, 0x66, 0xfb, 0xd1, 0x1e, 0x5a, 0xdd,
-	0xd5, 0xde, 0x9e, 0x1e, 0xb2, 0xbc, 0xc3, 0x0f, 0xc6, 0x5c, 0x2a, 0x7c, 0x0b, 0x21, 0xd8, 0xdf,
-	0xe7, 0xa2, 0xab, 0x71, 0x6b, 0xfe, 0x86, 0xdf, 0x28, 0x77, 0xca, 0xa6, 0xf2, 0x18, 0x92, 0x0c,
-	0xaf, 0xa3, 0x32, 0x93, 0xc3, 0x6e, 0x9f, 0x67, 0x90, 0xae, 0x2d, 0x98, 0xee, 0x12, 0x93, 0xc3,
-	0xb6, 0x7e, 0xde, 0x5e, 0x7a, 0x7f, 0x5c, 0xf3, 0x7e, 0x1e, 0xd7, 0xbc, 0xe8, 0x39, 0xba, 0x7e,
-	0x8e, 0x59, 0xe6, 0x90, 0x49, 0x8e, 0x1f, 0xa1, 0x65, 0xc1, 0xd5, 0x58, 0x64, 0x7f, 0xb8, 0x97,
-	0xb7, 0x6e, 0x12, 0x6b, 0x92, 0x68, 0x93, 0xb3, 0x40, 0x88, 0xd6, 0x6a, 0x2d, 0x9e, 0x9c, 0xd5,
-	0xbc, 0x0e, 0xb2, 0x33, 0xba, 0x12, 0x55, 0x51, 0x60, 0x68, 0x9f, 0xe9, 0x57, 0xdf, 0x01, 0x18,
-	0xb5, 0xf9, 0x48, 0x31, 0x67, 0x3d, 0x3a, 0x44, 0xeb, 0x85, 0x5d, 0x27, 0xbf, 0x87, 0x56, 0x4d,
-	0x64, 0xdd, 0x1c, 0x60, 0xd4, 0xed, 0xeb, 0x9e, 0xf1, 0x70, 0xad, 0x45, 0xb4, 0xd0, 0xb7, 0xb3,
-	0x5a, 0x7d, 0x90, 0xa8, 0x97, 0xe3, 0x1e, 0x89, 0x21, 0xa5, 0x2e, 0x3a, 0xfb, 0xb3, 0x29, 0xfb,
-	0x43, 0xaa, 0x8e, 0x72, 0x2e, 0x49, 0x9b, 0xc7, 0x9d, 0x15, 0x35, 0xa7, 0x10, 0x55, 0x10, 0x36,
-	0xc2, 0x3b, 0x4c, 0xb0, 0x54, 0xce, 0xec, 0xec, 0xa2, 0x1b, 0x73, 0x55, 0x67, 0x63, 0x1b, 0x95,
-	0x72, 0x53, 0x71, 0x01, 0x54, 0x49, 0xd1, 0x49, 0x10, 0x3b, 0xe5, 0x32, 0x70, 0x13, 0x5b, 0x9f,
-	0xaf, 0xa0, 0xab, 0x86, 0x13, 0xbf, 0x41, 0x8b, 0x3a, 0x5b, 0x5c, 0x2f, 0x9e, 0xbe, 0xb8, 0xd6,
-	0xe0, 0xee, 0x5f, 0x71, 0xd6, 0x5e, 0x14, 0xbd, 0xfd, 0xf2, 0xe3, 0xe3, 0x42, 0x15, 0x07, 0xb4,
-	0xf0, 0xbe, 0xa4, 0x16, 0xfd, 0xe4, 0xa3, 0x95, 0xf9, 0x90, 0xf1, 0x83, 0x4b, 0xf8, 0x0b, 0xb7,
-	0x15, 0x34, 0xff, 0x63, 0xc2, 0x79, 0x23, 0xc6, 0x5b, 0x03, 0xd7, 0x8b, 0xbd, 0x5d, 0xdc, 0x2e,
-	0x7e, 0xe7, 0xa3, 0x92, 0xcd, 0x11, 0x37, 0x2e, 0x51, 0x9b, 0x5b, 0x5b, 0x70, 0xef, 0x1f, 0x90,
-	0xce, 0xcf, 0x1d, 0xe3, 0x27, 0xc4, 0xd5, 0x62, 0x3f, 0x76, 0x69, 0xad, 0x27, 0x27, 0x93, 0xd0,
-	0x3f, 0x9d, 0x84, 0xfe, 0xf7, 0x49, 0xe8, 0x7f, 0x98, 0x86, 0xde, 0xe9, 0x34, 0xf4, 0xbe, 0x4e,
-	0x43, 0xef, 0xc5, 0xfd, 0xf3, 0xf7, 0x36, 0x62, 0x52, 0x26, 0xf1, 0xa6, 0x65, 0x8a, 0x41, 0x70,
-	0xfa, 0x7a, 0x46, 0x68, 0x2e, 0xaf, 0x57, 0x32, 0x1f, 0xed, 0xc3, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xeb, 0x4e, 0x4a, 0xdc, 0x65, 0x04, 0x00, 0x00,
+func (m *QuerySwapVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ context.Context
-	_ grpc.ClientConn
-)
+func (m *QuerySwapVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapVolumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QuerySwapVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapVolumeRequest.Merge(m, src)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Swap returns simulated swap amount.
-	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
-	// TerraPoolDelta returns terra_pool_delta amount.
-	TerraPoolDelta(ctx context.Context, in *QueryTerraPoolDeltaRequest, opts ...grpc.CallOption) (*QueryTerraPoolDeltaResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QuerySwapVolumeRequest) XXX_Size() int {
+	return m.Size()
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySwapVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapVolumeRequest.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QuerySwapVolumeRequest proto.InternalMessageInfo
+
+// QuerySwapVolumeResponse is the response type for the Query/SwapVolume RPC method.
+type QuerySwapVolumeResponse struct {
+	// block defines the swap volume capacity of the current block.
+	Block SwapVolumeCapacity `protobuf:"bytes,1,opt,name=block,proto3" json:"block"`
+	// epoch defines the swap volume capacity of the current swap volume epoch.
+	Epoch SwapVolumeCapacity `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch"`
 }
 
-func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
-	out := new(QuerySwapResponse)
-	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/Swap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapVolumeResponse) Reset()         { *m = QuerySwapVolumeResponse{} }
+func (m *QuerySwapVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapVolumeResponse) ProtoMessage()    {}
+func (*QuerySwapVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{7}
+}
+
+func (m *QuerySwapVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySwapVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-func (c *queryClient) TerraPoolDelta(ctx context.Context, in *QueryTerraPoolDeltaRequest, opts ...grpc.CallOption) (*QueryTerraPoolDeltaResponse, error) {
-	out := new(QueryTerraPoolDeltaResponse)
-	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/TerraPoolDelta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapVolumeResponse.Merge(m, src)
+}
+
+func (m *QuerySwapVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySwapVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapVolumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapVolumeResponse proto.InternalMessageInfo
+
+func (m *QuerySwapVolumeResponse) GetBlock() SwapVolumeCapacity {
+	if m != nil {
+		return m.Block
 	}
-	return out, nil
+	return SwapVolumeCapacity{}
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapVolumeResponse) GetEpoch() SwapVolumeCapacity {
+	if m != nil {
+		return m.Epoch
 	}
-	return out, nil
+	return SwapVolumeCapacity{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Swap returns simulated swap amount.
-	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
-	// TerraPoolDelta returns terra_pool_delta amount.
-	TerraPoolDelta(context.Context, *QueryTerraPoolDeltaRequest) (*QueryTerraPoolDeltaResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// SwapVolumeCapacity defines the net swap volume of a denom against its cap.
+type SwapVolumeCapacity struct {
+	// volume defines the net amount minted by swaps, negative when burned.
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	// limit defines the cap on the net volume, zero when there is no cap.
+	Limit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
+	// mint_remaining defines the amount swaps can still mint, empty when there is no cap.
+	MintRemaining *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=mint_remaining,json=mintRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"mint_remaining,omitempty"`
+	// burn_remaining defines the amount swaps can still burn, empty when there is no cap.
+	BurnRemaining *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=burn_remaining,json=burnRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burn_remaining,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
+func (m *SwapVolumeCapacity) Reset()         { *m = SwapVolumeCapacity{} }
+func (m *SwapVolumeCapacity) String() string { return proto.CompactTextString(m) }
+func (*SwapVolumeCapacity) ProtoMessage()    {}
+func (*SwapVolumeCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{8}
+}
 
-func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest) (*QuerySwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+func (m *SwapVolumeCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (*UnimplementedQueryServer) TerraPoolDelta(ctx context.Context, req *QueryTerraPoolDeltaRequest) (*QueryTerraPoolDeltaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TerraPoolDelta not implemented")
+func (m *SwapVolumeCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapVolumeCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *SwapVolumeCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapVolumeCapacity.Merge(m, src)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *SwapVolumeCapacity) XXX_Size() int {
+	return m.Size()
 }
 
-func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Swap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.market.v1beta1.Query/Swap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SwapVolumeCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapVolumeCapacity.DiscardUnknown(m)
 }
 
-func _Query_TerraPoolDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTerraPoolDeltaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TerraPoolDelta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.market.v1beta1.Query/TerraPoolDelta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TerraPoolDelta(ctx, req.(*QueryTerraPoolDeltaRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_SwapVolumeCapacity proto.InternalMessageInfo
+
+// QuerySwapStatisticsRequest is the request type for the Query/SwapStatistics RPC method.
+type QuerySwapStatisticsRequest struct {
+	// offer_denom filters the statistics by the offer denom when set
+	OfferDenom string `protobuf:"bytes,1,opt,name=offer_denom,json=offerDenom,proto3" json:"offer_denom,omitempty"`
+	// ask_denom filters the statistics by the ask denom when set
+	AskDenom string `protobuf:"bytes,2,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty"`
+	// window defines the number of swap statistics periods to aggregate, including the current one
+	Window uint64 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.market.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySwapStatisticsRequest) Reset()         { *m = QuerySwapStatisticsRequest{} }
+func (m *QuerySwapStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapStatisticsRequest) ProtoMessage()    {}
+func (*QuerySwapStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{9}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "terra.market.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Swap",
-			Handler:    _Query_Swap_Handler,
-		},
-		{
-			MethodName: "TerraPoolDelta",
-			Handler:    _Query_TerraPoolDelta_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "terra/market/v1beta1/query.proto",
+func (m *QuerySwapStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QuerySwapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySwapStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapStatisticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *QuerySwapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySwapStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapStatisticsRequest.Merge(m, src)
 }
 
-func (m *QuerySwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AskDenom) > 0 {
-		i -= len(m.AskDenom)
-		copy(dAtA[i:], m.AskDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OfferCoin) > 0 {
-		i -= len(m.OfferCoin)
-		copy(dAtA[i:], m.OfferCoin)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferCoin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySwapStatisticsRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QuerySwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySwapStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapStatisticsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySwapStatisticsRequest proto.InternalMessageInfo
+
+// QuerySwapStatisticsResponse is the response type for the Query/SwapStatistics RPC method.
+type QuerySwapStatisticsResponse struct {
+	// statistics defines the aggregated swap statistics of each denom pair.
+	Statistics []SwapStatistics `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics"`
 }
 
-func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ReturnCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySwapStatisticsResponse) Reset()         { *m = QuerySwapStatisticsResponse{} }
+func (m *QuerySwapStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapStatisticsResponse) ProtoMessage()    {}
+func (*QuerySwapStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{10}
+}
+
+func (m *QuerySwapStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySwapStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapStatisticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryTerraPoolDeltaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySwapStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapStatisticsResponse.Merge(m, src)
 }
 
-func (m *QueryTerraPoolDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySwapStatisticsResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryTerraPoolDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySwapStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapStatisticsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTerraPoolDeltaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySwapStatisticsResponse proto.InternalMessageInfo
+
+func (m *QuerySwapStatisticsResponse) GetStatistics() []SwapStatistics {
+	if m != nil {
+		return m.Statistics
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTerraPoolDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTerraSwapFeeRequest is the request type for the Query/TerraSwapFee RPC method.
+type QueryTerraSwapFeeRequest struct {
+	OfferDenom string `protobuf:"bytes,1,opt,name=offer_denom,json=offerDenom,proto3" json:"offer_denom,omitempty"`
+	AskDenom   string `protobuf:"bytes,2,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty"`
 }
 
-func (m *QueryTerraPoolDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TerraPoolDelta.Size()
-		i -= size
-		if _, err := m.TerraPoolDelta.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryTerraSwapFeeRequest) Reset()         { *m = QueryTerraSwapFeeRequest{} }
+func (m *QueryTerraSwapFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTerraSwapFeeRequest) ProtoMessage()    {}
+func (*QueryTerraSwapFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{11}
+}
+
+func (m *QueryTerraSwapFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTerraSwapFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTerraSwapFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTerraSwapFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTerraSwapFeeRequest.Merge(m, src)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTerraSwapFeeRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTerraSwapFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTerraSwapFeeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryTerraSwapFeeRequest proto.InternalMessageInfo
+
+// QueryTerraSwapFeeResponse is the response type for the Query/TerraSwapFee RPC method.
+type QueryTerraSwapFeeResponse struct {
+	// fee defines the spread charged on the swaps of the denom pair.
+	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+	// tobin_tax_fallback is true when the pair has no swap fee and the oracle tobin tax applies.
+	TobinTaxFallback bool `protobuf:"varint,2,opt,name=tobin_tax_fallback,json=tobinTaxFallback,proto3" json:"tobin_tax_fallback,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTerraSwapFeeResponse) Reset()         { *m = QueryTerraSwapFeeResponse{} }
+func (m *QueryTerraSwapFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTerraSwapFeeResponse) ProtoMessage()    {}
+func (*QueryTerraSwapFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{12}
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTerraSwapFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTerraSwapFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTerraSwapFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryTerraSwapFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTerraSwapFeeResponse.Merge(m, src)
 }
 
-func (m *QuerySwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OfferCoin)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AskDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTerraSwapFeeResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QuerySwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ReturnCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryTerraSwapFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTerraSwapFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTerraPoolDeltaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTerraSwapFeeResponse proto.InternalMessageInfo
+
+func (m *QueryTerraSwapFeeResponse) GetTobinTaxFallback() bool {
+	if m != nil {
+		return m.TobinTaxFallback
 	}
-	var l int
-	_ = l
-	return n
+	return false
 }
 
-func (m *QueryTerraPoolDeltaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TerraPoolDelta.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryTerraSwapFeesRequest is the request type for the Query/TerraSwapFees RPC method.
+type QueryTerraSwapFeesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTerraSwapFeesRequest) Reset()         { *m = QueryTerraSwapFeesRequest{} }
+func (m *QueryTerraSwapFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTerraSwapFeesRequest) ProtoMessage()    {}
+func (*QueryTerraSwapFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{13}
+}
+
+func (m *QueryTerraSwapFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTerraSwapFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTerraSwapFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTerraSwapFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTerraSwapFeesRequest.Merge(m, src)
+}
+
+func (m *QueryTerraSwapFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTerraSwapFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTerraSwapFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTerraSwapFeesRequest proto.InternalMessageInfo
+
+func (m *QueryTerraSwapFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTerraSwapFeesResponse is the response type for the Query/TerraSwapFees RPC method.
+type QueryTerraSwapFeesResponse struct {
+	TerraSwapFees []TerraSwapFee `protobuf:"bytes,1,rep,name=terra_swap_fees,json=terraSwapFees,proto3" json:"terra_swap_fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTerraSwapFeesResponse) Reset()         { *m = QueryTerraSwapFeesResponse{} }
+func (m *QueryTerraSwapFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTerraSwapFeesResponse) ProtoMessage()    {}
+func (*QueryTerraSwapFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{14}
+}
+
+func (m *QueryTerraSwapFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTerraSwapFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTerraSwapFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTerraSwapFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTerraSwapFeesResponse.Merge(m, src)
+}
+
+func (m *QueryTerraSwapFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTerraSwapFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTerraSwapFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTerraSwapFeesResponse proto.InternalMessageInfo
+
+func (m *QueryTerraSwapFeesResponse) GetTerraSwapFees() []TerraSwapFee {
+	if m != nil {
+		return m.TerraSwapFees
+	}
+	return nil
+}
+
+func (m *QueryTerraSwapFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySwapFeeAccountingRequest is the request type for the Query/SwapFeeAccounting RPC method.
+type QuerySwapFeeAccountingRequest struct {
+	// destination filters the totals to burn, oracle or community_pool, all destinations when empty.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (m *QuerySwapFeeAccountingRequest) Reset()         { *m = QuerySwapFeeAccountingRequest{} }
+func (m *QuerySwapFeeAccountingRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapFeeAccountingRequest) ProtoMessage()    {}
+func (*QuerySwapFeeAccountingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{15}
+}
+
+func (m *QuerySwapFeeAccountingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySwapFeeAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapFeeAccountingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QuerySwapFeeAccountingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapFeeAccountingRequest.Merge(m, src)
+}
+
+func (m *QuerySwapFeeAccountingRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySwapFeeAccountingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapFeeAccountingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapFeeAccountingRequest proto.InternalMessageInfo
+
+// QuerySwapFeeAccountingResponse is the response type for the Query/SwapFeeAccounting RPC method.
+type QuerySwapFeeAccountingResponse struct {
+	Totals []SwapFeeTotal `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals"`
+}
+
+func (m *QuerySwapFeeAccountingResponse) Reset()         { *m = QuerySwapFeeAccountingResponse{} }
+func (m *QuerySwapFeeAccountingResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapFeeAccountingResponse) ProtoMessage()    {}
+func (*QuerySwapFeeAccountingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{16}
+}
+
+func (m *QuerySwapFeeAccountingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySwapFeeAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapFeeAccountingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QuerySwapFeeAccountingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapFeeAccountingResponse.Merge(m, src)
+}
+
+func (m *QuerySwapFeeAccountingResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySwapFeeAccountingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapFeeAccountingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapFeeAccountingResponse proto.InternalMessageInfo
+
+func (m *QuerySwapFeeAccountingResponse) GetTotals() []SwapFeeTotal {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+// QueryBatchSwapOrdersRequest is the request type for the Query/BatchSwapOrders RPC method.
+type QueryBatchSwapOrdersRequest struct {
+	// trader filters the orders to those of the trader, all orders when empty.
+	Trader string `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBatchSwapOrdersRequest) Reset()         { *m = QueryBatchSwapOrdersRequest{} }
+func (m *QueryBatchSwapOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchSwapOrdersRequest) ProtoMessage()    {}
+func (*QueryBatchSwapOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{17}
+}
+
+func (m *QueryBatchSwapOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBatchSwapOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchSwapOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryBatchSwapOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchSwapOrdersRequest.Merge(m, src)
+}
+
+func (m *QueryBatchSwapOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBatchSwapOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchSwapOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchSwapOrdersRequest proto.InternalMessageInfo
+
+// QueryBatchSwapOrdersResponse is the response type for the Query/BatchSwapOrders RPC method.
+type QueryBatchSwapOrdersResponse struct {
+	Orders []BatchSwapOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBatchSwapOrdersResponse) Reset()         { *m = QueryBatchSwapOrdersResponse{} }
+func (m *QueryBatchSwapOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchSwapOrdersResponse) ProtoMessage()    {}
+func (*QueryBatchSwapOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{18}
+}
+
+func (m *QueryBatchSwapOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBatchSwapOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchSwapOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryBatchSwapOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchSwapOrdersResponse.Merge(m, src)
+}
+
+func (m *QueryBatchSwapOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBatchSwapOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchSwapOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchSwapOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryBatchSwapOrdersResponse) GetOrders() []BatchSwapOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *QueryBatchSwapOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIBCDenomMappingRequest is the request type for the Query/IBCDenomMapping RPC method.
+type QueryIBCDenomMappingRequest struct {
+	IBCDenom string `protobuf:"bytes,1,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
+}
+
+func (m *QueryIBCDenomMappingRequest) Reset()         { *m = QueryIBCDenomMappingRequest{} }
+func (m *QueryIBCDenomMappingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCDenomMappingRequest) ProtoMessage()    {}
+func (*QueryIBCDenomMappingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{19}
+}
+
+func (m *QueryIBCDenomMappingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryIBCDenomMappingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCDenomMappingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryIBCDenomMappingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCDenomMappingRequest.Merge(m, src)
+}
+
+func (m *QueryIBCDenomMappingRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryIBCDenomMappingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCDenomMappingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCDenomMappingRequest proto.InternalMessageInfo
+
+// QueryIBCDenomMappingResponse is the response type for the Query/IBCDenomMapping RPC method.
+type QueryIBCDenomMappingResponse struct {
+	Mapping IBCDenomMapping `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping"`
+}
+
+func (m *QueryIBCDenomMappingResponse) Reset()         { *m = QueryIBCDenomMappingResponse{} }
+func (m *QueryIBCDenomMappingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCDenomMappingResponse) ProtoMessage()    {}
+func (*QueryIBCDenomMappingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{20}
+}
+
+func (m *QueryIBCDenomMappingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryIBCDenomMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCDenomMappingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryIBCDenomMappingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCDenomMappingResponse.Merge(m, src)
+}
+
+func (m *QueryIBCDenomMappingResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryIBCDenomMappingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCDenomMappingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCDenomMappingResponse proto.InternalMessageInfo
+
+func (m *QueryIBCDenomMappingResponse) GetMapping() IBCDenomMapping {
+	if m != nil {
+		return m.Mapping
+	}
+	return IBCDenomMapping{}
+}
+
+// QueryIBCDenomMappingsRequest is the request type for the Query/IBCDenomMappings RPC method.
+type QueryIBCDenomMappingsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIBCDenomMappingsRequest) Reset()         { *m = QueryIBCDenomMappingsRequest{} }
+func (m *QueryIBCDenomMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCDenomMappingsRequest) ProtoMessage()    {}
+func (*QueryIBCDenomMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{21}
+}
+
+func (m *QueryIBCDenomMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryIBCDenomMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCDenomMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryIBCDenomMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCDenomMappingsRequest.Merge(m, src)
+}
+
+func (m *QueryIBCDenomMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryIBCDenomMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCDenomMappingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCDenomMappingsRequest proto.InternalMessageInfo
+
+func (m *QueryIBCDenomMappingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIBCDenomMappingsResponse is the response type for the Query/IBCDenomMappings RPC method.
+type QueryIBCDenomMappingsResponse struct {
+	Mappings []IBCDenomMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIBCDenomMappingsResponse) Reset()         { *m = QueryIBCDenomMappingsResponse{} }
+func (m *QueryIBCDenomMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCDenomMappingsResponse) ProtoMessage()    {}
+func (*QueryIBCDenomMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{22}
+}
+
+func (m *QueryIBCDenomMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryIBCDenomMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCDenomMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryIBCDenomMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCDenomMappingsResponse.Merge(m, src)
+}
+
+func (m *QueryIBCDenomMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryIBCDenomMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCDenomMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCDenomMappingsResponse proto.InternalMessageInfo
+
+func (m *QueryIBCDenomMappingsResponse) GetMappings() []IBCDenomMapping {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func (m *QueryIBCDenomMappingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTerraPoolDeltaRequest is the request type for the Query/TerraPoolDelta RPC method.
+type QueryTerraPoolDeltaRequest struct{}
+
+func (m *QueryTerraPoolDeltaRequest) Reset()         { *m = QueryTerraPoolDeltaRequest{} }
+func (m *QueryTerraPoolDeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTerraPoolDeltaRequest) ProtoMessage()    {}
+func (*QueryTerraPoolDeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{23}
+}
+
+func (m *QueryTerraPoolDeltaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTerraPoolDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTerraPoolDeltaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTerraPoolDeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTerraPoolDeltaRequest.Merge(m, src)
+}
+
+func (m *QueryTerraPoolDeltaRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTerraPoolDeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTerraPoolDeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTerraPoolDeltaRequest proto.InternalMessageInfo
+
+// QueryTerraPoolDeltaResponse is the response type for the Query/TerraPoolDelta RPC method.
+type QueryTerraPoolDeltaResponse struct {
+	// terra_pool_delta defines the gap between the TerraPool and the TerraBasePool
+	TerraPoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=terra_pool_delta,json=terraPoolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta"`
+}
+
+func (m *QueryTerraPoolDeltaResponse) Reset()         { *m = QueryTerraPoolDeltaResponse{} }
+func (m *QueryTerraPoolDeltaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTerraPoolDeltaResponse) ProtoMessage()    {}
+func (*QueryTerraPoolDeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{24}
+}
+
+func (m *QueryTerraPoolDeltaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTerraPoolDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTerraPoolDeltaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTerraPoolDeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTerraPoolDeltaResponse.Merge(m, src)
+}
+
+func (m *QueryTerraPoolDeltaResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTerraPoolDeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTerraPoolDeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTerraPoolDeltaResponse proto.InternalMessageInfo
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct{}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{25}
+}
+
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{26}
+}
+
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QuerySwapRequest)(nil), "terra.market.v1beta1.QuerySwapRequest")
+	proto.RegisterType((*QuerySwapResponse)(nil), "terra.market.v1beta1.QuerySwapResponse")
+	proto.RegisterType((*QuerySwapRouteRequest)(nil), "terra.market.v1beta1.QuerySwapRouteRequest")
+	proto.RegisterType((*QuerySwapRouteResponse)(nil), "terra.market.v1beta1.QuerySwapRouteResponse")
+	proto.RegisterType((*QuerySwapExactOutRequest)(nil), "terra.market.v1beta1.QuerySwapExactOutRequest")
+	proto.RegisterType((*QuerySwapExactOutResponse)(nil), "terra.market.v1beta1.QuerySwapExactOutResponse")
+	proto.RegisterType((*QuerySwapVolumeRequest)(nil), "terra.market.v1beta1.QuerySwapVolumeRequest")
+	proto.RegisterType((*QuerySwapVolumeResponse)(nil), "terra.market.v1beta1.QuerySwapVolumeResponse")
+	proto.RegisterType((*SwapVolumeCapacity)(nil), "terra.market.v1beta1.SwapVolumeCapacity")
+	proto.RegisterType((*QuerySwapStatisticsRequest)(nil), "terra.market.v1beta1.QuerySwapStatisticsRequest")
+	proto.RegisterType((*QuerySwapStatisticsResponse)(nil), "terra.market.v1beta1.QuerySwapStatisticsResponse")
+	proto.RegisterType((*QueryTerraSwapFeeRequest)(nil), "terra.market.v1beta1.QueryTerraSwapFeeRequest")
+	proto.RegisterType((*QueryTerraSwapFeeResponse)(nil), "terra.market.v1beta1.QueryTerraSwapFeeResponse")
+	proto.RegisterType((*QueryTerraSwapFeesRequest)(nil), "terra.market.v1beta1.QueryTerraSwapFeesRequest")
+	proto.RegisterType((*QueryTerraSwapFeesResponse)(nil), "terra.market.v1beta1.QueryTerraSwapFeesResponse")
+	proto.RegisterType((*QuerySwapFeeAccountingRequest)(nil), "terra.market.v1beta1.QuerySwapFeeAccountingRequest")
+	proto.RegisterType((*QuerySwapFeeAccountingResponse)(nil), "terra.market.v1beta1.QuerySwapFeeAccountingResponse")
+	proto.RegisterType((*QueryBatchSwapOrdersRequest)(nil), "terra.market.v1beta1.QueryBatchSwapOrdersRequest")
+	proto.RegisterType((*QueryBatchSwapOrdersResponse)(nil), "terra.market.v1beta1.QueryBatchSwapOrdersResponse")
+	proto.RegisterType((*QueryIBCDenomMappingRequest)(nil), "terra.market.v1beta1.QueryIBCDenomMappingRequest")
+	proto.RegisterType((*QueryIBCDenomMappingResponse)(nil), "terra.market.v1beta1.QueryIBCDenomMappingResponse")
+	proto.RegisterType((*QueryIBCDenomMappingsRequest)(nil), "terra.market.v1beta1.QueryIBCDenomMappingsRequest")
+	proto.RegisterType((*QueryIBCDenomMappingsResponse)(nil), "terra.market.v1beta1.QueryIBCDenomMappingsResponse")
+	proto.RegisterType((*QueryTerraPoolDeltaRequest)(nil), "terra.market.v1beta1.QueryTerraPoolDeltaRequest")
+	proto.RegisterType((*QueryTerraPoolDeltaResponse)(nil), "terra.market.v1beta1.QueryTerraPoolDeltaResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "terra.market.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "terra.market.v1beta1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("terra/market/v1beta1/query.proto", fileDescriptor_c172d0f188bf2fb6) }
+
+var fileDescriptor_c172d0f188bf2fb6 = []byte{
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x86, 0xc4, 0x24, 0x2f, 0x10, 0xc2, 0x7c, 0xf3, 0xe5, 0xeb, 0x2c, 0x89, 0x93, 0xef,
+	0x0a, 0xf2, 0x03, 0x12, 0x2f, 0x09, 0x95, 0x5a, 0x71, 0xa8, 0x5a, 0x13, 0x4c, 0x69, 0x55, 0x11,
+	0x4c, 0x5a, 0xa1, 0xf6, 0xe0, 0x8e, 0xd7, 0x63, 0x67, 0x65, 0x7b, 0x67, 0xd9, 0x1d, 0x93, 0x20,
+	0xda, 0x4b, 0x7b, 0x68, 0xa5, 0x5e, 0x90, 0xb8, 0xb6, 0x12, 0x95, 0xca, 0xa1, 0x42, 0x3d, 0x70,
+	0xea, 0xbf, 0xc0, 0x11, 0xa9, 0x97, 0xaa, 0x07, 0x54, 0x85, 0x1e, 0xfa, 0x67, 0x54, 0xf3, 0x63,
+	0xd7, 0xeb, 0xcd, 0xc6, 0x5e, 0x47, 0x39, 0x81, 0x67, 0xde, 0xe7, 0xbd, 0xcf, 0x7b, 0x6f, 0xde,
+	0xcc, 0x67, 0x03, 0x0b, 0x8c, 0x78, 0x1e, 0x36, 0x5b, 0xd8, 0x6b, 0x10, 0x66, 0x3e, 0x58, 0xaf,
+	0x10, 0x86, 0xd7, 0xcd, 0xfb, 0x6d, 0xe2, 0x3d, 0xcc, 0xbb, 0x1e, 0x65, 0x14, 0x4d, 0x0b, 0x8b,
+	0xbc, 0xb4, 0xc8, 0x2b, 0x0b, 0x7d, 0xba, 0x4e, 0xeb, 0x54, 0x18, 0x98, 0xfc, 0x7f, 0xd2, 0x56,
+	0x9f, 0xad, 0x53, 0x5a, 0x6f, 0x12, 0x13, 0xbb, 0xb6, 0x89, 0x1d, 0x87, 0x32, 0xcc, 0x6c, 0xea,
+	0xf8, 0x6a, 0xf7, 0xff, 0x89, 0xb1, 0x94, 0x63, 0x69, 0x92, 0xb3, 0xa8, 0xdf, 0xa2, 0xbe, 0x59,
+	0xc1, 0x3e, 0x09, 0x2d, 0x2c, 0x6a, 0x3b, 0x6a, 0xff, 0x52, 0x74, 0x5f, 0xb0, 0x0c, 0xad, 0x5c,
+	0x5c, 0xb7, 0x1d, 0x11, 0x4f, 0xda, 0x1a, 0xf7, 0x60, 0xea, 0x0e, 0xb7, 0xb8, 0xbb, 0x8b, 0xdd,
+	0x12, 0xb9, 0xdf, 0x26, 0x3e, 0x43, 0x73, 0x00, 0xb4, 0x56, 0x23, 0x5e, 0x99, 0xfb, 0xcc, 0x6a,
+	0x0b, 0xda, 0xf2, 0x78, 0x69, 0x5c, 0xac, 0x5c, 0xa7, 0xb6, 0x83, 0xce, 0xc3, 0x38, 0xf6, 0x1b,
+	0xe5, 0x2a, 0x71, 0x68, 0x2b, 0x3b, 0x2c, 0x76, 0xc7, 0xb0, 0xdf, 0xd8, 0xe4, 0xbf, 0xaf, 0x8d,
+	0x7d, 0xf7, 0x74, 0x7e, 0xe8, 0x9f, 0xa7, 0xf3, 0x43, 0xc6, 0x27, 0x70, 0x36, 0xe2, 0xd9, 0x77,
+	0xa9, 0xe3, 0x13, 0xf4, 0x1e, 0x4c, 0x78, 0x84, 0xb5, 0x3d, 0xa7, 0xe3, 0x7b, 0x62, 0x63, 0x26,
+	0x2f, 0x09, 0xe7, 0x39, 0xe1, 0xa0, 0x78, 0x79, 0x1e, 0xab, 0x30, 0xf2, 0xf2, 0xf5, 0xfc, 0x50,
+	0x09, 0x24, 0x86, 0xaf, 0x18, 0x65, 0xf8, 0x6f, 0xc7, 0x2d, 0x6d, 0x33, 0x92, 0x92, 0xf5, 0x1c,
+	0x40, 0xc8, 0xda, 0xcf, 0x0e, 0x2f, 0x9c, 0xe0, 0xdb, 0x01, 0x6d, 0x3f, 0xc2, 0xfb, 0x89, 0x06,
+	0xe7, 0xe2, 0x11, 0x8e, 0x8b, 0x3d, 0x7a, 0x1b, 0x46, 0x76, 0xa8, 0x2b, 0xe3, 0x4f, 0x6c, 0xcc,
+	0xe5, 0x93, 0x8e, 0x4d, 0x9e, 0x07, 0xfe, 0x80, 0xba, 0x0a, 0x2e, 0x00, 0xc6, 0x17, 0x90, 0x0d,
+	0x49, 0xdd, 0xd8, 0xc3, 0x16, 0xbb, 0xdd, 0x66, 0x41, 0xe6, 0x33, 0xc0, 0xeb, 0x1f, 0xcd, 0xfb,
+	0x24, 0xf6, 0x1b, 0x22, 0xde, 0x3c, 0x4c, 0xc8, 0xa2, 0x44, 0xbb, 0x25, 0xeb, 0x14, 0xef, 0xd7,
+	0xe7, 0x30, 0x93, 0x10, 0x41, 0x65, 0xfe, 0xee, 0x81, 0xe2, 0xa6, 0x48, 0xbc, 0x53, 0x7d, 0xe3,
+	0x9d, 0x48, 0x4d, 0x3f, 0xa5, 0xcd, 0x76, 0x2b, 0x6c, 0xdb, 0x34, 0x8c, 0x4a, 0x6e, 0x92, 0xf9,
+	0x68, 0x35, 0x46, 0xeb, 0x99, 0x06, 0xff, 0x3b, 0x00, 0x55, 0xac, 0x36, 0x61, 0xb4, 0xd2, 0xa4,
+	0x56, 0x43, 0x11, 0x5a, 0x3e, 0xbc, 0x9c, 0x12, 0x78, 0x1d, 0xbb, 0xd8, 0xb2, 0xd9, 0x43, 0xc5,
+	0x4f, 0x82, 0xb9, 0x17, 0xe2, 0x52, 0x6b, 0x27, 0x3b, 0x7c, 0x34, 0x2f, 0x02, 0x6c, 0xbc, 0x1c,
+	0x06, 0x74, 0xd0, 0x06, 0x15, 0x21, 0xf3, 0x40, 0xac, 0xc8, 0xfc, 0x0a, 0x79, 0x8e, 0xf9, 0xf3,
+	0xf5, 0xfc, 0x62, 0xdd, 0x66, 0x3b, 0xed, 0x4a, 0xde, 0xa2, 0x2d, 0x53, 0x8d, 0xab, 0xfc, 0x67,
+	0xcd, 0xaf, 0x36, 0x4c, 0xf6, 0xd0, 0x25, 0x7e, 0xfe, 0x96, 0xc3, 0x4a, 0x0a, 0xcd, 0x49, 0x36,
+	0xed, 0x96, 0xcd, 0xb2, 0xc3, 0x47, 0x72, 0x23, 0xc1, 0xe8, 0x0e, 0x4c, 0xb6, 0x6c, 0x87, 0x95,
+	0x3d, 0xd2, 0xc2, 0xb6, 0x63, 0x3b, 0xf5, 0xec, 0x09, 0xe1, 0xee, 0xd2, 0x00, 0xae, 0x4e, 0x73,
+	0x0f, 0xa5, 0xc0, 0x01, 0x77, 0x59, 0xe1, 0x13, 0xd1, 0x71, 0x39, 0x32, 0xb8, 0x4b, 0xee, 0x21,
+	0x74, 0x69, 0x7c, 0x09, 0x7a, 0xd8, 0xf1, 0xbb, 0xfc, 0x76, 0xf4, 0x99, 0x6d, 0xf9, 0xc1, 0x81,
+	0x89, 0x1d, 0x69, 0x2d, 0x7e, 0xa4, 0x7b, 0xde, 0x4f, 0xe8, 0x1c, 0x64, 0x76, 0x6d, 0xa7, 0x4a,
+	0x77, 0x45, 0xe6, 0x23, 0x25, 0xf5, 0x2b, 0x72, 0xe0, 0x6c, 0x38, 0x9f, 0x18, 0x5d, 0x9d, 0xb9,
+	0x0f, 0x01, 0xfc, 0x70, 0x35, 0xab, 0x89, 0x39, 0xbe, 0x70, 0xf8, 0x91, 0xe9, 0x78, 0x08, 0x6e,
+	0x83, 0x0e, 0xda, 0xa8, 0xa8, 0xa1, 0xde, 0xe6, 0x68, 0x6e, 0x5d, 0x24, 0xe4, 0x58, 0xd2, 0x8c,
+	0xa4, 0xf3, 0xbd, 0x06, 0x33, 0x09, 0x41, 0xc2, 0x1b, 0xed, 0x44, 0x8d, 0x1c, 0xe5, 0x6c, 0x6e,
+	0x12, 0xab, 0xc4, 0xa1, 0x68, 0x15, 0x10, 0xa3, 0x15, 0xdb, 0x29, 0x33, 0xbc, 0x57, 0xae, 0xe1,
+	0x66, 0xb3, 0x82, 0xad, 0x86, 0xe0, 0x33, 0x56, 0x9a, 0x12, 0x3b, 0xdb, 0x78, 0xaf, 0xa8, 0xd6,
+	0x0d, 0x2b, 0x81, 0x4c, 0xd8, 0xd9, 0x22, 0x40, 0xe7, 0x7d, 0x52, 0x33, 0xbd, 0xd8, 0x75, 0xc9,
+	0xc8, 0x27, 0x37, 0xa8, 0xef, 0x16, 0xae, 0x07, 0xe5, 0x2a, 0x45, 0x90, 0xc6, 0x6f, 0x1a, 0xe8,
+	0x49, 0x51, 0x54, 0xce, 0x5b, 0x70, 0x46, 0xb4, 0xab, 0xec, 0xef, 0x62, 0xb7, 0x5c, 0x23, 0x24,
+	0x68, 0xa3, 0x91, 0xdc, 0xc6, 0xa8, 0x17, 0xd5, 0xc4, 0xd3, 0x2c, 0xea, 0x19, 0xdd, 0xec, 0x22,
+	0x2e, 0xaf, 0x91, 0xa5, 0xbe, 0xc4, 0x25, 0x9d, 0x2e, 0xe6, 0x1f, 0xc1, 0x5c, 0x78, 0xf6, 0x8a,
+	0x84, 0xbc, 0x6f, 0x59, 0xb4, 0xed, 0x30, 0xdb, 0xa9, 0x07, 0x25, 0x5a, 0x80, 0x89, 0x2a, 0xf1,
+	0x59, 0xb4, 0x46, 0xe3, 0xa5, 0xe8, 0x52, 0xa4, 0xf3, 0x15, 0xc8, 0x1d, 0xe6, 0x2c, 0xec, 0x7e,
+	0x86, 0x51, 0x86, 0x9b, 0x7d, 0x0a, 0xa0, 0x1c, 0x6c, 0x73, 0x53, 0x55, 0x00, 0x85, 0x33, 0xbe,
+	0xd5, 0xd4, 0xb4, 0x14, 0x30, 0xb3, 0x76, 0xb8, 0xe1, 0x6d, 0xaf, 0x4a, 0xbc, 0xb0, 0xa5, 0xe7,
+	0x20, 0xc3, 0x3c, 0x5c, 0x25, 0x9e, 0xa2, 0xaa, 0x7e, 0xa1, 0x62, 0x42, 0xc5, 0x8e, 0xd0, 0xea,
+	0x48, 0xb6, 0xcf, 0x35, 0x98, 0x4d, 0x66, 0xa2, 0x92, 0x2d, 0x40, 0x86, 0x8a, 0x95, 0xde, 0x43,
+	0xdb, 0x0d, 0x0f, 0xd2, 0x95, 0xc8, 0xe3, 0x6b, 0x74, 0x49, 0x95, 0xed, 0x56, 0xe1, 0xba, 0x18,
+	0xd8, 0x8f, 0xb1, 0xeb, 0x46, 0xda, 0xbc, 0x02, 0xe3, 0x76, 0xc5, 0x8a, 0x8e, 0x7e, 0xe1, 0xd4,
+	0xfe, 0xeb, 0xf9, 0xb1, 0xc0, 0xbc, 0x34, 0x66, 0x57, 0xac, 0xf8, 0xa4, 0x13, 0x55, 0x80, 0x03,
+	0x3e, 0x55, 0x01, 0x6e, 0xc0, 0xc9, 0x96, 0x5c, 0x52, 0xb3, 0x75, 0x31, 0xb9, 0x02, 0x31, 0xbc,
+	0x2a, 0x41, 0x80, 0x35, 0x6a, 0xc9, 0x61, 0x8e, 0x7d, 0x8a, 0x5f, 0x68, 0x30, 0x77, 0x48, 0x20,
+	0x95, 0xd0, 0x4d, 0x18, 0x53, 0xa4, 0x82, 0x9e, 0x0e, 0x94, 0x51, 0x08, 0x3e, 0xbe, 0xb6, 0xce,
+	0x46, 0x2f, 0x9e, 0x2d, 0x4a, 0x9b, 0x9b, 0xa4, 0xc9, 0xb0, 0xca, 0xce, 0xd8, 0x85, 0xf3, 0x89,
+	0xbb, 0x2a, 0x9d, 0x7b, 0x30, 0x25, 0xef, 0x25, 0x97, 0xd2, 0x66, 0xb9, 0xca, 0xf7, 0x44, 0xf9,
+	0x4e, 0x0d, 0x7c, 0x31, 0x4f, 0xb2, 0xae, 0x08, 0xc6, 0x34, 0x20, 0x11, 0x78, 0x0b, 0x7b, 0xb8,
+	0x15, 0x34, 0xca, 0xb8, 0x03, 0xff, 0xe9, 0x5a, 0x55, 0x34, 0xae, 0x41, 0xc6, 0x15, 0x2b, 0xaa,
+	0x77, 0xb3, 0xc9, 0x35, 0x95, 0xa8, 0x60, 0x3e, 0x24, 0x62, 0xe3, 0xf9, 0x14, 0x8c, 0x0a, 0x9f,
+	0xe8, 0x11, 0x8c, 0xf0, 0x21, 0x42, 0x8b, 0xc9, 0xe8, 0xf8, 0x37, 0x87, 0xbe, 0xd4, 0xd7, 0x4e,
+	0xd2, 0x33, 0x8c, 0xaf, 0x7f, 0xff, 0xfb, 0xc9, 0xf0, 0x2c, 0xd2, 0xcd, 0xc4, 0x0f, 0x25, 0x7e,
+	0xa7, 0xa3, 0xc7, 0x1a, 0x8c, 0x87, 0xea, 0x1d, 0x5d, 0xee, 0xe7, 0x3a, 0xf2, 0x15, 0xa1, 0xaf,
+	0xa6, 0x33, 0x56, 0x64, 0x96, 0x05, 0x19, 0x03, 0x2d, 0x1c, 0x4e, 0xa6, 0xec, 0x09, 0x12, 0x3f,
+	0x6a, 0x70, 0x2a, 0xaa, 0xac, 0x51, 0xbe, 0x4f, 0xa0, 0x98, 0xc8, 0xd7, 0xcd, 0xd4, 0xf6, 0x8a,
+	0xdb, 0xaa, 0xe0, 0xb6, 0x88, 0x2e, 0xf4, 0xe0, 0x46, 0x38, 0xa8, 0x4c, 0xdb, 0x0c, 0xfd, 0xa0,
+	0x01, 0x74, 0xe4, 0x2b, 0xea, 0x57, 0x86, 0x2e, 0x0d, 0xaf, 0xaf, 0xa5, 0xb4, 0x56, 0xcc, 0xd6,
+	0x05, 0xb3, 0xcb, 0x68, 0xa5, 0x07, 0x33, 0x29, 0x7b, 0xcd, 0x47, 0xe2, 0x0a, 0xfc, 0x0a, 0xfd,
+	0xac, 0xc1, 0x64, 0xb7, 0x9c, 0x42, 0x57, 0xfa, 0x04, 0x3d, 0xa0, 0x1c, 0xf5, 0xf5, 0x01, 0x10,
+	0x8a, 0xea, 0x9a, 0xa0, 0xba, 0x84, 0x2e, 0xf6, 0xa0, 0xda, 0x11, 0x74, 0xa2, 0xcb, 0x51, 0xb9,
+	0xd0, 0xb3, 0xcb, 0x09, 0xaa, 0x4f, 0x37, 0x53, 0xdb, 0xa7, 0xeb, 0x72, 0xb7, 0xd0, 0x41, 0x3f,
+	0x69, 0x70, 0x7a, 0xbb, 0x4b, 0xba, 0xa4, 0x0d, 0x18, 0x16, 0xf1, 0x4a, 0x7a, 0x40, 0xba, 0x1a,
+	0xc6, 0xb4, 0x18, 0x7a, 0xa1, 0xc1, 0xd9, 0x03, 0x92, 0x05, 0x5d, 0xed, 0xd3, 0xbb, 0x24, 0xb5,
+	0xa4, 0xbf, 0x35, 0x18, 0x68, 0x80, 0xe3, 0x59, 0x23, 0xa4, 0x8c, 0x3b, 0xec, 0x7e, 0xd1, 0xe0,
+	0x4c, 0x4c, 0x77, 0xa0, 0x5e, 0xa7, 0x2d, 0x59, 0x2d, 0xe9, 0x1b, 0x83, 0x40, 0x14, 0x5b, 0x53,
+	0xb0, 0x5d, 0x41, 0x4b, 0xc9, 0x6c, 0x2b, 0x1c, 0x26, 0xab, 0xab, 0x34, 0x0c, 0xe7, 0x1a, 0x7b,
+	0x10, 0x7b, 0x72, 0x4d, 0x96, 0x28, 0xfa, 0xc6, 0x20, 0x90, 0x74, 0x5c, 0x43, 0xc9, 0x53, 0x56,
+	0x2f, 0x33, 0xfa, 0x55, 0x83, 0xa9, 0xf8, 0xf3, 0x8f, 0x06, 0x88, 0x1c, 0x56, 0xf6, 0xea, 0x40,
+	0x18, 0x45, 0xf7, 0x8a, 0xa0, 0x7b, 0x09, 0x2d, 0xa7, 0xa4, 0xeb, 0xa3, 0x67, 0x1a, 0x4c, 0x76,
+	0xbf, 0xee, 0xa8, 0xef, 0xbc, 0xc4, 0x65, 0x82, 0xbe, 0x3e, 0x00, 0x42, 0x31, 0xcd, 0x0b, 0xa6,
+	0xcb, 0x68, 0xb1, 0xd7, 0x88, 0x75, 0x64, 0x05, 0xfa, 0x46, 0x83, 0x8c, 0x7c, 0xc0, 0xd1, 0x72,
+	0x8f, 0x68, 0x5d, 0x7a, 0x41, 0x5f, 0x49, 0x61, 0xa9, 0xf8, 0x5c, 0x10, 0x7c, 0x72, 0x68, 0x36,
+	0x99, 0x8f, 0x54, 0x0b, 0x85, 0xe2, 0xcb, 0xfd, 0x9c, 0xf6, 0x6a, 0x3f, 0xa7, 0xfd, 0xb5, 0x9f,
+	0xd3, 0x1e, 0xbf, 0xc9, 0x0d, 0xbd, 0x7a, 0x93, 0x1b, 0xfa, 0xe3, 0x4d, 0x6e, 0xe8, 0xb3, 0xd5,
+	0xa8, 0xd0, 0x69, 0x62, 0xdf, 0xb7, 0xad, 0x35, 0xe9, 0xc9, 0xa2, 0x1e, 0x31, 0xf7, 0x02, 0x87,
+	0x42, 0xf2, 0x54, 0x32, 0xe2, 0x4f, 0x99, 0x57, 0xff, 0x1d, 0x00, 0x63, 0xcf, 0x7a, 0x81, 0xa7,
+	0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Swap returns simulated swap amount.
+	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	// SwapRoute returns simulated swap amount through an ordered list of denoms.
+	SwapRoute(ctx context.Context, in *QuerySwapRouteRequest, opts ...grpc.CallOption) (*QuerySwapRouteResponse, error)
+	// SwapExactOut returns simulated offer amount needed to receive the ask coin.
+	SwapExactOut(ctx context.Context, in *QuerySwapExactOutRequest, opts ...grpc.CallOption) (*QuerySwapExactOutResponse, error)
+	// SwapVolume returns the swap volume and the remaining capacity of a denom.
+	SwapVolume(ctx context.Context, in *QuerySwapVolumeRequest, opts ...grpc.CallOption) (*QuerySwapVolumeResponse, error)
+	// SwapStatistics returns the swap statistics of each denom pair over the last periods.
+	SwapStatistics(ctx context.Context, in *QuerySwapStatisticsRequest, opts ...grpc.CallOption) (*QuerySwapStatisticsResponse, error)
+	// TerraSwapFee returns the swap fee charged on the swaps of a terra denom pair.
+	TerraSwapFee(ctx context.Context, in *QueryTerraSwapFeeRequest, opts ...grpc.CallOption) (*QueryTerraSwapFeeResponse, error)
+	// TerraSwapFees returns the swap fees set for the terra denom pairs.
+	TerraSwapFees(ctx context.Context, in *QueryTerraSwapFeesRequest, opts ...grpc.CallOption) (*QueryTerraSwapFeesResponse, error)
+	// SwapFeeAccounting returns the cumulative swap fees routed to each destination.
+	SwapFeeAccounting(ctx context.Context, in *QuerySwapFeeAccountingRequest, opts ...grpc.CallOption) (*QuerySwapFeeAccountingResponse, error)
+	// BatchSwapOrders returns the swaps queued for the batch of the current block.
+	BatchSwapOrders(ctx context.Context, in *QueryBatchSwapOrdersRequest, opts ...grpc.CallOption) (*QueryBatchSwapOrdersResponse, error)
+	// IBCDenomMapping returns the native denom an IBC voucher is mapped to.
+	IBCDenomMapping(ctx context.Context, in *QueryIBCDenomMappingRequest, opts ...grpc.CallOption) (*QueryIBCDenomMappingResponse, error)
+	// IBCDenomMappings returns the native denoms the IBC vouchers are mapped to.
+	IBCDenomMappings(ctx context.Context, in *QueryIBCDenomMappingsRequest, opts ...grpc.CallOption) (*QueryIBCDenomMappingsResponse, error)
+	// TerraPoolDelta returns terra_pool_delta amount.
+	TerraPoolDelta(ctx context.Context, in *QueryTerraPoolDeltaRequest, opts ...grpc.CallOption) (*QueryTerraPoolDeltaResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
+	out := new(QuerySwapResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapRoute(ctx context.Context, in *QuerySwapRouteRequest, opts ...grpc.CallOption) (*QuerySwapRouteResponse, error) {
+	out := new(QuerySwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapExactOut(ctx context.Context, in *QuerySwapExactOutRequest, opts ...grpc.CallOption) (*QuerySwapExactOutResponse, error) {
+	out := new(QuerySwapExactOutResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/SwapExactOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapVolume(ctx context.Context, in *QuerySwapVolumeRequest, opts ...grpc.CallOption) (*QuerySwapVolumeResponse, error) {
+	out := new(QuerySwapVolumeResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/SwapVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapStatistics(ctx context.Context, in *QuerySwapStatisticsRequest, opts ...grpc.CallOption) (*QuerySwapStatisticsResponse, error) {
+	out := new(QuerySwapStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/SwapStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TerraSwapFee(ctx context.Context, in *QueryTerraSwapFeeRequest, opts ...grpc.CallOption) (*QueryTerraSwapFeeResponse, error) {
+	out := new(QueryTerraSwapFeeResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/TerraSwapFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TerraSwapFees(ctx context.Context, in *QueryTerraSwapFeesRequest, opts ...grpc.CallOption) (*QueryTerraSwapFeesResponse, error) {
+	out := new(QueryTerraSwapFeesResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/TerraSwapFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapFeeAccounting(ctx context.Context, in *QuerySwapFeeAccountingRequest, opts ...grpc.CallOption) (*QuerySwapFeeAccountingResponse, error) {
+	out := new(QuerySwapFeeAccountingResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/SwapFeeAccounting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchSwapOrders(ctx context.Context, in *QueryBatchSwapOrdersRequest, opts ...grpc.CallOption) (*QueryBatchSwapOrdersResponse, error) {
+	out := new(QueryBatchSwapOrdersResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/BatchSwapOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCDenomMapping(ctx context.Context, in *QueryIBCDenomMappingRequest, opts ...grpc.CallOption) (*QueryIBCDenomMappingResponse, error) {
+	out := new(QueryIBCDenomMappingResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/IBCDenomMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCDenomMappings(ctx context.Context, in *QueryIBCDenomMappingsRequest, opts ...grpc.CallOption) (*QueryIBCDenomMappingsResponse, error) {
+	out := new(QueryIBCDenomMappingsResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/IBCDenomMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TerraPoolDelta(ctx context.Context, in *QueryTerraPoolDeltaRequest, opts ...grpc.CallOption) (*QueryTerraPoolDeltaResponse, error) {
+	out := new(QueryTerraPoolDeltaResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/TerraPoolDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Swap returns simulated swap amount.
+	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	// SwapRoute returns simulated swap amount through an ordered list of denoms.
+	SwapRoute(context.Context, *QuerySwapRouteRequest) (*QuerySwapRouteResponse, error)
+	// SwapExactOut returns simulated offer amount needed to receive the ask coin.
+	SwapExactOut(context.Context, *QuerySwapExactOutRequest) (*QuerySwapExactOutResponse, error)
+	// SwapVolume returns the swap volume and the remaining capacity of a denom.
+	SwapVolume(context.Context, *QuerySwapVolumeRequest) (*QuerySwapVolumeResponse, error)
+	// SwapStatistics returns the swap statistics of each denom pair over the last periods.
+	SwapStatistics(context.Context, *QuerySwapStatisticsRequest) (*QuerySwapStatisticsResponse, error)
+	// TerraSwapFee returns the swap fee charged on the swaps of a terra denom pair.
+	TerraSwapFee(context.Context, *QueryTerraSwapFeeRequest) (*QueryTerraSwapFeeResponse, error)
+	// TerraSwapFees returns the swap fees set for the terra denom pairs.
+	TerraSwapFees(context.Context, *QueryTerraSwapFeesRequest) (*QueryTerraSwapFeesResponse, error)
+	// SwapFeeAccounting returns the cumulative swap fees routed to each destination.
+	SwapFeeAccounting(context.Context, *QuerySwapFeeAccountingRequest) (*QuerySwapFeeAccountingResponse, error)
+	// BatchSwapOrders returns the swaps queued for the batch of the current block.
+	BatchSwapOrders(context.Context, *QueryBatchSwapOrdersRequest) (*QueryBatchSwapOrdersResponse, error)
+	// IBCDenomMapping returns the native denom an IBC voucher is mapped to.
+	IBCDenomMapping(context.Context, *QueryIBCDenomMappingRequest) (*QueryIBCDenomMappingResponse, error)
+	// IBCDenomMappings returns the native denoms the IBC vouchers are mapped to.
+	IBCDenomMappings(context.Context, *QueryIBCDenomMappingsRequest) (*QueryIBCDenomMappingsResponse, error)
+	// TerraPoolDelta returns terra_pool_delta amount.
+	TerraPoolDelta(context.Context, *QueryTerraPoolDeltaRequest) (*QueryTerraPoolDeltaResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest) (*QuerySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+
+func (*UnimplementedQueryServer) SwapRoute(ctx context.Context, req *QuerySwapRouteRequest) (*QuerySwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
+
+func (*UnimplementedQueryServer) SwapExactOut(ctx context.Context, req *QuerySwapExactOutRequest) (*QuerySwapExactOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactOut not implemented")
+}
+
+func (*UnimplementedQueryServer) SwapVolume(ctx context.Context, req *QuerySwapVolumeRequest) (*QuerySwapVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapVolume not implemented")
+}
+
+func (*UnimplementedQueryServer) SwapStatistics(ctx context.Context, req *QuerySwapStatisticsRequest) (*QuerySwapStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapStatistics not implemented")
+}
+
+func (*UnimplementedQueryServer) TerraSwapFee(ctx context.Context, req *QueryTerraSwapFeeRequest) (*QueryTerraSwapFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerraSwapFee not implemented")
+}
+
+func (*UnimplementedQueryServer) TerraSwapFees(ctx context.Context, req *QueryTerraSwapFeesRequest) (*QueryTerraSwapFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerraSwapFees not implemented")
+}
+
+func (*UnimplementedQueryServer) SwapFeeAccounting(ctx context.Context, req *QuerySwapFeeAccountingRequest) (*QuerySwapFeeAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapFeeAccounting not implemented")
+}
+
+func (*UnimplementedQueryServer) BatchSwapOrders(ctx context.Context, req *QueryBatchSwapOrdersRequest) (*QueryBatchSwapOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSwapOrders not implemented")
+}
+
+func (*UnimplementedQueryServer) IBCDenomMapping(ctx context.Context, req *QueryIBCDenomMappingRequest) (*QueryIBCDenomMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCDenomMapping not implemented")
+}
+
+func (*UnimplementedQueryServer) IBCDenomMappings(ctx context.Context, req *QueryIBCDenomMappingsRequest) (*QueryIBCDenomMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCDenomMappings not implemented")
+}
+
+func (*UnimplementedQueryServer) TerraPoolDelta(ctx context.Context, req *QueryTerraPoolDeltaRequest) (*QueryTerraPoolDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerraPoolDelta not implemented")
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/SwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapRoute(ctx, req.(*QuerySwapRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapExactOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapExactOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/SwapExactOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapExactOut(ctx, req.(*QuerySwapExactOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/SwapVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapVolume(ctx, req.(*QuerySwapVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/SwapStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapStatistics(ctx, req.(*QuerySwapStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TerraSwapFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTerraSwapFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TerraSwapFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/TerraSwapFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TerraSwapFee(ctx, req.(*QueryTerraSwapFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TerraSwapFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTerraSwapFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TerraSwapFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/TerraSwapFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TerraSwapFees(ctx, req.(*QueryTerraSwapFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapFeeAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapFeeAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapFeeAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/SwapFeeAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapFeeAccounting(ctx, req.(*QuerySwapFeeAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchSwapOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchSwapOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchSwapOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/BatchSwapOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchSwapOrders(ctx, req.(*QueryBatchSwapOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCDenomMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCDenomMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCDenomMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/IBCDenomMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCDenomMapping(ctx, req.(*QueryIBCDenomMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCDenomMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCDenomMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCDenomMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/IBCDenomMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCDenomMappings(ctx, req.(*QueryIBCDenomMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TerraPoolDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTerraPoolDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TerraPoolDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/TerraPoolDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TerraPoolDelta(ctx, req.(*QueryTerraPoolDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.market.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Swap",
+			Handler:    _Query_Swap_Handler,
+		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Query_SwapRoute_Handler,
+		},
+		{
+			MethodName: "SwapExactOut",
+			Handler:    _Query_SwapExactOut_Handler,
+		},
+		{
+			MethodName: "SwapVolume",
+			Handler:    _Query_SwapVolume_Handler,
+		},
+		{
+			MethodName: "SwapStatistics",
+			Handler:    _Query_SwapStatistics_Handler,
+		},
+		{
+			MethodName: "TerraSwapFee",
+			Handler:    _Query_TerraSwapFee_Handler,
+		},
+		{
+			MethodName: "TerraSwapFees",
+			Handler:    _Query_TerraSwapFees_Handler,
+		},
+		{
+			MethodName: "SwapFeeAccounting",
+			Handler:    _Query_SwapFeeAccounting_Handler,
+		},
+		{
+			MethodName: "BatchSwapOrders",
+			Handler:    _Query_BatchSwapOrders_Handler,
+		},
+		{
+			MethodName: "IBCDenomMapping",
+			Handler:    _Query_IBCDenomMapping_Handler,
+		},
+		{
+			MethodName: "IBCDenomMappings",
+			Handler:    _Query_IBCDenomMappings_Handler,
+		},
+		{
+			MethodName: "TerraPoolDelta",
+			Handler:    _Query_TerraPoolDelta_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terra/market/v1beta1/query.proto",
+}
+
+func (m *QuerySwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OfferCoin) > 0 {
+		i -= len(m.OfferCoin)
+		copy(dAtA[i:], m.OfferCoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferCoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReturnCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AskDenoms) > 0 {
+		for iNdEx := len(m.AskDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AskDenoms[iNdEx])
+			copy(dAtA[i:], m.AskDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OfferCoin) > 0 {
+		i -= len(m.OfferCoin)
+		copy(dAtA[i:], m.OfferCoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferCoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ReturnCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OfferDenom) > 0 {
+		i -= len(m.OfferDenom)
+		copy(dAtA[i:], m.OfferDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AskCoin) > 0 {
+		i -= len(m.AskCoin)
+		copy(dAtA[i:], m.AskCoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskCoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapVolumeCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapVolumeCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapVolumeCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnRemaining != nil {
+		{
+			size := m.BurnRemaining.Size()
+			i -= size
+			if _, err := m.BurnRemaining.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MintRemaining != nil {
+		{
+			size := m.MintRemaining.Size()
+			i -= size
+			if _, err := m.MintRemaining.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OfferDenom) > 0 {
+		i -= len(m.OfferDenom)
+		copy(dAtA[i:], m.OfferDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTerraSwapFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTerraSwapFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTerraSwapFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OfferDenom) > 0 {
+		i -= len(m.OfferDenom)
+		copy(dAtA[i:], m.OfferDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTerraSwapFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTerraSwapFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTerraSwapFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TobinTaxFallback {
+		i--
+		if m.TobinTaxFallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTerraSwapFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTerraSwapFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTerraSwapFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTerraSwapFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTerraSwapFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTerraSwapFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TerraSwapFees) > 0 {
+		for iNdEx := len(m.TerraSwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TerraSwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapFeeAccountingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapFeeAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapFeeAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapFeeAccountingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapFeeAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapFeeAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchSwapOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchSwapOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchSwapOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchSwapOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchSwapOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchSwapOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCDenomMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCDenomMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCDenomMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IBCDenom) > 0 {
+		i -= len(m.IBCDenom)
+		copy(dAtA[i:], m.IBCDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IBCDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCDenomMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCDenomMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCDenomMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCDenomMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCDenomMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCDenomMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCDenomMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCDenomMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCDenomMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTerraPoolDeltaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTerraPoolDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTerraPoolDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTerraPoolDeltaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTerraPoolDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTerraPoolDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TerraPoolDelta.Size()
+		i -= size
+		if _, err := m.TerraPoolDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QuerySwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferCoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReturnCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferCoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AskDenoms) > 0 {
+		for _, s := range m.AskDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReturnCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapExactOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AskCoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OfferDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Block.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Epoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SwapVolumeCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MintRemaining != nil {
+		l = m.MintRemaining.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BurnRemaining != nil {
+		l = m.BurnRemaining.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QuerySwapStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTerraSwapFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTerraSwapFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TobinTaxFallback {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTerraSwapFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTerraSwapFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TerraSwapFees) > 0 {
+		for _, e := range m.TerraSwapFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapFeeAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapFeeAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBatchSwapOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchSwapOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCDenomMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IBCDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCDenomMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mapping.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIBCDenomMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCDenomMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTerraPoolDeltaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTerraPoolDeltaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TerraPoolDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferCoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferCoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenoms = append(m.AskDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, SwapHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapExactOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapExactOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapExactOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskCoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapExactOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapExactOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapExactOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapVolumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapVolumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapVolumeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapVolumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SwapVolumeCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapVolumeCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapVolumeCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MintRemaining = &v
+			if err := m.MintRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BurnRemaining = &v
+			if err := m.BurnRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, SwapStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTerraSwapFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTerraSwapFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTerraSwapFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTerraSwapFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTerraSwapFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTerraSwapFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TobinTaxFallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TobinTaxFallback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTerraSwapFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTerraSwapFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTerraSwapFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTerraSwapFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTerraSwapFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTerraSwapFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraSwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerraSwapFees = append(m.TerraSwapFees, TerraSwapFee{})
+			if err := m.TerraSwapFees[len(m.TerraSwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapFeeAccountingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapFeeAccountingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapFeeAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySwapFeeAccountingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapFeeAccountingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapFeeAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Totals = append(m.Totals, SwapFeeTotal{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBatchSwapOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchSwapOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchSwapOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBatchSwapOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchSwapOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchSwapOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, BatchSwapOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCDenomMappingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,15 +5598,15 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCDenomMappingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCDenomMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -847,13 +5634,64 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OfferCoin = string(dAtA[iNdEx:postIndex])
+			m.IBCDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryIBCDenomMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCDenomMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCDenomMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -863,23 +5701,24 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Mapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -903,7 +5742,7 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCDenomMappingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -926,15 +5765,15 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCDenomMappingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCDenomMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -961,7 +5800,131 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReturnCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryIBCDenomMappingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCDenomMappingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCDenomMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, IBCDenomMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pool replenish modes
+const (
+	// ReplenishModeBlock decays the pool delta by 1/PoolRecoveryPeriod every block
+	ReplenishModeBlock = "block"
+	// ReplenishModeLinear moves the pool delta towards zero by BasePool every PoolRecoveryTime
+	ReplenishModeLinear = "linear"
+	// ReplenishModeExponential halves the pool delta every PoolHalfLife
+	ReplenishModeExponential = "exponential"
+)
+
+// ln2 is the natural logarithm of 2 with 18 decimals
+var ln2 = sdk.MustNewDecFromStr("0.693147180559945309")
+
+// maxHalvings is the number of halvings after which the decay factor is treated as zero
+const maxHalvings = 64
+
+// ValidateReplenishMode checks the mode is one of the pool replenish modes
+func ValidateReplenishMode(mode string) error {
+	switch mode {
+	case ReplenishModeBlock, ReplenishModeLinear, ReplenishModeExponential:
+		return nil
+	default:
+		return fmt.Errorf("invalid pool replenish mode: %s", mode)
+	}
+}
+
+// HalfLifeDecayFactor returns 0.5^halfLives, the share of the pool delta left after the given
+// number of half-lives. The fraction of a half-life is computed as exp(-ln2 * fraction) with
+// its Taylor series, which keeps the result deterministic.
+func HalfLifeDecayFactor(halfLives sdk.Dec) sdk.Dec {
+	if !halfLives.IsPositive() {
+		return sdk.OneDec()
+	}
+
+	halvings := halfLives.TruncateInt()
+	if halvings.GTE(sdk.NewInt(maxHalvings)) {
+		return sdk.ZeroDec()
+	}
+
+	factor := sdk.NewDecWithPrec(5, 1).Power(halvings.Uint64())
+
+	// exp(-x) = sum((-x)^i / i!) converges fast as 0 <= x < ln2
+	x := halfLives.Sub(sdk.NewDecFromInt(halvings)).Mul(ln2)
+	term := sdk.OneDec()
+	sum := sdk.OneDec()
+	for i := int64(1); !term.IsZero(); i++ {
+		term = term.Mul(x).QuoInt64(i).Neg()
+		sum = sum.Add(term)
+	}
+
+	return factor.Mul(sum)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHalfLifeDecayFactor(t *testing.T) {
+	tolerance := sdk.NewDecWithPrec(1, 15)
+
+	tests := []struct {
+		halfLives sdk.Dec
+		expected  sdk.Dec
+	}{
+		{sdk.ZeroDec(), sdk.OneDec()},
+		{sdk.NewDec(-1), sdk.OneDec()},
+		{sdk.OneDec(), sdk.NewDecWithPrec(5, 1)},
+		{sdk.NewDec(3), sdk.NewDecWithPrec(125, 3)},
+		{sdk.NewDecWithPrec(5, 1), sdk.MustNewDecFromStr("0.707106781186547524")},
+		{sdk.NewDecWithPrec(25, 1), sdk.MustNewDecFromStr("0.176776695296636881")},
+		{sdk.NewDec(64), sdk.ZeroDec()},
+	}
+
+	for _, tc := range tests {
+		factor := HalfLifeDecayFactor(tc.halfLives)
+		require.True(t, factor.Sub(tc.expected).Abs().LTE(tolerance), "%s half lives: expected %s, got %s", tc.halfLives, tc.expected, factor)
+	}
+}
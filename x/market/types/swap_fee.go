@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Destinations of the swap fees
+const (
+	SwapFeeDestinationBurn          = "burn"
+	SwapFeeDestinationOracle        = "oracle"
+	SwapFeeDestinationCommunityPool = "community_pool"
+)
+
+// SwapFeeDestinations lists the destinations of the swap fees
+var SwapFeeDestinations = []string{
+	SwapFeeDestinationBurn,
+	SwapFeeDestinationOracle,
+	SwapFeeDestinationCommunityPool,
+}
+
+// ValidateSwapFeeDestination checks the destination is one of the swap fee destinations
+func ValidateSwapFeeDestination(destination string) error {
+	for _, d := range SwapFeeDestinations {
+		if d == destination {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid swap fee destination %s, must be one of %s", destination, strings.Join(SwapFeeDestinations, ", "))
+}
+
+// NewSwapFeeTotal creates a SwapFeeTotal instance
+func NewSwapFeeTotal(destination string, amount sdk.Coins) SwapFeeTotal {
+	return SwapFeeTotal{
+		Destination: destination,
+		Amount:      amount,
+	}
+}
+
+// Validate checks the total has a known destination and valid coins
+func (t SwapFeeTotal) Validate() error {
+	if err := ValidateSwapFeeDestination(t.Destination); err != nil {
+		return err
+	}
+
+	return t.Amount.Validate()
+}
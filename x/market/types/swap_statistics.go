@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSwapStatistics creates a SwapStatistics instance without any swap
+func NewSwapStatistics(offerDenom, askDenom string) SwapStatistics {
+	return SwapStatistics{
+		OfferDenom:  offerDenom,
+		AskDenom:    askDenom,
+		OfferVolume: sdk.ZeroInt(),
+		AskVolume:   sdk.ZeroInt(),
+		SwapFee:     sdk.ZeroInt(),
+		Count:       0,
+	}
+}
+
+// Add returns the statistics with the other statistics of the same denom pair added
+func (s SwapStatistics) Add(other SwapStatistics) SwapStatistics {
+	s.OfferVolume = s.OfferVolume.Add(other.OfferVolume)
+	s.AskVolume = s.AskVolume.Add(other.AskVolume)
+	s.SwapFee = s.SwapFee.Add(other.SwapFee)
+	s.Count += other.Count
+	return s
+}
+
+// Validate checks the statistics have valid denoms and non-negative volumes
+func (s SwapStatistics) Validate() error {
+	if err := sdk.ValidateDenom(s.OfferDenom); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(s.AskDenom); err != nil {
+		return err
+	}
+
+	if s.OfferVolume.IsNil() || s.OfferVolume.IsNegative() ||
+		s.AskVolume.IsNil() || s.AskVolume.IsNegative() ||
+		s.SwapFee.IsNil() || s.SwapFee.IsNegative() {
+		return fmt.Errorf("swap statistics of %s to %s must have positive or zero volumes", s.OfferDenom, s.AskDenom)
+	}
+
+	return nil
+}
+
+// NewSwapStatisticsRecord creates a SwapStatisticsRecord instance
+func NewSwapStatisticsRecord(period uint64, statistics SwapStatistics) SwapStatisticsRecord {
+	return SwapStatisticsRecord{
+		Period:     period,
+		Statistics: statistics,
+	}
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSwapVolumeLimit creates a SwapVolumeLimit instance
+func NewSwapVolumeLimit(denom string, blockLimit, epochLimit sdk.Int) SwapVolumeLimit {
+	return SwapVolumeLimit{
+		Denom:      denom,
+		BlockLimit: blockLimit,
+		EpochLimit: epochLimit,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (l SwapVolumeLimit) String() string {
+	out, _ := yaml.Marshal(l)
+	return string(out)
+}
+
+// SwapVolumeLimits is the type for a list of SwapVolumeLimit
+type SwapVolumeLimits []SwapVolumeLimit
+
+// String implements fmt.Stringer interface
+func (ls SwapVolumeLimits) String() (out string) {
+	for _, l := range ls {
+		out += l.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// LimitOf returns the swap volume limit of the denom, and false when the denom has no limit
+func (ls SwapVolumeLimits) LimitOf(denom string) (SwapVolumeLimit, bool) {
+	for _, l := range ls {
+		if l.Denom == denom {
+			return l, true
+		}
+	}
+
+	return SwapVolumeLimit{}, false
+}
+
+// Validate checks each limit has a valid denom, non-negative caps and no duplicates
+func (ls SwapVolumeLimits) Validate() error {
+	seen := make(map[string]bool, len(ls))
+	for _, l := range ls {
+		if err := sdk.ValidateDenom(l.Denom); err != nil {
+			return err
+		}
+
+		if seen[l.Denom] {
+			return fmt.Errorf("duplicate swap volume limit for %s", l.Denom)
+		}
+		seen[l.Denom] = true
+
+		if l.BlockLimit.IsNil() || l.BlockLimit.IsNegative() {
+			return fmt.Errorf("swap volume block limit of %s must be positive or zero: %s", l.Denom, l.BlockLimit)
+		}
+
+		if l.EpochLimit.IsNil() || l.EpochLimit.IsNegative() {
+			return fmt.Errorf("swap volume epoch limit of %s must be positive or zero: %s", l.Denom, l.EpochLimit)
+		}
+	}
+
+	return nil
+}
+
+// NewSwapVolume creates a SwapVolume instance
+func NewSwapVolume(denom string, volume sdk.Int) SwapVolume {
+	return SwapVolume{
+		Denom:  denom,
+		Volume: volume,
+	}
+}
+
+// NewSwapVolumeCapacity returns the capacity left by the net volume under the limit,
+// without remaining amounts when the limit is zero
+func NewSwapVolumeCapacity(volume, limit sdk.Int) SwapVolumeCapacity {
+	capacity := SwapVolumeCapacity{
+		Volume: volume,
+		Limit:  limit,
+	}
+
+	if limit.IsPositive() {
+		mintRemaining := sdk.MaxInt(limit.Sub(volume), sdk.ZeroInt())
+		burnRemaining := sdk.MaxInt(limit.Add(volume), sdk.ZeroInt())
+		capacity.MintRemaining = &mintRemaining
+		capacity.BurnRemaining = &burnRemaining
+	}
+
+	return capacity
+}
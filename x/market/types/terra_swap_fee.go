@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+)
+
+// NewTerraSwapFee creates a TerraSwapFee instance
+func NewTerraSwapFee(offerDenom, askDenom string, fee sdk.Dec) TerraSwapFee {
+	return TerraSwapFee{
+		OfferDenom: offerDenom,
+		AskDenom:   askDenom,
+		Fee:        fee,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (f TerraSwapFee) String() string {
+	out, _ := yaml.Marshal(f)
+	return string(out)
+}
+
+// Validate checks the fee is set on a pair of distinct terra denoms and is within [0, 1)
+func (f TerraSwapFee) Validate() error {
+	if err := ValidateTerraSwapFeePair(f.OfferDenom, f.AskDenom); err != nil {
+		return err
+	}
+
+	if f.Fee.IsNil() || f.Fee.IsNegative() || f.Fee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("terra swap fee of %s to %s must be within [0, 1): %s", f.OfferDenom, f.AskDenom, f.Fee)
+	}
+
+	return nil
+}
+
+// ValidateTerraSwapFeePair checks both denoms are valid, distinct and not luna
+func ValidateTerraSwapFeePair(offerDenom, askDenom string) error {
+	if err := sdk.ValidateDenom(offerDenom); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(askDenom); err != nil {
+		return err
+	}
+
+	if offerDenom == core.MicroLunaDenom || askDenom == core.MicroLunaDenom {
+		return fmt.Errorf("terra swap fee cannot be set on %s", core.MicroLunaDenom)
+	}
+
+	if offerDenom == askDenom {
+		return fmt.Errorf("terra swap fee cannot be set on the same denom %s", offerDenom)
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -37,6 +38,10 @@ type MsgSwap struct {
 	Trader    string     `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty" yaml:"trader"`
 	OfferCoin types.Coin `protobuf:"bytes,2,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
 	AskDenom  string     `protobuf:"bytes,3,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// min_receive_amount is the minimum amount of the ask denom to receive, the swap fails with less when set
+	MinReceiveAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_receive_amount,json=minReceiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_receive_amount,omitempty" yaml:"min_receive_amount,omitempty"`
+	// max_spread is the maximum spread to charge, the swap fails with a higher spread when set
+	MaxSpread *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_spread,json=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread,omitempty" yaml:"max_spread,omitempty"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -81,6 +86,8 @@ var xxx_messageInfo_MsgSwap proto.InternalMessageInfo
 type MsgSwapResponse struct {
 	SwapCoin types.Coin `protobuf:"bytes,1,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
 	SwapFee  types.Coin `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee" yaml:"swap_fee"`
+	// batch_order_id is the id of the order queued in batch swap mode, with zero swap_coin and swap_fee
+	BatchOrderID uint64 `protobuf:"varint,3,opt,name=batch_order_id,json=batchOrderId,proto3" json:"batch_order_id,omitempty" yaml:"batch_order_id"`
 }
 
 func (m *MsgSwapResponse) Reset()         { *m = MsgSwapResponse{} }
@@ -135,12 +142,23 @@ func (m *MsgSwapResponse) GetSwapFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapResponse) GetBatchOrderID() uint64 {
+	if m != nil {
+		return m.BatchOrderID
+	}
+	return 0
+}
+
 // MsgSwapSend represents a message to swap coin and send all result coin to recipient
 type MsgSwapSend struct {
 	FromAddress string     `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
 	ToAddress   string     `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
 	OfferCoin   types.Coin `protobuf:"bytes,3,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
 	AskDenom    string     `protobuf:"bytes,4,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// min_receive_amount is the minimum amount of the ask denom to receive, the swap fails with less when set
+	MinReceiveAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_receive_amount,json=minReceiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_receive_amount,omitempty" yaml:"min_receive_amount,omitempty"`
+	// max_spread is the maximum spread to charge, the swap fails with a higher spread when set
+	MaxSpread *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_spread,json=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread,omitempty" yaml:"max_spread,omitempty"`
 }
 
 func (m *MsgSwapSend) Reset()         { *m = MsgSwapSend{} }
@@ -185,6 +203,8 @@ var xxx_messageInfo_MsgSwapSend proto.InternalMessageInfo
 type MsgSwapSendResponse struct {
 	SwapCoin types.Coin `protobuf:"bytes,1,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
 	SwapFee  types.Coin `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee" yaml:"swap_fee"`
+	// batch_order_id is the id of the order queued in batch swap mode, with zero swap_coin and swap_fee
+	BatchOrderID uint64 `protobuf:"varint,3,opt,name=batch_order_id,json=batchOrderId,proto3" json:"batch_order_id,omitempty" yaml:"batch_order_id"`
 }
 
 func (m *MsgSwapSendResponse) Reset()         { *m = MsgSwapSendResponse{} }
@@ -239,49 +259,309 @@ func (m *MsgSwapSendResponse) GetSwapFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapSendResponse) GetBatchOrderID() uint64 {
+	if m != nil {
+		return m.BatchOrderID
+	}
+	return 0
+}
+
+// MsgSwapRoute represents a message to swap coin through an ordered list of ask denoms
+type MsgSwapRoute struct {
+	Trader    string     `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty" yaml:"trader"`
+	OfferCoin types.Coin `protobuf:"bytes,2,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
+	// ask_denoms is the ordered route of denoms to swap through, the last one is the denom to receive
+	AskDenoms []string `protobuf:"bytes,3,rep,name=ask_denoms,json=askDenoms,proto3" json:"ask_denoms,omitempty" yaml:"ask_denoms"`
+	// receiver is the recipient of the result coin, the trader receives it when empty
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver,omitempty"`
+	// min_receive_amount is the minimum amount of the last ask denom to receive, the swap fails with less when set
+	MinReceiveAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_receive_amount,json=minReceiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_receive_amount,omitempty" yaml:"min_receive_amount,omitempty"`
+}
+
+func (m *MsgSwapRoute) Reset()         { *m = MsgSwapRoute{} }
+func (m *MsgSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRoute) ProtoMessage()    {}
+func (*MsgSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{4}
+}
+
+func (m *MsgSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRoute.Merge(m, src)
+}
+
+func (m *MsgSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRoute proto.InternalMessageInfo
+
+// MsgSwapRouteResponse defines the Msg/SwapRoute response type.
+type MsgSwapRouteResponse struct {
+	SwapCoin types.Coin `protobuf:"bytes,1,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
+	Hops     []SwapHop  `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops" yaml:"hops"`
+}
+
+func (m *MsgSwapRouteResponse) Reset()         { *m = MsgSwapRouteResponse{} }
+func (m *MsgSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{5}
+}
+
+func (m *MsgSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRouteResponse.Merge(m, src)
+}
+
+func (m *MsgSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRouteResponse proto.InternalMessageInfo
+
+func (m *MsgSwapRouteResponse) GetSwapCoin() types.Coin {
+	if m != nil {
+		return m.SwapCoin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapRouteResponse) GetHops() []SwapHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+// MsgSwapExactOut represents a message to swap coin of the offer denom to receive the ask coin
+type MsgSwapExactOut struct {
+	Trader     string     `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty" yaml:"trader"`
+	AskCoin    types.Coin `protobuf:"bytes,2,opt,name=ask_coin,json=askCoin,proto3" json:"ask_coin" yaml:"ask_coin"`
+	OfferDenom string     `protobuf:"bytes,3,opt,name=offer_denom,json=offerDenom,proto3" json:"offer_denom,omitempty" yaml:"offer_denom"`
+	// max_offer_amount is the maximum amount of the offer denom to pay, the swap fails when more is needed
+	MaxOfferAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_offer_amount,json=maxOfferAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_offer_amount" yaml:"max_offer_amount"`
+	// receiver is the recipient of the ask coin, the trader receives it when empty
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver,omitempty"`
+}
+
+func (m *MsgSwapExactOut) Reset()         { *m = MsgSwapExactOut{} }
+func (m *MsgSwapExactOut) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactOut) ProtoMessage()    {}
+func (*MsgSwapExactOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{6}
+}
+
+func (m *MsgSwapExactOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSwapExactOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSwapExactOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactOut.Merge(m, src)
+}
+
+func (m *MsgSwapExactOut) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSwapExactOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactOut proto.InternalMessageInfo
+
+// MsgSwapExactOutResponse defines the Msg/SwapExactOut response type.
+type MsgSwapExactOutResponse struct {
+	OfferCoin types.Coin `protobuf:"bytes,1,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
+	SwapCoin  types.Coin `protobuf:"bytes,2,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
+	SwapFee   types.Coin `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee" yaml:"swap_fee"`
+}
+
+func (m *MsgSwapExactOutResponse) Reset()         { *m = MsgSwapExactOutResponse{} }
+func (m *MsgSwapExactOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactOutResponse) ProtoMessage()    {}
+func (*MsgSwapExactOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{7}
+}
+
+func (m *MsgSwapExactOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSwapExactOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSwapExactOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactOutResponse.Merge(m, src)
+}
+
+func (m *MsgSwapExactOutResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSwapExactOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactOutResponse proto.InternalMessageInfo
+
+func (m *MsgSwapExactOutResponse) GetOfferCoin() types.Coin {
+	if m != nil {
+		return m.OfferCoin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapExactOutResponse) GetSwapCoin() types.Coin {
+	if m != nil {
+		return m.SwapCoin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapExactOutResponse) GetSwapFee() types.Coin {
+	if m != nil {
+		return m.SwapFee
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgSwap)(nil), "terra.market.v1beta1.MsgSwap")
 	proto.RegisterType((*MsgSwapResponse)(nil), "terra.market.v1beta1.MsgSwapResponse")
 	proto.RegisterType((*MsgSwapSend)(nil), "terra.market.v1beta1.MsgSwapSend")
 	proto.RegisterType((*MsgSwapSendResponse)(nil), "terra.market.v1beta1.MsgSwapSendResponse")
+	proto.RegisterType((*MsgSwapRoute)(nil), "terra.market.v1beta1.MsgSwapRoute")
+	proto.RegisterType((*MsgSwapRouteResponse)(nil), "terra.market.v1beta1.MsgSwapRouteResponse")
+	proto.RegisterType((*MsgSwapExactOut)(nil), "terra.market.v1beta1.MsgSwapExactOut")
+	proto.RegisterType((*MsgSwapExactOutResponse)(nil), "terra.market.v1beta1.MsgSwapExactOutResponse")
 }
 
 func init() { proto.RegisterFile("terra/market/v1beta1/tx.proto", fileDescriptor_7dcd4b152743bd0f) }
 
 var fileDescriptor_7dcd4b152743bd0f = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0x25, 0x55, 0x9b, 0x5c, 0x40, 0xa5, 0x6e, 0x50, 0xd3, 0x48, 0xb5, 0xcb, 0x49, 0x48,
-	0xad, 0x04, 0xb6, 0x52, 0x98, 0xb2, 0x11, 0x50, 0x27, 0x22, 0x21, 0x67, 0x41, 0x2c, 0xd1, 0xc5,
-	0xfe, 0x6c, 0xa2, 0xd4, 0x3e, 0xeb, 0xee, 0xa0, 0xed, 0x1b, 0x30, 0xc2, 0x1b, 0xf4, 0x05, 0x58,
-	0x18, 0x78, 0x04, 0xd4, 0xb1, 0x23, 0x93, 0x85, 0x92, 0x85, 0x39, 0x4f, 0x80, 0xec, 0xbb, 0x38,
-	0x41, 0x42, 0x8d, 0x18, 0x18, 0xd8, 0xbe, 0xf3, 0xef, 0xcf, 0xfd, 0xbe, 0xfb, 0x7c, 0x87, 0x0f,
-	0x24, 0x70, 0x4e, 0xdd, 0x98, 0xf2, 0x09, 0x48, 0xf7, 0x7d, 0x67, 0x04, 0x92, 0x76, 0x5c, 0x79,
-	0xe1, 0xa4, 0x9c, 0x49, 0x66, 0x36, 0x0b, 0xd8, 0x51, 0xb0, 0xa3, 0xe1, 0x76, 0x33, 0x62, 0x11,
-	0x2b, 0x08, 0x6e, 0x5e, 0x29, 0x6e, 0xdb, 0xf2, 0x99, 0x88, 0x99, 0x70, 0x47, 0x54, 0x40, 0xe9,
-	0xe4, 0xb3, 0x71, 0xa2, 0x70, 0xf2, 0x0d, 0xe1, 0xad, 0xbe, 0x88, 0x06, 0xe7, 0x34, 0x35, 0x8f,
-	0xf1, 0xa6, 0xe4, 0x34, 0x00, 0xde, 0x42, 0x87, 0xe8, 0xa8, 0xde, 0xdb, 0x99, 0x67, 0xf6, 0xdd,
-	0x4b, 0x1a, 0x9f, 0x75, 0x89, 0xfa, 0x4e, 0x3c, 0x4d, 0x30, 0x07, 0x18, 0xb3, 0x30, 0x04, 0x3e,
-	0xcc, 0xad, 0x5a, 0x95, 0x43, 0x74, 0xd4, 0x38, 0xd9, 0x77, 0xd4, 0x5e, 0x4e, 0xbe, 0xd7, 0x22,
-	0x96, 0xf3, 0x9c, 0x8d, 0x93, 0xde, 0xfe, 0x75, 0x66, 0x1b, 0xf3, 0xcc, 0xde, 0x51, 0x6e, 0x4b,
-	0x29, 0xf1, 0xea, 0xc5, 0x22, 0x67, 0x99, 0x1d, 0x5c, 0xa7, 0x62, 0x32, 0x0c, 0x20, 0x61, 0x71,
-	0xab, 0x5a, 0x44, 0x68, 0xce, 0x33, 0xfb, 0x9e, 0x12, 0x95, 0x10, 0xf1, 0x6a, 0x54, 0x4c, 0x5e,
-	0xe4, 0x65, 0xb7, 0xf6, 0xe1, 0xca, 0x36, 0x7e, 0x5e, 0xd9, 0x06, 0xf9, 0x82, 0xf0, 0xb6, 0x6e,
-	0xc4, 0x03, 0x91, 0xb2, 0x44, 0x80, 0xf9, 0x0a, 0xd7, 0xc5, 0x39, 0x4d, 0x55, 0x48, 0xb4, 0x2e,
-	0x64, 0x4b, 0x87, 0xd4, 0xfb, 0x95, 0x4a, 0xe2, 0xd5, 0xf2, 0xba, 0x88, 0xd8, 0xc7, 0x45, 0x3d,
-	0x0c, 0x01, 0xd6, 0x77, 0xbd, 0xa7, 0x0d, 0xb7, 0x57, 0x0c, 0x43, 0x00, 0xe2, 0x6d, 0xe5, 0xe5,
-	0x29, 0x00, 0xf9, 0x54, 0xc1, 0x0d, 0x1d, 0x7a, 0x00, 0x49, 0x60, 0x76, 0xf1, 0x9d, 0x90, 0xb3,
-	0x78, 0x48, 0x83, 0x80, 0x83, 0x10, 0x7a, 0x0e, 0x7b, 0xf3, 0xcc, 0xde, 0x55, 0x1e, 0xab, 0x28,
-	0xf1, 0x1a, 0xf9, 0xf2, 0x99, 0x5a, 0x99, 0x4f, 0x31, 0x96, 0xac, 0x54, 0x56, 0x0a, 0xe5, 0xfd,
-	0xe5, 0x99, 0x2f, 0x31, 0xe2, 0xd5, 0x25, 0x5b, 0xa8, 0x7e, 0x1f, 0x64, 0xf5, 0x1f, 0x0c, 0x72,
-	0xe3, 0x2f, 0x07, 0xf9, 0x15, 0xe1, 0xdd, 0x95, 0x33, 0xf9, 0x6f, 0x86, 0x79, 0xf2, 0x19, 0xe1,
-	0x6a, 0x5f, 0x44, 0xe6, 0x4b, 0xbc, 0x51, 0x5c, 0xa7, 0x03, 0xe7, 0x4f, 0xf7, 0xd4, 0xd1, 0xbd,
-	0xb5, 0x1f, 0xde, 0x0a, 0x97, 0x6d, 0xbf, 0xc6, 0xb5, 0xf2, 0xf7, 0x78, 0x70, 0xab, 0x24, 0xa7,
-	0xb4, 0x8f, 0xd7, 0x52, 0x16, 0xce, 0xbd, 0xd3, 0xeb, 0xa9, 0x85, 0x6e, 0xa6, 0x16, 0xfa, 0x31,
-	0xb5, 0xd0, 0xc7, 0x99, 0x65, 0xdc, 0xcc, 0x2c, 0xe3, 0xfb, 0xcc, 0x32, 0xde, 0x3c, 0x8a, 0xc6,
-	0xf2, 0xed, 0xbb, 0x91, 0xe3, 0xb3, 0xd8, 0xf5, 0xcf, 0xa8, 0x10, 0x63, 0xff, 0xb1, 0x7a, 0x92,
-	0x7c, 0xc6, 0xc1, 0xbd, 0x58, 0xbc, 0x4c, 0xf2, 0x32, 0x05, 0x31, 0xda, 0x2c, 0x5e, 0x92, 0x27,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x0a, 0xcb, 0x76, 0xb6, 0x04, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0xe3, 0xb4, 0x4d, 0x26, 0x61, 0xb7, 0xeb, 0x76, 0x69, 0x1a, 0xd4, 0xb8, 0x3b, 0x08,
+	0xd4, 0xa2, 0xad, 0xa3, 0x96, 0x95, 0x10, 0xbd, 0x6d, 0x28, 0x15, 0x95, 0xa8, 0x0a, 0xee, 0x05,
+	0xc1, 0xc1, 0x9a, 0xd8, 0x93, 0xd4, 0xca, 0xda, 0x63, 0x79, 0xa6, 0xbb, 0xe9, 0x89, 0x03, 0x07,
+	0x10, 0x17, 0xf8, 0x09, 0xfb, 0x13, 0xe0, 0x5f, 0xec, 0x71, 0x8f, 0x88, 0x83, 0x85, 0xda, 0x0b,
+	0x47, 0xe4, 0x33, 0x42, 0x68, 0x3e, 0xfc, 0x91, 0x65, 0xe9, 0x87, 0x76, 0x4b, 0x0f, 0x9c, 0x3a,
+	0xf6, 0xf3, 0xbe, 0xcf, 0x3b, 0x99, 0xf7, 0x79, 0xde, 0x8e, 0xc1, 0x0a, 0xc3, 0x71, 0x8c, 0x7a,
+	0x01, 0x8a, 0xc7, 0x98, 0xf5, 0x1e, 0x6f, 0x0e, 0x30, 0x43, 0x9b, 0x3d, 0x36, 0xb1, 0xa2, 0x98,
+	0x30, 0x62, 0x2c, 0x0a, 0xd8, 0x92, 0xb0, 0xa5, 0xe0, 0xce, 0xe2, 0x88, 0x8c, 0x88, 0x08, 0xe8,
+	0xf1, 0x95, 0x8c, 0xed, 0x74, 0x5d, 0x42, 0x03, 0x42, 0x7b, 0x03, 0x44, 0x71, 0xce, 0xe4, 0x12,
+	0x3f, 0x54, 0xf8, 0xbd, 0x97, 0x96, 0x52, 0xd4, 0x22, 0x04, 0xfe, 0xac, 0x83, 0xb9, 0x7d, 0x3a,
+	0x3a, 0x7c, 0x82, 0x22, 0x63, 0x1d, 0xcc, 0xb2, 0x18, 0x79, 0x38, 0x6e, 0x6b, 0xab, 0xda, 0x5a,
+	0xa3, 0x7f, 0x27, 0x4d, 0xcc, 0x37, 0x4e, 0x50, 0xf0, 0x68, 0x1b, 0xca, 0xf7, 0xd0, 0x56, 0x01,
+	0xc6, 0x21, 0x00, 0x64, 0x38, 0xc4, 0xb1, 0xc3, 0xab, 0xb5, 0xab, 0xab, 0xda, 0x5a, 0x73, 0x6b,
+	0xd9, 0x92, 0xdb, 0xb1, 0xf8, 0x76, 0xb2, 0x9d, 0x5b, 0x1f, 0x11, 0x3f, 0xec, 0x2f, 0x3f, 0x4b,
+	0xcc, 0x4a, 0x9a, 0x98, 0x77, 0x24, 0x5b, 0x91, 0x0a, 0xed, 0x86, 0x78, 0xe0, 0x51, 0xc6, 0x26,
+	0x68, 0x20, 0x3a, 0x76, 0x3c, 0x1c, 0x92, 0xa0, 0xad, 0x8b, 0x2d, 0x2c, 0xa6, 0x89, 0x39, 0x2f,
+	0x93, 0x72, 0x08, 0xda, 0x75, 0x44, 0xc7, 0x3b, 0x7c, 0x69, 0x7c, 0x0d, 0x8c, 0xc0, 0x0f, 0x9d,
+	0x18, 0xbb, 0xd8, 0x7f, 0x8c, 0x1d, 0x14, 0x90, 0xe3, 0x90, 0xb5, 0x6b, 0x22, 0xf7, 0xf3, 0x5f,
+	0x13, 0xf3, 0xdd, 0x91, 0xcf, 0x8e, 0x8e, 0x07, 0x96, 0x4b, 0x82, 0x9e, 0x3a, 0x2c, 0xf9, 0x67,
+	0x83, 0x7a, 0xe3, 0x1e, 0x3b, 0x89, 0x30, 0xb5, 0xf6, 0x42, 0x96, 0x26, 0xe6, 0xdb, 0xb2, 0xca,
+	0x3f, 0xd9, 0xee, 0x93, 0xc0, 0x67, 0x38, 0x88, 0xd8, 0x09, 0xb4, 0xe7, 0x03, 0x3f, 0xb4, 0x25,
+	0xfa, 0x50, 0x80, 0xc6, 0x11, 0x00, 0x01, 0x9a, 0x38, 0x34, 0x8a, 0x31, 0xf2, 0xda, 0x33, 0xa2,
+	0xf0, 0xde, 0x25, 0x0b, 0xef, 0x60, 0x37, 0x4d, 0xcc, 0xb7, 0x54, 0xe1, 0x9c, 0xa5, 0x5c, 0xb0,
+	0x11, 0xa0, 0xc9, 0xa1, 0x78, 0xbb, 0x5d, 0xff, 0xee, 0xa9, 0x59, 0xf9, 0xfd, 0xa9, 0x59, 0x81,
+	0xdf, 0x54, 0xc1, 0x6d, 0xd5, 0x33, 0x1b, 0xd3, 0x88, 0x84, 0x14, 0x1b, 0x9f, 0x81, 0x06, 0x7d,
+	0x82, 0x22, 0xd9, 0x0f, 0xed, 0xa2, 0x7e, 0xb4, 0x55, 0x3f, 0xd4, 0xd1, 0xe6, 0x99, 0xd0, 0xae,
+	0xf3, 0xb5, 0xe8, 0xc6, 0x3e, 0x10, 0x6b, 0x67, 0x88, 0xf1, 0xc5, 0x0d, 0x5e, 0x52, 0x84, 0xb7,
+	0x4b, 0x84, 0x43, 0x8c, 0xa1, 0x3d, 0xc7, 0x97, 0xbb, 0x18, 0x1b, 0x07, 0xe0, 0xd6, 0x00, 0x31,
+	0xf7, 0xc8, 0x21, 0xb1, 0x87, 0x63, 0xc7, 0xf7, 0x44, 0x87, 0x6b, 0xfd, 0xf5, 0xd3, 0xc4, 0x6c,
+	0xf5, 0x39, 0x72, 0xc0, 0x81, 0xbd, 0x9d, 0x34, 0x31, 0xef, 0x4a, 0x96, 0xe9, 0x78, 0x68, 0xb7,
+	0x06, 0x45, 0x98, 0x07, 0xff, 0xd4, 0x41, 0x53, 0x9d, 0xc2, 0x21, 0x0e, 0x3d, 0x63, 0x1b, 0xb4,
+	0x86, 0x31, 0x09, 0x1c, 0xe4, 0x79, 0x31, 0xa6, 0x54, 0x69, 0x78, 0x29, 0x4d, 0xcc, 0x05, 0x49,
+	0x57, 0x46, 0xa1, 0xdd, 0xe4, 0x8f, 0x0f, 0xe5, 0x93, 0xf1, 0x00, 0x00, 0x46, 0xf2, 0xcc, 0xaa,
+	0xc8, 0xbc, 0x5b, 0xe8, 0xb5, 0xc0, 0xa0, 0xdd, 0x60, 0x24, 0xcb, 0x9a, 0x36, 0x81, 0x7e, 0x0d,
+	0x26, 0xa8, 0xbd, 0x82, 0x09, 0x66, 0x6e, 0xca, 0x04, 0xb3, 0xff, 0x89, 0x09, 0xbe, 0xad, 0x82,
+	0x85, 0x52, 0xfb, 0xff, 0xc7, 0x46, 0xf8, 0x5e, 0x07, 0xad, 0x6c, 0x1c, 0x90, 0x63, 0x86, 0x6f,
+	0x7c, 0x8e, 0x3f, 0x00, 0x20, 0xd7, 0x29, 0x6d, 0xeb, 0xab, 0xfa, 0xb4, 0x9b, 0x0a, 0x0c, 0xda,
+	0x8d, 0x4c, 0xc4, 0xd4, 0xf8, 0x10, 0xd4, 0x95, 0xe6, 0x62, 0xa5, 0xfb, 0x95, 0x34, 0x31, 0x97,
+	0x65, 0x4e, 0x86, 0x94, 0x65, 0x91, 0x87, 0xdf, 0xb8, 0x01, 0x4a, 0xb2, 0xfc, 0x49, 0x03, 0x8b,
+	0xe5, 0x66, 0x5c, 0xa3, 0x2e, 0x77, 0x41, 0xed, 0x88, 0x44, 0x7c, 0x5c, 0xe9, 0x6b, 0xcd, 0xad,
+	0x15, 0xeb, 0x65, 0x17, 0x07, 0x8b, 0x6f, 0xe4, 0x13, 0x12, 0xf5, 0x17, 0x14, 0x61, 0x53, 0x12,
+	0xf2, 0x44, 0x68, 0x8b, 0x7c, 0xf8, 0x57, 0xf1, 0xef, 0xe4, 0xe3, 0x09, 0x72, 0xd9, 0xc1, 0x31,
+	0xbb, 0x8a, 0x84, 0xf6, 0x01, 0x9f, 0x44, 0x97, 0x14, 0xd0, 0x0b, 0xf6, 0xc8, 0x12, 0xa1, 0x3d,
+	0x87, 0xe8, 0x58, 0xfc, 0xaa, 0x0f, 0x40, 0x53, 0xca, 0xaa, 0x7c, 0x0d, 0x78, 0x33, 0x4d, 0x4c,
+	0xa3, 0xac, 0x39, 0x35, 0x03, 0xa5, 0x78, 0xe5, 0x14, 0xa4, 0x60, 0x9e, 0x8f, 0x0f, 0x89, 0x4f,
+	0x5d, 0x04, 0xf6, 0x78, 0xd1, 0x2b, 0xc9, 0x60, 0xa9, 0x18, 0x47, 0x65, 0x3e, 0x68, 0xdf, 0x0a,
+	0xd0, 0xe4, 0x80, 0xbf, 0x51, 0x93, 0xaf, 0x2c, 0xda, 0x99, 0x2b, 0x89, 0xb6, 0xa4, 0x99, 0x1f,
+	0xaa, 0x60, 0xe9, 0x85, 0x06, 0xe4, 0xb2, 0x99, 0x36, 0xa8, 0xf6, 0x7a, 0x0c, 0x3a, 0xa5, 0xc5,
+	0xea, 0xeb, 0x9e, 0x91, 0xfa, 0x2b, 0xcf, 0xc8, 0xad, 0x3f, 0xaa, 0x40, 0xdf, 0xa7, 0x23, 0xe3,
+	0x53, 0x50, 0x13, 0x37, 0xd3, 0x7f, 0x11, 0xb7, 0x3a, 0xb4, 0xce, 0x3b, 0xe7, 0xc2, 0xf9, 0x59,
+	0x7e, 0x01, 0xea, 0xf9, 0x6d, 0xe1, 0xde, 0xb9, 0x29, 0x3c, 0xa4, 0xb3, 0x7e, 0x61, 0x48, 0xce,
+	0xfc, 0x15, 0x68, 0x14, 0xe3, 0x17, 0x9e, 0xbf, 0x1b, 0x1e, 0xd3, 0x79, 0xef, 0xe2, 0x98, 0x9c,
+	0xdc, 0x03, 0xad, 0x29, 0x6f, 0x9e, 0xff, 0x6b, 0xb3, 0xb0, 0xce, 0xc6, 0xa5, 0xc2, 0xb2, 0x2a,
+	0xfd, 0xdd, 0x67, 0xa7, 0x5d, 0xed, 0xf9, 0x69, 0x57, 0xfb, 0xed, 0xb4, 0xab, 0xfd, 0x78, 0xd6,
+	0xad, 0x3c, 0x3f, 0xeb, 0x56, 0x7e, 0x39, 0xeb, 0x56, 0xbe, 0xbc, 0x5f, 0xb6, 0xcd, 0x23, 0x44,
+	0xa9, 0xef, 0x6e, 0xc8, 0x0f, 0x0b, 0x97, 0xc4, 0xb8, 0x37, 0xc9, 0xbe, 0x2f, 0x84, 0x81, 0x06,
+	0xb3, 0xe2, 0xbb, 0xe2, 0xfd, 0xbf, 0x07, 0x00, 0x56, 0xf1, 0x6c, 0xf2, 0xe7, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -304,6 +584,12 @@ type MsgClient interface {
 	// SwapSend defines a method for swapping and sending coin from a account to other
 	// account.
 	SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.CallOption) (*MsgSwapSendResponse, error)
+	// SwapRoute defines a method for swapping coin through an ordered list of
+	// denoms in a single atomic operation.
+	SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error)
+	// SwapExactOut defines a method for swapping coin from one denom to receive
+	// an exact amount of another denom.
+	SwapExactOut(ctx context.Context, in *MsgSwapExactOut, opts ...grpc.CallOption) (*MsgSwapExactOutResponse, error)
 }
 
 type msgClient struct {
@@ -332,6 +618,24 @@ func (c *msgClient) SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error) {
+	out := new(MsgSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Msg/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactOut(ctx context.Context, in *MsgSwapExactOut, opts ...grpc.CallOption) (*MsgSwapExactOutResponse, error) {
+	out := new(MsgSwapExactOutResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Msg/SwapExactOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Swap defines a method for swapping coin from one denom to another
@@ -340,6 +644,12 @@ type MsgServer interface {
 	// SwapSend defines a method for swapping and sending coin from a account to other
 	// account.
 	SwapSend(context.Context, *MsgSwapSend) (*MsgSwapSendResponse, error)
+	// SwapRoute defines a method for swapping coin through an ordered list of
+	// denoms in a single atomic operation.
+	SwapRoute(context.Context, *MsgSwapRoute) (*MsgSwapRouteResponse, error)
+	// SwapExactOut defines a method for swapping coin from one denom to receive
+	// an exact amount of another denom.
+	SwapExactOut(context.Context, *MsgSwapExactOut) (*MsgSwapExactOutResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -353,6 +663,14 @@ func (*UnimplementedMsgServer) SwapSend(ctx context.Context, req *MsgSwapSend) (
 	return nil, status.Errorf(codes.Unimplemented, "method SwapSend not implemented")
 }
 
+func (*UnimplementedMsgServer) SwapRoute(ctx context.Context, req *MsgSwapRoute) (*MsgSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
+
+func (*UnimplementedMsgServer) SwapExactOut(ctx context.Context, req *MsgSwapExactOut) (*MsgSwapExactOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactOut not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -393,6 +711,42 @@ func _Msg_SwapSend_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Msg/SwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapRoute(ctx, req.(*MsgSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Msg/SwapExactOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactOut(ctx, req.(*MsgSwapExactOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.market.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -405,6 +759,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapSend",
 			Handler:    _Msg_SwapSend_Handler,
 		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Msg_SwapRoute_Handler,
+		},
+		{
+			MethodName: "SwapExactOut",
+			Handler:    _Msg_SwapExactOut_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/market/v1beta1/tx.proto",
@@ -430,6 +792,30 @@ func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpread != nil {
+		{
+			size := m.MaxSpread.Size()
+			i -= size
+			if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinReceiveAmount != nil {
+		{
+			size := m.MinReceiveAmount.Size()
+			i -= size
+			if _, err := m.MinReceiveAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.AskDenom) > 0 {
 		i -= len(m.AskDenom)
 		copy(dAtA[i:], m.AskDenom)
@@ -477,6 +863,11 @@ func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchOrderID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchOrderID))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.SwapFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -520,6 +911,30 @@ func (m *MsgSwapSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpread != nil {
+		{
+			size := m.MaxSpread.Size()
+			i -= size
+			if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinReceiveAmount != nil {
+		{
+			size := m.MinReceiveAmount.Size()
+			i -= size
+			if _, err := m.MinReceiveAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.AskDenom) > 0 {
 		i -= len(m.AskDenom)
 		copy(dAtA[i:], m.AskDenom)
@@ -574,6 +989,11 @@ func (m *MsgSwapSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchOrderID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchOrderID))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.SwapFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -597,47 +1017,290 @@ func (m *MsgSwapSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *MsgSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.OfferCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.AskDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SwapCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.MinReceiveAmount != nil {
+		{
+			size := m.MinReceiveAmount.Size()
+			i -= size
+			if _, err := m.MinReceiveAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AskDenoms) > 0 {
+		for iNdEx := len(m.AskDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AskDenoms[iNdEx])
+			copy(dAtA[i:], m.AskDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AskDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SwapCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MaxOfferAmount.Size()
+		i -= size
+		if _, err := m.MaxOfferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.OfferDenom) > 0 {
+		i -= len(m.OfferDenom)
+		copy(dAtA[i:], m.OfferDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OfferDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AskCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SwapFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SwapCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *MsgSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinReceiveAmount != nil {
+		l = m.MinReceiveAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpread != nil {
+		l = m.MaxSpread.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = m.SwapFee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.BatchOrderID != 0 {
+		n += 1 + sovTx(uint64(m.BatchOrderID))
+	}
 	return n
 }
 
@@ -661,31 +1324,872 @@ func (m *MsgSwapSend) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if m.MinReceiveAmount != nil {
+		l = m.MinReceiveAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpread != nil {
+		l = m.MaxSpread.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.BatchOrderID != 0 {
+		n += 1 + sovTx(uint64(m.BatchOrderID))
+	}
+	return n
+}
+
+func (m *MsgSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.AskDenoms) > 0 {
+		for _, s := range m.AskDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinReceiveAmount != nil {
+		l = m.MinReceiveAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSwapExactOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AskCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.OfferDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxOfferAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapExactOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SwapCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceiveAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinReceiveAmount = &v
+			if err := m.MinReceiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSpread = &v
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchOrderID", wireType)
+			}
+			m.BatchOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceiveAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinReceiveAmount = &v
+			if err := m.MinReceiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSpread = &v
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchOrderID", wireType)
+			}
+			m.BatchOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSwapSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.SwapCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.SwapFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -708,10 +2212,10 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -781,7 +2285,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -809,7 +2313,75 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			m.AskDenoms = append(m.AskDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceiveAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinReceiveAmount = &v
+			if err := m.MinReceiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -833,7 +2405,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -856,10 +2428,10 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -897,7 +2469,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,7 +2496,8 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hops = append(m.Hops, SwapHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -950,7 +2523,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -973,15 +2546,15 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1009,11 +2582,44 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1041,13 +2647,13 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.OfferDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOfferAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1057,28 +2663,29 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxOfferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1106,7 +2713,7 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1130,7 +2737,7 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1153,13 +2760,46 @@ func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SwapCoin", wireType)
 			}
@@ -1192,7 +2832,7 @@ func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
 			}
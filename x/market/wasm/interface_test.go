@@ -33,6 +33,8 @@ func TestEncoding(t *testing.T) {
 	}
 
 	invalidAddr := "xrnd1d02kd90n38qvr3qb9qof83fn2d2"
+	minReceiveAmount := sdk.NewInt(1000)
+	maxSpread := sdk.NewDecWithPrec(2, 2)
 
 	cases := map[string]struct {
 		sender sdk.AccAddress
@@ -75,6 +77,55 @@ func TestEncoding(t *testing.T) {
 				AskDenom:    core.MicroSDRDenom,
 			},
 		},
+		"swap with slippage protection": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(
+					fmt.Sprintf(
+						`{"swap": {"trader": "%s", "offer_coin": {"amount": "1234", "denom": "%s"}, "ask_denom": "%s", "min_receive_amount": "1000", "max_spread": "0.02"}}`,
+						addrs[0], core.MicroLunaDenom, core.MicroSDRDenom,
+					),
+				),
+			},
+			output: &types.MsgSwap{
+				Trader:           addrs[0].String(),
+				OfferCoin:        sdk.NewInt64Coin(core.MicroLunaDenom, 1234),
+				AskDenom:         core.MicroSDRDenom,
+				MinReceiveAmount: &minReceiveAmount,
+				MaxSpread:        &maxSpread,
+			},
+		},
+		"swap send with slippage protection": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(
+					fmt.Sprintf(
+						`{"swap_send": {"from_address": "%s", "to_address": "%s", "offer_coin": {"amount": "1234", "denom": "%s"}, "ask_denom": "%s", "min_receive_amount": "1000", "max_spread": "0.02"}}`,
+						addrs[0], addrs[1], core.MicroLunaDenom, core.MicroSDRDenom,
+					),
+				),
+			},
+			output: &types.MsgSwapSend{
+				FromAddress:      addrs[0].String(),
+				ToAddress:        addrs[1].String(),
+				OfferCoin:        sdk.NewInt64Coin(core.MicroLunaDenom, 1234),
+				AskDenom:         core.MicroSDRDenom,
+				MinReceiveAmount: &minReceiveAmount,
+				MaxSpread:        &maxSpread,
+			},
+		},
+		"invalid slippage protection": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(
+					fmt.Sprintf(
+						`{"swap": {"trader": "%s", "offer_coin": {"amount": "1234", "denom": "%s"}, "ask_denom": "%s", "max_spread": "1.5"}}`,
+						addrs[0], core.MicroLunaDenom, core.MicroSDRDenom,
+					),
+				),
+			},
+			isError: true,
+		},
 		"invalid swap amount": {
 			sender: addrs[0],
 			input: wasmvmtypes.CosmosMsg{
@@ -62,40 +62,35 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			ballotRT := voteMap[referenceTerra]
 			voteMapRT := ballotRT.ToMap()
 
-			var exchangeRateRT sdk.Dec
-
-			// softfork
-			if (ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() < int64(5_701_000)) ||
-				(ctx.ChainID() == core.BombayChainID && ctx.BlockHeight() < int64(7_000_000)) {
-				exchangeRateRT = ballotRT.WeightedMedian()
-			} else {
-				exchangeRateRT = ballotRT.WeightedMedianWithAssertion()
-			}
+			// Reference Terra exchange rate to calculate cross exchange rates with
+			exchangeRateRT, _ := k.AggregationStrategy(ctx, referenceTerra).Aggregate(ballotRT, params.Whitelist.RewardBandOf(referenceTerra, params.RewardBand))
 
 			// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
 			for denom, ballot := range voteMap {
 
 				// Convert ballot to cross exchange rates
 				if denom != referenceTerra {
-					// softfork
-					if (ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() < int64(5_701_000)) ||
-						(ctx.ChainID() == core.BombayChainID && ctx.BlockHeight() < int64(7_000_000)) {
-						ballot = ballot.ToCrossRate(voteMapRT)
-					} else {
-						ballot = ballot.ToCrossRateWithSort(voteMapRT)
-					}
+					ballot = ballot.ToCrossRate(voteMapRT)
 				}
 
-				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				// Aggregate cross exchange rates with the strategy and reward band of the denom
+				exchangeRate := Tally(ballot, k.AggregationStrategy(ctx, denom), params.Whitelist.RewardBandOf(denom, params.RewardBand), validatorClaimMap)
 
 				// Transform into the original form uluna/stablecoin
 				if denom != referenceTerra {
 					exchangeRate = exchangeRateRT.Quo(exchangeRate)
 				}
 
+				// Hold the exchange rate back if the denom is halted by the circuit breaker
+				if !k.ApplyCircuitBreaker(ctx, denom, exchangeRate) {
+					continue
+				}
+
 				// Set the exchange rate, emit ABCI event
 				k.SetLunaExchangeRateWithEvent(ctx, denom, exchangeRate)
+
+				// Keep a snapshot for historic and TWAP queries
+				k.AddHistoricExchangeRate(ctx, denom, exchangeRate)
 			}
 		}
 
@@ -112,6 +107,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
 		}
 
+		// Record the outcome of the vote period to the validator performances
+		k.RecordVotePeriodPerformances(ctx, voteTargets, validatorClaimMap)
+
 		// Distribute rewards to ballot winners
 		k.RewardBallotWinners(
 			ctx,
@@ -124,13 +122,23 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		// Clear the ballot
 		k.ClearBallots(ctx, params.VotePeriod)
 
+		// Apply the whitelist updates scheduled by governance reaching the activation height
+		k.ApplyWhitelistUpdates(ctx)
+
 		// Update vote targets and tobin tax
-		k.ApplyWhitelist(ctx, params.Whitelist, voteTargets)
+		k.ApplyWhitelist(ctx, k.Whitelist(ctx), voteTargets)
 	}
 
 	// Do slash who did miss voting over threshold and
 	// reset miss counters of all validators at the last block of slash window
 	if core.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+
+		// Drop the validator performances older than the history
+		k.PruneValidatorPerformances(ctx)
 	}
+
+	// Replace the feeders of the rotations reaching the activation height,
+	// after the votes of the block are accepted from both feeders
+	k.ApplyFeederRotations(ctx)
 }
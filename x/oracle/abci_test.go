@@ -200,7 +200,7 @@ func TestOracleTally(t *testing.T) {
 		}
 	}
 
-	tallyMedian := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
+	tallyMedian := oracle.Tally(ballot, input.OracleKeeper.AggregationStrategy(input.Ctx, core.MicroSDRDenom), input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
 
 	require.Equal(t, validatorClaimMap, expectedValidatorClaimMap)
 	require.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
@@ -298,6 +298,156 @@ func TestOracleRewardBand(t *testing.T) {
 	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
 }
 
+func TestOracleDenomRewardBand(t *testing.T) {
+	input, h := setup(t)
+	rewardBand := sdk.NewDecWithPrec(5, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, RewardBand: &rewardBand}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	// Account 1 is out of the global reward band, but within the one of the denom
+	globalRewardSpread := randomExchangeRate.Mul(params.RewardBand.QuoInt64(2))
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate.Sub(globalRewardSpread.Add(sdk.OneDec()))}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 2)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[1]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+}
+
+func TestOracleDenomVoteThreshold(t *testing.T) {
+	input, h := setup(t)
+	voteThreshold := sdk.OneDec()
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, VoteThreshold: &voteThreshold},
+		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, types.DefaultTobinTax)
+
+	// two thirds of the voting power pass the global threshold, but not the one of KRW
+	rates := sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}, {Denom: core.MicroSDRDenom, Amount: randomExchangeRate}}
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.Error(t, err)
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+}
+
+func TestOracleCircuitBreaker(t *testing.T) {
+	input, h := setup(t)
+	maxDeviation := sdk.NewDecWithPrec(1, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, MaxDeviation: &maxDeviation}}
+	params.CircuitBreakerRecoveryPeriods = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	tally := func(exchangeRate sdk.Dec) {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: exchangeRate}}, i)
+		}
+
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	// No previous exchange rate to deviate from
+	tally(randomExchangeRate)
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	// Deviation over 10% halts the denom
+	tally(randomExchangeRate.MulInt64(2))
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.ErrorIs(t, err, types.ErrDenomHalted)
+
+	haltedDenom, err := input.OracleKeeper.GetHaltedDenom(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, haltedDenom.ReferenceRate)
+	require.Equal(t, randomExchangeRate.MulInt64(2), haltedDenom.LastRate)
+	require.Equal(t, uint64(0), haltedDenom.InBandTallies)
+
+	// In-band tally counts towards the recovery
+	tally(randomExchangeRate.Mul(sdk.NewDecWithPrec(105, 2)))
+	haltedDenom, err = input.OracleKeeper.GetHaltedDenom(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), haltedDenom.InBandTallies)
+
+	// Out-of-band tally resets the recovery
+	tally(randomExchangeRate.MulInt64(2))
+	haltedDenom, err = input.OracleKeeper.GetHaltedDenom(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), haltedDenom.InBandTallies)
+
+	// Consecutive in-band tallies resume the denom
+	tally(randomExchangeRate)
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.ErrorIs(t, err, types.ErrDenomHalted)
+
+	tally(randomExchangeRate)
+	require.False(t, input.OracleKeeper.IsDenomHalted(input.Ctx, core.MicroKRWDenom))
+	rate, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+}
+
+func TestOracleCircuitBreakerAfterFailedPeriod(t *testing.T) {
+	input, h := setup(t)
+	maxDeviation := sdk.NewDecWithPrec(1, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, MaxDeviation: &maxDeviation}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	for i := 0; i < 3; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, i)
+	}
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// the ballot fails without votes, clearing the exchange rate
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + int64(params.VotePeriod))
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	_, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// an out-of-band rate is still checked against the last applied exchange rate
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + int64(params.VotePeriod))
+	for i := 0; i < 3; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate.MulInt64(2)}}, i)
+	}
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.ErrorIs(t, err, types.ErrDenomHalted)
+	haltedDenom, err := input.OracleKeeper.GetHaltedDenom(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, haltedDenom.ReferenceRate)
+}
+
 func TestOracleMultiRewardDistribution(t *testing.T) {
 	input, h := setup(t)
 
@@ -584,6 +734,47 @@ func TestAbstainSlashing(t *testing.T) {
 	require.Equal(t, stakingAmt, validator.GetBondedTokens())
 }
 
+func TestValidatorPerformanceRecording(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	rewardsAmt := sdk.NewInt(100000000)
+	err := input.BankKeeper.MintCoins(input.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, rewardsAmt)))
+	require.NoError(t, err)
+
+	// Account 1, KRW
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 0)
+
+	// Account 2, KRW, abstain vote
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: sdk.ZeroDec()}}, 1)
+
+	// Account 3, KRW
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 2)
+
+	ctx := input.Ctx.WithBlockHeight(1)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	window := input.OracleKeeper.PerformanceWindow(ctx)
+	performance := input.OracleKeeper.GetValidatorPerformance(ctx, keeper.ValAddrs[0], window)
+	require.Equal(t, uint64(1), performance.VotePeriods)
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(0), performance.Abstains)
+	require.Equal(t, uint64(0), performance.Misses)
+	require.Equal(t, uint64(1), performance.WinCount)
+	require.True(t, performance.Rewards.AmountOf(core.MicroLunaDenom).IsPositive())
+
+	performance = input.OracleKeeper.GetValidatorPerformance(ctx, keeper.ValAddrs[1], window)
+	require.Equal(t, uint64(1), performance.VotePeriods)
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(1), performance.Abstains)
+}
+
 func TestVoteTargets(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
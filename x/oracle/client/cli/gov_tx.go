@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/classic-terra/core/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAggregationStrategy = "aggregation-strategy"
+	flagVoteThreshold       = "vote-threshold"
+	flagRewardBand          = "reward-band"
+	flagMaxDeviation        = "max-deviation"
+)
+
+func ProposalResumeDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-oracle-denom [denom] --title [text] --description [text]",
+		Short: "Submit a proposal to resume a denom halted by the oracle circuit breaker",
+		Long: fmt.Sprintf(`Submit a proposal to resume a denom halted by the oracle circuit breaker.
+Example:
+$ %s tx gov submit-proposal resume-oracle-denom ukrw --title "resume ukrw" --description "resume the ukrw exchange rate"
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				return types.NewResumeDenomProposal(title, description, args[0]), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalAddOracleDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-oracle-denom [denom] [tobin-tax] [activation-height] --title [text] --description [text]",
+		Short: "Submit a proposal to add a denom to the oracle whitelist",
+		Long: fmt.Sprintf(`Submit a proposal to add a denom to the oracle whitelist at the end of the first vote period reaching the activation height.
+Example:
+$ %s tx gov submit-proposal add-oracle-denom ukrw 0.0025 1000000 --max-deviation 0.2 --title "add ukrw" --description "add ukrw to the vote targets"
+			`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				tobinTax, err := sdk.NewDecFromStr(args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				activationHeight, err := strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return nil, err
+				}
+
+				denom := types.Denom{Name: args[0], TobinTax: tobinTax}
+				if denom.AggregationStrategy, err = cmd.Flags().GetString(flagAggregationStrategy); err != nil {
+					return nil, err
+				}
+				if denom.VoteThreshold, err = getDecOverride(cmd, flagVoteThreshold); err != nil {
+					return nil, err
+				}
+				if denom.RewardBand, err = getDecOverride(cmd, flagRewardBand); err != nil {
+					return nil, err
+				}
+				if denom.MaxDeviation, err = getDecOverride(cmd, flagMaxDeviation); err != nil {
+					return nil, err
+				}
+
+				return types.NewAddOracleDenomProposal(title, description, denom, activationHeight), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().String(flagAggregationStrategy, "", "Aggregation strategy of the denom, defaults to the weighted median")
+	cmd.Flags().String(flagVoteThreshold, "", "Vote threshold override of the denom")
+	cmd.Flags().String(flagRewardBand, "", "Reward band override of the denom")
+	cmd.Flags().String(flagMaxDeviation, "", "Max deviation of the denom, the circuit breaker is disabled when unset")
+	return cmd
+}
+
+func ProposalRemoveOracleDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-oracle-denom [denom] [activation-height] --title [text] --description [text]",
+		Short: "Submit a proposal to remove a denom from the oracle whitelist",
+		Long: fmt.Sprintf(`Submit a proposal to remove a denom from the oracle whitelist at the end of the first vote period reaching the activation height.
+Example:
+$ %s tx gov submit-proposal remove-oracle-denom ukrw 1000000 --title "remove ukrw" --description "remove ukrw from the vote targets"
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				activationHeight, err := strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return nil, err
+				}
+
+				return types.NewRemoveOracleDenomProposal(title, description, args[0], activationHeight), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUpdateTobinTaxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-tobin-tax [denom] [tobin-tax] [activation-height] --title [text] --description [text]",
+		Short: "Submit a proposal to update the tobin tax of a whitelisted denom",
+		Long: fmt.Sprintf(`Submit a proposal to update the tobin tax of a whitelisted denom at the end of the first vote period reaching the activation height.
+Example:
+$ %s tx gov submit-proposal update-tobin-tax ukrw 0.0035 1000000 --title "update ukrw tobin tax" --description "raise the tobin tax of ukrw"
+			`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) (govtypes.Content, error) {
+				tobinTax, err := sdk.NewDecFromStr(args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				activationHeight, err := strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return nil, err
+				}
+
+				return types.NewUpdateTobinTaxProposal(title, description, args[0], tobinTax, activationHeight), nil
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// submitProposal reads the proposal flags and broadcasts the proposal built with them
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) (govtypes.Content, error)) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return fmt.Errorf("proposal title: %s", err)
+	}
+	proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+	if err != nil {
+		return fmt.Errorf("proposal description: %s", err)
+	}
+	depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositArg)
+	if err != nil {
+		return err
+	}
+
+	content, err := newContent(proposalTitle, proposalDescr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+}
+
+// getDecOverride returns the decimal of the flag, or nil when the flag is unset
+func getDecOverride(cmd *cobra.Command, flag string) (*sdk.Dec, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return nil, err
+	}
+
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dec, nil
+}
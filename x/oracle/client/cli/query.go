@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -13,6 +14,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	flagWindowBlocks  = "window-blocks"
+	flagWindowSeconds = "window-seconds"
+	flagSummary       = "summary"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	oracleQueryCmd := &cobra.Command{
@@ -28,11 +35,19 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
+		GetCmdQueryFeeders(),
 		GetCmdQueryMissCounter(),
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
 		GetCmdQueryTobinTaxes(),
+		GetCmdQueryHistoricExchangeRates(),
+		GetCmdQueryTwap(),
+		GetCmdQueryPerformance(),
+		GetCmdQueryPenaltyOutcomes(),
+		GetCmdQueryFeederRotations(),
+		GetCmdQueryHaltedDenoms(),
+		GetCmdQueryWhitelistUpdates(),
 	)
 
 	return oracleQueryCmd
@@ -184,6 +199,45 @@ $ terrad query oracle feeder terravaloper...
 	return cmd
 }
 
+// GetCmdQueryFeeders implements the query feeders of the validator command
+func GetCmdQueryFeeders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeders [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all the accounts authorized to feed for a validator",
+		Long: strings.TrimSpace(`
+Query the account the validator's oracle voting right is delegated to and the additional feeders of the validator.
+
+$ terrad query oracle feeders terravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Feeders(
+				context.Background(),
+				&types.QueryFeedersRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryMissCounter implements the query miss counter of the validator command
 func GetCmdQueryMissCounter() *cobra.Command {
 	cmd := &cobra.Command{
@@ -417,3 +471,330 @@ Or, can
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryHistoricExchangeRates implements the query historic exchange rates command.
+func GetCmdQueryHistoricExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historic-exchange-rates [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the kept exchange rate snapshots of a denom",
+		Long: strings.TrimSpace(`
+Query the exchange rate snapshots of a denom kept within the historic rate retention window, oldest first.
+
+$ terrad query oracle historic-exchange-rates ukrw
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HistoricExchangeRates(
+				context.Background(),
+				&types.QueryHistoricExchangeRatesRequest{Denom: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historic exchange rates")
+	return cmd
+}
+
+// GetCmdQueryTwap implements the query time-weighted average exchange rate command.
+func GetCmdQueryTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the time-weighted average exchange rate of a denom",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Query the time-weighted average exchange rate of Luna with an asset over the last
+blocks or seconds. Exactly one of --%s and --%s must be given.
+
+$ terrad query oracle twap ukrw --%s 600
+
+Or, over a time window
+
+$ terrad query oracle twap ukrw --%s 3600
+`, flagWindowBlocks, flagWindowSeconds, flagWindowBlocks, flagWindowSeconds)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			windowBlocks, err := cmd.Flags().GetUint64(flagWindowBlocks)
+			if err != nil {
+				return err
+			}
+
+			windowSeconds, err := cmd.Flags().GetUint64(flagWindowSeconds)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Twap(
+				context.Background(),
+				&types.QueryTwapRequest{Denom: args[0], WindowBlocks: windowBlocks, WindowSeconds: windowSeconds},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagWindowBlocks, 0, "Averaging window in blocks")
+	cmd.Flags().Uint64(flagWindowSeconds, 0, "Averaging window in seconds")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPerformance implements the query validator performance command.
+func GetCmdQueryPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle performance of a validator",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Query the oracle performance of a validator per slash window, oldest first.
+
+$ terrad query oracle performance terravaloper...
+
+Or, summed up over the kept windows
+
+$ terrad query oracle performance terravaloper... --%s
+`, flagSummary)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			summary, err := cmd.Flags().GetBool(flagSummary)
+			if err != nil {
+				return err
+			}
+
+			if summary {
+				res, err := queryClient.ValidatorPerformanceSummary(
+					context.Background(),
+					&types.QueryValidatorPerformanceSummaryRequest{ValidatorAddr: validator.String()},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPerformances(
+				context.Background(),
+				&types.QueryValidatorPerformancesRequest{ValidatorAddr: validator.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagSummary, false, "Sum up the performances over the kept windows")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator performances")
+	return cmd
+}
+
+// GetCmdQueryPenaltyOutcomes implements the query penalty outcomes command.
+func GetCmdQueryPenaltyOutcomes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "penalty-outcomes [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the penalty tiers applied at the end of the last slash window",
+		Long: strings.TrimSpace(`
+Query the penalty tiers (warning, jail, slash or grace) applied to the validators at the end of the last slash window.
+
+$ terrad query oracle penalty-outcomes
+
+Or, can filter with validator address
+
+$ terrad query oracle penalty-outcomes terravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.PenaltyOutcomes(context.Background(), &types.QueryPenaltyOutcomesRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PenaltyOutcome(
+				context.Background(),
+				&types.QueryPenaltyOutcomeRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "penalty outcomes")
+	return cmd
+}
+
+// GetCmdQueryFeederRotations implements the query feeder rotations command.
+func GetCmdQueryFeederRotations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder-rotations",
+		Args:  cobra.NoArgs,
+		Short: "Query the pending feeder rotations of the validators",
+		Long: strings.TrimSpace(`
+Query the pending feeder rotations of the validators with their activation heights.
+
+$ terrad query oracle feeder-rotations
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeederRotations(context.Background(), &types.QueryFeederRotationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "feeder rotations")
+	return cmd
+}
+
+// GetCmdQueryHaltedDenoms implements the query halted denoms command.
+func GetCmdQueryHaltedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halted-denoms",
+		Args:  cobra.NoArgs,
+		Short: "Query the denoms halted by the circuit breaker",
+		Long: strings.TrimSpace(`
+Query the denoms halted by the circuit breaker with their reference rates and recovery progress.
+
+$ terrad query oracle halted-denoms
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HaltedDenoms(context.Background(), &types.QueryHaltedDenomsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "halted denoms")
+	return cmd
+}
+
+// GetCmdQueryWhitelistUpdates implements the query whitelist updates command.
+func GetCmdQueryWhitelistUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist-updates",
+		Args:  cobra.NoArgs,
+		Short: "Query the whitelist updates scheduled by governance",
+		Long: strings.TrimSpace(`
+Query the whitelist updates scheduled by governance with their activation heights.
+
+$ terrad query oracle whitelist-updates
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WhitelistUpdates(context.Background(), &types.QueryWhitelistUpdatesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "whitelist updates")
+	return cmd
+}
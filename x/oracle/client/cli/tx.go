@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -28,8 +29,12 @@ func GetTxCmd() *cobra.Command {
 
 	oracleTxCmd.AddCommand(
 		GetCmdDelegateFeederPermission(),
+		GetCmdRotateFeeder(),
+		GetCmdAddFeeder(),
+		GetCmdRemoveFeeder(),
 		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdAggregateExchangeRateCombinedVote(),
 	)
 
 	return oracleTxCmd
@@ -84,6 +89,136 @@ where "terra1..." is the address you want to delegate your voting rights to.
 	return cmd
 }
 
+// GetCmdRotateFeeder will create a feeder rotation tx and sign it with the given key.
+func GetCmdRotateFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-feeder [feeder] [activation-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Schedule the rotation of the permission to vote for the oracle to an address",
+		Long: strings.TrimSpace(`
+Schedule the rotation of the permission to submit exchange rate votes for the oracle to an address.
+
+Both the current and the new feeder are allowed to vote until the end of the activation height,
+so the votes submitted by the current feeder in the vote period of the rotation are not orphaned.
+
+$ terrad tx oracle rotate-feeder terra1... 1000000
+
+where "terra1..." is the address you want to delegate your voting rights to from the block 1000000.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// The address the right is being delegated from
+			validator := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			feeder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			activationHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid activation height")
+			}
+
+			msg := types.NewMsgRotateFeeder(validator, feeder, activationHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAddFeeder will create a MsgAddFeeder tx and sign it with the given key.
+func GetCmdAddFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-feeder [feeder]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Authorize an additional address to vote for the oracle",
+		Long: strings.TrimSpace(`
+Authorize an additional address to submit exchange rate votes for the oracle along with the delegated feeder,
+e.g. to run hot-standby price feeders with their own keys.
+
+$ terrad tx oracle add-feeder terra1...
+
+where "terra1..." is the address you want to authorize to vote on your behalf.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			feeder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddFeeder(validator, feeder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveFeeder will create a MsgRemoveFeeder tx and sign it with the given key.
+func GetCmdRemoveFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-feeder [feeder]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke the permission of an additional address to vote for the oracle",
+		Long: strings.TrimSpace(`
+Revoke the permission of an additional address to submit exchange rate votes for the oracle.
+
+$ terrad tx oracle remove-feeder terra1...
+
+where "terra1..." is the additional feeder you want to revoke.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			feeder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFeeder(validator, feeder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAggregateExchangeRatePrevote will create a aggregateExchangeRatePrevote tx and sign it with the given key.
 func GetCmdAggregateExchangeRatePrevote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -209,3 +344,75 @@ $ terrad tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr terravalope
 
 	return cmd
 }
+
+// GetCmdAggregateExchangeRateCombinedVote will create a aggregateExchangeRateCombinedVote tx and sign it with the given key.
+func GetCmdAggregateExchangeRateCombinedVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-combined-vote [salt] [exchange-rates] [next-salt] [next-exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(4, 5),
+		Short: "Submit an oracle aggregate vote along with the aggregate prevote for the next vote period",
+		Long: strings.TrimSpace(`
+Submit a aggregate vote for the exchange_rates of Luna revealing the prevote submitted in the previous vote period,
+along with the aggregate prevote for the next vote period. Only allowed when the combined vote is enabled.
+
+$ terrad tx oracle aggregate-combined-vote 1234 8888.0ukrw,1.243uusd,0.99usdr 5678 8890.0ukrw,1.245uusd,0.99usdr
+
+where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro Luna in micro denoms from the voter's point of view.
+
+"salt" should match the salt used to generate the SHA256 hex in the previous prevote, and
+"next-salt" and "next-exchange-rates" are hidden in the prevote for the next vote period.
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ terrad tx oracle aggregate-combined-vote 1234 8888.0ukrw,1.243uusd,0.99usdr 5678 8890.0ukrw,1.245uusd,0.99usdr terravaloper1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt := args[0]
+			exchangeRatesStr := args[1]
+			_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rate {%s} is not a valid format; exchange rate should be formatted as DecCoin; %s", exchangeRatesStr, err.Error())
+			}
+
+			nextSalt := args[2]
+			nextExchangeRatesStr := args[3]
+			_, err = types.ParseExchangeRateTuples(nextExchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given next exchange_rates {%s} is not a valid format; exchange rate should be formatted as DecCoin; %s", nextExchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			voter := clientCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 5 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[4])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hash := types.GetAggregateVoteHash(nextSalt, nextExchangeRatesStr, validator)
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateCombinedVote(salt, exchangeRatesStr, hash, voter, validator)}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"github.com/classic-terra/core/x/oracle/client/cli"
+	"github.com/classic-terra/core/x/oracle/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	ProposalResumeDenomHandler       = govclient.NewProposalHandler(cli.ProposalResumeDenomCmd, rest.ResumeDenomProposalRESTHandler)
+	ProposalAddOracleDenomHandler    = govclient.NewProposalHandler(cli.ProposalAddOracleDenomCmd, rest.AddOracleDenomProposalRESTHandler)
+	ProposalRemoveOracleDenomHandler = govclient.NewProposalHandler(cli.ProposalRemoveOracleDenomCmd, rest.RemoveOracleDenomProposalRESTHandler)
+	ProposalUpdateTobinTaxHandler    = govclient.NewProposalHandler(cli.ProposalUpdateTobinTaxCmd, rest.UpdateTobinTaxProposalRESTHandler)
+)
@@ -0,0 +1,141 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/classic-terra/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type (
+	resumeDenomProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Denom       string         `json:"denom" yaml:"denom"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	addOracleDenomProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title            string         `json:"title" yaml:"title"`
+		Description      string         `json:"description" yaml:"description"`
+		Denom            types.Denom    `json:"denom" yaml:"denom"`
+		ActivationHeight uint64         `json:"activation_height" yaml:"activation_height"`
+		Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit          sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	removeOracleDenomProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title            string         `json:"title" yaml:"title"`
+		Description      string         `json:"description" yaml:"description"`
+		Denom            string         `json:"denom" yaml:"denom"`
+		ActivationHeight uint64         `json:"activation_height" yaml:"activation_height"`
+		Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit          sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	updateTobinTaxProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title            string         `json:"title" yaml:"title"`
+		Description      string         `json:"description" yaml:"description"`
+		Denom            string         `json:"denom" yaml:"denom"`
+		TobinTax         sdk.Dec        `json:"tobin_tax" yaml:"tobin_tax"`
+		ActivationHeight uint64         `json:"activation_height" yaml:"activation_height"`
+		Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit          sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ResumeDenomProposalRESTHandler returns a ProposalRESTHandler that exposes the resume denom REST handler with a given sub-route.
+func ResumeDenomProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "resume_oracle_denom",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req resumeDenomProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewResumeDenomProposal(req.Title, req.Description, req.Denom)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// AddOracleDenomProposalRESTHandler returns a ProposalRESTHandler that exposes the add oracle denom REST handler with a given sub-route.
+func AddOracleDenomProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_oracle_denom",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req addOracleDenomProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewAddOracleDenomProposal(req.Title, req.Description, req.Denom, req.ActivationHeight)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// RemoveOracleDenomProposalRESTHandler returns a ProposalRESTHandler that exposes the remove oracle denom REST handler with a given sub-route.
+func RemoveOracleDenomProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_oracle_denom",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req removeOracleDenomProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewRemoveOracleDenomProposal(req.Title, req.Description, req.Denom, req.ActivationHeight)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// UpdateTobinTaxProposalRESTHandler returns a ProposalRESTHandler that exposes the update tobin tax REST handler with a given sub-route.
+func UpdateTobinTaxProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_tobin_tax",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req updateTobinTaxProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewUpdateTobinTaxProposal(req.Title, req.Description, req.Denom, req.TobinTax, req.ActivationHeight)
+			writeProposalResponse(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func writeProposalResponse(clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -24,6 +24,7 @@ func setupWithSmallVotingPower(t *testing.T) (keeper.TestInput, sdk.Handler) {
 	params.VotePeriod = 1
 	params.SlashWindow = 100
 	params.RewardDistributionWindow = 100
+	params.SlashGracePeriod = 0
 	input.OracleKeeper.SetParams(input.Ctx, params)
 	h := oracle.NewHandler(input.OracleKeeper)
 
@@ -42,6 +43,7 @@ func setup(t *testing.T) (keeper.TestInput, sdk.Handler) {
 	params.VotePeriod = 1
 	params.SlashWindow = 100
 	params.RewardDistributionWindow = 100
+	params.SlashGracePeriod = 0
 	input.OracleKeeper.SetParams(input.Ctx, params)
 	h := oracle.NewHandler(input.OracleKeeper)
 
@@ -65,6 +67,7 @@ func setupVal5(t *testing.T) (keeper.TestInput, sdk.Handler) {
 	params.VotePeriod = 1
 	params.SlashWindow = 100
 	params.RewardDistributionWindow = 100
+	params.SlashGracePeriod = 0
 	input.OracleKeeper.SetParams(input.Ctx, params)
 	h := oracle.NewHandler(input.OracleKeeper)
 
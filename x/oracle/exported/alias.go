@@ -4,6 +4,7 @@ package exported
 import "github.com/classic-terra/core/x/oracle/types"
 
 type (
-	MsgAggregateExchangeRatePrevote = types.MsgAggregateExchangeRatePrevote
-	MsgAggregateExchangeRateVote    = types.MsgAggregateExchangeRateVote
+	MsgAggregateExchangeRatePrevote      = types.MsgAggregateExchangeRatePrevote
+	MsgAggregateExchangeRateVote         = types.MsgAggregateExchangeRateVote
+	MsgAggregateExchangeRateCombinedVote = types.MsgAggregateExchangeRateCombinedVote
 )
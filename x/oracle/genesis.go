@@ -67,6 +67,59 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		}
 	}
 
+	for _, hr := range data.HistoricExchangeRates {
+		keeper.SetHistoricExchangeRate(ctx, hr)
+	}
+
+	for _, vp := range data.ValidatorPerformances {
+		operator, err := sdk.ValAddressFromBech32(vp.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorPerformance(ctx, operator, vp)
+	}
+
+	for _, po := range data.PenaltyOutcomes {
+		operator, err := sdk.ValAddressFromBech32(po.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetPenaltyOutcome(ctx, operator, po)
+	}
+
+	for _, fr := range data.FeederRotations {
+		operator, err := sdk.ValAddressFromBech32(fr.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetFeederRotation(ctx, operator, fr)
+	}
+
+	for _, af := range data.AdditionalFeeders {
+		feeder, err := sdk.AccAddressFromBech32(af.FeederAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		operator, err := sdk.ValAddressFromBech32(af.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAdditionalFeeder(ctx, operator, feeder)
+	}
+
+	for _, hd := range data.HaltedDenoms {
+		keeper.SetHaltedDenom(ctx, hd)
+	}
+
+	for _, wu := range data.WhitelistUpdates {
+		keeper.SetWhitelistUpdate(ctx, wu)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 
 	// check if the module account exists
@@ -123,11 +176,63 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	historicExchangeRates := []types.HistoricExchangeRate{}
+	keeper.IterateHistoricExchangeRates(ctx, func(historicRate types.HistoricExchangeRate) (stop bool) {
+		historicExchangeRates = append(historicExchangeRates, historicRate)
+		return false
+	})
+
+	validatorPerformances := []types.ValidatorPerformance{}
+	keeper.IterateValidatorPerformances(ctx, func(_ sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		validatorPerformances = append(validatorPerformances, performance)
+		return false
+	})
+
+	penaltyOutcomes := []types.PenaltyOutcome{}
+	keeper.IteratePenaltyOutcomes(ctx, func(_ sdk.ValAddress, outcome types.PenaltyOutcome) (stop bool) {
+		penaltyOutcomes = append(penaltyOutcomes, outcome)
+		return false
+	})
+
+	feederRotations := []types.FeederRotation{}
+	keeper.IterateFeederRotations(ctx, func(_ sdk.ValAddress, rotation types.FeederRotation) (stop bool) {
+		feederRotations = append(feederRotations, rotation)
+		return false
+	})
+
+	additionalFeeders := []types.FeederDelegation{}
+	keeper.IterateAdditionalFeeders(ctx, func(operator sdk.ValAddress, feeder sdk.AccAddress) (stop bool) {
+		additionalFeeders = append(additionalFeeders, types.FeederDelegation{
+			FeederAddress:    feeder.String(),
+			ValidatorAddress: operator.String(),
+		})
+		return false
+	})
+
+	haltedDenoms := []types.HaltedDenom{}
+	keeper.IterateHaltedDenoms(ctx, func(haltedDenom types.HaltedDenom) (stop bool) {
+		haltedDenoms = append(haltedDenoms, haltedDenom)
+		return false
+	})
+
+	whitelistUpdates := []types.WhitelistUpdate{}
+	keeper.IterateWhitelistUpdates(ctx, func(update types.WhitelistUpdate) (stop bool) {
+		whitelistUpdates = append(whitelistUpdates, update)
+		return false
+	})
+
 	return types.NewGenesisState(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
 		aggregateExchangeRateVotes,
-		tobinTaxes)
+		tobinTaxes,
+		historicExchangeRates,
+		validatorPerformances,
+		penaltyOutcomes,
+		feederRotations,
+		additionalFeeders,
+		haltedDenoms,
+		whitelistUpdates)
 }
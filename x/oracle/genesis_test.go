@@ -22,6 +22,13 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetTobinTax(input.Ctx, "denom", sdk.NewDecWithPrec(123, 3))
 	input.OracleKeeper.SetTobinTax(input.Ctx, "denom2", sdk.NewDecWithPrec(123, 3))
 	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 10)
+	input.OracleKeeper.SetHistoricExchangeRate(input.Ctx, types.NewHistoricExchangeRate("denom", sdk.NewDec(123), 10, input.Ctx.BlockTime()))
+	input.OracleKeeper.AddValidatorPerformanceRewards(input.Ctx, keeper.ValAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("denom", 123)))
+	input.OracleKeeper.SetAdditionalFeeder(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[3])
+	input.OracleKeeper.SetFeederRotation(input.Ctx, keeper.ValAddrs[0], types.NewFeederRotation(keeper.ValAddrs[0], keeper.Addrs[2], 100))
+	input.OracleKeeper.SetPenaltyOutcome(input.Ctx, keeper.ValAddrs[0], types.NewPenaltyOutcome(keeper.ValAddrs[0], 1, types.PenaltyTierWarning, sdk.NewDecWithPrec(15, 2), 100, 85))
+	input.OracleKeeper.SetHaltedDenom(input.Ctx, types.NewHaltedDenom("denom", sdk.NewDec(123), sdk.NewDec(246), 10))
+	input.OracleKeeper.SetWhitelistUpdate(input.Ctx, types.NewWhitelistUpdate(types.WhitelistUpdateActionRemove, types.Denom{Name: "denom", TobinTax: sdk.ZeroDec()}, 100))
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
 
 	newInput := keeper.CreateTestInput(t)
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// HasAdditionalFeeder returns whether the feeder is an additional feeder of the validator
+func (k Keeper) HasAdditionalFeeder(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAdditionalFeederKey(operator, feeder))
+}
+
+// SetAdditionalFeeder authorizes the feeder to feed along with the delegated feeder of the validator
+func (k Keeper) SetAdditionalFeeder(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAdditionalFeederKey(operator, feeder), []byte{})
+}
+
+// DeleteAdditionalFeeder revokes the additional feeder of the validator
+func (k Keeper) DeleteAdditionalFeeder(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAdditionalFeederKey(operator, feeder))
+}
+
+// GetAdditionalFeeders returns the additional feeders of the validator
+func (k Keeper) GetAdditionalFeeders(ctx sdk.Context, operator sdk.ValAddress) (feeders []sdk.AccAddress) {
+	prefix := types.GetAdditionalFeedersKey(operator)
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		feeders = append(feeders, sdk.AccAddress(iter.Key()[len(prefix)+1:]))
+	}
+
+	return feeders
+}
+
+// IterateAdditionalFeeders iterates over the additional feeders of all validators in the store
+func (k Keeper) IterateAdditionalFeeders(ctx sdk.Context, handler func(operator sdk.ValAddress, feeder sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AdditionalFeederKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[1:]
+		operator := sdk.ValAddress(key[1 : 1+key[0]])
+		feeder := sdk.AccAddress(key[2+key[0]:])
+
+		if handler(operator, feeder) {
+			break
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// AggregationStrategy returns the strategy to tally the ballot of the denom with,
+// selected by the whitelist entry of the denom
+func (k Keeper) AggregationStrategy(ctx sdk.Context, denom string) types.AggregationStrategy {
+	// softfork for tally
+	if (ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() < int64(5_701_000)) ||
+		(ctx.ChainID() == core.BombayChainID && ctx.BlockHeight() < int64(7_000_000)) {
+		return types.LegacyWeightedMedianStrategy{}
+	}
+
+	for _, item := range k.Whitelist(ctx) {
+		if item.Name != denom {
+			continue
+		}
+
+		if strategy, ok := types.GetAggregationStrategy(item.AggregationStrategy); ok {
+			return strategy
+		}
+
+		break
+	}
+
+	return types.WeightedMedianStrategy{}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+func TestAggregationStrategy(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
+		{Name: core.MicroMNTDenom, TobinTax: types.DefaultTobinTax, AggregationStrategy: types.AggregationStrategyMedianMAD},
+	})
+
+	require.Equal(t, types.AggregationStrategyWeightedMedian, input.OracleKeeper.AggregationStrategy(input.Ctx, core.MicroSDRDenom).Name())
+	require.Equal(t, types.AggregationStrategyMedianMAD, input.OracleKeeper.AggregationStrategy(input.Ctx, core.MicroMNTDenom).Name())
+
+	// denom not in the whitelist
+	require.Equal(t, types.AggregationStrategyWeightedMedian, input.OracleKeeper.AggregationStrategy(input.Ctx, core.MicroKRWDenom).Name())
+
+	// before the tally softfork
+	ctx := input.Ctx.WithChainID(core.ColumbusChainID).WithBlockHeight(5_700_999)
+	require.Equal(t, types.AggregationStrategyLegacyWeightedMedian, input.OracleKeeper.AggregationStrategy(ctx, core.MicroMNTDenom).Name())
+
+	ctx = input.Ctx.WithChainID(core.ColumbusChainID).WithBlockHeight(5_701_000)
+	require.Equal(t, types.AggregationStrategyMedianMAD, input.OracleKeeper.AggregationStrategy(ctx, core.MicroMNTDenom).Name())
+
+}
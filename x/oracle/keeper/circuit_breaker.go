@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// IsDenomHalted returns whether the denom is halted by the circuit breaker
+func (k Keeper) IsDenomHalted(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetHaltedDenomKey(denom))
+}
+
+// GetHaltedDenom returns the circuit breaker state of the halted denom
+func (k Keeper) GetHaltedDenom(ctx sdk.Context, denom string) (haltedDenom types.HaltedDenom, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHaltedDenomKey(denom))
+	if bz == nil {
+		err = sdkerrors.Wrap(types.ErrDenomNotHalted, denom)
+		return
+	}
+
+	k.cdc.MustUnmarshal(bz, &haltedDenom)
+	return
+}
+
+// SetHaltedDenom halts the denom with the circuit breaker state
+func (k Keeper) SetHaltedDenom(ctx sdk.Context, haltedDenom types.HaltedDenom) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&haltedDenom)
+	store.Set(types.GetHaltedDenomKey(haltedDenom.Denom), bz)
+}
+
+// DeleteHaltedDenom resumes the halted denom
+func (k Keeper) DeleteHaltedDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHaltedDenomKey(denom))
+}
+
+// IterateHaltedDenoms iterates over the halted denoms in the store
+func (k Keeper) IterateHaltedDenoms(ctx sdk.Context, handler func(haltedDenom types.HaltedDenom) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HaltedDenomKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var haltedDenom types.HaltedDenom
+		k.cdc.MustUnmarshal(iter.Value(), &haltedDenom)
+
+		if handler(haltedDenom) {
+			break
+		}
+	}
+}
+
+// ResumeDenomWithEvent resumes the halted denom with ABCI event
+func (k Keeper) ResumeDenomWithEvent(ctx sdk.Context, denom string) {
+	k.DeleteHaltedDenom(ctx, denom)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeDenomResume,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+}
+
+// ApplyCircuitBreaker checks the tallied exchange rate of the denom against the
+// max deviation of the denom and returns whether the exchange rate can be applied.
+// A denom whose exchange rate deviates from the last applied exchange rate by more
+// than the max deviation is halted, and resumed once the tallied exchange rate
+// stays within the max deviation of the reference rate for the recovery periods.
+// The last applied exchange rate is the newest historic snapshot, so a vote period
+// failing to tally the denom does not clear the reference.
+func (k Keeper) ApplyCircuitBreaker(ctx sdk.Context, denom string, exchangeRate sdk.Dec) bool {
+	params := k.GetParams(ctx)
+	maxDeviation := params.Whitelist.MaxDeviationOf(denom)
+
+	haltedDenom, err := k.GetHaltedDenom(ctx, denom)
+	if err != nil {
+		// Not halted; trip the breaker on the deviation from the last applied exchange rate
+		if maxDeviation == nil {
+			return true
+		}
+
+		latest, found := k.GetLatestHistoricExchangeRate(ctx, denom)
+		if !found || !types.ExceedsDeviation(exchangeRate, latest.ExchangeRate, *maxDeviation) {
+			return true
+		}
+
+		k.SetHaltedDenom(ctx, types.NewHaltedDenom(denom, latest.ExchangeRate, exchangeRate, ctx.BlockHeight()))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeDenomHalt,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+				sdk.NewAttribute(types.AttributeKeyReferenceRate, latest.ExchangeRate.String()),
+			),
+		)
+
+		return false
+	}
+
+	// The circuit breaker has been disabled for the halted denom
+	if maxDeviation == nil {
+		k.ResumeDenomWithEvent(ctx, denom)
+		return true
+	}
+
+	haltedDenom.LastRate = exchangeRate
+	if types.ExceedsDeviation(exchangeRate, haltedDenom.ReferenceRate, *maxDeviation) {
+		haltedDenom.InBandTallies = 0
+	} else {
+		haltedDenom.InBandTallies++
+	}
+
+	if haltedDenom.InBandTallies >= params.CircuitBreakerRecoveryPeriods {
+		k.ResumeDenomWithEvent(ctx, denom)
+		return true
+	}
+
+	k.SetHaltedDenom(ctx, haltedDenom)
+	return false
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// GetFeederRotation returns the pending feeder rotation of the validator
+func (k Keeper) GetFeederRotation(ctx sdk.Context, operator sdk.ValAddress) (rotation types.FeederRotation, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeederRotationKey(operator))
+	if bz == nil {
+		err = sdkerrors.Wrap(types.ErrNoFeederRotation, operator.String())
+		return
+	}
+
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return
+}
+
+// SetFeederRotation schedules the feeder rotation of the validator
+func (k Keeper) SetFeederRotation(ctx sdk.Context, operator sdk.ValAddress, rotation types.FeederRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rotation)
+	store.Set(types.GetFeederRotationKey(operator), bz)
+}
+
+// DeleteFeederRotation cancels the pending feeder rotation of the validator
+func (k Keeper) DeleteFeederRotation(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeederRotationKey(operator))
+}
+
+// IterateFeederRotations iterates over the pending feeder rotations in the store
+func (k Keeper) IterateFeederRotations(ctx sdk.Context, handler func(operator sdk.ValAddress, rotation types.FeederRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeederRotationKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var rotation types.FeederRotation
+		k.cdc.MustUnmarshal(iter.Value(), &rotation)
+
+		if handler(operator, rotation) {
+			break
+		}
+	}
+}
+
+// ApplyFeederRotations replaces the feeder delegations with the rotations
+// whose activation height is reached
+func (k Keeper) ApplyFeederRotations(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+
+	var operators []sdk.ValAddress
+	var feeders []sdk.AccAddress
+	k.IterateFeederRotations(ctx, func(operator sdk.ValAddress, rotation types.FeederRotation) (stop bool) {
+		if rotation.ActivationHeight <= height {
+			operators = append(operators, operator)
+			feeders = append(feeders, sdk.MustAccAddressFromBech32(rotation.FeederAddress))
+		}
+
+		return false
+	})
+
+	for i, operator := range operators {
+		k.SetFeederDelegation(ctx, operator, feeders[i])
+		k.DeleteFeederRotation(ctx, operator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeFeedDelegate,
+				sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+				sdk.NewAttribute(types.AttributeKeyFeeder, feeders[i].String()),
+			),
+		)
+	}
+}
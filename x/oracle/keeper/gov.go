@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/classic-terra/core/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func HandleResumeDenomProposal(ctx sdk.Context, k Keeper, p *types.ResumeDenomProposal) error {
+	if !k.IsDenomHalted(ctx, p.Denom) {
+		return types.ErrDenomNotHalted.Wrap(p.Denom)
+	}
+
+	k.ResumeDenomWithEvent(ctx, p.Denom)
+
+	return nil
+}
+
+func HandleAddOracleDenomProposal(ctx sdk.Context, k Keeper, p *types.AddOracleDenomProposal) error {
+	if isWhitelisted(ctx, k, p.Denom.Name) {
+		return types.ErrDenomAlreadyWhitelisted.Wrap(p.Denom.Name)
+	}
+
+	return scheduleWhitelistUpdate(ctx, k, p.WhitelistUpdate())
+}
+
+func HandleRemoveOracleDenomProposal(ctx sdk.Context, k Keeper, p *types.RemoveOracleDenomProposal) error {
+	if !isWhitelisted(ctx, k, p.Denom) {
+		return types.ErrUnknownDenom.Wrap(p.Denom)
+	}
+
+	return scheduleWhitelistUpdate(ctx, k, p.WhitelistUpdate())
+}
+
+func HandleUpdateTobinTaxProposal(ctx sdk.Context, k Keeper, p *types.UpdateTobinTaxProposal) error {
+	if !isWhitelisted(ctx, k, p.Denom) {
+		return types.ErrUnknownDenom.Wrap(p.Denom)
+	}
+
+	return scheduleWhitelistUpdate(ctx, k, p.WhitelistUpdate())
+}
+
+func isWhitelisted(ctx sdk.Context, k Keeper, denom string) bool {
+	for _, d := range k.Whitelist(ctx) {
+		if d.Name == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scheduleWhitelistUpdate stores the whitelist update to be applied at the end of
+// the first vote period reaching its activation height, so feeders can prepare
+func scheduleWhitelistUpdate(ctx sdk.Context, k Keeper, update types.WhitelistUpdate) error {
+	if update.ActivationHeight <= uint64(ctx.BlockHeight()) {
+		return types.ErrInvalidActivationHeight.Wrapf("activation height %d must be greater than the current height %d", update.ActivationHeight, ctx.BlockHeight())
+	}
+
+	// a second update of the denom at the same height would silently replace the first one
+	if k.HasWhitelistUpdate(ctx, update.ActivationHeight, update.Denom.Name) {
+		return types.ErrWhitelistUpdateScheduled.Wrapf("denom %s at activation height %d", update.Denom.Name, update.ActivationHeight)
+	}
+
+	k.SetWhitelistUpdate(ctx, update)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// SetHistoricExchangeRate stores an exchange rate snapshot
+func (k Keeper) SetHistoricExchangeRate(ctx sdk.Context, historicRate types.HistoricExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&historicRate)
+	store.Set(types.GetHistoricExchangeRateKey(historicRate.Denom, historicRate.BlockHeight), bz)
+}
+
+// AddHistoricExchangeRate stores a snapshot of the exchange rate tallied at the current block
+// and prunes the snapshots of the denom which are older than the retention window
+func (k Keeper) AddHistoricExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	k.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(denom, exchangeRate, ctx.BlockHeight(), ctx.BlockTime()))
+	k.PruneHistoricExchangeRates(ctx, denom, ctx.BlockHeight()-int64(k.HistoricRateRetention(ctx)))
+}
+
+// PruneHistoricExchangeRates deletes the snapshots of the denom taken before the given height
+func (k Keeper) PruneHistoricExchangeRates(ctx sdk.Context, denom string, beforeHeight int64) {
+	if beforeHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetHistoricExchangeRatesKey(denom), types.GetHistoricExchangeRateKey(denom, beforeHeight))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateHistoricExchangeRates iterates over the snapshots of all denoms in the store
+func (k Keeper) IterateHistoricExchangeRates(ctx sdk.Context, handler func(historicRate types.HistoricExchangeRate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricExchangeRateKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var historicRate types.HistoricExchangeRate
+		k.cdc.MustUnmarshal(iter.Value(), &historicRate)
+		if handler(historicRate) {
+			break
+		}
+	}
+}
+
+// ReverseIterateHistoricExchangeRates iterates over the snapshots of the denom, newest first
+func (k Keeper) ReverseIterateHistoricExchangeRates(ctx sdk.Context, denom string, handler func(historicRate types.HistoricExchangeRate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetHistoricExchangeRatesKey(denom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var historicRate types.HistoricExchangeRate
+		k.cdc.MustUnmarshal(iter.Value(), &historicRate)
+		if handler(historicRate) {
+			break
+		}
+	}
+}
+
+// GetLatestHistoricExchangeRate returns the newest snapshot of the denom, being the last exchange
+// rate applied for it; the pruning on each new snapshot never deletes the newest one
+func (k Keeper) GetLatestHistoricExchangeRate(ctx sdk.Context, denom string) (latest types.HistoricExchangeRate, found bool) {
+	k.ReverseIterateHistoricExchangeRates(ctx, denom, func(historicRate types.HistoricExchangeRate) (stop bool) {
+		latest, found = historicRate, true
+		return true
+	})
+
+	return latest, found
+}
+
+// GetTWAPByBlocks returns the average of the exchange rate snapshots of the denom
+// weighted by the number of blocks each was in effect during the last window blocks
+func (k Keeper) GetTWAPByBlocks(ctx sdk.Context, denom string, window uint64) (sdk.Dec, error) {
+	return k.getTWAP(ctx, denom, ctx.BlockHeight()-int64(window), ctx.BlockHeight(), func(hr types.HistoricExchangeRate) int64 {
+		return hr.BlockHeight
+	})
+}
+
+// GetTWAPByTime returns the average of the exchange rate snapshots of the denom
+// weighted by the number of seconds each was in effect during the last window
+func (k Keeper) GetTWAPByTime(ctx sdk.Context, denom string, window time.Duration) (sdk.Dec, error) {
+	end := ctx.BlockTime().Unix()
+	return k.getTWAP(ctx, denom, end-int64(window/time.Second), end, func(hr types.HistoricExchangeRate) int64 {
+		return hr.BlockTime.Unix()
+	})
+}
+
+func (k Keeper) getTWAP(ctx sdk.Context, denom string, start, end int64, pos func(types.HistoricExchangeRate) int64) (sdk.Dec, error) {
+	// collect the snapshots within the window together with the
+	// latest one before it, which was in effect when the window opened
+	var historicRates types.HistoricExchangeRates
+	k.ReverseIterateHistoricExchangeRates(ctx, denom, func(historicRate types.HistoricExchangeRate) (stop bool) {
+		historicRates = append(historicRates, historicRate)
+		return pos(historicRate) <= start
+	})
+
+	for i, j := 0, len(historicRates)-1; i < j; i, j = i+1, j-1 {
+		historicRates[i], historicRates[j] = historicRates[j], historicRates[i]
+	}
+
+	twap, err := historicRates.TimeWeightedAverage(start, end, pos)
+	if err != nil {
+		return sdk.ZeroDec(), sdkerrors.Wrap(err, denom)
+	}
+
+	return twap, nil
+}
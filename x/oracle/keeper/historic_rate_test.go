@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+func TestHistoricExchangeRatePrune(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetHistoricRateRetention(input.Ctx, 10)
+
+	blockTime := input.Ctx.BlockTime()
+	for height := int64(1); height <= 20; height++ {
+		ctx := input.Ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(time.Duration(height) * time.Second))
+		input.OracleKeeper.AddHistoricExchangeRate(ctx, core.MicroKRWDenom, sdk.NewDec(height))
+	}
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(20), core.MicroSDRDenom, sdk.OneDec())
+
+	var heights []int64
+	input.OracleKeeper.IterateHistoricExchangeRates(input.Ctx, func(historicRate types.HistoricExchangeRate) (stop bool) {
+		if historicRate.Denom == core.MicroKRWDenom {
+			heights = append(heights, historicRate.BlockHeight)
+		}
+		return false
+	})
+	require.Equal(t, []int64{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, heights)
+
+	var latest types.HistoricExchangeRate
+	input.OracleKeeper.ReverseIterateHistoricExchangeRates(input.Ctx, core.MicroKRWDenom, func(historicRate types.HistoricExchangeRate) (stop bool) {
+		latest = historicRate
+		return true
+	})
+	require.Equal(t, int64(20), latest.BlockHeight)
+	require.Equal(t, sdk.NewDec(20), latest.ExchangeRate)
+	require.Equal(t, blockTime.Add(20*time.Second).Unix(), latest.BlockTime.Unix())
+}
+
+func TestTWAPByBlocks(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.GetTWAPByBlocks(input.Ctx.WithBlockHeight(100), core.MicroKRWDenom, 10)
+	require.Error(t, err)
+
+	// rate 10 at height 85, 20 at height 95, 40 at height 98
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(85), core.MicroKRWDenom, sdk.NewDec(10))
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(95), core.MicroKRWDenom, sdk.NewDec(20))
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(98), core.MicroKRWDenom, sdk.NewDec(40))
+
+	// window [90, 100]: 10 for 5 blocks, 20 for 3 blocks, 40 for 2 blocks
+	twap, err := input.OracleKeeper.GetTWAPByBlocks(input.Ctx.WithBlockHeight(100), core.MicroKRWDenom, 10)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(5*10+3*20+2*40).QuoInt64(10), twap)
+
+	// window [97, 100]: 20 for 1 block, 40 for 2 blocks
+	twap, err = input.OracleKeeper.GetTWAPByBlocks(input.Ctx.WithBlockHeight(100), core.MicroKRWDenom, 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1*20+2*40).QuoInt64(3), twap)
+
+	// empty window returns the latest snapshot
+	twap, err = input.OracleKeeper.GetTWAPByBlocks(input.Ctx.WithBlockHeight(98), core.MicroKRWDenom, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), twap)
+}
+
+func TestTWAPByTime(t *testing.T) {
+	input := CreateTestInput(t)
+	now := time.Unix(1_600_000_000, 0).UTC()
+
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(1).WithBlockTime(now.Add(-100*time.Second)), core.MicroKRWDenom, sdk.NewDec(10))
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(2).WithBlockTime(now.Add(-30*time.Second)), core.MicroKRWDenom, sdk.NewDec(30))
+
+	// window [now-60s, now]: 10 for 30 seconds, 30 for 30 seconds
+	twap, err := input.OracleKeeper.GetTWAPByTime(input.Ctx.WithBlockHeight(3).WithBlockTime(now), core.MicroKRWDenom, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), twap)
+
+	// window wider than the history only weights the kept snapshots
+	twap, err = input.OracleKeeper.GetTWAPByTime(input.Ctx.WithBlockHeight(3).WithBlockTime(now), core.MicroKRWDenom, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(70*10+30*30).QuoInt64(100), twap)
+}
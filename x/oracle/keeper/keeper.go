@@ -27,6 +27,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistributionKeeper
 	StakingKeeper types.StakingKeeper
+	marketKeeper  types.MarketKeeper
 
 	distrName string
 }
@@ -35,7 +36,7 @@ type Keeper struct {
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey,
 	paramspace paramstypes.Subspace, accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
-	stakingKeeper types.StakingKeeper, distrName string,
+	stakingKeeper types.StakingKeeper, marketKeeper types.MarketKeeper, distrName string,
 ) Keeper {
 	// ensure oracle module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -55,6 +56,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
 		StakingKeeper: stakingKeeper,
+		marketKeeper:  marketKeeper,
 		distrName:     distrName,
 	}
 }
@@ -68,11 +70,17 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // ExchangeRate logic
 
 // GetLunaExchangeRate gets the consensus exchange rate of Luna denominated in the denom asset from the store.
+// The ibc vouchers mapped by the market module are priced as their native denom.
 func (k Keeper) GetLunaExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	denom = k.nativeDenom(ctx, denom)
 	if denom == core.MicroLunaDenom {
 		return sdk.OneDec(), nil
 	}
 
+	if k.IsDenomHalted(ctx, denom) {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrDenomHalted, denom)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(types.GetExchangeRateKey(denom))
 	if b == nil {
@@ -84,6 +92,16 @@ func (k Keeper) GetLunaExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, err
 	return dp.Dec, nil
 }
 
+// nativeDenom returns the native denom the ibc denom is mapped to by the market module,
+// so that the bridged back vouchers are priced as the native denom
+func (k Keeper) nativeDenom(ctx sdk.Context, denom string) string {
+	if k.marketKeeper == nil {
+		return denom
+	}
+
+	return k.marketKeeper.NativeDenom(ctx, denom)
+}
+
 // SetLunaExchangeRate sets the consensus exchange rate of Luna denominated in the denom asset to the store.
 func (k Keeper) SetLunaExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
@@ -300,8 +318,9 @@ func (k Keeper) IterateAggregateExchangeRateVotes(ctx sdk.Context, handler func(
 	}
 }
 
-// GetTobinTax return tobin tax for the denom
+// GetTobinTax return tobin tax for the denom, or for its native denom when it is a mapped ibc voucher
 func (k Keeper) GetTobinTax(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	denom = k.nativeDenom(ctx, denom)
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetTobinTaxKey(denom))
 	if bz == nil {
@@ -353,7 +372,13 @@ func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, valid
 	if !feederAddr.Equals(validatorAddr) {
 		delegate := k.GetFeederDelegation(ctx, validatorAddr)
 		if !delegate.Equals(feederAddr) {
-			return sdkerrors.Wrap(types.ErrNoVotingPermission, feederAddr.String())
+			// The new feeder of a pending rotation and the additional feeders are allowed
+			// along with the current one
+			rotation, err := k.GetFeederRotation(ctx, validatorAddr)
+			if (err != nil || rotation.FeederAddress != feederAddr.String()) &&
+				!k.HasAdditionalFeeder(ctx, validatorAddr, feederAddr) {
+				return sdkerrors.Wrap(types.ErrNoVotingPermission, feederAddr.String())
+			}
 		}
 	}
 
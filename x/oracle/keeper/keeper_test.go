@@ -57,6 +57,39 @@ func TestExchangeRate(t *testing.T) {
 	require.True(t, numExchangeRates == 3)
 }
 
+// dummyMarketKeeper maps the ibc denoms to their native denoms
+type dummyMarketKeeper map[string]string
+
+func (k dummyMarketKeeper) NativeDenom(_ sdk.Context, denom string) string {
+	if nativeDenom, ok := k[denom]; ok {
+		return nativeDenom
+	}
+
+	return denom
+}
+
+func TestExchangeRateIBCDenomMapping(t *testing.T) {
+	input := CreateTestInput(t)
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	krwExchangeRate := sdk.NewDecWithPrec(2838, int64(OracleDecPrecision)).MulInt64(core.MicroUnit)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, krwExchangeRate)
+
+	// unmapped vouchers have no rate
+	_, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, ibcDenom)
+	require.Error(t, err)
+
+	input.OracleKeeper.marketKeeper = dummyMarketKeeper{ibcDenom: core.MicroKRWDenom}
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, krwExchangeRate, rate)
+
+	tobinTax, err := input.OracleKeeper.GetTobinTax(input.Ctx, ibcDenom)
+	require.NoError(t, err)
+	krwTobinTax, err := input.OracleKeeper.GetTobinTax(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, krwTobinTax, tobinTax)
+}
+
 func TestIterateLunaExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -116,6 +149,13 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	historicRateRetention := uint64(100)
+	performanceHistoryWindows := uint64(6)
+	warningValidPerWindow := sdk.NewDecWithPrec(3, 1)
+	jailValidPerWindow := sdk.NewDecWithPrec(1, 1)
+	slashGracePeriod := uint64(100)
+	combinedVoteEnabled := true
+	circuitBreakerRecoveryPeriods := uint64(5)
 	whitelist := types.DenomList{
 		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
 		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
@@ -123,14 +163,21 @@ func TestParams(t *testing.T) {
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
-		VotePeriod:               votePeriod,
-		VoteThreshold:            voteThreshold,
-		RewardBand:               oracleRewardBand,
-		RewardDistributionWindow: rewardDistributionWindow,
-		Whitelist:                whitelist,
-		SlashFraction:            slashFraction,
-		SlashWindow:              slashWindow,
-		MinValidPerWindow:        minValidPerWindow,
+		VotePeriod:                    votePeriod,
+		VoteThreshold:                 voteThreshold,
+		RewardBand:                    oracleRewardBand,
+		RewardDistributionWindow:      rewardDistributionWindow,
+		Whitelist:                     whitelist,
+		SlashFraction:                 slashFraction,
+		SlashWindow:                   slashWindow,
+		MinValidPerWindow:             minValidPerWindow,
+		HistoricRateRetention:         historicRateRetention,
+		PerformanceHistoryWindows:     performanceHistoryWindows,
+		WarningValidPerWindow:         warningValidPerWindow,
+		JailValidPerWindow:            jailValidPerWindow,
+		SlashGracePeriod:              slashGracePeriod,
+		CombinedVoteEnabled:           combinedVoteEnabled,
+		CircuitBreakerRecoveryPeriods: circuitBreakerRecoveryPeriods,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	v06 "github.com/classic-terra/core/x/oracle/legacy/v06"
+	"github.com/classic-terra/core/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetHistoricRateRetention(ctx, types.DefaultHistoricRateRetention)
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	v06.MigrateParams(ctx, m.keeper.paramSpace)
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.SetPerformanceHistoryWindows(ctx, types.DefaultPerformanceHistoryWindows)
+
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	// keep the all-or-nothing slashing until governance opens the jail-only tier
+	minValidPerWindow := m.keeper.MinValidPerWindow(ctx)
+	m.keeper.SetWarningValidPerWindow(ctx, sdk.MaxDec(types.DefaultWarningValidPerWindow, minValidPerWindow))
+	m.keeper.SetJailValidPerWindow(ctx, minValidPerWindow)
+
+	slashGracePeriod := uint64(types.DefaultSlashGracePeriod)
+	if slashWindow := m.keeper.SlashWindow(ctx); slashGracePeriod > slashWindow {
+		slashGracePeriod = slashWindow
+	}
+	m.keeper.SetSlashGracePeriod(ctx, slashGracePeriod)
+
+	// rate the miss counters of the window of the upgrade against its whole elapsed part
+	m.keeper.seedValidatorPerformances(ctx)
+
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.SetCombinedVoteEnabled(ctx, types.DefaultCombinedVoteEnabled)
+
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.keeper.SetCircuitBreakerRecoveryPeriods(ctx, types.DefaultCircuitBreakerRecoveryPeriods)
+
+	return nil
+}
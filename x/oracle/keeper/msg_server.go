@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -37,15 +38,10 @@ func (ms msgServer) AggregateExchangeRatePrevote(goCtx context.Context, msg *typ
 		return nil, err
 	}
 
-	// Convert hex string to votehash
-	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
-	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	if err := ms.prevote(ctx, valAddr, msg.Hash); err != nil {
+		return nil, err
 	}
 
-	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight()))
-	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, aggregatePrevote)
-
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeAggregatePrevote,
@@ -78,57 +74,169 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 		return nil, err
 	}
 
+	if err := ms.reveal(ctx, valAddr, msg.Salt, msg.ExchangeRates); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregateVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRateVoteResponse{}, nil
+}
+
+func (ms msgServer) AggregateExchangeRateCombinedVote(goCtx context.Context, msg *types.MsgAggregateExchangeRateCombinedVote) (*types.MsgAggregateExchangeRateCombinedVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.CombinedVoteEnabled(ctx) {
+		return nil, types.ErrCombinedVoteDisabled
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	// Reveal the prevote of the previous vote period before it is replaced
+	if err := ms.reveal(ctx, valAddr, msg.Salt, msg.ExchangeRates); err != nil {
+		return nil, err
+	}
+
+	if err := ms.prevote(ctx, valAddr, msg.Hash); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregateVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates),
+		),
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRateCombinedVoteResponse{}, nil
+}
+
+// prevote stores the aggregate prevote of the validator with the given hash
+func (ms msgServer) prevote(ctx sdk.Context, valAddr sdk.ValAddress, hash string) error {
+	// Convert hex string to votehash
+	voteHash, err := types.AggregateVoteHashFromHexString(hash)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight()))
+	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, aggregatePrevote)
+
+	return nil
+}
+
+// reveal verifies the exchange rates against the aggregate prevote of the
+// validator submitted in the previous vote period and stores them as its aggregate vote
+func (ms msgServer) reveal(ctx sdk.Context, valAddr sdk.ValAddress, salt string, exchangeRates string) error {
 	params := ms.GetParams(ctx)
 
 	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrNoAggregatePrevote, msg.Validator)
+		return sdkerrors.Wrap(types.ErrNoAggregatePrevote, valAddr.String())
 	}
 
 	// Check a msg is submitted proper period
 	if (uint64(ctx.BlockHeight())/params.VotePeriod)-(aggregatePrevote.SubmitBlock/params.VotePeriod) != 1 {
-		return nil, types.ErrRevealPeriodMissMatch
+		return types.ErrRevealPeriodMissMatch
 	}
 
-	exchangeRateTuples, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
+	exchangeRateTuples, err := types.ParseExchangeRateTuples(exchangeRates)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
 	}
 
 	// check all denoms are in the vote target
 	for _, tuple := range exchangeRateTuples {
 		if !ms.IsVoteTarget(ctx, tuple.Denom) {
-			return nil, sdkerrors.Wrap(types.ErrUnknownDenom, tuple.Denom)
+			return sdkerrors.Wrap(types.ErrUnknownDenom, tuple.Denom)
 		}
 	}
 
 	// Verify a exchange rate with aggregate prevote hash
-	hash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddr)
+	hash := types.GetAggregateVoteHash(salt, exchangeRates, valAddr)
 	if aggregatePrevote.Hash != hash.String() {
-		return nil, sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, hash)
+		return sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, hash)
 	}
 
 	// Move aggregate prevote to aggregate vote with given exchange rates
 	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr))
 	ms.DeleteAggregateExchangeRatePrevote(ctx, valAddr)
 
+	return nil
+}
+
+func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDelegateFeedConsent) (*types.MsgDelegateFeedConsentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegateAddr, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the delegator is a validator
+	val := ms.StakingKeeper.Validator(ctx, operatorAddr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
+	}
+
+	// Set the delegation, which overrides the pending rotation
+	ms.SetFeederDelegation(ctx, operatorAddr, delegateAddr)
+	ms.DeleteFeederRotation(ctx, operatorAddr)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeAggregateVote,
-			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
-			sdk.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates),
+			types.EventTypeFeedDelegate,
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Delegate),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
 		),
 	})
 
-	return &types.MsgAggregateExchangeRateVoteResponse{}, nil
+	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
 
-func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDelegateFeedConsent) (*types.MsgDelegateFeedConsentResponse, error) {
+func (ms msgServer) RotateFeeder(goCtx context.Context, msg *types.MsgRotateFeeder) (*types.MsgRotateFeederResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
@@ -136,7 +244,7 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 		return nil, err
 	}
 
-	delegateAddr, err := sdk.AccAddressFromBech32(msg.Delegate)
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
 	if err != nil {
 		return nil, err
 	}
@@ -147,13 +255,20 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
 	}
 
-	// Set the delegation
-	ms.SetFeederDelegation(ctx, operatorAddr, delegateAddr)
+	// Rotation must be scheduled for a future block
+	if msg.ActivationHeight <= uint64(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidActivationHeight, "activation height %d must be greater than the current height %d", msg.ActivationHeight, ctx.BlockHeight())
+	}
+
+	// Schedule the rotation, which replaces the pending one
+	ms.SetFeederRotation(ctx, operatorAddr, types.NewFeederRotation(operatorAddr, feederAddr, msg.ActivationHeight))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeFeedDelegate,
-			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Delegate),
+			types.EventTypeFeederRotation,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatUint(msg.ActivationHeight, 10)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -162,5 +277,83 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 		),
 	})
 
-	return &types.MsgDelegateFeedConsentResponse{}, nil
+	return &types.MsgRotateFeederResponse{}, nil
+}
+
+func (ms msgServer) AddFeeder(goCtx context.Context, msg *types.MsgAddFeeder) (*types.MsgAddFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the delegator is a validator
+	val := ms.StakingKeeper.Validator(ctx, operatorAddr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
+	}
+
+	// Keep the set of additional feeders small
+	if !ms.HasAdditionalFeeder(ctx, operatorAddr, feederAddr) &&
+		len(ms.GetAdditionalFeeders(ctx, operatorAddr)) >= types.MaxAdditionalFeeders {
+		return nil, sdkerrors.Wrap(types.ErrTooManyFeeders, msg.Operator)
+	}
+
+	ms.SetAdditionalFeeder(ctx, operatorAddr, feederAddr)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddFeeder,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgAddFeederResponse{}, nil
+}
+
+func (ms msgServer) RemoveFeeder(goCtx context.Context, msg *types.MsgRemoveFeeder) (*types.MsgRemoveFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ms.HasAdditionalFeeder(ctx, operatorAddr, feederAddr) {
+		return nil, sdkerrors.Wrap(types.ErrNoAdditionalFeeder, msg.Feeder)
+	}
+
+	ms.DeleteAdditionalFeeder(ctx, operatorAddr, feederAddr)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveFeeder,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRemoveFeederResponse{}, nil
 }
@@ -71,6 +71,158 @@ func TestMsgServer_FeederDelegation(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_RotateFeeder(t *testing.T) {
+	input, msgServer := setup(t)
+	ctx := input.Ctx.WithBlockHeight(10)
+
+	salt := "1"
+	hash := types.GetAggregateVoteHash(salt, randomExchangeRate.String()+core.MicroSDRDenom, ValAddrs[0])
+
+	// Case 1: empty message
+	_, err := msgServer.RotateFeeder(sdk.WrapSDKContext(ctx), &types.MsgRotateFeeder{})
+	require.Error(t, err)
+
+	// Case 2: activation height not in the future
+	_, err = msgServer.RotateFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRotateFeeder(ValAddrs[0], Addrs[2], 10))
+	require.Error(t, err)
+
+	// Case 3: current feeder Addrs[1], rotating to Addrs[2] at height 20
+	input.OracleKeeper.SetFeederDelegation(ctx, ValAddrs[0], Addrs[1])
+	_, err = msgServer.RotateFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRotateFeeder(ValAddrs[0], Addrs[2], 20))
+	require.NoError(t, err)
+
+	// both feeders are allowed until the activation height
+	require.NoError(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[1], ValAddrs[0]))
+	require.NoError(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[2], ValAddrs[0]))
+	require.Error(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[3], ValAddrs[0]))
+
+	// prevote of the current feeder is revealed by the new feeder
+	_, err = msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[1], ValAddrs[0]))
+	require.NoError(t, err)
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx.WithBlockHeight(11)), types.NewMsgAggregateExchangeRateVote(salt, randomExchangeRate.String()+core.MicroSDRDenom, Addrs[2], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// not activated yet
+	input.OracleKeeper.ApplyFeederRotations(ctx.WithBlockHeight(19))
+	require.Equal(t, Addrs[1], input.OracleKeeper.GetFeederDelegation(ctx, ValAddrs[0]))
+
+	// activated at the activation height
+	input.OracleKeeper.ApplyFeederRotations(ctx.WithBlockHeight(20))
+	require.Equal(t, Addrs[2], input.OracleKeeper.GetFeederDelegation(ctx, ValAddrs[0]))
+	require.Error(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[1], ValAddrs[0]))
+	_, err = input.OracleKeeper.GetFeederRotation(ctx, ValAddrs[0])
+	require.Error(t, err)
+
+	// Case 4: immediate delegation cancels the pending rotation
+	_, err = msgServer.RotateFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRotateFeeder(ValAddrs[0], Addrs[3], 30))
+	require.NoError(t, err)
+	_, err = msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeedConsent(ValAddrs[0], Addrs[4]))
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetFeederRotation(ctx, ValAddrs[0])
+	require.Error(t, err)
+	require.Error(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[3], ValAddrs[0]))
+}
+
+func TestMsgServer_AggregateCombinedVote(t *testing.T) {
+	input, msgServer := setup(t)
+
+	salt := "1"
+	exchangeRatesStr := fmt.Sprintf("1000.23%s,0.29%s,0.27%s", core.MicroKRWDenom, core.MicroUSDDenom, core.MicroSDRDenom)
+	nextSalt := "2"
+	nextExchangeRatesStr := fmt.Sprintf("1000.12%s,0.29%s,0.27%s", core.MicroKRWDenom, core.MicroUSDDenom, core.MicroSDRDenom)
+
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, ValAddrs[0])
+	nextHash := types.GetAggregateVoteHash(nextSalt, nextExchangeRatesStr, ValAddrs[0])
+
+	_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// Disabled by default
+	ctx := input.Ctx.WithBlockHeight(1)
+	combinedVoteMsg := types.NewMsgAggregateExchangeRateCombinedVote(salt, exchangeRatesStr, nextHash, Addrs[0], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateCombinedVote(sdk.WrapSDKContext(ctx), combinedVoteMsg)
+	require.ErrorIs(t, err, types.ErrCombinedVoteDisabled)
+
+	input.OracleKeeper.SetCombinedVoteEnabled(input.Ctx, true)
+
+	// Unauthorized feeder
+	_, err = msgServer.AggregateExchangeRateCombinedVote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRateCombinedVote(salt, exchangeRatesStr, nextHash, Addrs[1], ValAddrs[0]))
+	require.Error(t, err)
+
+	// Reveal does not match the prevote
+	_, err = msgServer.AggregateExchangeRateCombinedVote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRateCombinedVote(nextSalt, exchangeRatesStr, nextHash, Addrs[0], ValAddrs[0]))
+	require.Error(t, err)
+
+	// Valid combined vote reveals the prevote and submits the next one
+	_, err = msgServer.AggregateExchangeRateCombinedVote(sdk.WrapSDKContext(ctx), combinedVoteMsg)
+	require.NoError(t, err)
+
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(ctx, ValAddrs[0])
+	require.NoError(t, err)
+	exchangeRateTuples, err := types.ParseExchangeRateTuples(exchangeRatesStr)
+	require.NoError(t, err)
+	require.Equal(t, exchangeRateTuples, vote.ExchangeRateTuples)
+
+	prevote, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, nextHash.String(), prevote.Hash)
+	require.Equal(t, uint64(1), prevote.SubmitBlock)
+
+	// The next prevote is revealed in the next vote period
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx.WithBlockHeight(2)), types.NewMsgAggregateExchangeRateVote(nextSalt, nextExchangeRatesStr, Addrs[0], ValAddrs[0]))
+	require.NoError(t, err)
+}
+
+func TestMsgServer_AddRemoveFeeder(t *testing.T) {
+	input, msgServer := setup(t)
+	ctx := input.Ctx
+
+	salt := "1"
+	hash := types.GetAggregateVoteHash(salt, randomExchangeRate.String()+core.MicroSDRDenom, ValAddrs[0])
+
+	// Case 1: empty message
+	_, err := msgServer.AddFeeder(sdk.WrapSDKContext(ctx), &types.MsgAddFeeder{})
+	require.Error(t, err)
+
+	// Case 2: primary feeder Addrs[1] with the standby feeder Addrs[2]
+	input.OracleKeeper.SetFeederDelegation(ctx, ValAddrs[0], Addrs[1])
+	_, err = msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(ValAddrs[0], Addrs[2]))
+	require.NoError(t, err)
+
+	require.NoError(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[1], ValAddrs[0]))
+	require.NoError(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[2], ValAddrs[0]))
+	require.Error(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[3], ValAddrs[0]))
+	require.Error(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[2], ValAddrs[1]))
+
+	// prevote of the primary feeder is revealed by the standby feeder
+	_, err = msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[1], ValAddrs[0]))
+	require.NoError(t, err)
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx.WithBlockHeight(1)), types.NewMsgAggregateExchangeRateVote(salt, randomExchangeRate.String()+core.MicroSDRDenom, Addrs[2], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// Case 3: adding an authorized feeder again does not count twice
+	_, err = msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(ValAddrs[0], Addrs[2]))
+	require.NoError(t, err)
+	require.Len(t, input.OracleKeeper.GetAdditionalFeeders(ctx, ValAddrs[0]), 1)
+
+	// Case 4: too many additional feeders
+	for i := 1; i < types.MaxAdditionalFeeders; i++ {
+		_, err = msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(ValAddrs[0], sdk.AccAddress(fmt.Sprintf("feeder%d______________", i))))
+		require.NoError(t, err)
+	}
+	_, err = msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(ValAddrs[0], Addrs[3]))
+	require.Error(t, err)
+
+	// Case 5: revoked feeder is not allowed anymore
+	_, err = msgServer.RemoveFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRemoveFeeder(ValAddrs[0], Addrs[2]))
+	require.NoError(t, err)
+	require.Error(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[2], ValAddrs[0]))
+
+	// Case 6: unknown feeder cannot be removed
+	_, err = msgServer.RemoveFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRemoveFeeder(ValAddrs[0], Addrs[2]))
+	require.Error(t, err)
+}
+
 func TestMsgServer_AggregatePrevoteVote(t *testing.T) {
 	input, msgServer := setup(t)
 
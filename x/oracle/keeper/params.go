@@ -37,7 +37,6 @@ func (k Keeper) Whitelist(ctx sdk.Context) (res types.DenomList) {
 }
 
 // SetWhitelist store new whitelist to param store
-// this function is only for test purpose
 func (k Keeper) SetWhitelist(ctx sdk.Context, whitelist types.DenomList) {
 	k.paramSpace.Set(ctx, types.KeyWhitelist, whitelist)
 }
@@ -60,6 +59,83 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// HistoricRateRetention returns the number of blocks historic exchange rates are kept for
+func (k Keeper) HistoricRateRetention(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyHistoricRateRetention, &res)
+	return
+}
+
+// SetHistoricRateRetention updates the number of blocks historic exchange rates are kept for
+func (k Keeper) SetHistoricRateRetention(ctx sdk.Context, historicRateRetention uint64) {
+	k.paramSpace.Set(ctx, types.KeyHistoricRateRetention, historicRateRetention)
+}
+
+// PerformanceHistoryWindows returns the number of slash windows validator performances are kept for
+func (k Keeper) PerformanceHistoryWindows(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyPerformanceHistoryWindows, &res)
+	return
+}
+
+// SetPerformanceHistoryWindows updates the number of slash windows validator performances are kept for
+func (k Keeper) SetPerformanceHistoryWindows(ctx sdk.Context, performanceHistoryWindows uint64) {
+	k.paramSpace.Set(ctx, types.KeyPerformanceHistoryWindows, performanceHistoryWindows)
+}
+
+// WarningValidPerWindow returns the valid vote rate below which a warning is emitted for the validator
+func (k Keeper) WarningValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyWarningValidPerWindow, &res)
+	return
+}
+
+// SetWarningValidPerWindow updates the valid vote rate below which a warning is emitted for the validator
+func (k Keeper) SetWarningValidPerWindow(ctx sdk.Context, warningValidPerWindow sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyWarningValidPerWindow, warningValidPerWindow)
+}
+
+// JailValidPerWindow returns the valid vote rate below which the validator is jailed without slashing
+func (k Keeper) JailValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyJailValidPerWindow, &res)
+	return
+}
+
+// SetJailValidPerWindow updates the valid vote rate below which the validator is jailed without slashing
+func (k Keeper) SetJailValidPerWindow(ctx sdk.Context, jailValidPerWindow sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyJailValidPerWindow, jailValidPerWindow)
+}
+
+// SlashGracePeriod returns the number of blocks a validator must have been active in the slash window to be penalized
+func (k Keeper) SlashGracePeriod(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeySlashGracePeriod, &res)
+	return
+}
+
+// SetSlashGracePeriod updates the number of blocks a validator must have been active in the slash window to be penalized
+func (k Keeper) SetSlashGracePeriod(ctx sdk.Context, slashGracePeriod uint64) {
+	k.paramSpace.Set(ctx, types.KeySlashGracePeriod, slashGracePeriod)
+}
+
+// CombinedVoteEnabled returns whether the combined vote is allowed
+func (k Keeper) CombinedVoteEnabled(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyCombinedVoteEnabled, &res)
+	return
+}
+
+// SetCombinedVoteEnabled updates whether the combined vote is allowed
+func (k Keeper) SetCombinedVoteEnabled(ctx sdk.Context, combinedVoteEnabled bool) {
+	k.paramSpace.Set(ctx, types.KeyCombinedVoteEnabled, combinedVoteEnabled)
+}
+
+// CircuitBreakerRecoveryPeriods returns the number of consecutive in-band tallies to resume a halted denom
+func (k Keeper) CircuitBreakerRecoveryPeriods(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyCircuitBreakerRecoveryPeriods, &res)
+	return
+}
+
+// SetCircuitBreakerRecoveryPeriods updates the number of consecutive in-band tallies to resume a halted denom
+func (k Keeper) SetCircuitBreakerRecoveryPeriods(ctx sdk.Context, circuitBreakerRecoveryPeriods uint64) {
+	k.paramSpace.Set(ctx, types.KeyCircuitBreakerRecoveryPeriods, circuitBreakerRecoveryPeriods)
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
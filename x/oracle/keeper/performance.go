@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// PerformanceWindow returns the index of the slash window the current block belongs to
+func (k Keeper) PerformanceWindow(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockHeight()) / k.SlashWindow(ctx)
+}
+
+// GetValidatorPerformance returns the performance of the validator in the slash window
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, window uint64) types.ValidatorPerformance {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPerformanceKey(operator, window))
+	if bz == nil {
+		return types.NewValidatorPerformance(operator, window)
+	}
+
+	var performance types.ValidatorPerformance
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance
+}
+
+// SetValidatorPerformance stores the performance of the validator in the slash window
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformanceKey(operator, performance.Window), bz)
+}
+
+// IterateValidatorPerformances iterates over the performances of all validators in the store
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorPerformanceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2 : len(iter.Key())-8])
+
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(operator, performance) {
+			break
+		}
+	}
+}
+
+// GetValidatorPerformances returns the performances of the validator, oldest window first
+func (k Keeper) GetValidatorPerformances(ctx sdk.Context, operator sdk.ValAddress) (performances []types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorPerformancesKey(operator))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+		performances = append(performances, performance)
+	}
+
+	return performances
+}
+
+// RecordVotePeriodPerformances records the outcome of the tallied vote period
+// to the performances of the validators in the active set
+func (k Keeper) RecordVotePeriodPerformances(ctx sdk.Context, voteTargets map[string]sdk.Dec, validatorClaimMap map[string]types.Claim) {
+	voted := make(map[string]bool)
+	abstained := make(map[string]bool)
+	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
+		voted[vote.Voter] = true
+		for _, tuple := range vote.ExchangeRateTuples {
+			if _, ok := voteTargets[tuple.Denom]; ok && !tuple.ExchangeRate.IsPositive() {
+				abstained[vote.Voter] = true
+			}
+		}
+
+		return false
+	})
+
+	window := k.PerformanceWindow(ctx)
+	voteTargetsLen := len(voteTargets)
+	for key, claim := range validatorClaimMap {
+		performance := k.GetValidatorPerformance(ctx, claim.Recipient, window)
+		performance.VotePeriods++
+		if voted[key] {
+			performance.Votes++
+		}
+		if abstained[key] {
+			performance.Abstains++
+		}
+		if int(claim.WinCount) != voteTargetsLen {
+			performance.Misses++
+		}
+		performance.WinCount += uint64(claim.WinCount)
+
+		k.SetValidatorPerformance(ctx, claim.Recipient, performance)
+	}
+}
+
+// seedValidatorPerformances counts the vote periods of the slash window elapsed before the
+// performances were recorded, for the bonded validators and the validators with a miss counter.
+// The miss counters cover the whole window, so they would otherwise be rated against the vote
+// periods recorded since the upgrade only. The validators are assumed active since the window start.
+func (k Keeper) seedValidatorPerformances(ctx sdk.Context) {
+	window := k.PerformanceWindow(ctx)
+	elapsedVotePeriods := (uint64(ctx.BlockHeight()) - window*k.SlashWindow(ctx)) / k.VotePeriod(ctx)
+	if elapsedVotePeriods == 0 {
+		return
+	}
+
+	var operators []sdk.ValAddress
+	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, _ uint64) (stop bool) {
+		operators = append(operators, operator)
+		return false
+	})
+
+	maxValidators := k.StakingKeeper.MaxValidators(ctx)
+	iterator := k.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+
+	i := 0
+	for ; iterator.Valid() && i < int(maxValidators); iterator.Next() {
+		validator := k.StakingKeeper.Validator(ctx, iterator.Value())
+		if validator.IsBonded() {
+			operators = append(operators, validator.GetOperator())
+			i++
+		}
+	}
+
+	// a validator listed twice is seeded once, the performance being set the first time
+	for _, operator := range operators {
+		performance := k.GetValidatorPerformance(ctx, operator, window)
+		if performance.VotePeriods < elapsedVotePeriods {
+			performance.VotePeriods = elapsedVotePeriods
+			k.SetValidatorPerformance(ctx, operator, performance)
+		}
+	}
+}
+
+// AddValidatorPerformanceRewards adds the oracle rewards the validator received to its performance
+func (k Keeper) AddValidatorPerformanceRewards(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	performance := k.GetValidatorPerformance(ctx, operator, k.PerformanceWindow(ctx))
+	performance.Rewards = performance.Rewards.Add(rewards...)
+	k.SetValidatorPerformance(ctx, operator, performance)
+}
+
+// PruneValidatorPerformances deletes the performances of the windows older than
+// the PerformanceHistoryWindows windows before the next window
+func (k Keeper) PruneValidatorPerformances(ctx sdk.Context) {
+	window := k.PerformanceWindow(ctx)
+	historyWindows := k.PerformanceHistoryWindows(ctx)
+
+	var keys [][]byte
+	k.IterateValidatorPerformances(ctx, func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		if performance.Window+historyWindows <= window {
+			keys = append(keys, types.GetValidatorPerformanceKey(operator, performance.Window))
+		}
+
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
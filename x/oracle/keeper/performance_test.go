@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+func TestRecordVotePeriodPerformances(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashWindow = 10
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	ctx := input.Ctx.WithBlockHeight(25)
+
+	voteTargets := map[string]sdk.Dec{
+		core.MicroSDRDenom: types.DefaultTobinTax,
+		core.MicroKRWDenom: types.DefaultTobinTax,
+	}
+
+	// validator 0 votes on both targets, validator 1 abstains on one, validator 2 does not vote
+	input.OracleKeeper.SetAggregateExchangeRateVote(ctx, ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		types.NewExchangeRateTuple(core.MicroSDRDenom, sdk.OneDec()),
+		types.NewExchangeRateTuple(core.MicroKRWDenom, sdk.OneDec()),
+	}, ValAddrs[0]))
+	input.OracleKeeper.SetAggregateExchangeRateVote(ctx, ValAddrs[1], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		types.NewExchangeRateTuple(core.MicroSDRDenom, sdk.OneDec()),
+		types.NewExchangeRateTuple(core.MicroKRWDenom, sdk.ZeroDec()),
+	}, ValAddrs[1]))
+
+	validatorClaimMap := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(10, 10, 2, ValAddrs[0]),
+		ValAddrs[1].String(): types.NewClaim(10, 10, 1, ValAddrs[1]),
+		ValAddrs[2].String(): types.NewClaim(10, 0, 0, ValAddrs[2]),
+	}
+	input.OracleKeeper.RecordVotePeriodPerformances(ctx, voteTargets, validatorClaimMap)
+	input.OracleKeeper.RecordVotePeriodPerformances(ctx, voteTargets, validatorClaimMap)
+
+	performance := input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[0], 2)
+	require.Equal(t, uint64(2), performance.VotePeriods)
+	require.Equal(t, uint64(2), performance.Votes)
+	require.Equal(t, uint64(0), performance.Abstains)
+	require.Equal(t, uint64(0), performance.Misses)
+	require.Equal(t, uint64(4), performance.WinCount)
+
+	performance = input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[1], 2)
+	require.Equal(t, uint64(2), performance.VotePeriods)
+	require.Equal(t, uint64(2), performance.Votes)
+	require.Equal(t, uint64(2), performance.Abstains)
+	require.Equal(t, uint64(2), performance.Misses)
+	require.Equal(t, uint64(2), performance.WinCount)
+
+	performance = input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[2], 2)
+	require.Equal(t, uint64(2), performance.VotePeriods)
+	require.Equal(t, uint64(0), performance.Votes)
+	require.Equal(t, uint64(2), performance.Misses)
+	require.Equal(t, uint64(0), performance.WinCount)
+
+	// nothing recorded to the other windows
+	require.Equal(t, types.NewValidatorPerformance(ValAddrs[0], 1), input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[0], 1))
+}
+
+func TestValidatorPerformanceRewards(t *testing.T) {
+	input := CreateTestInput(t)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100))
+	input.OracleKeeper.AddValidatorPerformanceRewards(input.Ctx, ValAddrs[0], rewards)
+	input.OracleKeeper.AddValidatorPerformanceRewards(input.Ctx, ValAddrs[0], rewards)
+
+	performance := input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[0], input.OracleKeeper.PerformanceWindow(input.Ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 200)), performance.Rewards)
+}
+
+func TestPruneValidatorPerformances(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashWindow = 10
+	params.PerformanceHistoryWindows = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	for window := uint64(0); window < 10; window++ {
+		for _, valAddr := range ValAddrs[:2] {
+			performance := types.NewValidatorPerformance(valAddr, window)
+			performance.VotePeriods = 10
+			input.OracleKeeper.SetValidatorPerformance(input.Ctx, valAddr, performance)
+		}
+	}
+
+	// window 9, so windows 7 to 9 remain
+	input.OracleKeeper.PruneValidatorPerformances(input.Ctx.WithBlockHeight(99))
+
+	for _, valAddr := range ValAddrs[:2] {
+		performances := input.OracleKeeper.GetValidatorPerformances(input.Ctx, valAddr)
+		require.Len(t, performances, 3)
+		for i, performance := range performances {
+			require.Equal(t, uint64(7+i), performance.Window)
+			require.Equal(t, valAddr.String(), performance.ValidatorAddress)
+		}
+	}
+}
@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/classic-terra/core/x/oracle/types"
 )
@@ -136,6 +139,30 @@ func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDeleg
 	}, nil
 }
 
+// Feeders queries all the account addresses authorized to feed for the validator operator
+func (q querier) Feeders(c context.Context, req *types.QueryFeedersRequest) (*types.QueryFeedersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	additionalFeeders := []string{}
+	for _, feeder := range q.GetAdditionalFeeders(ctx, valAddr) {
+		additionalFeeders = append(additionalFeeders, feeder.String())
+	}
+
+	return &types.QueryFeedersResponse{
+		FeederAddr:            q.GetFeederDelegation(ctx, valAddr).String(),
+		AdditionalFeederAddrs: additionalFeeders,
+	}, nil
+}
+
 // MissCounter queries oracle miss counter of a validator
 func (q querier) MissCounter(c context.Context, req *types.QueryMissCounterRequest) (*types.QueryMissCounterResponse, error) {
 	if req == nil {
@@ -226,3 +253,257 @@ func (q querier) AggregateVotes(c context.Context, req *types.QueryAggregateVote
 		AggregateVotes: votes,
 	}, nil
 }
+
+// HistoricExchangeRates queries exchange rate snapshots of a denom
+func (q querier) HistoricExchangeRates(c context.Context, req *types.QueryHistoricExchangeRatesRequest) (*types.QueryHistoricExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetHistoricExchangeRatesKey(req.Denom))
+
+	var historicRates []types.HistoricExchangeRate
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var historicRate types.HistoricExchangeRate
+		if err := q.cdc.Unmarshal(value, &historicRate); err != nil {
+			return err
+		}
+
+		historicRates = append(historicRates, historicRate)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricExchangeRatesResponse{
+		HistoricExchangeRates: historicRates,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// Twap queries time-weighted average exchange rate of a denom
+func (q querier) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	if (req.WindowBlocks == 0) == (req.WindowSeconds == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of window blocks and window seconds must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		twap sdk.Dec
+		err  error
+	)
+	if req.WindowBlocks != 0 {
+		twap, err = q.GetTWAPByBlocks(ctx, req.Denom, req.WindowBlocks)
+	} else {
+		twap, err = q.GetTWAPByTime(ctx, req.Denom, time.Duration(req.WindowSeconds)*time.Second)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{Twap: twap}, nil
+}
+
+// ValidatorPerformances queries oracle performances of a validator per slash window
+func (q querier) ValidatorPerformances(c context.Context, req *types.QueryValidatorPerformancesRequest) (*types.QueryValidatorPerformancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetValidatorPerformancesKey(valAddr))
+
+	var performances []types.ValidatorPerformance
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var performance types.ValidatorPerformance
+		if err := q.cdc.Unmarshal(value, &performance); err != nil {
+			return err
+		}
+
+		performances = append(performances, performance)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorPerformancesResponse{
+		Performances: performances,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// ValidatorPerformanceSummary queries oracle performance of a validator over the kept slash windows
+func (q querier) ValidatorPerformanceSummary(c context.Context, req *types.QueryValidatorPerformanceSummaryRequest) (*types.QueryValidatorPerformanceSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorPerformanceSummaryResponse{
+		Summary: types.NewValidatorPerformanceSummary(valAddr, q.GetValidatorPerformances(ctx, valAddr)),
+	}, nil
+}
+
+// PenaltyOutcome queries the penalty tier applied to a validator at the end of the last slash window
+func (q querier) PenaltyOutcome(c context.Context, req *types.QueryPenaltyOutcomeRequest) (*types.QueryPenaltyOutcomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	outcome, err := q.GetPenaltyOutcome(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPenaltyOutcomeResponse{
+		PenaltyOutcome: outcome,
+	}, nil
+}
+
+// PenaltyOutcomes queries the penalty tiers applied to all validators at the end of the last slash window
+func (q querier) PenaltyOutcomes(c context.Context, req *types.QueryPenaltyOutcomesRequest) (*types.QueryPenaltyOutcomesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.PenaltyOutcomeKey)
+
+	var outcomes []types.PenaltyOutcome
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var outcome types.PenaltyOutcome
+		if err := q.cdc.Unmarshal(value, &outcome); err != nil {
+			return err
+		}
+
+		outcomes = append(outcomes, outcome)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPenaltyOutcomesResponse{
+		PenaltyOutcomes: outcomes,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// FeederRotations queries the pending feeder rotations of all validators
+func (q querier) FeederRotations(c context.Context, req *types.QueryFeederRotationsRequest) (*types.QueryFeederRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.FeederRotationKey)
+
+	var rotations []types.FeederRotation
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var rotation types.FeederRotation
+		if err := q.cdc.Unmarshal(value, &rotation); err != nil {
+			return err
+		}
+
+		rotations = append(rotations, rotation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeederRotationsResponse{
+		FeederRotations: rotations,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// HaltedDenoms queries the denoms halted by the circuit breaker
+func (q querier) HaltedDenoms(c context.Context, req *types.QueryHaltedDenomsRequest) (*types.QueryHaltedDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.HaltedDenomKey)
+
+	var haltedDenoms []types.HaltedDenom
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var haltedDenom types.HaltedDenom
+		if err := q.cdc.Unmarshal(value, &haltedDenom); err != nil {
+			return err
+		}
+
+		haltedDenoms = append(haltedDenoms, haltedDenom)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHaltedDenomsResponse{
+		HaltedDenoms: haltedDenoms,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// WhitelistUpdates queries the whitelist updates scheduled by governance
+func (q querier) WhitelistUpdates(c context.Context, req *types.QueryWhitelistUpdatesRequest) (*types.QueryWhitelistUpdatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.WhitelistUpdateKey)
+
+	var updates []types.WhitelistUpdate
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var update types.WhitelistUpdate
+		if err := q.cdc.Unmarshal(value, &update); err != nil {
+			return err
+		}
+
+		updates = append(updates, update)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWhitelistUpdatesResponse{
+		WhitelistUpdates: updates,
+		Pagination:       pageRes,
+	}, nil
+}
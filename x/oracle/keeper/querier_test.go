@@ -123,6 +123,28 @@ func TestQueryFeederDelegation(t *testing.T) {
 	require.Equal(t, Addrs[1].String(), res.FeederAddr)
 }
 
+func TestQueryFeeders(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, ValAddrs[0], Addrs[1])
+	input.OracleKeeper.SetAdditionalFeeder(input.Ctx, ValAddrs[0], Addrs[2])
+	input.OracleKeeper.SetAdditionalFeeder(input.Ctx, ValAddrs[1], Addrs[3])
+
+	// empty request
+	_, err := querier.Feeders(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.Feeders(ctx, &types.QueryFeedersRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, Addrs[1].String(), res.FeederAddr)
+	require.Equal(t, []string{Addrs[2].String()}, res.AdditionalFeederAddrs)
+}
+
 func TestQueryAggregatePrevote(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -290,3 +312,177 @@ func TestQueryTobinTax(t *testing.T) {
 
 	require.Equal(t, denom.TobinTax, res.TobinTax)
 }
+
+func TestQueryHistoricExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	for height := int64(1); height <= 3; height++ {
+		input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(height), core.MicroSDRDenom, sdk.NewDec(height))
+	}
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(3), core.MicroKRWDenom, sdk.OneDec())
+
+	// empty request
+	_, err := querier.HistoricExchangeRates(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.HistoricExchangeRates(ctx, &types.QueryHistoricExchangeRatesRequest{
+		Denom: core.MicroSDRDenom,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.HistoricExchangeRates, 3)
+	for i, historicRate := range res.HistoricExchangeRates {
+		require.Equal(t, core.MicroSDRDenom, historicRate.Denom)
+		require.Equal(t, int64(i+1), historicRate.BlockHeight)
+		require.Equal(t, sdk.NewDec(int64(i+1)), historicRate.ExchangeRate)
+	}
+}
+
+func TestQueryTwap(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx.WithBlockHeight(10))
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(4), core.MicroSDRDenom, sdk.NewDec(10))
+	input.OracleKeeper.AddHistoricExchangeRate(input.Ctx.WithBlockHeight(8), core.MicroSDRDenom, sdk.NewDec(20))
+
+	// empty request
+	_, err := querier.Twap(ctx, nil)
+	require.Error(t, err)
+
+	// both windows set
+	_, err = querier.Twap(ctx, &types.QueryTwapRequest{Denom: core.MicroSDRDenom, WindowBlocks: 4, WindowSeconds: 4})
+	require.Error(t, err)
+
+	// no snapshot for the denom
+	_, err = querier.Twap(ctx, &types.QueryTwapRequest{Denom: core.MicroKRWDenom, WindowBlocks: 4})
+	require.Error(t, err)
+
+	res, err := querier.Twap(ctx, &types.QueryTwapRequest{Denom: core.MicroSDRDenom, WindowBlocks: 4})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(15), res.Twap)
+}
+
+func TestQueryValidatorPerformances(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	for window := uint64(0); window < 3; window++ {
+		performance := types.NewValidatorPerformance(ValAddrs[0], window)
+		performance.VotePeriods = 10
+		performance.Votes = 9
+		performance.Misses = 1
+		performance.WinCount = 18
+		performance.Rewards = sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100))
+		input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[0], performance)
+	}
+	input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[1], types.NewValidatorPerformance(ValAddrs[1], 0))
+
+	// empty request
+	_, err := querier.ValidatorPerformances(ctx, nil)
+	require.Error(t, err)
+
+	// invalid address
+	_, err = querier.ValidatorPerformances(ctx, &types.QueryValidatorPerformancesRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+
+	res, err := querier.ValidatorPerformances(ctx, &types.QueryValidatorPerformancesRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Performances, 3)
+	for i, performance := range res.Performances {
+		require.Equal(t, uint64(i), performance.Window)
+	}
+
+	summaryRes, err := querier.ValidatorPerformanceSummary(ctx, &types.QueryValidatorPerformanceSummaryRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), summaryRes.Summary.WindowsServed)
+	require.Equal(t, uint64(30), summaryRes.Summary.VotePeriods)
+	require.Equal(t, uint64(27), summaryRes.Summary.Votes)
+	require.Equal(t, uint64(3), summaryRes.Summary.Misses)
+	require.Equal(t, uint64(54), summaryRes.Summary.WinCount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 300)), summaryRes.Summary.Rewards)
+
+	// validator without performance
+	summaryRes, err = querier.ValidatorPerformanceSummary(ctx, &types.QueryValidatorPerformanceSummaryRequest{ValidatorAddr: ValAddrs[2].String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), summaryRes.Summary.WindowsServed)
+}
+
+func TestQueryPenaltyOutcomes(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	outcome := types.NewPenaltyOutcome(ValAddrs[0], 1, types.PenaltyTierWarning, sdk.NewDecWithPrec(15, 2), 100, 85)
+	input.OracleKeeper.SetPenaltyOutcome(input.Ctx, ValAddrs[0], outcome)
+	input.OracleKeeper.SetPenaltyOutcome(input.Ctx, ValAddrs[1], types.NewPenaltyOutcome(ValAddrs[1], 1, types.PenaltyTierJail, sdk.NewDecWithPrec(5, 2), 100, 95))
+
+	// empty request
+	_, err := querier.PenaltyOutcome(ctx, nil)
+	require.Error(t, err)
+
+	// validator without outcome
+	_, err = querier.PenaltyOutcome(ctx, &types.QueryPenaltyOutcomeRequest{ValidatorAddr: ValAddrs[2].String()})
+	require.Error(t, err)
+
+	res, err := querier.PenaltyOutcome(ctx, &types.QueryPenaltyOutcomeRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, outcome, res.PenaltyOutcome)
+
+	outcomesRes, err := querier.PenaltyOutcomes(ctx, &types.QueryPenaltyOutcomesRequest{})
+	require.NoError(t, err)
+	require.Len(t, outcomesRes.PenaltyOutcomes, 2)
+}
+
+func TestQueryFeederRotations(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rotation := types.NewFeederRotation(ValAddrs[0], Addrs[1], 100)
+	input.OracleKeeper.SetFeederRotation(input.Ctx, ValAddrs[0], rotation)
+
+	// empty request
+	_, err := querier.FeederRotations(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.FeederRotations(ctx, &types.QueryFeederRotationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.FeederRotation{rotation}, res.FeederRotations)
+}
+
+func TestQueryHaltedDenoms(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	haltedDenom := types.NewHaltedDenom(core.MicroKRWDenom, sdk.NewDec(100), sdk.NewDec(200), 10)
+	input.OracleKeeper.SetHaltedDenom(input.Ctx, haltedDenom)
+
+	// empty request
+	_, err := querier.HaltedDenoms(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.HaltedDenoms(ctx, &types.QueryHaltedDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.HaltedDenom{haltedDenom}, res.HaltedDenoms)
+}
+
+func TestQueryWhitelistUpdates(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	update := types.NewWhitelistUpdate(types.WhitelistUpdateActionUpdateTobinTax, types.Denom{Name: core.MicroKRWDenom, TobinTax: sdk.NewDecWithPrec(1, 2)}, 100)
+	input.OracleKeeper.SetWhitelistUpdate(input.Ctx, update)
+
+	// empty request
+	_, err := querier.WhitelistUpdates(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.WhitelistUpdates(ctx, &types.QueryWhitelistUpdatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.WhitelistUpdate{update}, res.WhitelistUpdates)
+}
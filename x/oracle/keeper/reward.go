@@ -77,6 +77,7 @@ func (k Keeper) RewardBallotWinners(
 		if receiverVal != nil && !rewardCoins.IsZero() {
 			k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(rewardCoins...))
 			distributedReward = distributedReward.Add(rewardCoins...)
+			k.AddValidatorPerformanceRewards(ctx, winner.Recipient, rewardCoins)
 		}
 	}
 
@@ -132,6 +133,7 @@ func (k Keeper) RewardBallotWinnersLegacy(
 		if receiverVal != nil && !rewardCoins.IsZero() {
 			k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(rewardCoins...))
 			distributedReward = distributedReward.Add(rewardCoins...)
+			k.AddValidatorPerformanceRewards(ctx, winner.Recipient, rewardCoins)
 		}
 	}
 
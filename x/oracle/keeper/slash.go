@@ -2,31 +2,56 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/classic-terra/core/x/oracle/types"
 )
 
-// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
+// SlashAndResetMissCounters do penalize any operator who over criteria & clear all operators miss counter to zero.
+// The penalty is graduated by the valid vote rate of the operator over the vote periods it was active in the window:
+//   - below MinValidPerWindow, the operator is slashed by SlashFraction and jailed
+//   - below JailValidPerWindow, the operator is jailed without slashing
+//   - below WarningValidPerWindow, a warning is emitted for the operator
+//
+// Operators active for less than SlashGracePeriod blocks in the window are spared.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
 	// slash_window / vote_period
+	votePeriod := k.VotePeriod(ctx)
 	votePeriodsPerWindow := uint64(
 		sdk.NewDec(int64(k.SlashWindow(ctx))).
-			QuoInt64(int64(k.VotePeriod(ctx))).
+			QuoInt64(int64(votePeriod)).
 			TruncateInt64(),
 	)
 	minValidPerWindow := k.MinValidPerWindow(ctx)
+	jailValidPerWindow := k.JailValidPerWindow(ctx)
+	warningValidPerWindow := k.WarningValidPerWindow(ctx)
+	slashGracePeriod := k.SlashGracePeriod(ctx)
 	slashFraction := k.SlashFraction(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+	window := k.PerformanceWindow(ctx)
+
+	// outcomes of the previous window are replaced
+	k.ClearPenaltyOutcomes(ctx)
 
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
-		// Calculate valid vote rate; (SlashWindow - MissCounter)/SlashWindow
+		// Count the vote periods the operator was in the active set; the whole window
+		// is assumed when the performance of the window was not recorded
+		votePeriods := k.GetValidatorPerformance(ctx, operator, window).VotePeriods
+		if votePeriods == 0 || votePeriods < missCounter {
+			votePeriods = votePeriodsPerWindow
+		}
+
+		// Calculate valid vote rate; (VotePeriods - MissCounter)/VotePeriods
 		validVoteRate := sdk.NewDecFromInt(
-			sdk.NewInt(int64(votePeriodsPerWindow - missCounter))).
-			QuoInt64(int64(votePeriodsPerWindow))
+			sdk.NewInt(int64(votePeriods - missCounter))).
+			QuoInt64(int64(votePeriods))
 
-		// Penalize the validator whose the valid vote rate is smaller than min threshold
-		if validVoteRate.LT(minValidPerWindow) {
+		// Penalize the validator whose the valid vote rate is smaller than the thresholds
+		tier := penaltyTier(validVoteRate, minValidPerWindow, jailValidPerWindow, warningValidPerWindow)
+		if tier != "" {
 			validator := k.StakingKeeper.Validator(ctx, operator)
 			if validator.IsBonded() && !validator.IsJailed() {
 				consAddr, err := validator.GetConsAddr()
@@ -34,11 +59,32 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 					panic(err)
 				}
 
-				k.StakingKeeper.Slash(
-					ctx, consAddr,
-					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
+				// Spare the validator which joined the active set late in the window
+				if votePeriods*votePeriod < slashGracePeriod {
+					tier = types.PenaltyTierGrace
+				}
+
+				switch tier {
+				case types.PenaltyTierSlash:
+					k.StakingKeeper.Slash(
+						ctx, consAddr,
+						distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
+					)
+					k.StakingKeeper.Jail(ctx, consAddr)
+				case types.PenaltyTierJail:
+					k.StakingKeeper.Jail(ctx, consAddr)
+				}
+
+				k.SetPenaltyOutcome(ctx, operator, types.NewPenaltyOutcome(operator, window, tier, validVoteRate, votePeriods, missCounter))
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(types.EventTypePenalty,
+						sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+						sdk.NewAttribute(types.AttributeKeyTier, tier),
+						sdk.NewAttribute(types.AttributeKeyValidVoteRate, validVoteRate.String()),
+						sdk.NewAttribute(types.AttributeKeyWindow, sdk.NewUint(window).String()),
+					),
 				)
-				k.StakingKeeper.Jail(ctx, consAddr)
 			}
 		}
 
@@ -46,3 +92,64 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 		return false
 	})
 }
+
+// penaltyTier returns the tier of the penalty for the valid vote rate, or empty string when not penalized
+func penaltyTier(validVoteRate, minValidPerWindow, jailValidPerWindow, warningValidPerWindow sdk.Dec) string {
+	switch {
+	case validVoteRate.LT(minValidPerWindow):
+		return types.PenaltyTierSlash
+	case validVoteRate.LT(jailValidPerWindow):
+		return types.PenaltyTierJail
+	case validVoteRate.LT(warningValidPerWindow):
+		return types.PenaltyTierWarning
+	default:
+		return ""
+	}
+}
+
+// GetPenaltyOutcome returns the penalty outcome of the validator in the last slash window
+func (k Keeper) GetPenaltyOutcome(ctx sdk.Context, operator sdk.ValAddress) (outcome types.PenaltyOutcome, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPenaltyOutcomeKey(operator))
+	if bz == nil {
+		err = sdkerrors.Wrap(types.ErrNoPenaltyOutcome, operator.String())
+		return
+	}
+
+	k.cdc.MustUnmarshal(bz, &outcome)
+	return
+}
+
+// SetPenaltyOutcome stores the penalty outcome of the validator
+func (k Keeper) SetPenaltyOutcome(ctx sdk.Context, operator sdk.ValAddress, outcome types.PenaltyOutcome) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&outcome)
+	store.Set(types.GetPenaltyOutcomeKey(operator), bz)
+}
+
+// IteratePenaltyOutcomes iterates over the penalty outcomes in the store
+func (k Keeper) IteratePenaltyOutcomes(ctx sdk.Context, handler func(operator sdk.ValAddress, outcome types.PenaltyOutcome) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PenaltyOutcomeKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var outcome types.PenaltyOutcome
+		k.cdc.MustUnmarshal(iter.Value(), &outcome)
+
+		if handler(operator, outcome) {
+			break
+		}
+	}
+}
+
+// ClearPenaltyOutcomes deletes all the penalty outcomes in the store
+func (k Keeper) ClearPenaltyOutcomes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PenaltyOutcomeKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
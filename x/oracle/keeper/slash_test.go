@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/classic-terra/core/x/oracle/types"
 )
 
 func TestSlashAndResetMissCounters(t *testing.T) {
@@ -37,6 +39,9 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	)
 	require.Equal(t, amt, input.StakingKeeper.Validator(ctx, addr1).GetBondedTokens())
 
+	// all-or-nothing slashing without the jail-only tier
+	input.OracleKeeper.SetJailValidPerWindow(input.Ctx, input.OracleKeeper.MinValidPerWindow(input.Ctx))
+
 	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
 	slashFraction := input.OracleKeeper.SlashFraction(input.Ctx)
 	minValidVotes := input.OracleKeeper.MinValidPerWindow(input.Ctx).MulInt64(votePeriodsPerWindow).TruncateInt64()
@@ -80,3 +85,139 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 	require.Equal(t, amt, validator.Tokens)
 }
+
+func TestGraduatedPenalties(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	for i := 0; i < 4; i++ {
+		_, err := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[i], ValPubKeys[i], amt))
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	// 100 vote periods per window; slash below 5%, jail below 10%, warn below 20%
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+	params.SlashWindow = 1000
+	params.SlashGracePeriod = 500
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	ctx := input.Ctx.WithBlockHeight(999)
+
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[0], 85)
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[1], 91)
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[2], 96)
+
+	// joined late in the window, active for 40 vote periods which is shorter than the grace period
+	performance := types.NewValidatorPerformance(ValAddrs[3], 0)
+	performance.VotePeriods = 40
+	input.OracleKeeper.SetValidatorPerformance(ctx, ValAddrs[3], performance)
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[3], 40)
+
+	input.OracleKeeper.SlashAndResetMissCounters(ctx)
+
+	// warning
+	validator, _ := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.Equal(t, amt, validator.GetTokens())
+	require.False(t, validator.IsJailed())
+	outcome, err := input.OracleKeeper.GetPenaltyOutcome(ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.PenaltyTierWarning, outcome.Tier)
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), outcome.ValidVoteRate)
+	require.Equal(t, uint64(0), outcome.Window)
+
+	// jail only
+	validator, _ = input.StakingKeeper.GetValidator(ctx, ValAddrs[1])
+	require.Equal(t, amt, validator.GetTokens())
+	require.True(t, validator.IsJailed())
+	outcome, err = input.OracleKeeper.GetPenaltyOutcome(ctx, ValAddrs[1])
+	require.NoError(t, err)
+	require.Equal(t, types.PenaltyTierJail, outcome.Tier)
+
+	// slash and jail
+	validator, _ = input.StakingKeeper.GetValidator(ctx, ValAddrs[2])
+	require.Equal(t, amt.Sub(params.SlashFraction.MulInt(amt).TruncateInt()), validator.GetTokens())
+	require.True(t, validator.IsJailed())
+	outcome, err = input.OracleKeeper.GetPenaltyOutcome(ctx, ValAddrs[2])
+	require.NoError(t, err)
+	require.Equal(t, types.PenaltyTierSlash, outcome.Tier)
+
+	// grace
+	validator, _ = input.StakingKeeper.GetValidator(ctx, ValAddrs[3])
+	require.Equal(t, amt, validator.GetTokens())
+	require.False(t, validator.IsJailed())
+	outcome, err = input.OracleKeeper.GetPenaltyOutcome(ctx, ValAddrs[3])
+	require.NoError(t, err)
+	require.Equal(t, types.PenaltyTierGrace, outcome.Tier)
+	require.Equal(t, uint64(40), outcome.VotePeriods)
+
+	// penalties are emitted as events
+	var tiers []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypePenalty {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyTier {
+				tiers = append(tiers, string(attr.Value))
+			}
+		}
+	}
+	require.ElementsMatch(t, []string{types.PenaltyTierWarning, types.PenaltyTierJail, types.PenaltyTierSlash, types.PenaltyTierGrace}, tiers)
+
+	// outcomes are replaced at the end of the next window
+	input.OracleKeeper.SlashAndResetMissCounters(ctx.WithBlockHeight(1999))
+	_, err = input.OracleKeeper.GetPenaltyOutcome(ctx, ValAddrs[0])
+	require.Error(t, err)
+}
+
+func TestSlashWithoutRecordedVotePeriods(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	// a zero miss counter, e.g. imported from genesis, without recorded performance
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], 0)
+	require.NotPanics(t, func() { input.OracleKeeper.SlashAndResetMissCounters(input.Ctx) })
+
+	_, err = input.OracleKeeper.GetPenaltyOutcome(input.Ctx, ValAddrs[0])
+	require.Error(t, err)
+}
+
+func TestSlashAfterMidWindowUpgrade(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	// 100 vote periods per window, upgraded after 50 of them
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+	params.SlashWindow = 1000
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	ctx := input.Ctx.WithBlockHeight(500)
+
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[0], 5)
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate4to5(ctx))
+	require.Equal(t, uint64(50), input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[0], 0).VotePeriods)
+
+	// 45 misses in the 50 vote periods recorded after the upgrade, 50 misses in the whole window
+	performance := input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[0], 0)
+	performance.VotePeriods += 50
+	input.OracleKeeper.SetValidatorPerformance(ctx, ValAddrs[0], performance)
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[0], 50)
+
+	ctx = ctx.WithBlockHeight(999)
+	input.OracleKeeper.SlashAndResetMissCounters(ctx)
+
+	validator, _ := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.Equal(t, amt, validator.GetTokens())
+	require.False(t, validator.IsJailed())
+	_, err = input.OracleKeeper.GetPenaltyOutcome(ctx, ValAddrs[0])
+	require.Error(t, err)
+}
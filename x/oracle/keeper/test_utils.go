@@ -228,6 +228,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		nil,
 		distrtypes.ModuleName,
 	)
 
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// SetWhitelistUpdate schedules the whitelist update at its activation height
+func (k Keeper) SetWhitelistUpdate(ctx sdk.Context, update types.WhitelistUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&update)
+	store.Set(types.GetWhitelistUpdateKey(update.ActivationHeight, update.Denom.Name), bz)
+}
+
+// HasWhitelistUpdate returns whether an update is scheduled for the denom at the activation height
+func (k Keeper) HasWhitelistUpdate(ctx sdk.Context, activationHeight uint64, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetWhitelistUpdateKey(activationHeight, denom))
+}
+
+// DeleteWhitelistUpdate deletes the whitelist update scheduled for the denom at the activation height
+func (k Keeper) DeleteWhitelistUpdate(ctx sdk.Context, activationHeight uint64, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetWhitelistUpdateKey(activationHeight, denom))
+}
+
+// IterateWhitelistUpdates iterates over the scheduled whitelist updates in the order of activation height
+func (k Keeper) IterateWhitelistUpdates(ctx sdk.Context, handler func(update types.WhitelistUpdate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WhitelistUpdateKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var update types.WhitelistUpdate
+		k.cdc.MustUnmarshal(iter.Value(), &update)
+
+		if handler(update) {
+			break
+		}
+	}
+}
+
+// ApplyWhitelistUpdates applies the scheduled whitelist updates whose activation
+// height is reached to the whitelist param, in the order of activation height
+func (k Keeper) ApplyWhitelistUpdates(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+
+	var updates []types.WhitelistUpdate
+	k.IterateWhitelistUpdates(ctx, func(update types.WhitelistUpdate) (stop bool) {
+		if update.ActivationHeight > height {
+			return true
+		}
+
+		updates = append(updates, update)
+		return false
+	})
+
+	if len(updates) == 0 {
+		return
+	}
+
+	whitelist := k.Whitelist(ctx)
+	for _, update := range updates {
+		whitelist = update.Apply(whitelist)
+		k.DeleteWhitelistUpdate(ctx, update.ActivationHeight, update.Denom.Name)
+
+		// A removed denom is no longer tallied, so nothing could resume it, and its
+		// exchange rate of the ending vote period must not be swapped against until
+		// the next tally clears it
+		if update.Action == types.WhitelistUpdateActionRemove {
+			k.DeleteHaltedDenom(ctx, update.Denom.Name)
+			k.DeleteLunaExchangeRate(ctx, update.Denom.Name)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeWhitelistUpdate,
+				sdk.NewAttribute(types.AttributeKeyAction, update.Action),
+				sdk.NewAttribute(types.AttributeKeyDenom, update.Denom.Name),
+				sdk.NewAttribute(types.AttributeKeyTobinTax, update.Denom.TobinTax.String()),
+				sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatUint(update.ActivationHeight, 10)),
+			),
+		)
+	}
+
+	k.SetWhitelist(ctx, whitelist)
+}
@@ -3,6 +3,8 @@ package v05
 import (
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	v04oracle "github.com/classic-terra/core/x/oracle/legacy/v04"
 	v05oracle "github.com/classic-terra/core/x/oracle/types"
 )
@@ -101,6 +103,11 @@ func Migrate(
 	sort.Slice(exchangeRates, func(i, j int) bool { return exchangeRates[i].Denom < exchangeRates[j].Denom })
 	sort.Slice(tobinTaxes, func(i, j int) bool { return tobinTaxes[i].Denom < tobinTaxes[j].Denom })
 
+	slashGracePeriod := uint64(v05oracle.DefaultSlashGracePeriod)
+	if slashWindow := uint64(oracleGenState.Params.SlashWindow); slashGracePeriod > slashWindow {
+		slashGracePeriod = slashWindow
+	}
+
 	return &v05oracle.GenesisState{
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevote,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVote,
@@ -108,15 +115,29 @@ func Migrate(
 		ExchangeRates:                 exchangeRates,
 		FeederDelegations:             feederDelegations,
 		TobinTaxes:                    tobinTaxes,
+		HistoricExchangeRates:         []v05oracle.HistoricExchangeRate{},
+		ValidatorPerformances:         []v05oracle.ValidatorPerformance{},
+		PenaltyOutcomes:               []v05oracle.PenaltyOutcome{},
+		FeederRotations:               []v05oracle.FeederRotation{},
+		AdditionalFeeders:             []v05oracle.FeederDelegation{},
+		HaltedDenoms:                  []v05oracle.HaltedDenom{},
+		WhitelistUpdates:              []v05oracle.WhitelistUpdate{},
 		Params: v05oracle.Params{
-			VotePeriod:               uint64(oracleGenState.Params.VotePeriod),
-			VoteThreshold:            oracleGenState.Params.VoteThreshold,
-			RewardBand:               oracleGenState.Params.RewardBand,
-			RewardDistributionWindow: uint64(oracleGenState.Params.RewardDistributionWindow),
-			SlashFraction:            oracleGenState.Params.SlashFraction,
-			SlashWindow:              uint64(oracleGenState.Params.SlashWindow),
-			MinValidPerWindow:        oracleGenState.Params.MinValidPerWindow,
-			Whitelist:                whitelist,
+			VotePeriod:                uint64(oracleGenState.Params.VotePeriod),
+			VoteThreshold:             oracleGenState.Params.VoteThreshold,
+			RewardBand:                oracleGenState.Params.RewardBand,
+			RewardDistributionWindow:  uint64(oracleGenState.Params.RewardDistributionWindow),
+			SlashFraction:             oracleGenState.Params.SlashFraction,
+			SlashWindow:               uint64(oracleGenState.Params.SlashWindow),
+			MinValidPerWindow:         oracleGenState.Params.MinValidPerWindow,
+			Whitelist:                 whitelist,
+			HistoricRateRetention:     v05oracle.DefaultHistoricRateRetention,
+			PerformanceHistoryWindows: v05oracle.DefaultPerformanceHistoryWindows,
+			// keep the all-or-nothing slashing until governance opens the jail-only tier
+			WarningValidPerWindow:         sdk.MaxDec(v05oracle.DefaultWarningValidPerWindow, oracleGenState.Params.MinValidPerWindow),
+			JailValidPerWindow:            oracleGenState.Params.MinValidPerWindow,
+			SlashGracePeriod:              slashGracePeriod,
+			CircuitBreakerRecoveryPeriods: v05oracle.DefaultCircuitBreakerRecoveryPeriods,
 		},
 	}
 }
@@ -136,6 +136,7 @@ func TestMigrate(t *testing.T) {
 	// ExchangeRateVotes removed
 	// ExchangeRatePrevotes removed
 	expected := `{
+	"additional_feeders": [],
 	"aggregate_exchange_rate_prevotes": [
 		{
 			"hash": "24738fdea72142136dde59c1e1f79f32c53dee12",
@@ -196,6 +197,9 @@ func TestMigrate(t *testing.T) {
 			"validator_address": "terravaloper1mx72uukvzqtzhc6gde7shrjqfu5srk22v3yx7a"
 		}
 	],
+	"feeder_rotations": [],
+	"halted_denoms": [],
+	"historic_exchange_rates": [],
 	"miss_counters": [
 		{
 			"miss_counter": "321",
@@ -207,24 +211,40 @@ func TestMigrate(t *testing.T) {
 		}
 	],
 	"params": {
+		"circuit_breaker_recovery_periods": "3",
+		"combined_vote_enabled": false,
+		"historic_rate_retention": "14400",
+		"jail_valid_per_window": "0.050000000000000000",
 		"min_valid_per_window": "0.050000000000000000",
+		"performance_history_windows": "12",
 		"reward_band": "0.070000000000000000",
 		"reward_distribution_window": "100",
 		"slash_fraction": "0.001000000000000000",
+		"slash_grace_period": "100",
 		"slash_window": "100",
 		"vote_period": "100",
 		"vote_threshold": "0.500000000000000000",
+		"warning_valid_per_window": "0.200000000000000000",
 		"whitelist": [
 			{
+				"aggregation_strategy": "",
+				"max_deviation": null,
 				"name": "usdr",
-				"tobin_tax": "0.010000000000000000"
+				"reward_band": null,
+				"tobin_tax": "0.010000000000000000",
+				"vote_threshold": null
 			},
 			{
+				"aggregation_strategy": "",
+				"max_deviation": null,
 				"name": "uusd",
-				"tobin_tax": "0.020000000000000000"
+				"reward_band": null,
+				"tobin_tax": "0.020000000000000000",
+				"vote_threshold": null
 			}
 		]
 	},
+	"penalty_outcomes": [],
 	"tobin_taxes": [
 		{
 			"denom": "usdr",
@@ -234,7 +254,9 @@ func TestMigrate(t *testing.T) {
 			"denom": "uusd",
 			"tobin_tax": "0.020000000000000000"
 		}
-	]
+	],
+	"validator_performances": [],
+	"whitelist_updates": []
 }`
 
 	assert.JSONEq(t, expected, string(indentedBz))
@@ -0,0 +1,24 @@
+package v06
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+// MigrateParams migrates the x/oracle params from v0.5 to v0.6. The migration includes:
+//
+// - Re-encode the Whitelist with the per-denom VoteThreshold and RewardBand overrides unset,
+// so every whitelisted denom keeps being tallied with the global VoteThreshold and RewardBand.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	var whitelist types.DenomList
+	paramSpace.Get(ctx, types.KeyWhitelist, &whitelist)
+
+	for i := range whitelist {
+		whitelist[i].VoteThreshold = nil
+		whitelist[i].RewardBand = nil
+	}
+
+	paramSpace.Set(ctx, types.KeyWhitelist, whitelist)
+}
@@ -0,0 +1,35 @@
+package v06_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/oracle/keeper"
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	voteThreshold := sdk.NewDecWithPrec(4, 1)
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, VoteThreshold: &voteThreshold},
+		{Name: core.MicroSDRDenom, TobinTax: sdk.NewDecWithPrec(1, 2)},
+	})
+
+	require.NoError(t, keeper.NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+
+	require.Equal(t, types.DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
+		{Name: core.MicroSDRDenom, TobinTax: sdk.NewDecWithPrec(1, 2)},
+	}, input.OracleKeeper.Whitelist(input.Ctx))
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, params.VoteThreshold, params.Whitelist.VoteThresholdOf(core.MicroKRWDenom, params.VoteThreshold))
+	require.Equal(t, params.RewardBand, params.Whitelist.RewardBandOf(core.MicroKRWDenom, params.RewardBand))
+}
@@ -0,0 +1,42 @@
+package oracle
+
+import (
+	"github.com/classic-terra/core/x/oracle/keeper"
+	"github.com/classic-terra/core/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ResumeDenomProposal:
+			return handleResumeDenomProposal(ctx, k, c)
+		case *types.AddOracleDenomProposal:
+			return handleAddOracleDenomProposal(ctx, k, c)
+		case *types.RemoveOracleDenomProposal:
+			return handleRemoveOracleDenomProposal(ctx, k, c)
+		case *types.UpdateTobinTaxProposal:
+			return handleUpdateTobinTaxProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized oracle proposal content type: %T", c)
+		}
+	}
+}
+
+func handleResumeDenomProposal(ctx sdk.Context, k keeper.Keeper, p *types.ResumeDenomProposal) error {
+	return keeper.HandleResumeDenomProposal(ctx, k, p)
+}
+
+func handleAddOracleDenomProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddOracleDenomProposal) error {
+	return keeper.HandleAddOracleDenomProposal(ctx, k, p)
+}
+
+func handleRemoveOracleDenomProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveOracleDenomProposal) error {
+	return keeper.HandleRemoveOracleDenomProposal(ctx, k, p)
+}
+
+func handleUpdateTobinTaxProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateTobinTaxProposal) error {
+	return keeper.HandleUpdateTobinTaxProposal(ctx, k, p)
+}
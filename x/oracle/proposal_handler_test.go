@@ -0,0 +1,118 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/oracle"
+	"github.com/classic-terra/core/x/oracle/keeper"
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+func TestResumeDenomProposal(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	h := oracle.NewProposalHandler(input.OracleKeeper)
+
+	proposal := types.NewResumeDenomProposal("title", "description", core.MicroKRWDenom)
+
+	// Denom not halted
+	err := h(input.Ctx, proposal)
+	require.ErrorIs(t, err, types.ErrDenomNotHalted)
+
+	input.OracleKeeper.SetHaltedDenom(input.Ctx, types.NewHaltedDenom(core.MicroKRWDenom, randomExchangeRate, randomExchangeRate.MulInt64(2), 1))
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.ErrorIs(t, err, types.ErrDenomHalted)
+
+	err = h(input.Ctx, proposal)
+	require.NoError(t, err)
+	require.False(t, input.OracleKeeper.IsDenomHalted(input.Ctx, core.MicroKRWDenom))
+}
+
+func TestOracleDenomProposals(t *testing.T) {
+	input, h := setup(t)
+	ph := oracle.NewProposalHandler(input.OracleKeeper)
+	ctx := input.Ctx.WithBlockHeight(10)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.Whitelist = types.DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
+		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
+	}
+	input.OracleKeeper.SetParams(ctx, params)
+
+	tobinTax := sdk.NewDecWithPrec(5, 3)
+	add := types.NewAddOracleDenomProposal("title", "description", types.Denom{Name: core.MicroUSDDenom, TobinTax: tobinTax}, 20)
+	remove := types.NewRemoveOracleDenomProposal("title", "description", core.MicroSDRDenom, 20)
+	update := types.NewUpdateTobinTaxProposal("title", "description", core.MicroKRWDenom, tobinTax, 30)
+
+	// activation height must be in the future
+	require.ErrorIs(t, ph(ctx, types.NewRemoveOracleDenomProposal("title", "description", core.MicroSDRDenom, 10)), types.ErrInvalidActivationHeight)
+
+	// denom must (not) be whitelisted
+	require.ErrorIs(t, ph(ctx, types.NewAddOracleDenomProposal("title", "description", types.Denom{Name: core.MicroKRWDenom, TobinTax: tobinTax}, 20)), types.ErrDenomAlreadyWhitelisted)
+	require.ErrorIs(t, ph(ctx, types.NewRemoveOracleDenomProposal("title", "description", core.MicroUSDDenom, 20)), types.ErrUnknownDenom)
+	require.ErrorIs(t, ph(ctx, types.NewUpdateTobinTaxProposal("title", "description", core.MicroUSDDenom, tobinTax, 20)), types.ErrUnknownDenom)
+
+	require.NoError(t, ph(ctx, add))
+	require.NoError(t, ph(ctx, remove))
+	require.NoError(t, ph(ctx, update))
+
+	// a second update of the denom at the same height is refused
+	require.ErrorIs(t, ph(ctx, types.NewUpdateTobinTaxProposal("title", "description", core.MicroKRWDenom, sdk.NewDecWithPrec(1, 2), 30)), types.ErrWhitelistUpdateScheduled)
+
+	var updates []types.WhitelistUpdate
+	input.OracleKeeper.IterateWhitelistUpdates(ctx, func(update types.WhitelistUpdate) (stop bool) {
+		updates = append(updates, update)
+		return false
+	})
+	require.Len(t, updates, 3)
+	require.Equal(t, uint64(30), updates[2].ActivationHeight)
+
+	// nothing changes before the activation height
+	input.OracleKeeper.SetHaltedDenom(ctx, types.NewHaltedDenom(core.MicroSDRDenom, sdk.OneDec(), sdk.NewDec(2), 10))
+	oracle.EndBlocker(ctx.WithBlockHeight(19), input.OracleKeeper)
+	require.Equal(t, params.Whitelist, input.OracleKeeper.Whitelist(ctx))
+	require.True(t, input.OracleKeeper.IsVoteTarget(ctx, core.MicroSDRDenom))
+
+	// denoms are added and removed at the activation height
+	oracle.EndBlocker(ctx.WithBlockHeight(20), input.OracleKeeper)
+	require.Equal(t, types.DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
+		{Name: core.MicroUSDDenom, TobinTax: tobinTax},
+	}, input.OracleKeeper.Whitelist(ctx))
+	require.False(t, input.OracleKeeper.IsVoteTarget(ctx, core.MicroSDRDenom))
+	require.False(t, input.OracleKeeper.IsDenomHalted(ctx, core.MicroSDRDenom))
+	usdTobinTax, err := input.OracleKeeper.GetTobinTax(ctx, core.MicroUSDDenom)
+	require.NoError(t, err)
+	require.Equal(t, tobinTax, usdTobinTax)
+
+	// feeders vote on the new vote targets
+	makeAggregatePrevoteAndVote(t, input, h, 20, sdk.DecCoins{
+		{Denom: core.MicroKRWDenom, Amount: randomExchangeRate},
+		{Denom: core.MicroUSDDenom, Amount: randomExchangeRate},
+	}, 0)
+
+	// tobin tax is updated at the activation height
+	oracle.EndBlocker(ctx.WithBlockHeight(30), input.OracleKeeper)
+	krwTobinTax, err := input.OracleKeeper.GetTobinTax(ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, tobinTax, krwTobinTax)
+
+	updates = nil
+	input.OracleKeeper.IterateWhitelistUpdates(ctx, func(update types.WhitelistUpdate) (stop bool) {
+		updates = append(updates, update)
+		return false
+	})
+	require.Empty(t, updates)
+
+	// a removed denom loses the exchange rate of the ending vote period
+	require.NoError(t, ph(ctx, types.NewRemoveOracleDenomProposal("title", "description", core.MicroKRWDenom, 40)))
+	input.OracleKeeper.SetLunaExchangeRate(ctx, core.MicroKRWDenom, randomExchangeRate)
+	input.OracleKeeper.ApplyWhitelistUpdates(ctx.WithBlockHeight(40))
+	_, err = input.OracleKeeper.GetLunaExchangeRate(ctx, core.MicroKRWDenom)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+}
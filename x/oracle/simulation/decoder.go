@@ -45,6 +45,40 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &tobinTaxA)
 			cdc.MustUnmarshal(kvB.Value, &tobinTaxB)
 			return fmt.Sprintf("%v\n%v", tobinTaxA, tobinTaxB)
+		case bytes.Equal(kvA.Key[:1], types.HistoricExchangeRateKey):
+			var historicRateA, historicRateB types.HistoricExchangeRate
+			cdc.MustUnmarshal(kvA.Value, &historicRateA)
+			cdc.MustUnmarshal(kvB.Value, &historicRateB)
+			return fmt.Sprintf("%v\n%v", historicRateA, historicRateB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPerformanceKey):
+			var performanceA, performanceB types.ValidatorPerformance
+			cdc.MustUnmarshal(kvA.Value, &performanceA)
+			cdc.MustUnmarshal(kvB.Value, &performanceB)
+			return fmt.Sprintf("%v\n%v", performanceA, performanceB)
+		case bytes.Equal(kvA.Key[:1], types.PenaltyOutcomeKey):
+			var outcomeA, outcomeB types.PenaltyOutcome
+			cdc.MustUnmarshal(kvA.Value, &outcomeA)
+			cdc.MustUnmarshal(kvB.Value, &outcomeB)
+			return fmt.Sprintf("%v\n%v", outcomeA, outcomeB)
+		case bytes.Equal(kvA.Key[:1], types.FeederRotationKey):
+			var rotationA, rotationB types.FeederRotation
+			cdc.MustUnmarshal(kvA.Value, &rotationA)
+			cdc.MustUnmarshal(kvB.Value, &rotationB)
+			return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+		case bytes.Equal(kvA.Key[:1], types.AdditionalFeederKey):
+			// the feeder address is the last length-prefixed part of the key
+			keyA, keyB := kvA.Key[1:], kvB.Key[1:]
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(keyA[2+keyA[0]:]), sdk.AccAddress(keyB[2+keyB[0]:]))
+		case bytes.Equal(kvA.Key[:1], types.HaltedDenomKey):
+			var haltedDenomA, haltedDenomB types.HaltedDenom
+			cdc.MustUnmarshal(kvA.Value, &haltedDenomA)
+			cdc.MustUnmarshal(kvB.Value, &haltedDenomB)
+			return fmt.Sprintf("%v\n%v", haltedDenomA, haltedDenomB)
+		case bytes.Equal(kvA.Key[:1], types.WhitelistUpdateKey):
+			var updateA, updateB types.WhitelistUpdate
+			cdc.MustUnmarshal(kvA.Value, &updateA)
+			cdc.MustUnmarshal(kvB.Value, &updateB)
+			return fmt.Sprintf("%v\n%v", updateA, updateB)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 	}, valAddr)
 
 	tobinTax := sdk.NewDecWithPrec(2, 2)
+	historicRate := types.NewHistoricExchangeRate(core.MicroKRWDenom, exchangeRate, 123, time.Unix(1600000000, 0).UTC())
+	performance := types.NewValidatorPerformance(valAddr, 12)
+	performance.VotePeriods = 100
+	rotation := types.NewFeederRotation(valAddr, feederAddr, 123)
+	outcome := types.NewPenaltyOutcome(valAddr, 12, types.PenaltyTierWarning, sdk.NewDecWithPrec(15, 2), 100, 85)
+	whitelistUpdate := types.NewWhitelistUpdate(types.WhitelistUpdateActionUpdateTobinTax, types.Denom{Name: core.MicroKRWDenom, TobinTax: tobinTax}, 123)
+	haltedDenom := types.NewHaltedDenom(core.MicroKRWDenom, sdk.NewDecWithPrec(1234, 1), sdk.NewDecWithPrec(2468, 1), 123)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -47,6 +55,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.AggregateExchangeRatePrevoteKey, Value: cdc.MustMarshal(&aggregatePrevote)},
 			{Key: types.AggregateExchangeRateVoteKey, Value: cdc.MustMarshal(&aggregateVote)},
 			{Key: types.TobinTaxKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: tobinTax})},
+			{Key: types.HistoricExchangeRateKey, Value: cdc.MustMarshal(&historicRate)},
+			{Key: types.ValidatorPerformanceKey, Value: cdc.MustMarshal(&performance)},
+			{Key: types.PenaltyOutcomeKey, Value: cdc.MustMarshal(&outcome)},
+			{Key: types.FeederRotationKey, Value: cdc.MustMarshal(&rotation)},
+			{Key: types.GetAdditionalFeederKey(valAddr, feederAddr), Value: []byte{}},
+			{Key: types.GetHaltedDenomKey(core.MicroKRWDenom), Value: cdc.MustMarshal(&haltedDenom)},
+			{Key: types.GetWhitelistUpdateKey(123, core.MicroKRWDenom), Value: cdc.MustMarshal(&whitelistUpdate)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -61,6 +76,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"AggregatePrevote", fmt.Sprintf("%v\n%v", aggregatePrevote, aggregatePrevote)},
 		{"AggregateVote", fmt.Sprintf("%v\n%v", aggregateVote, aggregateVote)},
 		{"TobinTax", fmt.Sprintf("%v\n%v", tobinTax, tobinTax)},
+		{"HistoricExchangeRate", fmt.Sprintf("%v\n%v", historicRate, historicRate)},
+		{"ValidatorPerformance", fmt.Sprintf("%v\n%v", performance, performance)},
+		{"PenaltyOutcome", fmt.Sprintf("%v\n%v", outcome, outcome)},
+		{"FeederRotation", fmt.Sprintf("%v\n%v", rotation, rotation)},
+		{"AdditionalFeeder", fmt.Sprintf("%v\n%v", feederAddr, feederAddr)},
+		{"HaltedDenom", fmt.Sprintf("%v\n%v", haltedDenom, haltedDenom)},
+		{"WhitelistUpdate", fmt.Sprintf("%v\n%v", whitelistUpdate, whitelistUpdate)},
 		{"other", ""},
 	}
 
@@ -16,13 +16,20 @@ import (
 
 // Simulation parameter constants
 const (
-	votePeriodKey               = "vote_period"
-	voteThresholdKey            = "vote_threshold"
-	rewardBandKey               = "reward_band"
-	rewardDistributionWindowKey = "reward_distribution_window"
-	slashFractionKey            = "slash_fraction"
-	slashWindowKey              = "slash_window"
-	minValidPerWindowKey        = "min_valid_per_window"
+	votePeriodKey                    = "vote_period"
+	voteThresholdKey                 = "vote_threshold"
+	rewardBandKey                    = "reward_band"
+	rewardDistributionWindowKey      = "reward_distribution_window"
+	slashFractionKey                 = "slash_fraction"
+	slashWindowKey                   = "slash_window"
+	minValidPerWindowKey             = "min_valid_per_window"
+	historicRateRetentionKey         = "historic_rate_retention"
+	performanceHistoryWindowsKey     = "performance_history_windows"
+	warningValidPerWindowKey         = "warning_valid_per_window"
+	jailValidPerWindowKey            = "jail_valid_per_window"
+	slashGracePeriodKey              = "slash_grace_period"
+	combinedVoteEnabledKey           = "combined_vote_enabled"
+	circuitBreakerRecoveryPeriodsKey = "circuit_breaker_recovery_periods"
 )
 
 // GenVotePeriod randomized VotePeriod
@@ -60,6 +67,41 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenHistoricRateRetention randomized HistoricRateRetention
+func GenHistoricRateRetention(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(100000))
+}
+
+// GenPerformanceHistoryWindows randomized PerformanceHistoryWindows
+func GenPerformanceHistoryWindows(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(52))
+}
+
+// GenWarningValidPerWindow randomized WarningValidPerWindow
+func GenWarningValidPerWindow(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(750, 3).Add(sdk.NewDecWithPrec(int64(r.Intn(250)), 3))
+}
+
+// GenJailValidPerWindow randomized JailValidPerWindow
+func GenJailValidPerWindow(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(500, 3).Add(sdk.NewDecWithPrec(int64(r.Intn(250)), 3))
+}
+
+// GenSlashGracePeriod randomized SlashGracePeriod
+func GenSlashGracePeriod(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenCombinedVoteEnabled randomized CombinedVoteEnabled
+func GenCombinedVoteEnabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenCircuitBreakerRecoveryPeriods randomized CircuitBreakerRecoveryPeriods
+func GenCircuitBreakerRecoveryPeriods(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(10))
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -104,6 +146,48 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var historicRateRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, historicRateRetentionKey, &historicRateRetention, simState.Rand,
+		func(r *rand.Rand) { historicRateRetention = GenHistoricRateRetention(r) },
+	)
+
+	var performanceHistoryWindows uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, performanceHistoryWindowsKey, &performanceHistoryWindows, simState.Rand,
+		func(r *rand.Rand) { performanceHistoryWindows = GenPerformanceHistoryWindows(r) },
+	)
+
+	var warningValidPerWindow sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, warningValidPerWindowKey, &warningValidPerWindow, simState.Rand,
+		func(r *rand.Rand) { warningValidPerWindow = GenWarningValidPerWindow(r) },
+	)
+
+	var jailValidPerWindow sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, jailValidPerWindowKey, &jailValidPerWindow, simState.Rand,
+		func(r *rand.Rand) { jailValidPerWindow = GenJailValidPerWindow(r) },
+	)
+
+	var slashGracePeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashGracePeriodKey, &slashGracePeriod, simState.Rand,
+		func(r *rand.Rand) { slashGracePeriod = GenSlashGracePeriod(r) },
+	)
+
+	var combinedVoteEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, combinedVoteEnabledKey, &combinedVoteEnabled, simState.Rand,
+		func(r *rand.Rand) { combinedVoteEnabled = GenCombinedVoteEnabled(r) },
+	)
+
+	var circuitBreakerRecoveryPeriods uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, circuitBreakerRecoveryPeriodsKey, &circuitBreakerRecoveryPeriods, simState.Rand,
+		func(r *rand.Rand) { circuitBreakerRecoveryPeriods = GenCircuitBreakerRecoveryPeriods(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:               votePeriod,
@@ -116,9 +200,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 				{Name: core.MicroUSDDenom, TobinTax: types.DefaultTobinTax},
 				{Name: core.MicroMNTDenom, TobinTax: sdk.NewDecWithPrec(2, 2)},
 			},
-			SlashFraction:     slashFraction,
-			SlashWindow:       slashWindow,
-			MinValidPerWindow: minValidPerWindow,
+			SlashFraction:                 slashFraction,
+			SlashWindow:                   slashWindow,
+			MinValidPerWindow:             minValidPerWindow,
+			HistoricRateRetention:         historicRateRetention,
+			PerformanceHistoryWindows:     performanceHistoryWindows,
+			WarningValidPerWindow:         warningValidPerWindow,
+			JailValidPerWindow:            jailValidPerWindow,
+			SlashGracePeriod:              slashGracePeriod,
+			CombinedVoteEnabled:           combinedVoteEnabled,
+			CircuitBreakerRecoveryPeriods: circuitBreakerRecoveryPeriods,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
@@ -126,6 +217,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.AggregateExchangeRatePrevote{},
 		[]types.AggregateExchangeRateVote{},
 		[]types.TobinTax{},
+		[]types.HistoricExchangeRate{},
+		[]types.ValidatorPerformance{},
+		[]types.PenaltyOutcome{},
+		[]types.FeederRotation{},
+		[]types.FeederDelegation{},
+		[]types.HaltedDenom{},
+		[]types.WhitelistUpdate{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
@@ -46,5 +46,30 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenSlashWindow(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyHistoricRateRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenHistoricRateRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPerformanceHistoryWindows),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenPerformanceHistoryWindows(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySlashGracePeriod),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSlashGracePeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCombinedVoteEnabled),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenCombinedVoteEnabled(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCircuitBreakerRecoveryPeriods),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenCircuitBreakerRecoveryPeriods(r))
+			},
+		),
 	}
 }
@@ -3,48 +3,28 @@ package oracle
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	core "github.com/classic-terra/core/types"
 	"github.com/classic-terra/core/x/oracle/keeper"
 	"github.com/classic-terra/core/x/oracle/types"
 )
 
-// Tally calculates the median and returns it. Sets the set of voters to be rewarded, i.e. voted within
-// a reasonable spread from the weighted median to the store
-// CONTRACT: pb must be sorted
-func Tally(ctx sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, validatorClaimMap map[string]types.Claim) (weightedMedian sdk.Dec) {
-	// softfork
-	if (ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() < int64(5_701_000)) ||
-		(ctx.ChainID() == core.BombayChainID && ctx.BlockHeight() < int64(7_000_000)) {
-		weightedMedian = pb.WeightedMedian()
-	} else {
-		weightedMedian = pb.WeightedMedianWithAssertion()
+// Tally calculates the exchange rate of the ballot with the aggregation strategy and returns it.
+// Sets the set of voters to be rewarded, i.e. the ballot winners reported by the strategy, to the claim map
+func Tally(pb types.ExchangeRateBallot, strategy types.AggregationStrategy, rewardBand sdk.Dec, validatorClaimMap map[string]types.Claim) sdk.Dec {
+	exchangeRate, winners := strategy.Aggregate(pb, rewardBand)
+
+	for _, vote := range winners {
+		key := vote.Voter.String()
+		claim := validatorClaimMap[key]
+		claim.Weight += vote.Power
+		claim.WinCount++
+		validatorClaimMap[key] = claim
 	}
 
-	standardDeviation := pb.StandardDeviation(weightedMedian)
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
-
-	if standardDeviation.GT(rewardSpread) {
-		rewardSpread = standardDeviation
-	}
-
-	for _, vote := range pb {
-		// Filter ballot winners & abstain voters
-		if (vote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			vote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))) ||
-			!vote.ExchangeRate.IsPositive() {
-
-			key := vote.Voter.String()
-			claim := validatorClaimMap[key]
-			claim.Weight += vote.Power
-			claim.WinCount++
-			validatorClaimMap[key] = claim
-		}
-	}
-
-	return weightedMedian
+	return exchangeRate
 }
 
-// ballot for the asset is passing the threshold amount of voting power
+// ballot for the asset is passing the threshold amount of voting power,
+// which is computed with the vote threshold of the denom
 func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (sdk.Int, bool) {
 	ballotPower := sdk.NewInt(ballot.Power())
 	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
@@ -59,7 +39,7 @@ func PickReferenceTerra(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
+	whitelist := k.Whitelist(ctx)
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
@@ -70,6 +50,7 @@ func PickReferenceTerra(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 		}
 
 		ballotPower := int64(0)
+		thresholdVotes := whitelist.VoteThresholdOf(denom, voteThreshold).MulInt64(totalBondedPower).RoundInt()
 
 		// If the ballot is not passed, remove it from the voteTargets array
 		// to prevent slashing validators who did valid vote.
@@ -55,8 +55,6 @@ func TestFuzz_Tally(t *testing.T) {
 	// set random denoms and validators
 	f.Fuzz(&validators)
 
-	input, _ := setup(t)
-
 	claimMap := map[string]types.Claim{}
 	f.Fuzz(&claimMap)
 
@@ -66,9 +64,16 @@ func TestFuzz_Tally(t *testing.T) {
 	var rewardBand sdk.Dec
 	f.Fuzz(&rewardBand)
 
-	require.NotPanics(t, func() {
-		oracle.Tally(input.Ctx, ballot, rewardBand, claimMap)
-	})
+	for _, strategy := range []types.AggregationStrategy{
+		types.WeightedMedianStrategy{},
+		types.LegacyWeightedMedianStrategy{},
+		types.NewTrimmedMeanStrategy(types.DefaultTrimFraction),
+		types.NewMedianMADStrategy(types.DefaultMADMultiplier),
+	} {
+		require.NotPanics(t, func() {
+			oracle.Tally(ballot, strategy, rewardBand, claimMap)
+		}, strategy.Name())
+	}
 }
 
 func TestFuzz_PickReferenceTerra(t *testing.T) {
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Names of the ballot aggregation strategies
+const (
+	AggregationStrategyWeightedMedian       = "weighted_median"
+	AggregationStrategyLegacyWeightedMedian = "legacy_weighted_median"
+	AggregationStrategyTrimmedMean          = "trimmed_mean"
+	AggregationStrategyMedianMAD            = "median_mad"
+)
+
+// Default configurations of the ballot aggregation strategies
+var (
+	DefaultTrimFraction  = sdk.NewDecWithPrec(1, 1) // 10%
+	DefaultMADMultiplier = sdk.NewDec(3)
+)
+
+// AggregationStrategy computes the exchange rate of a ballot and decides
+// which votes of the ballot are rewarded as ballot winners
+type AggregationStrategy interface {
+	// Name returns the name the strategy is selected by in the whitelist
+	Name() string
+
+	// Aggregate returns the exchange rate of the ballot and the ballot winners,
+	// which include the abstain votes. The ballot can be sorted in place.
+	Aggregate(pb ExchangeRateBallot, rewardBand sdk.Dec) (exchangeRate sdk.Dec, winners ExchangeRateBallot)
+}
+
+var aggregationStrategies = map[string]AggregationStrategy{
+	AggregationStrategyWeightedMedian: WeightedMedianStrategy{},
+	AggregationStrategyTrimmedMean:    NewTrimmedMeanStrategy(DefaultTrimFraction),
+	AggregationStrategyMedianMAD:      NewMedianMADStrategy(DefaultMADMultiplier),
+}
+
+// GetAggregationStrategy returns the strategy registered with the name,
+// the weighted median strategy is returned for the empty name
+func GetAggregationStrategy(name string) (AggregationStrategy, bool) {
+	if len(name) == 0 {
+		return WeightedMedianStrategy{}, true
+	}
+
+	strategy, ok := aggregationStrategies[name]
+	return strategy, ok
+}
+
+// ValidateAggregationStrategy checks the strategy name can be selected in the whitelist
+func ValidateAggregationStrategy(name string) error {
+	if _, ok := GetAggregationStrategy(name); !ok {
+		return fmt.Errorf("unknown aggregation strategy: %s", name)
+	}
+
+	return nil
+}
+
+// WeightedMedianStrategy takes the weighted median of the ballot and rewards the votes
+// within the larger of the reward band and the standard deviation around it
+type WeightedMedianStrategy struct{}
+
+var _ AggregationStrategy = WeightedMedianStrategy{}
+
+// Name implements AggregationStrategy
+func (WeightedMedianStrategy) Name() string {
+	return AggregationStrategyWeightedMedian
+}
+
+// Aggregate implements AggregationStrategy
+func (WeightedMedianStrategy) Aggregate(pb ExchangeRateBallot, rewardBand sdk.Dec) (sdk.Dec, ExchangeRateBallot) {
+	sort.Sort(pb)
+	weightedMedian := pb.WeightedMedianWithAssertion()
+	return weightedMedian, pb.winners(weightedMedian, pb.rewardSpread(weightedMedian, rewardBand))
+}
+
+// LegacyWeightedMedianStrategy is the weighted median strategy which neither
+// sorts the ballot nor asserts it is sorted, used before the tally softfork
+type LegacyWeightedMedianStrategy struct{}
+
+var _ AggregationStrategy = LegacyWeightedMedianStrategy{}
+
+// Name implements AggregationStrategy
+func (LegacyWeightedMedianStrategy) Name() string {
+	return AggregationStrategyLegacyWeightedMedian
+}
+
+// Aggregate implements AggregationStrategy
+func (LegacyWeightedMedianStrategy) Aggregate(pb ExchangeRateBallot, rewardBand sdk.Dec) (sdk.Dec, ExchangeRateBallot) {
+	weightedMedian := pb.WeightedMedian()
+	return weightedMedian, pb.winners(weightedMedian, pb.rewardSpread(weightedMedian, rewardBand))
+}
+
+// TrimmedMeanStrategy discards TrimFraction of the voting power from each end of
+// the ballot and takes the power weighted mean of the rest
+type TrimmedMeanStrategy struct {
+	TrimFraction sdk.Dec
+}
+
+var _ AggregationStrategy = TrimmedMeanStrategy{}
+
+// NewTrimmedMeanStrategy returns a new TrimmedMeanStrategy instance
+func NewTrimmedMeanStrategy(trimFraction sdk.Dec) TrimmedMeanStrategy {
+	return TrimmedMeanStrategy{TrimFraction: trimFraction}
+}
+
+// Name implements AggregationStrategy
+func (TrimmedMeanStrategy) Name() string {
+	return AggregationStrategyTrimmedMean
+}
+
+// Aggregate implements AggregationStrategy
+func (s TrimmedMeanStrategy) Aggregate(pb ExchangeRateBallot, rewardBand sdk.Dec) (sdk.Dec, ExchangeRateBallot) {
+	sort.Sort(pb)
+
+	totalPower := sdk.NewDec(pb.Power())
+	lower := totalPower.Mul(s.TrimFraction)
+	upper := totalPower.Sub(lower)
+
+	// weight each vote by the part of its power within [lower, upper]
+	sum := sdk.ZeroDec()
+	weight := sdk.ZeroDec()
+	pivot := sdk.ZeroDec()
+	for _, vote := range pb {
+		start := pivot
+		pivot = pivot.Add(sdk.NewDec(vote.Power))
+
+		overlap := sdk.MinDec(pivot, upper).Sub(sdk.MaxDec(start, lower))
+		if overlap.IsPositive() {
+			sum = sum.Add(vote.ExchangeRate.Mul(overlap))
+			weight = weight.Add(overlap)
+		}
+	}
+
+	if !weight.IsPositive() {
+		weightedMedian := pb.WeightedMedianWithAssertion()
+		return weightedMedian, pb.winners(weightedMedian, pb.rewardSpread(weightedMedian, rewardBand))
+	}
+
+	trimmedMean := sum.Quo(weight)
+	return trimmedMean, pb.winners(trimmedMean, pb.rewardSpread(trimmedMean, rewardBand))
+}
+
+// MedianMADStrategy rejects the votes further than Multiplier times the weighted median
+// absolute deviation (MAD) from the weighted median, and takes the weighted median of the rest
+type MedianMADStrategy struct {
+	Multiplier sdk.Dec
+}
+
+var _ AggregationStrategy = MedianMADStrategy{}
+
+// NewMedianMADStrategy returns a new MedianMADStrategy instance
+func NewMedianMADStrategy(multiplier sdk.Dec) MedianMADStrategy {
+	return MedianMADStrategy{Multiplier: multiplier}
+}
+
+// Name implements AggregationStrategy
+func (MedianMADStrategy) Name() string {
+	return AggregationStrategyMedianMAD
+}
+
+// Aggregate implements AggregationStrategy
+func (s MedianMADStrategy) Aggregate(pb ExchangeRateBallot, rewardBand sdk.Dec) (sdk.Dec, ExchangeRateBallot) {
+	sort.Sort(pb)
+	weightedMedian := pb.WeightedMedianWithAssertion()
+
+	var deviations ExchangeRateBallot
+	for _, vote := range pb {
+		if vote.ExchangeRate.IsPositive() {
+			vote.ExchangeRate = vote.ExchangeRate.Sub(weightedMedian).Abs()
+			deviations = append(deviations, vote)
+		}
+	}
+
+	sort.Sort(deviations)
+	maxDeviation := deviations.WeightedMedianWithAssertion().Mul(s.Multiplier)
+
+	var inliers ExchangeRateBallot
+	for _, vote := range pb {
+		if vote.ExchangeRate.IsPositive() && vote.ExchangeRate.Sub(weightedMedian).Abs().LTE(maxDeviation) {
+			inliers = append(inliers, vote)
+		}
+	}
+
+	if len(inliers) != 0 {
+		weightedMedian = inliers.WeightedMedianWithAssertion()
+	}
+
+	rewardSpread := sdk.MaxDec(weightedMedian.Mul(rewardBand.QuoInt64(2)), maxDeviation)
+	return weightedMedian, pb.winners(weightedMedian, rewardSpread)
+}
+
+// rewardSpread returns the larger of the reward band around the exchange rate
+// and the standard deviation of the ballot
+func (pb ExchangeRateBallot) rewardSpread(exchangeRate sdk.Dec, rewardBand sdk.Dec) sdk.Dec {
+	standardDeviation := pb.StandardDeviation(exchangeRate)
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
+
+	if standardDeviation.GT(rewardSpread) {
+		rewardSpread = standardDeviation
+	}
+
+	return rewardSpread
+}
+
+// winners returns the votes within the spread around the exchange rate and the abstain votes
+func (pb ExchangeRateBallot) winners(exchangeRate sdk.Dec, rewardSpread sdk.Dec) (winners ExchangeRateBallot) {
+	for _, vote := range pb {
+		if (vote.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			vote.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))) ||
+			!vote.ExchangeRate.IsPositive() {
+			winners = append(winners, vote)
+		}
+	}
+
+	return winners
+}
@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+	"github.com/classic-terra/core/x/oracle/types"
+)
+
+func aggregationTestBallot() types.ExchangeRateBallot {
+	ballot := types.ExchangeRateBallot{
+		// abstain vote
+		types.NewVoteForTally(sdk.ZeroDec(), core.MicroMNTDenom, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 0),
+	}
+
+	// outlier at the end
+	for _, rate := range []int64{100, 13, 12, 11, 10} {
+		ballot = append(ballot, types.NewVoteForTally(sdk.NewDec(rate), core.MicroMNTDenom, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 10))
+	}
+
+	return ballot
+}
+
+func requireWinnerRates(t *testing.T, expected []int64, winners types.ExchangeRateBallot) {
+	rates := make([]int64, len(winners))
+	for i, vote := range winners {
+		rates[i] = vote.ExchangeRate.TruncateInt64()
+	}
+
+	require.ElementsMatch(t, expected, rates)
+}
+
+func TestGetAggregationStrategy(t *testing.T) {
+	strategy, ok := types.GetAggregationStrategy("")
+	require.True(t, ok)
+	require.Equal(t, types.AggregationStrategyWeightedMedian, strategy.Name())
+
+	for _, name := range []string{
+		types.AggregationStrategyWeightedMedian,
+		types.AggregationStrategyTrimmedMean,
+		types.AggregationStrategyMedianMAD,
+	} {
+		strategy, ok := types.GetAggregationStrategy(name)
+		require.True(t, ok)
+		require.Equal(t, name, strategy.Name())
+		require.NoError(t, types.ValidateAggregationStrategy(name))
+	}
+
+	// legacy strategy is only used before the tally softfork
+	_, ok = types.GetAggregationStrategy(types.AggregationStrategyLegacyWeightedMedian)
+	require.False(t, ok)
+	require.Error(t, types.ValidateAggregationStrategy(types.AggregationStrategyLegacyWeightedMedian))
+	require.Error(t, types.ValidateAggregationStrategy("mean"))
+}
+
+func TestWeightedMedianStrategy(t *testing.T) {
+	exchangeRate, winners := types.WeightedMedianStrategy{}.Aggregate(aggregationTestBallot(), sdk.NewDecWithPrec(2, 2))
+	require.Equal(t, sdk.NewDec(12), exchangeRate)
+
+	// standard deviation is large enough to reward all votes but the outlier
+	requireWinnerRates(t, []int64{0, 10, 11, 12, 13}, winners)
+
+	// unsorted ballot does not panic
+	exchangeRate, _ = types.LegacyWeightedMedianStrategy{}.Aggregate(aggregationTestBallot(), sdk.NewDecWithPrec(2, 2))
+	require.Equal(t, sdk.NewDec(12), exchangeRate)
+}
+
+func TestTrimmedMeanStrategy(t *testing.T) {
+	// trim 10 of 50 power from each end
+	exchangeRate, winners := types.NewTrimmedMeanStrategy(sdk.NewDecWithPrec(2, 1)).Aggregate(aggregationTestBallot(), sdk.NewDecWithPrec(2, 2))
+	require.Equal(t, sdk.NewDec(12), exchangeRate)
+	requireWinnerRates(t, []int64{0, 10, 11, 12, 13}, winners)
+
+	// trim 5 of 50 power from each end, half of the power of both ends remains
+	exchangeRate, _ = types.NewTrimmedMeanStrategy(types.DefaultTrimFraction).Aggregate(aggregationTestBallot(), sdk.NewDecWithPrec(2, 2))
+	require.Equal(t, sdk.NewDec(5*10+10*11+10*12+10*13+5*100).QuoInt64(40), exchangeRate)
+
+	// nothing remains after trimming the whole ballot
+	exchangeRate, _ = types.NewTrimmedMeanStrategy(sdk.NewDecWithPrec(5, 1)).Aggregate(aggregationTestBallot(), sdk.NewDecWithPrec(2, 2))
+	require.Equal(t, sdk.NewDec(12), exchangeRate)
+}
+
+func TestMedianMADStrategy(t *testing.T) {
+	// median 12, MAD 1, so the votes within 3 of the median remain
+	exchangeRate, winners := types.NewMedianMADStrategy(types.DefaultMADMultiplier).Aggregate(aggregationTestBallot(), sdk.NewDecWithPrec(2, 2))
+	require.Equal(t, sdk.NewDec(11), exchangeRate)
+	requireWinnerRates(t, []int64{0, 10, 11, 12, 13}, winners)
+
+	// tight multiplier only rewards the votes within the reward band
+	exchangeRate, winners = types.NewMedianMADStrategy(sdk.ZeroDec()).Aggregate(aggregationTestBallot(), sdk.NewDecWithPrec(2, 2))
+	require.Equal(t, sdk.NewDec(12), exchangeRate)
+	requireWinnerRates(t, []int64{0, 12}, winners)
+}
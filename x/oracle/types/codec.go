@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/oracle interfaces and concrete types
@@ -13,7 +14,15 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote", nil)
+	cdc.RegisterConcrete(&MsgAggregateExchangeRateCombinedVote{}, "oracle/MsgAggregateExchangeRateCombinedVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgRotateFeeder{}, "oracle/MsgRotateFeeder", nil)
+	cdc.RegisterConcrete(&MsgAddFeeder{}, "oracle/MsgAddFeeder", nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeder{}, "oracle/MsgRemoveFeeder", nil)
+	cdc.RegisterConcrete(&ResumeDenomProposal{}, "oracle/ResumeDenomProposal", nil)
+	cdc.RegisterConcrete(&AddOracleDenomProposal{}, "oracle/AddOracleDenomProposal", nil)
+	cdc.RegisterConcrete(&RemoveOracleDenomProposal{}, "oracle/RemoveOracleDenomProposal", nil)
+	cdc.RegisterConcrete(&UpdateTobinTaxProposal{}, "oracle/UpdateTobinTaxProposal", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -22,6 +31,17 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDelegateFeedConsent{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
+		&MsgAggregateExchangeRateCombinedVote{},
+		&MsgRotateFeeder{},
+		&MsgAddFeeder{},
+		&MsgRemoveFeeder{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&ResumeDenomProposal{},
+		&AddOracleDenomProposal{},
+		&RemoveOracleDenomProposal{},
+		&UpdateTobinTaxProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
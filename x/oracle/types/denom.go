@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // String implements fmt.Stringer interface
@@ -14,7 +16,17 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax)
+	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax) && d.AggregationStrategy == d1.AggregationStrategy &&
+		equalDecOverride(d.VoteThreshold, d1.VoteThreshold) && equalDecOverride(d.RewardBand, d1.RewardBand) &&
+		equalDecOverride(d.MaxDeviation, d1.MaxDeviation)
+}
+
+func equalDecOverride(d1, d2 *sdk.Dec) bool {
+	if d1 == nil || d2 == nil {
+		return d1 == d2
+	}
+
+	return d1.Equal(*d2)
 }
 
 // DenomList is array of Denom
@@ -27,3 +39,39 @@ func (dl DenomList) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// VoteThresholdOf returns the vote threshold of the denom,
+// which is the override in the list if any or the default
+func (dl DenomList) VoteThresholdOf(denom string, defaultVoteThreshold sdk.Dec) sdk.Dec {
+	for _, d := range dl {
+		if d.Name == denom && d.VoteThreshold != nil {
+			return *d.VoteThreshold
+		}
+	}
+
+	return defaultVoteThreshold
+}
+
+// RewardBandOf returns the reward band of the denom,
+// which is the override in the list if any or the default
+func (dl DenomList) RewardBandOf(denom string, defaultRewardBand sdk.Dec) sdk.Dec {
+	for _, d := range dl {
+		if d.Name == denom && d.RewardBand != nil {
+			return *d.RewardBand
+		}
+	}
+
+	return defaultRewardBand
+}
+
+// MaxDeviationOf returns the max deviation of the denom,
+// which is nil when the circuit breaker is disabled for the denom
+func (dl DenomList) MaxDeviationOf(denom string) *sdk.Dec {
+	for _, d := range dl {
+		if d.Name == denom {
+			return d.MaxDeviation
+		}
+	}
+
+	return nil
+}
@@ -32,3 +32,63 @@ func Test_DenomList(t *testing.T) {
 	require.Equal(t, "name: denom3\ntobin_tax: \"300.000000000000000000\"\n", denoms[2].String())
 	require.Equal(t, "name: denom1\ntobin_tax: \"100.000000000000000000\"\n\nname: denom2\ntobin_tax: \"200.000000000000000000\"\n\nname: denom3\ntobin_tax: \"300.000000000000000000\"", denoms.String())
 }
+
+func Test_DenomListOverrides(t *testing.T) {
+	voteThreshold := sdk.NewDecWithPrec(8, 1)
+	rewardBand := sdk.NewDecWithPrec(1, 2)
+	denoms := types.DenomList{
+		types.Denom{
+			Name:          "denom1",
+			TobinTax:      sdk.NewDec(100),
+			VoteThreshold: &voteThreshold,
+			RewardBand:    &rewardBand,
+		},
+		types.Denom{
+			Name:     "denom2",
+			TobinTax: sdk.NewDec(100),
+		},
+	}
+
+	defaultVoteThreshold := sdk.NewDecWithPrec(5, 1)
+	defaultRewardBand := sdk.NewDecWithPrec(2, 2)
+	require.Equal(t, voteThreshold, denoms.VoteThresholdOf("denom1", defaultVoteThreshold))
+	require.Equal(t, rewardBand, denoms.RewardBandOf("denom1", defaultRewardBand))
+	require.Equal(t, defaultVoteThreshold, denoms.VoteThresholdOf("denom2", defaultVoteThreshold))
+	require.Equal(t, defaultRewardBand, denoms.RewardBandOf("denom2", defaultRewardBand))
+	require.Equal(t, defaultVoteThreshold, denoms.VoteThresholdOf("denom3", defaultVoteThreshold))
+
+	denom := denoms[0]
+	require.True(t, denoms[0].Equal(&denom))
+	denom.RewardBand = nil
+	require.False(t, denoms[0].Equal(&denom))
+	require.Equal(t, "name: denom1\ntobin_tax: \"100.000000000000000000\"\nvote_threshold: \"0.800000000000000000\"\nreward_band: \"0.010000000000000000\"\n", denoms[0].String())
+}
+
+func Test_DenomListMaxDeviation(t *testing.T) {
+	maxDeviation := sdk.NewDecWithPrec(1, 1)
+	denoms := types.DenomList{
+		types.Denom{
+			Name:         "denom1",
+			TobinTax:     sdk.NewDec(100),
+			MaxDeviation: &maxDeviation,
+		},
+		types.Denom{
+			Name:     "denom2",
+			TobinTax: sdk.NewDec(100),
+		},
+	}
+
+	require.Equal(t, &maxDeviation, denoms.MaxDeviationOf("denom1"))
+	require.Nil(t, denoms.MaxDeviationOf("denom2"))
+	require.Nil(t, denoms.MaxDeviationOf("denom3"))
+
+	denom := denoms[0]
+	require.True(t, denoms[0].Equal(&denom))
+	denom.MaxDeviation = nil
+	require.False(t, denoms[0].Equal(&denom))
+
+	require.True(t, types.ExceedsDeviation(sdk.NewDec(111), sdk.NewDec(100), maxDeviation))
+	require.True(t, types.ExceedsDeviation(sdk.NewDec(89), sdk.NewDec(100), maxDeviation))
+	require.False(t, types.ExceedsDeviation(sdk.NewDec(110), sdk.NewDec(100), maxDeviation))
+	require.False(t, types.ExceedsDeviation(sdk.NewDec(1000), sdk.ZeroDec(), maxDeviation))
+}
@@ -10,17 +10,28 @@ import (
 
 // Oracle Errors
 var (
-	ErrInvalidExchangeRate   = sdkerrors.Register(ModuleName, 2, "invalid exchange rate")
-	ErrNoPrevote             = sdkerrors.Register(ModuleName, 3, "no prevote")
-	ErrNoVote                = sdkerrors.Register(ModuleName, 4, "no vote")
-	ErrNoVotingPermission    = sdkerrors.Register(ModuleName, 5, "unauthorized voter")
-	ErrInvalidHash           = sdkerrors.Register(ModuleName, 6, "invalid hash")
-	ErrInvalidHashLength     = sdkerrors.Register(ModuleName, 7, fmt.Sprintf("invalid hash length; should equal %d", tmhash.TruncatedSize))
-	ErrVerificationFailed    = sdkerrors.Register(ModuleName, 8, "hash verification failed")
-	ErrRevealPeriodMissMatch = sdkerrors.Register(ModuleName, 9, "reveal period of submitted vote do not match with registered prevote")
-	ErrInvalidSaltLength     = sdkerrors.Register(ModuleName, 10, "invalid salt length; should be 1~4")
-	ErrNoAggregatePrevote    = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
-	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
-	ErrNoTobinTax            = sdkerrors.Register(ModuleName, 13, "no tobin tax")
-	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
+	ErrInvalidExchangeRate      = sdkerrors.Register(ModuleName, 2, "invalid exchange rate")
+	ErrNoPrevote                = sdkerrors.Register(ModuleName, 3, "no prevote")
+	ErrNoVote                   = sdkerrors.Register(ModuleName, 4, "no vote")
+	ErrNoVotingPermission       = sdkerrors.Register(ModuleName, 5, "unauthorized voter")
+	ErrInvalidHash              = sdkerrors.Register(ModuleName, 6, "invalid hash")
+	ErrInvalidHashLength        = sdkerrors.Register(ModuleName, 7, fmt.Sprintf("invalid hash length; should equal %d", tmhash.TruncatedSize))
+	ErrVerificationFailed       = sdkerrors.Register(ModuleName, 8, "hash verification failed")
+	ErrRevealPeriodMissMatch    = sdkerrors.Register(ModuleName, 9, "reveal period of submitted vote do not match with registered prevote")
+	ErrInvalidSaltLength        = sdkerrors.Register(ModuleName, 10, "invalid salt length; should be 1~4")
+	ErrNoAggregatePrevote       = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
+	ErrNoAggregateVote          = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
+	ErrNoTobinTax               = sdkerrors.Register(ModuleName, 13, "no tobin tax")
+	ErrUnknownDenom             = sdkerrors.Register(ModuleName, 14, "unknown denom")
+	ErrNoHistoricExchangeRate   = sdkerrors.Register(ModuleName, 15, "no historic exchange rate")
+	ErrNoPenaltyOutcome         = sdkerrors.Register(ModuleName, 16, "no penalty outcome")
+	ErrInvalidActivationHeight  = sdkerrors.Register(ModuleName, 17, "invalid activation height")
+	ErrNoFeederRotation         = sdkerrors.Register(ModuleName, 18, "no feeder rotation")
+	ErrNoAdditionalFeeder       = sdkerrors.Register(ModuleName, 19, "no additional feeder")
+	ErrTooManyFeeders           = sdkerrors.Register(ModuleName, 20, fmt.Sprintf("too many additional feeders; should be at most %d", MaxAdditionalFeeders))
+	ErrCombinedVoteDisabled     = sdkerrors.Register(ModuleName, 21, "combined vote is disabled")
+	ErrDenomHalted              = sdkerrors.Register(ModuleName, 22, "denom halted by the circuit breaker")
+	ErrDenomNotHalted           = sdkerrors.Register(ModuleName, 23, "denom not halted")
+	ErrDenomAlreadyWhitelisted  = sdkerrors.Register(ModuleName, 24, "denom already whitelisted")
+	ErrWhitelistUpdateScheduled = sdkerrors.Register(ModuleName, 25, "whitelist update already scheduled")
 )
@@ -8,13 +8,27 @@ const (
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypePenalty            = "penalty"
+	EventTypeFeederRotation     = "feeder_rotation"
+	EventTypeAddFeeder          = "add_feeder"
+	EventTypeRemoveFeeder       = "remove_feeder"
+	EventTypeDenomHalt          = "denom_halt"
+	EventTypeDenomResume        = "denom_resume"
+	EventTypeWhitelistUpdate    = "whitelist_update"
 
-	AttributeKeyDenom         = "denom"
-	AttributeKeyVoter         = "voter"
-	AttributeKeyExchangeRate  = "exchange_rate"
-	AttributeKeyExchangeRates = "exchange_rates"
-	AttributeKeyOperator      = "operator"
-	AttributeKeyFeeder        = "feeder"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyVoter            = "voter"
+	AttributeKeyExchangeRate     = "exchange_rate"
+	AttributeKeyExchangeRates    = "exchange_rates"
+	AttributeKeyOperator         = "operator"
+	AttributeKeyFeeder           = "feeder"
+	AttributeKeyTier             = "tier"
+	AttributeKeyValidVoteRate    = "valid_vote_rate"
+	AttributeKeyWindow           = "window"
+	AttributeKeyActivationHeight = "activation_height"
+	AttributeKeyReferenceRate    = "reference_rate"
+	AttributeKeyAction           = "action"
+	AttributeKeyTobinTax         = "tobin_tax"
 
 	AttributeValueCategory = ModuleName
 )
@@ -44,3 +44,8 @@ type BankKeeper interface {
 	// only used for simulation
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// MarketKeeper defines the expected interface needed to resolve the ibc denom mappings
+type MarketKeeper interface {
+	NativeDenom(ctx sdk.Context, denom string) string
+}
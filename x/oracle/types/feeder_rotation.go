@@ -0,0 +1,22 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeederRotation creates a FeederRotation instance
+func NewFeederRotation(validator sdk.ValAddress, feeder sdk.AccAddress, activationHeight uint64) FeederRotation {
+	return FeederRotation{
+		ValidatorAddress: validator.String(),
+		FeederAddress:    feeder.String(),
+		ActivationHeight: activationHeight,
+	}
+}
+
+// String implement stringify
+func (fr FeederRotation) String() string {
+	out, _ := yaml.Marshal(fr)
+	return string(out)
+}
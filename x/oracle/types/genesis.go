@@ -13,6 +13,13 @@ func NewGenesisState(
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	tobinTaxes []TobinTax,
+	historicExchangeRates []HistoricExchangeRate,
+	validatorPerformances []ValidatorPerformance,
+	penaltyOutcomes []PenaltyOutcome,
+	feederRotations []FeederRotation,
+	additionalFeeders []FeederDelegation,
+	haltedDenoms []HaltedDenom,
+	whitelistUpdates []WhitelistUpdate,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -22,6 +29,13 @@ func NewGenesisState(
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		TobinTaxes:                    tobinTaxes,
+		HistoricExchangeRates:         historicExchangeRates,
+		ValidatorPerformances:         validatorPerformances,
+		PenaltyOutcomes:               penaltyOutcomes,
+		FeederRotations:               feederRotations,
+		AdditionalFeeders:             additionalFeeders,
+		HaltedDenoms:                  haltedDenoms,
+		WhitelistUpdates:              whitelistUpdates,
 	}
 }
 
@@ -33,7 +47,14 @@ func DefaultGenesisState() *GenesisState {
 		[]MissCounter{},
 		[]AggregateExchangeRatePrevote{},
 		[]AggregateExchangeRateVote{},
-		[]TobinTax{})
+		[]TobinTax{},
+		[]HistoricExchangeRate{},
+		[]ValidatorPerformance{},
+		[]PenaltyOutcome{},
+		[]FeederRotation{},
+		[]FeederDelegation{},
+		[]HaltedDenom{},
+		[]WhitelistUpdate{})
 }
 
 // ValidateGenesis validates the oracle genesis state
@@ -36,6 +36,13 @@ type GenesisState struct {
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	TobinTaxes                    []TobinTax                     `protobuf:"bytes,7,rep,name=tobin_taxes,json=tobinTaxes,proto3" json:"tobin_taxes"`
+	HistoricExchangeRates         []HistoricExchangeRate         `protobuf:"bytes,8,rep,name=historic_exchange_rates,json=historicExchangeRates,proto3" json:"historic_exchange_rates"`
+	ValidatorPerformances         []ValidatorPerformance         `protobuf:"bytes,9,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
+	PenaltyOutcomes               []PenaltyOutcome               `protobuf:"bytes,10,rep,name=penalty_outcomes,json=penaltyOutcomes,proto3" json:"penalty_outcomes"`
+	FeederRotations               []FeederRotation               `protobuf:"bytes,11,rep,name=feeder_rotations,json=feederRotations,proto3" json:"feeder_rotations"`
+	AdditionalFeeders             []FeederDelegation             `protobuf:"bytes,12,rep,name=additional_feeders,json=additionalFeeders,proto3" json:"additional_feeders"`
+	HaltedDenoms                  []HaltedDenom                  `protobuf:"bytes,13,rep,name=halted_denoms,json=haltedDenoms,proto3" json:"halted_denoms"`
+	WhitelistUpdates              []WhitelistUpdate              `protobuf:"bytes,14,rep,name=whitelist_updates,json=whitelistUpdates,proto3" json:"whitelist_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +132,55 @@ func (m *GenesisState) GetTobinTaxes() []TobinTax {
 	return nil
 }
 
+func (m *GenesisState) GetHistoricExchangeRates() []HistoricExchangeRate {
+	if m != nil {
+		return m.HistoricExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPenaltyOutcomes() []PenaltyOutcome {
+	if m != nil {
+		return m.PenaltyOutcomes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeederRotations() []FeederRotation {
+	if m != nil {
+		return m.FeederRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdditionalFeeders() []FeederDelegation {
+	if m != nil {
+		return m.AdditionalFeeders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHaltedDenoms() []HaltedDenom {
+	if m != nil {
+		return m.HaltedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWhitelistUpdates() []WhitelistUpdate {
+	if m != nil {
+		return m.WhitelistUpdates
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -308,42 +364,54 @@ func init() {
 }
 
 var fileDescriptor_7ff46fd82c752f1f = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0x7e, 0xd1, 0x6e, 0xda, 0xaa, 0x5d, 0xf5, 0x10, 0x45, 0xd4, 0xf9, 0x90, 0x28,
-	0x95, 0xa0, 0xb6, 0x1a, 0x6e, 0xdc, 0x1a, 0xda, 0x72, 0x00, 0xa4, 0xca, 0x44, 0x1c, 0x40, 0xc8,
-	0xda, 0xd8, 0x13, 0xd7, 0x10, 0x7b, 0xa3, 0x9d, 0x4d, 0x14, 0x8e, 0xbc, 0x01, 0x4f, 0xc1, 0x81,
-	0x27, 0xe9, 0xb1, 0x47, 0xc4, 0xa1, 0xa0, 0xe4, 0x45, 0x90, 0x77, 0x37, 0x89, 0x29, 0x2e, 0x12,
-	0xa7, 0x64, 0x67, 0x7f, 0xf3, 0xff, 0xcf, 0xac, 0x47, 0x43, 0x9a, 0x12, 0x84, 0x60, 0x2e, 0x17,
-	0x2c, 0xe8, 0x83, 0x3b, 0x3a, 0xee, 0x82, 0x64, 0xc7, 0x6e, 0x04, 0x29, 0x60, 0x8c, 0xce, 0x40,
-	0x70, 0xc9, 0xe9, 0x9e, 0x62, 0x1c, 0xcd, 0x38, 0x86, 0xa9, 0xee, 0x45, 0x3c, 0xe2, 0x0a, 0x70,
-	0xb3, 0x7f, 0x9a, 0xad, 0x36, 0x0a, 0xf5, 0x4c, 0xaa, 0x42, 0x9a, 0x5f, 0x57, 0xc9, 0xe6, 0x73,
-	0x6d, 0xf0, 0x5a, 0x32, 0x09, 0xf4, 0x29, 0x59, 0x1b, 0x30, 0xc1, 0x12, 0xac, 0x58, 0x75, 0xeb,
-	0xb0, 0xdc, 0xba, 0xef, 0x14, 0x19, 0x3a, 0x17, 0x8a, 0x69, 0xaf, 0x5c, 0xdd, 0xd4, 0x4a, 0x9e,
-	0xc9, 0xa0, 0xef, 0x08, 0xed, 0x01, 0x84, 0x20, 0xfc, 0x10, 0xfa, 0x10, 0x31, 0x19, 0xf3, 0x14,
-	0x2b, 0x4b, 0xf5, 0xe5, 0xc3, 0x72, 0xeb, 0xa0, 0x58, 0xe7, 0x5c, 0xf1, 0xa7, 0x73, 0xdc, 0x28,
-	0xee, 0xf6, 0x6e, 0xc5, 0x91, 0x7e, 0x20, 0xdb, 0x30, 0x0e, 0x2e, 0x59, 0x1a, 0x81, 0x2f, 0x98,
-	0x04, 0xac, 0x2c, 0x2b, 0xe1, 0x87, 0xc5, 0xc2, 0x67, 0x86, 0xf5, 0x98, 0x84, 0xce, 0x70, 0xd0,
-	0x87, 0x76, 0x35, 0x53, 0xfe, 0xf6, 0xb3, 0x46, 0xff, 0xba, 0x42, 0x6f, 0x0b, 0x72, 0x31, 0xa4,
-	0x2f, 0xc9, 0x56, 0x12, 0x23, 0xfa, 0x01, 0x1f, 0xa6, 0x12, 0x04, 0x56, 0x56, 0x94, 0x55, 0xa3,
-	0xd8, 0xea, 0x55, 0x8c, 0xf8, 0x4c, 0x93, 0xa6, 0xfc, 0xcd, 0x64, 0x11, 0x42, 0xfa, 0xd9, 0x22,
-	0x75, 0x16, 0x45, 0x22, 0x6b, 0x05, 0xfc, 0x3f, 0x9a, 0xf0, 0x07, 0x02, 0x46, 0x3c, 0x6b, 0x66,
-	0x55, 0x39, 0xb4, 0x8a, 0x1d, 0x4e, 0x66, 0xd9, 0xf9, 0xd2, 0x2f, 0x74, 0xaa, 0xb1, 0xdc, 0x67,
-	0xff, 0x60, 0x90, 0x8e, 0xc9, 0xfe, 0x5d, 0x25, 0x68, 0xff, 0x35, 0xe5, 0xef, 0xfe, 0x87, 0xff,
-	0x9b, 0x85, 0x79, 0x95, 0xdd, 0x05, 0x20, 0x3d, 0x23, 0x65, 0xc9, 0xbb, 0x71, 0xea, 0x4b, 0x36,
-	0x06, 0xac, 0xdc, 0x53, 0x3e, 0x76, 0xb1, 0x4f, 0x27, 0x03, 0x3b, 0x6c, 0x6c, 0x64, 0x89, 0x34,
-	0x67, 0xc0, 0x66, 0x8f, 0xec, 0xdc, 0x9e, 0x15, 0xfa, 0x80, 0x6c, 0x9b, 0x79, 0x63, 0x61, 0x28,
-	0x00, 0xf5, 0xcc, 0x6e, 0x78, 0x5b, 0x3a, 0x7a, 0xa2, 0x83, 0xf4, 0x11, 0xd9, 0x1d, 0xb1, 0x7e,
-	0x1c, 0x32, 0xc9, 0x17, 0xe4, 0x92, 0x22, 0x77, 0xe6, 0x17, 0x06, 0x6e, 0xbe, 0x27, 0xe5, 0xdc,
-	0xf7, 0x2c, 0xce, 0xb5, 0x8a, 0x73, 0x69, 0x83, 0x6c, 0xe6, 0xc7, 0x46, 0x79, 0xac, 0x78, 0xe5,
-	0xdc, 0x30, 0x34, 0x13, 0xb2, 0x3e, 0x6b, 0x92, 0xee, 0x91, 0xd5, 0x10, 0x52, 0x9e, 0x18, 0x3d,
-	0x7d, 0xa0, 0x2f, 0xc8, 0xc6, 0xfc, 0xbd, 0x74, 0x95, 0x6d, 0x27, 0x7b, 0x8d, 0x1f, 0x37, 0xb5,
-	0x83, 0x28, 0x96, 0x97, 0xc3, 0xae, 0x13, 0xf0, 0xc4, 0x0d, 0x38, 0x26, 0x1c, 0xcd, 0xcf, 0x11,
-	0x86, 0x1f, 0x5d, 0xf9, 0x69, 0x00, 0xe8, 0x9c, 0x42, 0xe0, 0xad, 0xcf, 0xde, 0xad, 0x7d, 0x7e,
-	0x35, 0xb1, 0xad, 0xeb, 0x89, 0x6d, 0xfd, 0x9a, 0xd8, 0xd6, 0x97, 0xa9, 0x5d, 0xba, 0x9e, 0xda,
-	0xa5, 0xef, 0x53, 0xbb, 0xf4, 0xf6, 0x71, 0x5e, 0xab, 0xcf, 0x10, 0xe3, 0xe0, 0x48, 0xaf, 0x8b,
-	0x80, 0x0b, 0x70, 0xc7, 0xb3, 0xad, 0xa1, 0x54, 0xbb, 0x6b, 0x6a, 0x5b, 0x3c, 0xf9, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x42, 0xbe, 0xb8, 0x8e, 0xa2, 0x04, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xd1, 0x4e, 0x33, 0x45,
+	0x14, 0xc7, 0xdb, 0xef, 0x03, 0x84, 0x69, 0x8b, 0x65, 0x82, 0xba, 0x69, 0xa4, 0x40, 0x23, 0x48,
+	0x54, 0x76, 0x03, 0xde, 0x79, 0x47, 0x05, 0x24, 0x51, 0x23, 0xa9, 0x80, 0x46, 0x63, 0x36, 0xd3,
+	0xdd, 0xd3, 0xdd, 0xd5, 0xdd, 0x9d, 0xcd, 0x9c, 0x69, 0x29, 0x97, 0xbe, 0x81, 0xcf, 0x61, 0x7c,
+	0x10, 0x2e, 0xb9, 0x34, 0x5e, 0xa0, 0x81, 0x17, 0x31, 0x3b, 0x33, 0x4b, 0x5b, 0x5c, 0x9a, 0xf8,
+	0x5d, 0xb5, 0x7b, 0xe6, 0x77, 0xfe, 0xff, 0x99, 0xc9, 0xfc, 0x67, 0x48, 0x47, 0x82, 0x10, 0xcc,
+	0xe1, 0x82, 0x79, 0x31, 0x38, 0xa3, 0x83, 0x3e, 0x48, 0x76, 0xe0, 0x04, 0x90, 0x02, 0x46, 0x68,
+	0x67, 0x82, 0x4b, 0x4e, 0xd7, 0x15, 0x63, 0x6b, 0xc6, 0x36, 0x4c, 0x6b, 0x3d, 0xe0, 0x01, 0x57,
+	0x80, 0x93, 0xff, 0xd3, 0x6c, 0x6b, 0xbb, 0x54, 0xcf, 0xb4, 0x2a, 0xa4, 0xf3, 0x07, 0x21, 0xf5,
+	0x2f, 0xb4, 0xc1, 0xb7, 0x92, 0x49, 0xa0, 0x9f, 0x91, 0xa5, 0x8c, 0x09, 0x96, 0xa0, 0x55, 0xdd,
+	0xaa, 0xee, 0xd5, 0x0e, 0xdf, 0xb7, 0xcb, 0x0c, 0xed, 0x73, 0xc5, 0x74, 0x17, 0x6e, 0xef, 0x37,
+	0x2b, 0x3d, 0xd3, 0x41, 0x7f, 0x24, 0x74, 0x00, 0xe0, 0x83, 0x70, 0x7d, 0x88, 0x21, 0x60, 0x32,
+	0xe2, 0x29, 0x5a, 0xaf, 0xb6, 0x5e, 0xef, 0xd5, 0x0e, 0x77, 0xcb, 0x75, 0x4e, 0x15, 0x7f, 0xfc,
+	0x84, 0x1b, 0xc5, 0xb5, 0xc1, 0xb3, 0x3a, 0xd2, 0x9f, 0xc9, 0x2a, 0x8c, 0xbd, 0x90, 0xa5, 0x01,
+	0xb8, 0x82, 0x49, 0x40, 0xeb, 0xb5, 0x12, 0xfe, 0xb0, 0x5c, 0xf8, 0xc4, 0xb0, 0x3d, 0x26, 0xe1,
+	0x62, 0x98, 0xc5, 0xd0, 0x6d, 0xe5, 0xca, 0xbf, 0xff, 0xbd, 0x49, 0xff, 0x33, 0x84, 0xbd, 0x06,
+	0x4c, 0xd5, 0x90, 0x7e, 0x45, 0x1a, 0x49, 0x84, 0xe8, 0x7a, 0x7c, 0x98, 0x4a, 0x10, 0x68, 0x2d,
+	0x28, 0xab, 0xed, 0x72, 0xab, 0xaf, 0x23, 0xc4, 0xcf, 0x35, 0x69, 0xa6, 0x5f, 0x4f, 0x26, 0x25,
+	0xa4, 0xbf, 0x56, 0xc9, 0x16, 0x0b, 0x02, 0x91, 0x2f, 0x05, 0xdc, 0x99, 0x45, 0xb8, 0x99, 0x80,
+	0x11, 0xcf, 0x17, 0xb3, 0xa8, 0x1c, 0x0e, 0xcb, 0x1d, 0x8e, 0x8a, 0xee, 0xe9, 0xa9, 0x9f, 0xeb,
+	0x56, 0x63, 0xb9, 0xc1, 0xe6, 0x30, 0x48, 0xc7, 0x64, 0xe3, 0xa5, 0x29, 0x68, 0xff, 0x25, 0xe5,
+	0xef, 0xfc, 0x0f, 0xff, 0xab, 0x89, 0x79, 0x8b, 0xbd, 0x04, 0x20, 0x3d, 0x21, 0x35, 0xc9, 0xfb,
+	0x51, 0xea, 0x4a, 0x36, 0x06, 0xb4, 0xde, 0x52, 0x3e, 0xed, 0x72, 0x9f, 0x8b, 0x1c, 0xbc, 0x60,
+	0x63, 0x23, 0x4b, 0xa4, 0xf9, 0x06, 0xa4, 0x21, 0x79, 0x2f, 0x8c, 0x50, 0x72, 0x11, 0x79, 0xee,
+	0xb3, 0x73, 0xb0, 0xac, 0x24, 0x3f, 0x2a, 0x97, 0x3c, 0x33, 0x4d, 0xd3, 0x13, 0x33, 0xf2, 0xef,
+	0x84, 0x25, 0x63, 0x48, 0x03, 0xf2, 0xee, 0x88, 0xc5, 0x91, 0xcf, 0x24, 0x17, 0x6e, 0x06, 0x62,
+	0xc0, 0x45, 0xc2, 0x52, 0x0f, 0xd0, 0x5a, 0x99, 0x67, 0x74, 0x55, 0xf4, 0x9c, 0x4f, 0x5a, 0x0a,
+	0xa3, 0x51, 0xc9, 0x18, 0xd2, 0x4b, 0xd2, 0xcc, 0x20, 0x65, 0xb1, 0xbc, 0x71, 0xf9, 0x50, 0x7a,
+	0x3c, 0x01, 0xb4, 0x88, 0xb2, 0xf8, 0xe0, 0x85, 0xd0, 0x69, 0xfa, 0x1b, 0x0d, 0x1b, 0xf1, 0xb7,
+	0xb3, 0x99, 0xaa, 0x92, 0x35, 0x29, 0x14, 0x5c, 0x9a, 0x0c, 0xd6, 0xe6, 0xc9, 0xea, 0x0c, 0xf6,
+	0x0c, 0x5c, 0xc8, 0x0e, 0x66, 0xaa, 0x2a, 0xdc, 0xcc, 0xf7, 0xa3, 0xfc, 0x83, 0xc5, 0xae, 0x1e,
+	0x45, 0xab, 0xfe, 0x26, 0xe1, 0x9e, 0xe8, 0x68, 0x42, 0x05, 0x2e, 0x64, 0xb1, 0x04, 0xdf, 0xf5,
+	0x21, 0xe5, 0x09, 0x5a, 0x8d, 0x79, 0x81, 0x3b, 0x53, 0xe8, 0x71, 0x4e, 0x16, 0x81, 0x0b, 0x27,
+	0x25, 0xa4, 0xdf, 0x93, 0xb5, 0xeb, 0x30, 0x92, 0x10, 0x47, 0x28, 0xdd, 0x61, 0xe6, 0xab, 0x53,
+	0xb2, 0xaa, 0x14, 0x77, 0xca, 0x15, 0xbf, 0x2b, 0xf0, 0x4b, 0x45, 0x1b, 0xd5, 0xe6, 0xf5, 0x6c,
+	0x19, 0x3b, 0x03, 0xd2, 0x7c, 0xbe, 0x28, 0xba, 0x43, 0x56, 0xcd, 0x7e, 0x33, 0xdf, 0x17, 0x80,
+	0xfa, 0xe6, 0x5c, 0xe9, 0x35, 0x74, 0xf5, 0x48, 0x17, 0xe9, 0xc7, 0x64, 0x6d, 0x72, 0xac, 0x0a,
+	0xf2, 0x95, 0x22, 0x9b, 0x4f, 0x03, 0x06, 0xee, 0xfc, 0x44, 0x6a, 0x53, 0xb7, 0x4a, 0x79, 0x6f,
+	0xb5, 0xbc, 0x97, 0x6e, 0x93, 0xfa, 0xf4, 0xe5, 0xa5, 0x3c, 0x16, 0x7a, 0xb5, 0xa9, 0x2b, 0xa9,
+	0x93, 0x90, 0xe5, 0x22, 0x6a, 0x74, 0x9d, 0x2c, 0xaa, 0x3d, 0x37, 0x7a, 0xfa, 0x83, 0x7e, 0x49,
+	0x56, 0x9e, 0x52, 0xab, 0x67, 0xd9, 0xb5, 0xf3, 0x3d, 0xf9, 0xeb, 0x7e, 0x73, 0x37, 0x88, 0x64,
+	0x38, 0xec, 0xdb, 0x1e, 0x4f, 0x1c, 0x8f, 0x63, 0xc2, 0xd1, 0xfc, 0xec, 0xa3, 0xff, 0x8b, 0x23,
+	0x6f, 0x32, 0x40, 0xfb, 0x18, 0xbc, 0xde, 0x72, 0x91, 0xde, 0xee, 0xe9, 0xed, 0x43, 0xbb, 0x7a,
+	0xf7, 0xd0, 0xae, 0xfe, 0xf3, 0xd0, 0xae, 0xfe, 0xf6, 0xd8, 0xae, 0xdc, 0x3d, 0xb6, 0x2b, 0x7f,
+	0x3e, 0xb6, 0x2b, 0x3f, 0x7c, 0x32, 0xad, 0x15, 0x33, 0xc4, 0xc8, 0xdb, 0xd7, 0x8f, 0x96, 0xc7,
+	0x05, 0x38, 0xe3, 0xe2, 0xed, 0x52, 0xaa, 0xfd, 0x25, 0xf5, 0x66, 0x7d, 0xfa, 0xef, 0x00, 0xd9,
+	0xea, 0xed, 0xe9, 0x28, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -366,6 +434,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WhitelistUpdates) > 0 {
+		for iNdEx := len(m.WhitelistUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.HaltedDenoms) > 0 {
+		for iNdEx := len(m.HaltedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HaltedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AdditionalFeeders) > 0 {
+		for iNdEx := len(m.AdditionalFeeders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFeeders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FeederRotations) > 0 {
+		for iNdEx := len(m.FeederRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeederRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PenaltyOutcomes) > 0 {
+		for iNdEx := len(m.PenaltyOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PenaltyOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.HistoricExchangeRates) > 0 {
+		for iNdEx := len(m.HistoricExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TobinTaxes) > 0 {
 		for iNdEx := len(m.TobinTaxes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -631,6 +797,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HistoricExchangeRates) > 0 {
+		for _, e := range m.HistoricExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PenaltyOutcomes) > 0 {
+		for _, e := range m.PenaltyOutcomes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeederRotations) > 0 {
+		for _, e := range m.FeederRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdditionalFeeders) > 0 {
+		for _, e := range m.AdditionalFeeders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedDenoms) > 0 {
+		for _, e := range m.HaltedDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhitelistUpdates) > 0 {
+		for _, e := range m.WhitelistUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -956,6 +1164,244 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricExchangeRates = append(m.HistoricExchangeRates, HistoricExchangeRate{})
+			if err := m.HistoricExchangeRates[len(m.HistoricExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyOutcomes = append(m.PenaltyOutcomes, PenaltyOutcome{})
+			if err := m.PenaltyOutcomes[len(m.PenaltyOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederRotations = append(m.FeederRotations, FeederRotation{})
+			if err := m.FeederRotations[len(m.FeederRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFeeders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalFeeders = append(m.AdditionalFeeders, FeederDelegation{})
+			if err := m.AdditionalFeeders[len(m.AdditionalFeeders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedDenoms = append(m.HaltedDenoms, HaltedDenom{})
+			if err := m.HaltedDenoms[len(m.HaltedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistUpdates = append(m.WhitelistUpdates, WhitelistUpdate{})
+			if err := m.WhitelistUpdates[len(m.WhitelistUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,221 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeResumeDenom       = "ResumeDenom"
+	ProposalTypeAddOracleDenom    = "AddOracleDenom"
+	ProposalTypeRemoveOracleDenom = "RemoveOracleDenom"
+	ProposalTypeUpdateTobinTax    = "UpdateTobinTax"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeResumeDenom)
+	govtypes.RegisterProposalTypeCodec(&ResumeDenomProposal{}, "oracle/ResumeDenomProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddOracleDenom)
+	govtypes.RegisterProposalTypeCodec(&AddOracleDenomProposal{}, "oracle/AddOracleDenomProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveOracleDenom)
+	govtypes.RegisterProposalTypeCodec(&RemoveOracleDenomProposal{}, "oracle/RemoveOracleDenomProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateTobinTax)
+	govtypes.RegisterProposalTypeCodec(&UpdateTobinTaxProposal{}, "oracle/UpdateTobinTaxProposal")
+}
+
+var (
+	_ govtypes.Content = &ResumeDenomProposal{}
+	_ govtypes.Content = &AddOracleDenomProposal{}
+	_ govtypes.Content = &RemoveOracleDenomProposal{}
+	_ govtypes.Content = &UpdateTobinTaxProposal{}
+)
+
+// ======ResumeDenomProposal======
+
+func NewResumeDenomProposal(title, description, denom string) govtypes.Content {
+	return &ResumeDenomProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+func (p *ResumeDenomProposal) GetTitle() string { return p.Title }
+
+func (p *ResumeDenomProposal) GetDescription() string { return p.Description }
+
+func (p *ResumeDenomProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ResumeDenomProposal) ProposalType() string {
+	return ProposalTypeResumeDenom
+}
+
+func (p ResumeDenomProposal) String() string {
+	return fmt.Sprintf(`ResumeDenomProposal:
+	Title:       %s
+	Description: %s
+	Denom:       %s
+  `, p.Title, p.Description, p.Denom)
+}
+
+func (p *ResumeDenomProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Denom) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	return nil
+}
+
+// ======AddOracleDenomProposal======
+
+func NewAddOracleDenomProposal(title, description string, denom Denom, activationHeight uint64) govtypes.Content {
+	return &AddOracleDenomProposal{
+		Title:            title,
+		Description:      description,
+		Denom:            denom,
+		ActivationHeight: activationHeight,
+	}
+}
+
+func (p *AddOracleDenomProposal) GetTitle() string { return p.Title }
+
+func (p *AddOracleDenomProposal) GetDescription() string { return p.Description }
+
+func (p *AddOracleDenomProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddOracleDenomProposal) ProposalType() string {
+	return ProposalTypeAddOracleDenom
+}
+
+func (p AddOracleDenomProposal) String() string {
+	return fmt.Sprintf(`AddOracleDenomProposal:
+	Title:            %s
+	Description:      %s
+	Denom:            %s
+	ActivationHeight: %d
+  `, p.Title, p.Description, p.Denom.Name, p.ActivationHeight)
+}
+
+// WhitelistUpdate returns the whitelist update scheduled by the proposal
+func (p *AddOracleDenomProposal) WhitelistUpdate() WhitelistUpdate {
+	return NewWhitelistUpdate(WhitelistUpdateActionAdd, p.Denom, p.ActivationHeight)
+}
+
+func (p *AddOracleDenomProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateWhitelistUpdate(p.WhitelistUpdate()); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ======RemoveOracleDenomProposal======
+
+func NewRemoveOracleDenomProposal(title, description, denom string, activationHeight uint64) govtypes.Content {
+	return &RemoveOracleDenomProposal{
+		Title:            title,
+		Description:      description,
+		Denom:            denom,
+		ActivationHeight: activationHeight,
+	}
+}
+
+func (p *RemoveOracleDenomProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveOracleDenomProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveOracleDenomProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveOracleDenomProposal) ProposalType() string {
+	return ProposalTypeRemoveOracleDenom
+}
+
+func (p RemoveOracleDenomProposal) String() string {
+	return fmt.Sprintf(`RemoveOracleDenomProposal:
+	Title:            %s
+	Description:      %s
+	Denom:            %s
+	ActivationHeight: %d
+  `, p.Title, p.Description, p.Denom, p.ActivationHeight)
+}
+
+// WhitelistUpdate returns the whitelist update scheduled by the proposal
+func (p *RemoveOracleDenomProposal) WhitelistUpdate() WhitelistUpdate {
+	return NewWhitelistUpdate(WhitelistUpdateActionRemove, Denom{Name: p.Denom, TobinTax: sdk.ZeroDec()}, p.ActivationHeight)
+}
+
+func (p *RemoveOracleDenomProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateWhitelistUpdate(p.WhitelistUpdate()); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ======UpdateTobinTaxProposal======
+
+func NewUpdateTobinTaxProposal(title, description, denom string, tobinTax sdk.Dec, activationHeight uint64) govtypes.Content {
+	return &UpdateTobinTaxProposal{
+		Title:            title,
+		Description:      description,
+		Denom:            denom,
+		TobinTax:         tobinTax,
+		ActivationHeight: activationHeight,
+	}
+}
+
+func (p *UpdateTobinTaxProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateTobinTaxProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateTobinTaxProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateTobinTaxProposal) ProposalType() string {
+	return ProposalTypeUpdateTobinTax
+}
+
+func (p UpdateTobinTaxProposal) String() string {
+	return fmt.Sprintf(`UpdateTobinTaxProposal:
+	Title:            %s
+	Description:      %s
+	Denom:            %s
+	TobinTax:         %s
+	ActivationHeight: %d
+  `, p.Title, p.Description, p.Denom, p.TobinTax, p.ActivationHeight)
+}
+
+// WhitelistUpdate returns the whitelist update scheduled by the proposal
+func (p *UpdateTobinTaxProposal) WhitelistUpdate() WhitelistUpdate {
+	return NewWhitelistUpdate(WhitelistUpdateActionUpdateTobinTax, Denom{Name: p.Denom, TobinTax: p.TobinTax}, p.ActivationHeight)
+}
+
+func (p *UpdateTobinTaxProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateWhitelistUpdate(p.WhitelistUpdate()); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
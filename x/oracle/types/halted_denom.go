@@ -0,0 +1,34 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHaltedDenom creates a HaltedDenom instance
+func NewHaltedDenom(denom string, referenceRate sdk.Dec, lastRate sdk.Dec, haltHeight int64) HaltedDenom {
+	return HaltedDenom{
+		Denom:         denom,
+		ReferenceRate: referenceRate,
+		LastRate:      lastRate,
+		InBandTallies: 0,
+		HaltHeight:    haltHeight,
+	}
+}
+
+// String implement stringify
+func (hd HaltedDenom) String() string {
+	out, _ := yaml.Marshal(hd)
+	return string(out)
+}
+
+// ExceedsDeviation returns whether the exchange rate deviates from the
+// reference rate by more than the max deviation
+func ExceedsDeviation(exchangeRate sdk.Dec, referenceRate sdk.Dec, maxDeviation sdk.Dec) bool {
+	if !referenceRate.IsPositive() {
+		return false
+	}
+
+	return exchangeRate.Sub(referenceRate).Abs().Quo(referenceRate).GT(maxDeviation)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHistoricExchangeRate creates a HistoricExchangeRate instance
+func NewHistoricExchangeRate(denom string, exchangeRate sdk.Dec, blockHeight int64, blockTime time.Time) HistoricExchangeRate {
+	return HistoricExchangeRate{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		BlockHeight:  blockHeight,
+		BlockTime:    blockTime,
+	}
+}
+
+// String implement stringify
+func (hr HistoricExchangeRate) String() string {
+	out, _ := yaml.Marshal(hr)
+	return string(out)
+}
+
+// HistoricExchangeRates is array of HistoricExchangeRate
+type HistoricExchangeRates []HistoricExchangeRate
+
+// TimeWeightedAverage returns the average of the snapshots, each weighted by
+// the span of the window [start, end] during which it was the latest rate.
+// pos maps a snapshot onto the weighting axis (block height or unix time).
+// The snapshots must be sorted by pos and may begin with one snapshot taken
+// before start, which then covers the head of the window.
+// If the window has no width, the latest snapshot is returned.
+func (hrs HistoricExchangeRates) TimeWeightedAverage(start, end int64, pos func(HistoricExchangeRate) int64) (sdk.Dec, error) {
+	if len(hrs) == 0 {
+		return sdk.ZeroDec(), ErrNoHistoricExchangeRate
+	}
+
+	sum := sdk.ZeroDec()
+	totalWeight := int64(0)
+	for i, hr := range hrs {
+		from := pos(hr)
+		if from < start {
+			from = start
+		}
+
+		to := end
+		if i+1 < len(hrs) && pos(hrs[i+1]) < end {
+			to = pos(hrs[i+1])
+		}
+
+		if to <= from {
+			continue
+		}
+
+		sum = sum.Add(hr.ExchangeRate.MulInt64(to - from))
+		totalWeight += to - from
+	}
+
+	if totalWeight == 0 {
+		return hrs[len(hrs)-1].ExchangeRate, nil
+	}
+
+	return sum.QuoInt64(totalWeight), nil
+}
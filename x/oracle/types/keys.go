@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -33,6 +35,20 @@ const (
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07<denom_Bytes><height_Bytes>: HistoricExchangeRate
+//
+// - 0x08<valAddress_Bytes><window_Bytes>: ValidatorPerformance
+//
+// - 0x09<valAddress_Bytes>: PenaltyOutcome
+//
+// - 0x0A<valAddress_Bytes>: FeederRotation
+//
+// - 0x0B<valAddress_Bytes><accAddress_Bytes>: []byte{}
+//
+// - 0x0C<denom_Bytes>: HaltedDenom
+//
+// - 0x0D<height_Bytes><denom_Bytes>: WhitelistUpdate
 var (
 	// Keys for store prefixes
 	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
@@ -41,6 +57,13 @@ var (
 	AggregateExchangeRatePrevoteKey = []byte{0x04} // prefix for each key to a aggregate prevote
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
 	TobinTaxKey                     = []byte{0x06} // prefix for each key to a tobin tax
+	HistoricExchangeRateKey         = []byte{0x07} // prefix for each key to a historic exchange rate
+	ValidatorPerformanceKey         = []byte{0x08} // prefix for each key to a validator performance
+	PenaltyOutcomeKey               = []byte{0x09} // prefix for each key to a penalty outcome
+	FeederRotationKey               = []byte{0x0A} // prefix for each key to a feeder rotation
+	AdditionalFeederKey             = []byte{0x0B} // prefix for each key to an additional feeder
+	HaltedDenomKey                  = []byte{0x0C} // prefix for each key to a halted denom
+	WhitelistUpdateKey              = []byte{0x0D} // prefix for each key to a scheduled whitelist update
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -78,3 +101,64 @@ func ExtractDenomFromTobinTaxKey(key []byte) (denom string) {
 	denom = string(key[1:])
 	return
 }
+
+// GetHistoricExchangeRatesKey - stored by *denom* bytes
+func GetHistoricExchangeRatesKey(denom string) []byte {
+	return append(HistoricExchangeRateKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetHistoricExchangeRateKey - stored by *denom* bytes and *height*
+func GetHistoricExchangeRateKey(denom string, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(GetHistoricExchangeRatesKey(denom), bz...)
+}
+
+// GetValidatorPerformancesKey - stored by *Validator* address
+func GetValidatorPerformancesKey(v sdk.ValAddress) []byte {
+	return append(ValidatorPerformanceKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorPerformanceKey - stored by *Validator* address and slash *window*
+func GetValidatorPerformanceKey(v sdk.ValAddress, window uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, window)
+	return append(GetValidatorPerformancesKey(v), bz...)
+}
+
+// GetPenaltyOutcomeKey - stored by *Validator* address
+func GetPenaltyOutcomeKey(v sdk.ValAddress) []byte {
+	return append(PenaltyOutcomeKey, address.MustLengthPrefix(v)...)
+}
+
+// GetFeederRotationKey - stored by *Validator* address
+func GetFeederRotationKey(v sdk.ValAddress) []byte {
+	return append(FeederRotationKey, address.MustLengthPrefix(v)...)
+}
+
+// GetAdditionalFeedersKey - stored by *Validator* address
+func GetAdditionalFeedersKey(v sdk.ValAddress) []byte {
+	return append(AdditionalFeederKey, address.MustLengthPrefix(v)...)
+}
+
+// GetAdditionalFeederKey - stored by *Validator* address and *feeder* address
+func GetAdditionalFeederKey(v sdk.ValAddress, feeder sdk.AccAddress) []byte {
+	return append(GetAdditionalFeedersKey(v), address.MustLengthPrefix(feeder)...)
+}
+
+// GetHaltedDenomKey - stored by *denom* bytes
+func GetHaltedDenomKey(denom string) []byte {
+	return append(HaltedDenomKey, []byte(denom)...)
+}
+
+// GetWhitelistUpdatesKey - stored by activation *height*
+func GetWhitelistUpdatesKey(height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	return append(WhitelistUpdateKey, bz...)
+}
+
+// GetWhitelistUpdateKey - stored by activation *height* and *denom* bytes
+func GetWhitelistUpdateKey(height uint64, denom string) []byte {
+	return append(GetWhitelistUpdatesKey(height), []byte(denom)...)
+}
@@ -12,15 +12,27 @@ var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAggregateExchangeRateCombinedVote{}
+	_ sdk.Msg = &MsgRotateFeeder{}
+	_ sdk.Msg = &MsgAddFeeder{}
+	_ sdk.Msg = &MsgRemoveFeeder{}
 )
 
 // oracle message types
 const (
-	TypeMsgDelegateFeedConsent          = "delegate_feeder"
-	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
-	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
+	TypeMsgDelegateFeedConsent               = "delegate_feeder"
+	TypeMsgAggregateExchangeRatePrevote      = "aggregate_exchange_rate_prevote"
+	TypeMsgAggregateExchangeRateVote         = "aggregate_exchange_rate_vote"
+	TypeMsgAggregateExchangeRateCombinedVote = "aggregate_exchange_rate_combined_vote"
+	TypeMsgRotateFeeder                      = "rotate_feeder"
+	TypeMsgAddFeeder                         = "add_feeder"
+	TypeMsgRemoveFeeder                      = "remove_feeder"
 )
 
+// MaxAdditionalFeeders is the maximum number of feeders a validator can
+// authorize in addition to its delegated feeder
+const MaxAdditionalFeeders = 4
+
 //-------------------------------------------------
 //-------------------------------------------------
 
@@ -147,6 +159,71 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgAggregateExchangeRateCombinedVote returns MsgAggregateExchangeRateCombinedVote instance
+func NewMsgAggregateExchangeRateCombinedVote(salt string, exchangeRates string, hash AggregateVoteHash, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateCombinedVote {
+	return &MsgAggregateExchangeRateCombinedVote{
+		Salt:          salt,
+		ExchangeRates: exchangeRates,
+		Hash:          hash.String(),
+		Feeder:        feeder.String(),
+		Validator:     validator.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAggregateExchangeRateCombinedVote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAggregateExchangeRateCombinedVote) Type() string {
+	return TypeMsgAggregateExchangeRateCombinedVote
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAggregateExchangeRateCombinedVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAggregateExchangeRateCombinedVote) GetSigners() []sdk.AccAddress {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{feeder}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAggregateExchangeRateCombinedVote) ValidateBasic() error {
+	// the reveal part follows the rules of the aggregate vote
+	if err := NewMsgAggregateExchangeRateVoteFromCombinedVote(msg).ValidateBasic(); err != nil {
+		return err
+	}
+
+	// the next hash follows the rules of the aggregate prevote
+	_, err := AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHash, "Invalid vote hash (%s)", err)
+	}
+
+	// HEX encoding doubles the hash length
+	if len(msg.Hash) != tmhash.TruncatedSize*2 {
+		return ErrInvalidHashLength
+	}
+
+	return nil
+}
+
+// NewMsgAggregateExchangeRateVoteFromCombinedVote returns the aggregate vote revealed by the combined vote
+func NewMsgAggregateExchangeRateVoteFromCombinedVote(msg MsgAggregateExchangeRateCombinedVote) *MsgAggregateExchangeRateVote {
+	return &MsgAggregateExchangeRateVote{
+		Salt:          msg.Salt,
+		ExchangeRates: msg.ExchangeRates,
+		Feeder:        msg.Feeder,
+		Validator:     msg.Validator,
+	}
+}
+
 // NewMsgDelegateFeedConsent creates a MsgDelegateFeedConsent instance
 func NewMsgDelegateFeedConsent(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress) *MsgDelegateFeedConsent {
 	return &MsgDelegateFeedConsent{
@@ -190,3 +267,140 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgRotateFeeder creates a MsgRotateFeeder instance
+func NewMsgRotateFeeder(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress, activationHeight uint64) *MsgRotateFeeder {
+	return &MsgRotateFeeder{
+		Operator:         operatorAddress.String(),
+		Feeder:           feederAddress.String(),
+		ActivationHeight: activationHeight,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRotateFeeder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRotateFeeder) Type() string { return TypeMsgRotateFeeder }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRotateFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRotateFeeder) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRotateFeeder) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	if msg.ActivationHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidActivationHeight, "activation height must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgAddFeeder creates a MsgAddFeeder instance
+func NewMsgAddFeeder(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress) *MsgAddFeeder {
+	return &MsgAddFeeder{
+		Operator: operatorAddress.String(),
+		Feeder:   feederAddress.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddFeeder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddFeeder) Type() string { return TypeMsgAddFeeder }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddFeeder) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddFeeder) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	return nil
+}
+
+// NewMsgRemoveFeeder creates a MsgRemoveFeeder instance
+func NewMsgRemoveFeeder(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress) *MsgRemoveFeeder {
+	return &MsgRemoveFeeder{
+		Operator: operatorAddress.String(),
+		Feeder:   feederAddress.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRemoveFeeder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRemoveFeeder) Type() string { return TypeMsgRemoveFeeder }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRemoveFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveFeeder) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveFeeder) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	return nil
+}
@@ -39,6 +39,94 @@ func TestMsgFeederDelegation(t *testing.T) {
 	}
 }
 
+func TestMsgRotateFeeder(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	tests := []struct {
+		operator         sdk.ValAddress
+		feeder           sdk.AccAddress
+		activationHeight uint64
+		expectPass       bool
+	}{
+		{sdk.ValAddress(addrs[0]), addrs[1], 100, true},
+		{sdk.ValAddress(addrs[0]), addrs[1], 0, false},
+		{sdk.ValAddress{}, addrs[1], 100, false},
+		{sdk.ValAddress(addrs[0]), sdk.AccAddress{}, 100, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgRotateFeeder(tc.operator, tc.feeder, tc.activationHeight)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAggregateExchangeRateCombinedVote(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	exchangeRates := "1.0foo,1232.132bar"
+	hash := types.GetAggregateVoteHash("2", exchangeRates, sdk.ValAddress(addrs[0]))
+
+	tests := []struct {
+		salt          string
+		exchangeRates string
+		hash          types.AggregateVoteHash
+		expectPass    bool
+	}{
+		{"123", exchangeRates, hash, true},
+		{"123", "a,b", hash, false},
+		{"", exchangeRates, hash, false},
+		{"123", exchangeRates, []byte{}, false},
+		{"123", exchangeRates, []byte("short"), false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgAggregateExchangeRateCombinedVote(tc.salt, tc.exchangeRates, tc.hash, addrs[0], sdk.ValAddress(addrs[0]))
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAddRemoveFeeder(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	tests := []struct {
+		operator   sdk.ValAddress
+		feeder     sdk.AccAddress
+		expectPass bool
+	}{
+		{sdk.ValAddress(addrs[0]), addrs[1], true},
+		{sdk.ValAddress{}, addrs[1], false},
+		{sdk.ValAddress(addrs[0]), sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		addMsg := types.NewMsgAddFeeder(tc.operator, tc.feeder)
+		removeMsg := types.NewMsgRemoveFeeder(tc.operator, tc.feeder)
+		if tc.expectPass {
+			require.Nil(t, addMsg.ValidateBasic(), "test: %v", i)
+			require.Nil(t, removeMsg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, addMsg.ValidateBasic(), "test: %v", i)
+			require.NotNil(t, removeMsg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
 	addrs := []sdk.AccAddress{
 		sdk.AccAddress([]byte("addr1_______________")),
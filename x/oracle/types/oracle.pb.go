@@ -8,10 +8,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -19,6 +23,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -29,14 +34,29 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the oracle module.
 type Params struct {
-	VotePeriod               uint64                                 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
-	VoteThreshold            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold" yaml:"vote_threshold"`
-	RewardBand               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band" yaml:"reward_band"`
-	RewardDistributionWindow uint64                                 `protobuf:"varint,4,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty" yaml:"reward_distribution_window"`
-	Whitelist                DenomList                              `protobuf:"bytes,5,rep,name=whitelist,proto3,castrepeated=DenomList" json:"whitelist" yaml:"whitelist"`
-	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
-	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
-	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	VotePeriod                uint64                                 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	VoteThreshold             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold" yaml:"vote_threshold"`
+	RewardBand                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band" yaml:"reward_band"`
+	RewardDistributionWindow  uint64                                 `protobuf:"varint,4,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty" yaml:"reward_distribution_window"`
+	Whitelist                 DenomList                              `protobuf:"bytes,5,rep,name=whitelist,proto3,castrepeated=DenomList" json:"whitelist" yaml:"whitelist"`
+	SlashFraction             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	SlashWindow               uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
+	MinValidPerWindow         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	HistoricRateRetention     uint64                                 `protobuf:"varint,9,opt,name=historic_rate_retention,json=historicRateRetention,proto3" json:"historic_rate_retention,omitempty" yaml:"historic_rate_retention"`
+	PerformanceHistoryWindows uint64                                 `protobuf:"varint,10,opt,name=performance_history_windows,json=performanceHistoryWindows,proto3" json:"performance_history_windows,omitempty" yaml:"performance_history_windows"`
+	// warning_valid_per_window is the valid vote rate below which a warning is emitted for the validator
+	WarningValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=warning_valid_per_window,json=warningValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"warning_valid_per_window" yaml:"warning_valid_per_window"`
+	// jail_valid_per_window is the valid vote rate below which the validator is jailed without slashing
+	JailValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=jail_valid_per_window,json=jailValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jail_valid_per_window" yaml:"jail_valid_per_window"`
+	// slash_grace_period is the number of blocks a validator must have been in the active set
+	// during the slash window to be penalized
+	SlashGracePeriod uint64 `protobuf:"varint,13,opt,name=slash_grace_period,json=slashGracePeriod,proto3" json:"slash_grace_period,omitempty" yaml:"slash_grace_period"`
+	// combined_vote_enabled allows feeders to reveal the previous prevote and submit
+	// the next prevote in a single MsgAggregateExchangeRateCombinedVote
+	CombinedVoteEnabled bool `protobuf:"varint,14,opt,name=combined_vote_enabled,json=combinedVoteEnabled,proto3" json:"combined_vote_enabled,omitempty" yaml:"combined_vote_enabled"`
+	// circuit_breaker_recovery_periods is the number of consecutive vote periods the tallied rate
+	// of a halted denom must stay within its max_deviation for the denom to resume
+	CircuitBreakerRecoveryPeriods uint64 `protobuf:"varint,15,opt,name=circuit_breaker_recovery_periods,json=circuitBreakerRecoveryPeriods,proto3" json:"circuit_breaker_recovery_periods,omitempty" yaml:"circuit_breaker_recovery_periods"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -104,10 +124,55 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetHistoricRateRetention() uint64 {
+	if m != nil {
+		return m.HistoricRateRetention
+	}
+	return 0
+}
+
+func (m *Params) GetPerformanceHistoryWindows() uint64 {
+	if m != nil {
+		return m.PerformanceHistoryWindows
+	}
+	return 0
+}
+
+func (m *Params) GetSlashGracePeriod() uint64 {
+	if m != nil {
+		return m.SlashGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetCombinedVoteEnabled() bool {
+	if m != nil {
+		return m.CombinedVoteEnabled
+	}
+	return false
+}
+
+func (m *Params) GetCircuitBreakerRecoveryPeriods() uint64 {
+	if m != nil {
+		return m.CircuitBreakerRecoveryPeriods
+	}
+	return 0
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	Name     string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	TobinTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tobin_tax,json=tobinTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tobin_tax" yaml:"tobin_tax"`
+	// aggregation_strategy is the name of the strategy used to tally the ballots of the denom,
+	// defaults to the weighted median when empty
+	AggregationStrategy string `protobuf:"bytes,3,opt,name=aggregation_strategy,json=aggregationStrategy,proto3" json:"aggregation_strategy,omitempty" yaml:"aggregation_strategy,omitempty"`
+	// vote_threshold overrides the vote_threshold param for the denom when set
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// reward_band overrides the reward_band param for the denom when set
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// max_deviation is the ratio the tallied rate of the denom can move from the previous one
+	// in a vote period before the denom is halted, the circuit breaker is disabled when unset
+	MaxDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation,omitempty" yaml:"max_deviation,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -280,66 +345,477 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// HistoricExchangeRate - snapshot of a tallied exchange rate kept for
+// historical and time-weighted average queries
+type HistoricExchangeRate struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	BlockHeight  int64                                  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	BlockTime    time.Time                              `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
+}
+
+func (m *HistoricExchangeRate) Reset()      { *m = HistoricExchangeRate{} }
+func (*HistoricExchangeRate) ProtoMessage() {}
+func (*HistoricExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{5}
+}
+
+func (m *HistoricExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *HistoricExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *HistoricExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricExchangeRate.Merge(m, src)
+}
+
+func (m *HistoricExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *HistoricExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricExchangeRate proto.InternalMessageInfo
+
+// ValidatorPerformance - oracle performance of a validator during a slash window
+type ValidatorPerformance struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// window is the index of the slash window, block height / slash_window
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty" yaml:"window"`
+	// vote_periods is the number of vote periods tallied while the validator was in the active set
+	VotePeriods uint64 `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// votes is the number of vote periods the validator submitted an aggregate vote in
+	Votes uint64 `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	// abstains is the number of vote periods the validator abstained from a vote target in
+	Abstains uint64 `protobuf:"varint,5,opt,name=abstains,proto3" json:"abstains,omitempty" yaml:"abstains"`
+	// misses is the number of vote periods counted to the miss counter of the validator
+	Misses uint64 `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty" yaml:"misses"`
+	// win_count is the number of ballots the validator won
+	WinCount uint64                                   `protobuf:"varint,7,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty" yaml:"win_count"`
+	Rewards  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards" yaml:"rewards"`
+}
+
+func (m *ValidatorPerformance) Reset()      { *m = ValidatorPerformance{} }
+func (*ValidatorPerformance) ProtoMessage() {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{6}
+}
+
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+// ValidatorPerformanceSummary - oracle performance of a validator summed over the kept slash windows
+type ValidatorPerformanceSummary struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	WindowsServed    uint64                                   `protobuf:"varint,2,opt,name=windows_served,json=windowsServed,proto3" json:"windows_served,omitempty" yaml:"windows_served"`
+	VotePeriods      uint64                                   `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	Votes            uint64                                   `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	Abstains         uint64                                   `protobuf:"varint,5,opt,name=abstains,proto3" json:"abstains,omitempty" yaml:"abstains"`
+	Misses           uint64                                   `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty" yaml:"misses"`
+	WinCount         uint64                                   `protobuf:"varint,7,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty" yaml:"win_count"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards" yaml:"rewards"`
+}
+
+func (m *ValidatorPerformanceSummary) Reset()      { *m = ValidatorPerformanceSummary{} }
+func (*ValidatorPerformanceSummary) ProtoMessage() {}
+func (*ValidatorPerformanceSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{7}
+}
+
+func (m *ValidatorPerformanceSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ValidatorPerformanceSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ValidatorPerformanceSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceSummary.Merge(m, src)
+}
+
+func (m *ValidatorPerformanceSummary) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ValidatorPerformanceSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceSummary proto.InternalMessageInfo
+
+// PenaltyOutcome - penalty tier applied to a validator at the end of a slash window
+type PenaltyOutcome struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// window is the index of the slash window, block height / slash_window
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty" yaml:"window"`
+	// tier is one of warning, jail, slash and grace
+	Tier          string                                 `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty" yaml:"tier"`
+	ValidVoteRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate" yaml:"valid_vote_rate"`
+	// vote_periods is the number of vote periods the validator was in the active set during the window
+	VotePeriods uint64 `protobuf:"varint,5,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	Misses      uint64 `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty" yaml:"misses"`
+}
+
+func (m *PenaltyOutcome) Reset()      { *m = PenaltyOutcome{} }
+func (*PenaltyOutcome) ProtoMessage() {}
+func (*PenaltyOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{8}
+}
+
+func (m *PenaltyOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PenaltyOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *PenaltyOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyOutcome.Merge(m, src)
+}
+
+func (m *PenaltyOutcome) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PenaltyOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyOutcome proto.InternalMessageInfo
+
+// FeederRotation - scheduled rotation of the feeder delegation of a validator.
+// Both the current and the new feeder are allowed to feed until the activation height.
+type FeederRotation struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	FeederAddress    string `protobuf:"bytes,2,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty" yaml:"feeder_address"`
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
+}
+
+func (m *FeederRotation) Reset()      { *m = FeederRotation{} }
+func (*FeederRotation) ProtoMessage() {}
+func (*FeederRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{9}
+}
+
+func (m *FeederRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeederRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeederRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FeederRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeederRotation.Merge(m, src)
+}
+
+func (m *FeederRotation) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeederRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeederRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeederRotation proto.InternalMessageInfo
+
+// HaltedDenom - denom halted by the circuit breaker, whose tallied rates are not
+// applied until they stay within the max deviation from the reference rate
+type HaltedDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// reference_rate is the last rate applied before the denom was halted
+	ReferenceRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reference_rate,json=referenceRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_rate" yaml:"reference_rate"`
+	// last_rate is the last tallied rate of the denom
+	LastRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_rate,json=lastRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_rate" yaml:"last_rate"`
+	// in_band_tallies is the number of consecutive tallies within the max deviation
+	InBandTallies uint64 `protobuf:"varint,4,opt,name=in_band_tallies,json=inBandTallies,proto3" json:"in_band_tallies,omitempty" yaml:"in_band_tallies"`
+	HaltHeight    int64  `protobuf:"varint,5,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty" yaml:"halt_height"`
+}
+
+func (m *HaltedDenom) Reset()      { *m = HaltedDenom{} }
+func (*HaltedDenom) ProtoMessage() {}
+func (*HaltedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{10}
+}
+
+func (m *HaltedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *HaltedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HaltedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *HaltedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaltedDenom.Merge(m, src)
+}
+
+func (m *HaltedDenom) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *HaltedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaltedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaltedDenom proto.InternalMessageInfo
+
+// WhitelistUpdate - struct to store an update of the whitelist scheduled by governance,
+// applied at the end of the first vote period reaching the activation height
+type WhitelistUpdate struct {
+	// action is one of add, remove or update_tobin_tax
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty" yaml:"action"`
+	// denom is the whitelisted denom for add, and only holds the name (and tobin_tax) otherwise
+	Denom            Denom  `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom" yaml:"denom"`
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
+}
+
+func (m *WhitelistUpdate) Reset()      { *m = WhitelistUpdate{} }
+func (*WhitelistUpdate) ProtoMessage() {}
+func (*WhitelistUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{11}
+}
+
+func (m *WhitelistUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WhitelistUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhitelistUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WhitelistUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistUpdate.Merge(m, src)
+}
+
+func (m *WhitelistUpdate) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WhitelistUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhitelistUpdate proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.oracle.v1beta1.Params")
 	proto.RegisterType((*Denom)(nil), "terra.oracle.v1beta1.Denom")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "terra.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "terra.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "terra.oracle.v1beta1.ExchangeRateTuple")
+	proto.RegisterType((*HistoricExchangeRate)(nil), "terra.oracle.v1beta1.HistoricExchangeRate")
+	proto.RegisterType((*ValidatorPerformance)(nil), "terra.oracle.v1beta1.ValidatorPerformance")
+	proto.RegisterType((*ValidatorPerformanceSummary)(nil), "terra.oracle.v1beta1.ValidatorPerformanceSummary")
+	proto.RegisterType((*PenaltyOutcome)(nil), "terra.oracle.v1beta1.PenaltyOutcome")
+	proto.RegisterType((*FeederRotation)(nil), "terra.oracle.v1beta1.FeederRotation")
+	proto.RegisterType((*HaltedDenom)(nil), "terra.oracle.v1beta1.HaltedDenom")
+	proto.RegisterType((*WhitelistUpdate)(nil), "terra.oracle.v1beta1.WhitelistUpdate")
 }
 
 func init() { proto.RegisterFile("terra/oracle/v1beta1/oracle.proto", fileDescriptor_2a008582d55f197f) }
 
 var fileDescriptor_2a008582d55f197f = []byte{
-	// 753 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xd3, 0x48,
-	0x14, 0x8e, 0xb7, 0x6d, 0xb6, 0x99, 0xa4, 0xbb, 0xad, 0x37, 0xbb, 0xeb, 0x6d, 0x57, 0x71, 0x3b,
-	0xa8, 0xa5, 0x07, 0x9a, 0xa8, 0x70, 0x40, 0xe4, 0x86, 0x15, 0xca, 0x05, 0xa4, 0xc8, 0xaa, 0x8a,
-	0xc4, 0xc5, 0x8c, 0xed, 0x21, 0xb6, 0x6a, 0x7b, 0xa2, 0x99, 0x49, 0x93, 0x5e, 0x38, 0x73, 0xe4,
-	0x00, 0x12, 0x12, 0x97, 0x9e, 0xb9, 0xc3, 0x6f, 0xe8, 0xb1, 0x47, 0xc4, 0xc1, 0xa0, 0xf6, 0xd2,
-	0x73, 0x7e, 0x01, 0x9a, 0xf1, 0xa4, 0x75, 0x9a, 0x1c, 0x88, 0x38, 0xc5, 0xef, 0x7d, 0xcf, 0xdf,
-	0xf7, 0xcd, 0x7b, 0xf3, 0x62, 0xb0, 0xc1, 0x31, 0xa5, 0xa8, 0x41, 0x28, 0xf2, 0x22, 0xdc, 0x38,
-	0xda, 0x75, 0x31, 0x47, 0xbb, 0x2a, 0xac, 0x77, 0x29, 0xe1, 0x44, 0xaf, 0xca, 0x92, 0xba, 0xca,
-	0xa9, 0x92, 0xd5, 0x6a, 0x87, 0x74, 0x88, 0x2c, 0x68, 0x88, 0xa7, 0xac, 0x16, 0xbe, 0x2b, 0x82,
-	0x62, 0x1b, 0x51, 0x14, 0x33, 0xfd, 0x3e, 0x28, 0x1f, 0x11, 0x8e, 0x9d, 0x2e, 0xa6, 0x21, 0xf1,
-	0x0d, 0x6d, 0x5d, 0xdb, 0x9e, 0xb7, 0xfe, 0x19, 0xa6, 0xa6, 0x7e, 0x8c, 0xe2, 0xa8, 0x09, 0x73,
-	0x20, 0xb4, 0x81, 0x88, 0xda, 0x32, 0xd0, 0x13, 0xf0, 0x87, 0xc4, 0x78, 0x40, 0x31, 0x0b, 0x48,
-	0xe4, 0x1b, 0xbf, 0xad, 0x6b, 0xdb, 0x25, 0xeb, 0xf1, 0x69, 0x6a, 0x16, 0xbe, 0xa6, 0xe6, 0x56,
-	0x27, 0xe4, 0x41, 0xcf, 0xad, 0x7b, 0x24, 0x6e, 0x78, 0x84, 0xc5, 0x84, 0xa9, 0x9f, 0x1d, 0xe6,
-	0x1f, 0x36, 0xf8, 0x71, 0x17, 0xb3, 0x7a, 0x0b, 0x7b, 0xc3, 0xd4, 0xfc, 0x3b, 0xa7, 0x74, 0xc5,
-	0x06, 0xed, 0x25, 0x91, 0xd8, 0x1f, 0xc5, 0x3a, 0x06, 0x65, 0x8a, 0xfb, 0x88, 0xfa, 0x8e, 0x8b,
-	0x12, 0xdf, 0x98, 0x93, 0x62, 0xad, 0x99, 0xc5, 0xd4, 0xb1, 0x72, 0x54, 0xd0, 0x06, 0x59, 0x64,
-	0xa1, 0xc4, 0xd7, 0x3d, 0xb0, 0xaa, 0x30, 0x3f, 0x64, 0x9c, 0x86, 0x6e, 0x8f, 0x87, 0x24, 0x71,
-	0xfa, 0x61, 0xe2, 0x93, 0xbe, 0x31, 0x2f, 0xdb, 0xb3, 0x39, 0x4c, 0xcd, 0x8d, 0x31, 0x9e, 0x29,
-	0xb5, 0xd0, 0x36, 0x32, 0xb0, 0x95, 0xc3, 0x9e, 0x49, 0x48, 0x7f, 0x01, 0x4a, 0xfd, 0x20, 0xe4,
-	0x38, 0x0a, 0x19, 0x37, 0x16, 0xd6, 0xe7, 0xb6, 0xcb, 0x77, 0xd7, 0xea, 0xd3, 0xe6, 0x57, 0x6f,
-	0xe1, 0x84, 0xc4, 0xd6, 0xa6, 0x38, 0xe6, 0x30, 0x35, 0x97, 0x33, 0xd1, 0xab, 0x77, 0xe1, 0xc7,
-	0x6f, 0x66, 0x49, 0x96, 0x3c, 0x09, 0x19, 0xb7, 0xaf, 0x49, 0xc5, 0x74, 0x58, 0x84, 0x58, 0xe0,
-	0xbc, 0xa4, 0xc8, 0x13, 0xca, 0x46, 0xf1, 0xd7, 0xa6, 0x33, 0xce, 0x06, 0xed, 0x25, 0x99, 0xd8,
-	0x53, 0xb1, 0xde, 0x04, 0x95, 0xac, 0x42, 0x35, 0xea, 0x77, 0xd9, 0xa8, 0x7f, 0x87, 0xa9, 0xf9,
-	0x57, 0xfe, 0xfd, 0x51, 0x6b, 0xca, 0x32, 0x54, 0xdd, 0x78, 0x05, 0xaa, 0x71, 0x98, 0x38, 0x47,
-	0x28, 0x0a, 0x7d, 0x71, 0xd5, 0x46, 0x1c, 0x8b, 0xd2, 0xf1, 0xd3, 0x99, 0x1d, 0xaf, 0x65, 0x8a,
-	0xd3, 0x38, 0xa1, 0xbd, 0x12, 0x87, 0xc9, 0x81, 0xc8, 0xb6, 0x31, 0xcd, 0xf4, 0x9b, 0x8b, 0xef,
-	0x4f, 0xcc, 0xc2, 0xe5, 0x89, 0xa9, 0xc1, 0x0f, 0x1a, 0x58, 0x90, 0xed, 0xd4, 0x6f, 0x81, 0xf9,
-	0x04, 0xc5, 0x58, 0xee, 0x43, 0xc9, 0xfa, 0x73, 0x98, 0x9a, 0xe5, 0x8c, 0x55, 0x64, 0xa1, 0x2d,
-	0x41, 0xdd, 0x01, 0x25, 0x4e, 0xdc, 0x30, 0x71, 0x38, 0x1a, 0xa8, 0xdb, 0x6f, 0xcd, 0xec, 0x56,
-	0xcd, 0xf4, 0x8a, 0x08, 0xda, 0x8b, 0xf2, 0x79, 0x1f, 0x0d, 0x9a, 0x95, 0xd7, 0x27, 0x66, 0x41,
-	0xb9, 0x2b, 0xc0, 0x4f, 0x1a, 0xf8, 0xff, 0x61, 0xa7, 0x43, 0x71, 0x07, 0x71, 0xfc, 0x68, 0xe0,
-	0x05, 0x28, 0xe9, 0x60, 0x1b, 0x71, 0xdc, 0xa6, 0x58, 0xec, 0x8a, 0x30, 0x1d, 0x20, 0x16, 0x4c,
-	0x9a, 0x16, 0x59, 0x68, 0x4b, 0x50, 0xdf, 0x02, 0x0b, 0xa2, 0x98, 0x2a, 0xc3, 0xcb, 0xc3, 0xd4,
-	0xac, 0x5c, 0x2f, 0x20, 0x85, 0x76, 0x06, 0xcb, 0x89, 0xf6, 0xdc, 0x38, 0xe4, 0x8e, 0x1b, 0x11,
-	0xef, 0x50, 0x2e, 0xdc, 0xf8, 0x44, 0x73, 0xa8, 0x98, 0xa8, 0x0c, 0x2d, 0x11, 0xdd, 0xf0, 0x7d,
-	0xa9, 0x81, 0xff, 0xa6, 0xfa, 0x3e, 0x10, 0xa6, 0xdf, 0x6a, 0xa0, 0x8a, 0x55, 0xd2, 0xa1, 0x48,
-	0xfc, 0x07, 0xf4, 0xba, 0x11, 0x66, 0x86, 0x26, 0xf7, 0xe2, 0xf6, 0xf4, 0xbd, 0xc8, 0xd3, 0xec,
-	0x8b, 0x7a, 0xeb, 0x81, 0xda, 0x11, 0x35, 0xfd, 0x69, 0x94, 0x62, 0x5d, 0xf4, 0x89, 0x37, 0x99,
-	0xad, 0xe3, 0x89, 0xdc, 0xcf, 0xb6, 0xe9, 0xc6, 0x51, 0x3f, 0x6b, 0x60, 0x65, 0x42, 0x40, 0x70,
-	0xf9, 0xe2, 0x56, 0xa9, 0xc1, 0xe4, 0xb8, 0x64, 0x1a, 0xda, 0x19, 0xac, 0x1f, 0x82, 0xa5, 0x31,
-	0xdb, 0x4a, 0x7b, 0x6f, 0xe6, 0x3b, 0x55, 0x9d, 0xd2, 0x03, 0x68, 0x57, 0xf2, 0xc7, 0x1c, 0x37,
-	0x6e, 0xed, 0x9d, 0x9e, 0xd7, 0xb4, 0xb3, 0xf3, 0x9a, 0xf6, 0xfd, 0xbc, 0xa6, 0xbd, 0xb9, 0xa8,
-	0x15, 0xce, 0x2e, 0x6a, 0x85, 0x2f, 0x17, 0xb5, 0xc2, 0xf3, 0x3b, 0x79, 0xd5, 0x08, 0x31, 0x16,
-	0x7a, 0x3b, 0xd9, 0xd7, 0xc8, 0x23, 0x14, 0x37, 0x06, 0xa3, 0x8f, 0x92, 0xd4, 0x77, 0x8b, 0xf2,
-	0x03, 0x73, 0xef, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x16, 0xce, 0x38, 0xec, 0xb1, 0x06, 0x00,
-	0x00,
+	// 1758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x73, 0x23, 0x47,
+	0x15, 0xf7, 0xac, 0x65, 0xc7, 0x6a, 0x59, 0xfe, 0x98, 0x95, 0x93, 0xb1, 0x77, 0xd7, 0xe3, 0x74,
+	0x6a, 0x37, 0xde, 0x22, 0x91, 0x6a, 0xc3, 0x81, 0x62, 0x4f, 0x44, 0x71, 0x76, 0x1d, 0x08, 0x60,
+	0x7a, 0x4d, 0x42, 0xa5, 0xa8, 0x1a, 0x5a, 0x33, 0x6d, 0xa9, 0xf1, 0x7c, 0x88, 0xee, 0x96, 0x6d,
+	0x5d, 0xa8, 0xa2, 0xa8, 0xa2, 0x28, 0x4e, 0x81, 0xe2, 0xc0, 0x71, 0x0f, 0x9c, 0xb8, 0xc3, 0xdf,
+	0x90, 0x5b, 0x72, 0xa4, 0x38, 0x4c, 0xa8, 0xdd, 0x4b, 0xce, 0x3a, 0x70, 0xa6, 0xfa, 0x63, 0xa4,
+	0xd6, 0x48, 0x09, 0xab, 0x6c, 0xa8, 0x70, 0xe0, 0x24, 0xbd, 0x8f, 0x79, 0xef, 0xf5, 0xeb, 0x5f,
+	0xbf, 0xf7, 0xba, 0xc1, 0xcb, 0x82, 0x30, 0x86, 0x5b, 0x19, 0xc3, 0x61, 0x4c, 0x5a, 0x17, 0xf7,
+	0x3a, 0x44, 0xe0, 0x7b, 0x86, 0x6c, 0xf6, 0x59, 0x26, 0x32, 0xb7, 0xa1, 0x54, 0x9a, 0x86, 0x67,
+	0x54, 0xf6, 0x1a, 0xdd, 0xac, 0x9b, 0x29, 0x85, 0x96, 0xfc, 0xa7, 0x75, 0xf7, 0xfc, 0x6e, 0x96,
+	0x75, 0x63, 0xd2, 0x52, 0x54, 0x67, 0x70, 0xd6, 0x12, 0x34, 0x21, 0x5c, 0xe0, 0xa4, 0x6f, 0x14,
+	0xf6, 0xc3, 0x8c, 0x27, 0x19, 0x6f, 0x75, 0x30, 0x9f, 0xb8, 0x0b, 0x33, 0x9a, 0x6a, 0x39, 0xfc,
+	0xf5, 0x3a, 0x58, 0x3d, 0xc1, 0x0c, 0x27, 0xdc, 0xfd, 0x16, 0xa8, 0x5d, 0x64, 0x82, 0x04, 0x7d,
+	0xc2, 0x68, 0x16, 0x79, 0xce, 0x81, 0x73, 0x58, 0x69, 0xbf, 0x38, 0xca, 0x7d, 0x77, 0x88, 0x93,
+	0xf8, 0x3e, 0xb4, 0x84, 0x10, 0x01, 0x49, 0x9d, 0x28, 0xc2, 0x4d, 0xc1, 0x86, 0x92, 0x89, 0x1e,
+	0x23, 0xbc, 0x97, 0xc5, 0x91, 0x77, 0xed, 0xc0, 0x39, 0xac, 0xb6, 0x1f, 0x7e, 0x94, 0xfb, 0x4b,
+	0xff, 0xc8, 0xfd, 0x3b, 0x5d, 0x2a, 0x7a, 0x83, 0x4e, 0x33, 0xcc, 0x92, 0x96, 0x09, 0x47, 0xff,
+	0xbc, 0xce, 0xa3, 0xf3, 0x96, 0x18, 0xf6, 0x09, 0x6f, 0x1e, 0x91, 0x70, 0x94, 0xfb, 0x3b, 0x96,
+	0xa7, 0xb1, 0x35, 0x88, 0xea, 0x92, 0x71, 0x5a, 0xd0, 0x2e, 0x01, 0x35, 0x46, 0x2e, 0x31, 0x8b,
+	0x82, 0x0e, 0x4e, 0x23, 0x6f, 0x59, 0x39, 0x3b, 0x5a, 0xd8, 0x99, 0x59, 0x96, 0x65, 0x0a, 0x22,
+	0xa0, 0xa9, 0x36, 0x4e, 0x23, 0x37, 0x04, 0x7b, 0x46, 0x16, 0x51, 0x2e, 0x18, 0xed, 0x0c, 0x04,
+	0xcd, 0xd2, 0xe0, 0x92, 0xa6, 0x51, 0x76, 0xe9, 0x55, 0x54, 0x7a, 0x6e, 0x8f, 0x72, 0xff, 0xe5,
+	0x29, 0x3b, 0x73, 0x74, 0x21, 0xf2, 0xb4, 0xf0, 0xc8, 0x92, 0xbd, 0xaf, 0x44, 0xee, 0xcf, 0x40,
+	0xf5, 0xb2, 0x47, 0x05, 0x89, 0x29, 0x17, 0xde, 0xca, 0xc1, 0xf2, 0x61, 0xed, 0x8d, 0x1b, 0xcd,
+	0x79, 0x00, 0x68, 0x1e, 0x91, 0x34, 0x4b, 0xda, 0xb7, 0xe5, 0x32, 0x47, 0xb9, 0xbf, 0xa5, 0x9d,
+	0x8e, 0xbf, 0x85, 0x7f, 0xf9, 0xd4, 0xaf, 0x2a, 0x95, 0x77, 0x29, 0x17, 0x68, 0x62, 0x54, 0xee,
+	0x0e, 0x8f, 0x31, 0xef, 0x05, 0x67, 0x0c, 0x87, 0xd2, 0xb3, 0xb7, 0xfa, 0x7c, 0xbb, 0x33, 0x6d,
+	0x0d, 0xa2, 0xba, 0x62, 0x3c, 0x30, 0xb4, 0x7b, 0x1f, 0xac, 0x6b, 0x0d, 0x93, 0xa8, 0x17, 0x54,
+	0xa2, 0x5e, 0x1a, 0xe5, 0xfe, 0x75, 0xfb, 0xfb, 0x22, 0x35, 0x35, 0x45, 0x9a, 0x6c, 0xfc, 0x12,
+	0x34, 0x12, 0x9a, 0x06, 0x17, 0x38, 0xa6, 0x91, 0x84, 0x5a, 0x61, 0x63, 0x4d, 0x45, 0xfc, 0xfd,
+	0x85, 0x23, 0xbe, 0xa1, 0x3d, 0xce, 0xb3, 0x09, 0xd1, 0x76, 0x42, 0xd3, 0xf7, 0x24, 0xf7, 0x84,
+	0x30, 0xe3, 0xff, 0x03, 0xf0, 0x52, 0x8f, 0x72, 0x91, 0x31, 0x1a, 0x06, 0x0c, 0x0b, 0x12, 0x30,
+	0x22, 0x48, 0xaa, 0x92, 0x56, 0x55, 0xcb, 0x80, 0xa3, 0xdc, 0xdf, 0xd7, 0x46, 0x3f, 0x47, 0x11,
+	0xa2, 0x9d, 0x42, 0x82, 0xb0, 0x20, 0xa8, 0xe0, 0xbb, 0x67, 0xe0, 0x46, 0x9f, 0xb0, 0xb3, 0x8c,
+	0x25, 0x38, 0x0d, 0x49, 0xa0, 0x95, 0x86, 0x26, 0x1a, 0xee, 0x01, 0x65, 0xff, 0xce, 0x28, 0xf7,
+	0xa1, 0xb6, 0xff, 0x05, 0xca, 0x10, 0xed, 0x5a, 0xd2, 0x63, 0x2d, 0xd4, 0x4b, 0xe0, 0xee, 0xef,
+	0x1c, 0xe0, 0x5d, 0x62, 0x96, 0xd2, 0xb4, 0x3b, 0x9b, 0xc8, 0x9a, 0x4a, 0xe4, 0x8f, 0x16, 0x4e,
+	0xa4, 0x6f, 0xe0, 0xf6, 0x39, 0x76, 0x21, 0xda, 0x31, 0xa2, 0x52, 0x42, 0x7f, 0xe5, 0x80, 0x9d,
+	0x9f, 0x63, 0x1a, 0xcf, 0x46, 0xb2, 0xae, 0x22, 0xf9, 0xc1, 0xc2, 0x91, 0xdc, 0xd4, 0x91, 0xcc,
+	0x35, 0x0a, 0x91, 0x2b, 0xf9, 0xa5, 0x18, 0xbe, 0x07, 0x5c, 0x0d, 0xb9, 0x2e, 0xc3, 0xe1, 0xb8,
+	0xbc, 0xd5, 0x55, 0xbe, 0x6f, 0x8d, 0x72, 0x7f, 0xd7, 0x86, 0xa5, 0xad, 0x03, 0xd1, 0x96, 0x62,
+	0x3e, 0x94, 0x3c, 0x53, 0xeb, 0x4e, 0xc1, 0x4e, 0x98, 0x25, 0x1d, 0x9a, 0x92, 0x28, 0x50, 0x65,
+	0x8a, 0xa4, 0xb8, 0x13, 0x93, 0xc8, 0xdb, 0x38, 0x70, 0x0e, 0xd7, 0xda, 0x07, 0x93, 0x08, 0xe7,
+	0xaa, 0x41, 0x74, 0xbd, 0xe0, 0xbf, 0x97, 0x09, 0xf2, 0xb6, 0xe6, 0xba, 0x02, 0x1c, 0x84, 0x94,
+	0x85, 0x03, 0x2a, 0x82, 0x0e, 0x23, 0xf8, 0x9c, 0xb0, 0x80, 0x91, 0x30, 0xbb, 0x20, 0x6c, 0x68,
+	0x62, 0xe1, 0xde, 0xa6, 0x0a, 0xf8, 0x1b, 0xa3, 0xdc, 0x7f, 0xd5, 0x38, 0xf8, 0x0f, 0x5f, 0x40,
+	0x74, 0xcb, 0xa8, 0xb4, 0xb5, 0x06, 0x32, 0x0a, 0x7a, 0x29, 0xfc, 0xfe, 0xda, 0x9f, 0x1e, 0xfb,
+	0x4b, 0x9f, 0x3d, 0xf6, 0x1d, 0xf8, 0x71, 0x05, 0xac, 0xa8, 0xe2, 0xe1, 0xbe, 0x02, 0x2a, 0x29,
+	0x4e, 0x88, 0xaa, 0xfe, 0xd5, 0xf6, 0xe6, 0x28, 0xf7, 0x6b, 0xda, 0x9b, 0xe4, 0x42, 0xa4, 0x84,
+	0x6e, 0x00, 0xaa, 0x22, 0xeb, 0xd0, 0x34, 0x10, 0xf8, 0xca, 0xd4, 0xfa, 0xf6, 0xc2, 0x1b, 0x69,
+	0x2a, 0xd8, 0xd8, 0x10, 0x44, 0x6b, 0xea, 0xff, 0x29, 0xbe, 0x72, 0x7f, 0x0a, 0x1a, 0xb8, 0xdb,
+	0x65, 0xa4, 0x8b, 0x55, 0x19, 0xe5, 0x42, 0x9e, 0xb1, 0xee, 0xd0, 0x94, 0xfa, 0xbb, 0xa3, 0xdc,
+	0xbf, 0xad, 0xbf, 0x9e, 0xa7, 0xf5, 0x5a, 0x96, 0x50, 0x41, 0x92, 0xbe, 0x18, 0x42, 0x74, 0xdd,
+	0x52, 0x78, 0x64, 0xe4, 0xae, 0x98, 0xe9, 0x57, 0x15, 0x5d, 0x5f, 0xbe, 0xcc, 0x91, 0x98, 0xb6,
+	0x64, 0xfb, 0x2e, 0x75, 0xad, 0xf3, 0xe9, 0xae, 0xb5, 0xa2, 0x5c, 0x7e, 0xf7, 0xcb, 0x60, 0xdf,
+	0x32, 0x63, 0xfb, 0xb3, 0x7b, 0xd7, 0x2f, 0x40, 0x3d, 0xc1, 0x57, 0x41, 0x44, 0x2e, 0x28, 0xb6,
+	0x6a, 0xfe, 0xbb, 0x0b, 0xb9, 0x33, 0x85, 0x6e, 0xca, 0x90, 0xed, 0x70, 0x3d, 0xc1, 0x57, 0x47,
+	0x85, 0xe0, 0xfe, 0xfa, 0x6f, 0x1f, 0xfb, 0x4b, 0x06, 0x51, 0x4b, 0xf0, 0xaf, 0x0e, 0xb8, 0xf9,
+	0xa6, 0xc9, 0x3d, 0x79, 0xfb, 0x2a, 0xec, 0xe1, 0xb4, 0x4b, 0x64, 0x41, 0x3c, 0x61, 0x44, 0xe6,
+	0x45, 0x02, 0xad, 0x87, 0x79, 0x6f, 0x16, 0x68, 0x92, 0x0b, 0x91, 0x12, 0xba, 0x77, 0xc0, 0x8a,
+	0x54, 0x66, 0x06, 0x64, 0x5b, 0xa3, 0xdc, 0x5f, 0x9f, 0xa4, 0x9d, 0x41, 0xa4, 0xc5, 0xaa, 0xe7,
+	0x0c, 0x3a, 0x89, 0x3c, 0x0c, 0x71, 0x16, 0x9e, 0x7b, 0xcb, 0x33, 0x3d, 0xc7, 0x92, 0xca, 0x9e,
+	0xa3, 0xc8, 0xb6, 0xa4, 0x4a, 0x71, 0x7f, 0xe6, 0x80, 0xdd, 0xb9, 0x71, 0xcb, 0xe3, 0xea, 0xfe,
+	0xd1, 0x01, 0x0d, 0x62, 0x98, 0xba, 0xee, 0x8b, 0x41, 0x3f, 0x26, 0xdc, 0x73, 0x54, 0xe7, 0x7e,
+	0x75, 0x7e, 0xe7, 0xb6, 0xcd, 0x9c, 0x4a, 0xfd, 0xf6, 0xb7, 0x4d, 0x17, 0x37, 0xfd, 0x69, 0x9e,
+	0x49, 0xd9, 0xd0, 0xdd, 0x99, 0x2f, 0x39, 0x72, 0xc9, 0x0c, 0xef, 0x59, 0xd3, 0x54, 0x5a, 0xea,
+	0xdf, 0x1c, 0xb0, 0x3d, 0xe3, 0x40, 0xda, 0x8a, 0x64, 0x25, 0xf0, 0x9c, 0xb2, 0x2d, 0xc5, 0x86,
+	0x48, 0x8b, 0xdd, 0x73, 0x50, 0x9f, 0x0a, 0xdb, 0xf8, 0x7e, 0xb0, 0x70, 0x1d, 0x68, 0xcc, 0xc9,
+	0x01, 0x44, 0xeb, 0xf6, 0x32, 0x4b, 0x81, 0x7f, 0x7c, 0x0d, 0x34, 0x8e, 0x4d, 0x8f, 0xb5, 0x17,
+	0xf0, 0x3f, 0x19, 0xbb, 0xc4, 0xa6, 0x82, 0x5d, 0xd0, 0x23, 0xb4, 0xdb, 0x13, 0x0a, 0x9b, 0xcb,
+	0x36, 0x36, 0x6d, 0x29, 0x44, 0x35, 0x45, 0x1e, 0x2b, 0xca, 0xfd, 0x09, 0x00, 0x5a, 0x2a, 0xc7,
+	0x7a, 0x55, 0xa5, 0x6a, 0x6f, 0xec, 0x35, 0xf5, 0xcc, 0xdf, 0x2c, 0x66, 0xfe, 0xe6, 0x69, 0x31,
+	0xf3, 0xb7, 0x6f, 0x19, 0x5c, 0x6d, 0xdb, 0x96, 0xe5, 0xb7, 0xf0, 0xc3, 0x4f, 0x7d, 0x07, 0x55,
+	0x15, 0x43, 0xaa, 0x97, 0x32, 0xfa, 0x9b, 0x0a, 0x68, 0xa8, 0xae, 0x89, 0x45, 0xc6, 0x4e, 0x26,
+	0xa3, 0x85, 0xfb, 0x0e, 0xd8, 0xbe, 0x28, 0xf8, 0x01, 0x8e, 0x22, 0x46, 0x38, 0x37, 0xd9, 0xbd,
+	0x39, 0xca, 0x7d, 0xcf, 0xa0, 0xac, 0xac, 0x02, 0xd1, 0xd6, 0x98, 0xf7, 0xa6, 0x66, 0xb9, 0x77,
+	0xc1, 0xaa, 0x69, 0xfd, 0xd7, 0xd4, 0xe9, 0xdc, 0x1e, 0xe5, 0x7e, 0xdd, 0x8c, 0x15, 0xa6, 0x7b,
+	0x1b, 0x05, 0x99, 0x32, 0xeb, 0xb2, 0xc1, 0x67, 0x8f, 0xb3, 0x2d, 0x85, 0xa8, 0x36, 0xb9, 0x8b,
+	0x8c, 0xcf, 0x02, 0x37, 0x03, 0x7a, 0xe9, 0x2c, 0x70, 0x73, 0x16, 0xb8, 0xdb, 0x02, 0x6b, 0xb8,
+	0xc3, 0x05, 0xa6, 0x29, 0x57, 0xb5, 0xb8, 0xd2, 0xbe, 0x3e, 0xca, 0xfd, 0x4d, 0xad, 0x5a, 0x48,
+	0x20, 0x1a, 0x2b, 0xc9, 0xf8, 0x13, 0xca, 0x39, 0xe1, 0xde, 0x6a, 0x39, 0x7e, 0xcd, 0x87, 0xc8,
+	0x28, 0xb8, 0xf7, 0x40, 0xf5, 0x92, 0xa6, 0x41, 0x98, 0x0d, 0x52, 0x61, 0xe6, 0xdf, 0x86, 0x35,
+	0xb3, 0x17, 0x22, 0x88, 0xd6, 0x2e, 0x69, 0xfa, 0x96, 0xfc, 0xeb, 0x5e, 0x82, 0x17, 0x74, 0xf9,
+	0xe6, 0xde, 0x9a, 0xaa, 0x25, 0xbb, 0x4d, 0x8d, 0xb9, 0xa6, 0xbc, 0xb9, 0x8d, 0x4b, 0xc9, 0x5b,
+	0x19, 0x4d, 0x75, 0xaf, 0x1d, 0xe5, 0xfe, 0x86, 0xdd, 0x0e, 0x54, 0xc1, 0x38, 0x7c, 0x06, 0xe4,
+	0x4a, 0x13, 0x1c, 0x15, 0xde, 0x4a, 0x40, 0xf8, 0x73, 0x05, 0xdc, 0x98, 0x07, 0x84, 0x47, 0x83,
+	0x24, 0xc1, 0x6c, 0xf8, 0x55, 0xe2, 0xe1, 0x3b, 0x60, 0xc3, 0x8c, 0xb3, 0x01, 0x27, 0xec, 0x82,
+	0x44, 0x06, 0x17, 0xbb, 0x93, 0x9b, 0xc6, 0xb4, 0x1c, 0xa2, 0xba, 0x61, 0x3c, 0x52, 0xf4, 0xff,
+	0x61, 0xf2, 0xb5, 0xc1, 0xe4, 0x0f, 0xcb, 0x60, 0xe3, 0x84, 0xa4, 0x38, 0x16, 0xc3, 0x1f, 0x0e,
+	0x44, 0x98, 0x25, 0x5f, 0x57, 0xa5, 0x78, 0x05, 0x54, 0x04, 0x25, 0xcc, 0x5b, 0x2e, 0x4f, 0x11,
+	0x92, 0x0b, 0x91, 0x12, 0xba, 0x7d, 0xb0, 0xa9, 0x6f, 0x0a, 0x0a, 0x0f, 0xaa, 0xe0, 0xeb, 0x81,
+	0xef, 0x78, 0xe1, 0x82, 0xff, 0xa2, 0xb5, 0x8c, 0x89, 0x39, 0x39, 0xeb, 0x49, 0x8e, 0x9c, 0x11,
+	0x8a, 0x9a, 0x3f, 0x85, 0xcc, 0x95, 0x05, 0x90, 0xf9, 0xec, 0x00, 0x2a, 0x6d, 0xca, 0xbf, 0x1c,
+	0xb0, 0xf1, 0x80, 0x90, 0x88, 0x30, 0x94, 0x09, 0x35, 0x93, 0x7d, 0xc5, 0xc7, 0xf5, 0x4c, 0x19,
+	0x1f, 0xdb, 0xd1, 0x4d, 0xd3, 0x3a, 0xae, 0xd3, 0x72, 0x88, 0xea, 0x9a, 0x51, 0x58, 0x78, 0x07,
+	0x6c, 0xcb, 0x27, 0x82, 0x0b, 0x3d, 0xad, 0x5b, 0xdd, 0xb0, 0x62, 0x07, 0x33, 0xa3, 0x02, 0xd1,
+	0xd6, 0x84, 0xa7, 0xfb, 0x62, 0x69, 0xe1, 0xbf, 0x5f, 0x06, 0xb5, 0x63, 0x1c, 0x0b, 0x12, 0xe9,
+	0x3b, 0xcc, 0xb3, 0x8e, 0x01, 0x29, 0xd8, 0x60, 0xe4, 0x8c, 0x30, 0x92, 0x86, 0x7a, 0x1f, 0x9f,
+	0xf7, 0xdd, 0x6a, 0xda, 0x1a, 0x44, 0xf5, 0x31, 0x43, 0xa1, 0x22, 0x00, 0xd5, 0x18, 0x73, 0xa1,
+	0x5d, 0x2d, 0x3f, 0xdf, 0xb5, 0x69, 0x6c, 0x08, 0xa2, 0x35, 0xf9, 0x5f, 0x39, 0x68, 0x83, 0x4d,
+	0x9a, 0xaa, 0x7b, 0x41, 0x20, 0x70, 0x1c, 0xd3, 0x71, 0x79, 0xdb, 0x9b, 0x40, 0xb7, 0xa4, 0x00,
+	0x51, 0x9d, 0xa6, 0xf2, 0xca, 0x70, 0xaa, 0x69, 0xf9, 0x0a, 0xd8, 0xc3, 0xb1, 0x28, 0xf6, 0x67,
+	0x45, 0x4d, 0x2b, 0xd6, 0x2b, 0xa0, 0x25, 0x84, 0x08, 0x48, 0x6a, 0xee, 0x9e, 0xe4, 0x0e, 0xd8,
+	0x7c, 0xbf, 0x78, 0x83, 0xfa, 0x71, 0x3f, 0x92, 0xe1, 0xdd, 0x05, 0xab, 0xe6, 0x05, 0x4a, 0x6f,
+	0x8c, 0x85, 0xec, 0xe2, 0x2d, 0xc9, 0x28, 0xb8, 0x0f, 0x8b, 0x2d, 0xbc, 0xa6, 0x66, 0x9e, 0x2f,
+	0x7c, 0x12, 0x6b, 0x98, 0x3a, 0x37, 0x77, 0x8f, 0xff, 0x5b, 0xa0, 0x6b, 0x3f, 0xf8, 0xe8, 0xc9,
+	0xbe, 0xf3, 0xc9, 0x93, 0x7d, 0xe7, 0x9f, 0x4f, 0xf6, 0x9d, 0x0f, 0x9f, 0xee, 0x2f, 0x7d, 0xf2,
+	0x74, 0x7f, 0xe9, 0xef, 0x4f, 0xf7, 0x97, 0x3e, 0x78, 0xcd, 0xde, 0xcb, 0x18, 0x73, 0x4e, 0xc3,
+	0xd7, 0xf5, 0xab, 0x6f, 0x98, 0x31, 0xd2, 0xba, 0x2a, 0x1e, 0x7f, 0xd5, 0xae, 0x76, 0x56, 0xd5,
+	0x18, 0xf7, 0xcd, 0x7f, 0x0f, 0x00, 0xf6, 0x87, 0x6a, 0xc0, 0x19, 0x16, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -390,6 +866,27 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if this.HistoricRateRetention != that1.HistoricRateRetention {
+		return false
+	}
+	if this.PerformanceHistoryWindows != that1.PerformanceHistoryWindows {
+		return false
+	}
+	if !this.WarningValidPerWindow.Equal(that1.WarningValidPerWindow) {
+		return false
+	}
+	if !this.JailValidPerWindow.Equal(that1.JailValidPerWindow) {
+		return false
+	}
+	if this.SlashGracePeriod != that1.SlashGracePeriod {
+		return false
+	}
+	if this.CombinedVoteEnabled != that1.CombinedVoteEnabled {
+		return false
+	}
+	if this.CircuitBreakerRecoveryPeriods != that1.CircuitBreakerRecoveryPeriods {
+		return false
+	}
 	return true
 }
 
@@ -413,6 +910,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerRecoveryPeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.CircuitBreakerRecoveryPeriods))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.CombinedVoteEnabled {
+		i--
+		if m.CombinedVoteEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SlashGracePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashGracePeriod))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.JailValidPerWindow.Size()
+		i -= size
+		if _, err := m.JailValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.WarningValidPerWindow.Size()
+		i -= size
+		if _, err := m.WarningValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.PerformanceHistoryWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerformanceHistoryWindows))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HistoricRateRetention != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HistoricRateRetention))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -505,13 +1052,56 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TobinTax.Size()
-		i -= size
-		if _, err := m.TobinTax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.MaxDeviation != nil {
+		{
+			size := m.MaxDeviation.Size()
+			i -= size
+			if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AggregationStrategy) > 0 {
+		i -= len(m.AggregationStrategy)
+		copy(dAtA[i:], m.AggregationStrategy)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregationStrategy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TobinTax.Size()
+		i -= size
+		if _, err := m.TobinTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
@@ -651,134 +1241,1998 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HistoricExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HistoricExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Whitelist) > 0 {
-		for _, e := range m.Whitelist {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TobinTax.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.WinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	if m.Misses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if m.Abstains != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Abstains))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Votes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPerformanceSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.WinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
+	if m.Misses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Abstains != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Abstains))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Votes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowsServed != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowsServed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PenaltyOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Misses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Window != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *FeederRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *FeederRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+func (m *FeederRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeederAddress) > 0 {
+		i -= len(m.FeederAddress)
+		copy(dAtA[i:], m.FeederAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeederAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HaltedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HaltedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HaltedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InBandTallies != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.InBandTallies))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LastRate.Size()
+		i -= size
+		if _, err := m.LastRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReferenceRate.Size()
+		i -= size
+		if _, err := m.ReferenceRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhitelistUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.Whitelist) > 0 {
+		for _, e := range m.Whitelist {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.HistoricRateRetention != 0 {
+		n += 1 + sovOracle(uint64(m.HistoricRateRetention))
+	}
+	if m.PerformanceHistoryWindows != 0 {
+		n += 1 + sovOracle(uint64(m.PerformanceHistoryWindows))
+	}
+	l = m.WarningValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.JailValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashGracePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.SlashGracePeriod))
+	}
+	if m.CombinedVoteEnabled {
+		n += 2
+	}
+	if m.CircuitBreakerRecoveryPeriods != 0 {
+		n += 1 + sovOracle(uint64(m.CircuitBreakerRecoveryPeriods))
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.TobinTax.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.AggregationStrategy)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxDeviation != nil {
+		l = m.MaxDeviation.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *HistoricExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovOracle(uint64(m.Window))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovOracle(uint64(m.Votes))
+	}
+	if m.Abstains != 0 {
+		n += 1 + sovOracle(uint64(m.Abstains))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovOracle(uint64(m.Misses))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovOracle(uint64(m.WinCount))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.WindowsServed != 0 {
+		n += 1 + sovOracle(uint64(m.WindowsServed))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovOracle(uint64(m.Votes))
+	}
+	if m.Abstains != 0 {
+		n += 1 + sovOracle(uint64(m.Abstains))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovOracle(uint64(m.Misses))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovOracle(uint64(m.WinCount))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PenaltyOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovOracle(uint64(m.Window))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovOracle(uint64(m.Misses))
+	}
+	return n
+}
+
+func (m *FeederRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.FeederAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovOracle(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *HaltedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ReferenceRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.LastRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.InBandTallies != 0 {
+		n += 1 + sovOracle(uint64(m.InBandTallies))
+	}
+	if m.HaltHeight != 0 {
+		n += 1 + sovOracle(uint64(m.HaltHeight))
+	}
+	return n
+}
+
+func (m *WhitelistUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Denom.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovOracle(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Denom{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricRateRetention", wireType)
+			}
+			m.HistoricRateRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricRateRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceHistoryWindows", wireType)
+			}
+			m.PerformanceHistoryWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceHistoryWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WarningValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashGracePeriod", wireType)
+			}
+			m.SlashGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedVoteEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CombinedVoteEnabled = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerRecoveryPeriods", wireType)
+			}
+			m.CircuitBreakerRecoveryPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerRecoveryPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TobinTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TobinTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregationStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxDeviation = &v
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *HistoricExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowOracle
 			}
@@ -795,17 +3249,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.VotePeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -815,16 +3269,143 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstains", wireType)
+			}
+			m.Abstains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstains |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
+			}
+			m.WinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -834,29 +3415,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ValidatorPerformanceSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -884,15 +3516,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowsServed", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			m.WindowsServed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -902,16 +3532,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				m.WindowsServed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
 			}
-			var msglen int
+			m.VotePeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -921,31 +3551,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VotePeriods |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.Whitelist = append(m.Whitelist, Denom{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstains", wireType)
 			}
-			var stringLen uint64
+			m.Abstains = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -955,31 +3589,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Abstains |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
 			}
-			m.SlashWindow = 0
+			m.WinCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -989,16 +3627,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.WinCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1008,23 +3646,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1050,7 +3688,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *PenaltyOutcome) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,15 +3711,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: PenaltyOutcome: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PenaltyOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1109,11 +3747,30 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TobinTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1141,10 +3798,80 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TobinTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1167,7 +3894,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *FeederRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,15 +3917,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeederRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeederRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1226,11 +3953,11 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1258,13 +3985,13 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.FeederAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.SubmitBlock = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1274,7 +4001,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				m.ActivationHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1301,7 +4028,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *HaltedDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1324,17 +4051,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: HaltedDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HaltedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1344,29 +4071,61 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1394,8 +4153,48 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.LastRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBandTallies", wireType)
+			}
+			m.InBandTallies = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InBandTallies |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1418,7 +4217,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *WhitelistUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1441,15 +4240,15 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhitelistUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhitelistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1477,13 +4276,13 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1493,26 +4292,44 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
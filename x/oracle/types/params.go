@@ -13,21 +13,32 @@ import (
 
 // Parameter keys
 var (
-	KeyVotePeriod               = []byte("VotePeriod")
-	KeyVoteThreshold            = []byte("VoteThreshold")
-	KeyRewardBand               = []byte("RewardBand")
-	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
-	KeyWhitelist                = []byte("Whitelist")
-	KeySlashFraction            = []byte("SlashFraction")
-	KeySlashWindow              = []byte("SlashWindow")
-	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyVotePeriod                    = []byte("VotePeriod")
+	KeyVoteThreshold                 = []byte("VoteThreshold")
+	KeyRewardBand                    = []byte("RewardBand")
+	KeyRewardDistributionWindow      = []byte("RewardDistributionWindow")
+	KeyWhitelist                     = []byte("Whitelist")
+	KeySlashFraction                 = []byte("SlashFraction")
+	KeySlashWindow                   = []byte("SlashWindow")
+	KeyMinValidPerWindow             = []byte("MinValidPerWindow")
+	KeyHistoricRateRetention         = []byte("HistoricRateRetention")
+	KeyPerformanceHistoryWindows     = []byte("PerformanceHistoryWindows")
+	KeyWarningValidPerWindow         = []byte("WarningValidPerWindow")
+	KeyJailValidPerWindow            = []byte("JailValidPerWindow")
+	KeySlashGracePeriod              = []byte("SlashGracePeriod")
+	KeyCombinedVoteEnabled           = []byte("CombinedVoteEnabled")
+	KeyCircuitBreakerRecoveryPeriods = []byte("CircuitBreakerRecoveryPeriods")
 )
 
 // Default parameter values
 const (
-	DefaultVotePeriod               = core.BlocksPerMinute / 2 // 30 seconds
-	DefaultSlashWindow              = core.BlocksPerWeek       // window for a week
-	DefaultRewardDistributionWindow = core.BlocksPerYear       // window for a year
+	DefaultVotePeriod                    = core.BlocksPerMinute / 2 // 30 seconds
+	DefaultSlashWindow                   = core.BlocksPerWeek       // window for a week
+	DefaultRewardDistributionWindow      = core.BlocksPerYear       // window for a year
+	DefaultHistoricRateRetention         = core.BlocksPerDay        // keep rates for a day
+	DefaultPerformanceHistoryWindows     = 12                       // keep performances for 12 slash windows
+	DefaultSlashGracePeriod              = core.BlocksPerDay        // penalize validators active for a day or more
+	DefaultCircuitBreakerRecoveryPeriods = 3                        // resume after 3 vote periods in band
 )
 
 // Default parameter values
@@ -41,8 +52,11 @@ var (
 		{Name: core.MicroUSDDenom, TobinTax: DefaultTobinTax},
 		{Name: core.MicroMNTDenom, TobinTax: DefaultTobinTax.MulInt64(8)},
 	}
-	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4) // 0.01%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultSlashFraction         = sdk.NewDecWithPrec(1, 4)  // 0.01%
+	DefaultMinValidPerWindow     = sdk.NewDecWithPrec(5, 2)  // 5%
+	DefaultWarningValidPerWindow = sdk.NewDecWithPrec(20, 2) // 20%
+	DefaultJailValidPerWindow    = sdk.NewDecWithPrec(10, 2) // 10%
+	DefaultCombinedVoteEnabled   = false
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -50,14 +64,21 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:               DefaultVotePeriod,
-		VoteThreshold:            DefaultVoteThreshold,
-		RewardBand:               DefaultRewardBand,
-		RewardDistributionWindow: DefaultRewardDistributionWindow,
-		Whitelist:                DefaultWhitelist,
-		SlashFraction:            DefaultSlashFraction,
-		SlashWindow:              DefaultSlashWindow,
-		MinValidPerWindow:        DefaultMinValidPerWindow,
+		VotePeriod:                    DefaultVotePeriod,
+		VoteThreshold:                 DefaultVoteThreshold,
+		RewardBand:                    DefaultRewardBand,
+		RewardDistributionWindow:      DefaultRewardDistributionWindow,
+		Whitelist:                     DefaultWhitelist,
+		SlashFraction:                 DefaultSlashFraction,
+		SlashWindow:                   DefaultSlashWindow,
+		MinValidPerWindow:             DefaultMinValidPerWindow,
+		HistoricRateRetention:         DefaultHistoricRateRetention,
+		PerformanceHistoryWindows:     DefaultPerformanceHistoryWindows,
+		WarningValidPerWindow:         DefaultWarningValidPerWindow,
+		JailValidPerWindow:            DefaultJailValidPerWindow,
+		SlashGracePeriod:              DefaultSlashGracePeriod,
+		CombinedVoteEnabled:           DefaultCombinedVoteEnabled,
+		CircuitBreakerRecoveryPeriods: DefaultCircuitBreakerRecoveryPeriods,
 	}
 }
 
@@ -78,6 +99,13 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyHistoricRateRetention, &p.HistoricRateRetention, validateHistoricRateRetention),
+		paramstypes.NewParamSetPair(KeyPerformanceHistoryWindows, &p.PerformanceHistoryWindows, validatePerformanceHistoryWindows),
+		paramstypes.NewParamSetPair(KeyWarningValidPerWindow, &p.WarningValidPerWindow, validateWarningValidPerWindow),
+		paramstypes.NewParamSetPair(KeyJailValidPerWindow, &p.JailValidPerWindow, validateJailValidPerWindow),
+		paramstypes.NewParamSetPair(KeySlashGracePeriod, &p.SlashGracePeriod, validateSlashGracePeriod),
+		paramstypes.NewParamSetPair(KeyCombinedVoteEnabled, &p.CombinedVoteEnabled, validateCombinedVoteEnabled),
+		paramstypes.NewParamSetPair(KeyCircuitBreakerRecoveryPeriods, &p.CircuitBreakerRecoveryPeriods, validateCircuitBreakerRecoveryPeriods),
 	}
 }
 
@@ -116,6 +144,30 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.HistoricRateRetention < p.VotePeriod {
+		return fmt.Errorf("oracle parameter HistoricRateRetention must be greater than or equal with VotePeriod")
+	}
+
+	if p.PerformanceHistoryWindows == 0 {
+		return fmt.Errorf("oracle parameter PerformanceHistoryWindows must be > 0, is %d", p.PerformanceHistoryWindows)
+	}
+
+	if p.JailValidPerWindow.LT(p.MinValidPerWindow) || p.JailValidPerWindow.GT(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter JailValidPerWindow must be between [MinValidPerWindow, 1]")
+	}
+
+	if p.WarningValidPerWindow.LT(p.JailValidPerWindow) || p.WarningValidPerWindow.GT(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter WarningValidPerWindow must be between [JailValidPerWindow, 1]")
+	}
+
+	if p.SlashGracePeriod > p.SlashWindow {
+		return fmt.Errorf("oracle parameter SlashGracePeriod must be less than or equal with SlashWindow")
+	}
+
+	if p.CircuitBreakerRecoveryPeriods == 0 {
+		return fmt.Errorf("oracle parameter CircuitBreakerRecoveryPeriods must be > 0, is %d", p.CircuitBreakerRecoveryPeriods)
+	}
+
 	for _, denom := range p.Whitelist {
 		if denom.TobinTax.GT(sdk.OneDec()) || denom.TobinTax.IsNegative() {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have TobinTax between [0, 1]")
@@ -123,6 +175,24 @@ func (p Params) Validate() error {
 		if len(denom.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if err := ValidateAggregationStrategy(denom.AggregationStrategy); err != nil {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", denom.Name, err)
+		}
+		if denom.VoteThreshold != nil {
+			if err := validateVoteThreshold(*denom.VoteThreshold); err != nil {
+				return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", denom.Name, err)
+			}
+		}
+		if denom.RewardBand != nil {
+			if err := validateRewardBand(*denom.RewardBand); err != nil {
+				return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", denom.Name, err)
+			}
+		}
+		if denom.MaxDeviation != nil {
+			if err := validateMaxDeviation(*denom.MaxDeviation); err != nil {
+				return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", denom.Name, err)
+			}
+		}
 	}
 	return nil
 }
@@ -200,6 +270,24 @@ func validateWhitelist(i interface{}) error {
 		if len(d.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if err := ValidateAggregationStrategy(d.AggregationStrategy); err != nil {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", d.Name, err)
+		}
+		if d.VoteThreshold != nil {
+			if err := validateVoteThreshold(*d.VoteThreshold); err != nil {
+				return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", d.Name, err)
+			}
+		}
+		if d.RewardBand != nil {
+			if err := validateRewardBand(*d.RewardBand); err != nil {
+				return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", d.Name, err)
+			}
+		}
+		if d.MaxDeviation != nil {
+			if err := validateMaxDeviation(*d.MaxDeviation); err != nil {
+				return fmt.Errorf("oracle parameter Whitelist Denom %s: %s", d.Name, err)
+			}
+		}
 	}
 
 	return nil
@@ -251,3 +339,102 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateHistoricRateRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("historic rate retention must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePerformanceHistoryWindows(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("performance history windows must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateWarningValidPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("warning valid per window must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("warning valid per window is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateJailValidPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("jail valid per window must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("jail valid per window is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashGracePeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCombinedVoteEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerRecoveryPeriods(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("circuit breaker recovery periods must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxDeviation(v sdk.Dec) error {
+	if !v.IsPositive() {
+		return fmt.Errorf("max deviation must be positive: %s", v)
+	}
+
+	return nil
+}
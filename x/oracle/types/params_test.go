@@ -78,6 +78,77 @@ func TestParamsEqual(t *testing.T) {
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.ParamSetPairs())
 	require.NotNil(t, p11.String())
+
+	// historic rate retention shorter than vote period
+	p12 := types.DefaultParams()
+	p12.HistoricRateRetention = p12.VotePeriod - 1
+	err = p12.Validate()
+	require.Error(t, err)
+
+	// unknown aggregation strategy
+	p13 := types.DefaultParams()
+	p13.Whitelist = types.DenomList{{Name: "denom", TobinTax: types.DefaultTobinTax, AggregationStrategy: "mean"}}
+	err = p13.Validate()
+	require.Error(t, err)
+
+	// vote threshold override below 33%
+	voteThreshold := sdk.NewDecWithPrec(3, 1)
+	p14 := types.DefaultParams()
+	p14.Whitelist = types.DenomList{{Name: "denom", TobinTax: types.DefaultTobinTax, VoteThreshold: &voteThreshold}}
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// reward band override over 100%
+	rewardBand := sdk.NewDecWithPrec(101, 2)
+	p15 := types.DefaultParams()
+	p15.Whitelist = types.DenomList{{Name: "denom", TobinTax: types.DefaultTobinTax, RewardBand: &rewardBand}}
+	err = p15.Validate()
+	require.Error(t, err)
+
+	// valid overrides
+	voteThreshold = sdk.NewDecWithPrec(4, 1)
+	rewardBand = sdk.NewDecWithPrec(1, 2)
+	p16 := types.DefaultParams()
+	p16.Whitelist = types.DenomList{{Name: "denom", TobinTax: types.DefaultTobinTax, VoteThreshold: &voteThreshold, RewardBand: &rewardBand}}
+	err = p16.Validate()
+	require.NoError(t, err)
+
+	// zero performance history windows
+	p17 := types.DefaultParams()
+	p17.PerformanceHistoryWindows = 0
+	err = p17.Validate()
+	require.Error(t, err)
+
+	// jail threshold below slash threshold
+	p18 := types.DefaultParams()
+	p18.JailValidPerWindow = p18.MinValidPerWindow.Sub(sdk.NewDecWithPrec(1, 2))
+	err = p18.Validate()
+	require.Error(t, err)
+
+	// warning threshold below jail threshold
+	p19 := types.DefaultParams()
+	p19.WarningValidPerWindow = p19.JailValidPerWindow.Sub(sdk.NewDecWithPrec(1, 2))
+	err = p19.Validate()
+	require.Error(t, err)
+
+	// grace period longer than slash window
+	p20 := types.DefaultParams()
+	p20.SlashGracePeriod = p20.SlashWindow + 1
+	err = p20.Validate()
+	require.Error(t, err)
+
+	// zero circuit breaker recovery periods
+	p21 := types.DefaultParams()
+	p21.CircuitBreakerRecoveryPeriods = 0
+	err = p21.Validate()
+	require.Error(t, err)
+
+	// non-positive max deviation override
+	maxDeviation := sdk.ZeroDec()
+	p22 := types.DefaultParams()
+	p22.Whitelist = types.DenomList{{Name: "denom", TobinTax: types.DefaultTobinTax, MaxDeviation: &maxDeviation}}
+	err = p22.Validate()
+	require.Error(t, err)
 }
 
 func TestValidate(t *testing.T) {
@@ -87,7 +158,10 @@ func TestValidate(t *testing.T) {
 		switch {
 		case bytes.Equal(types.KeyVotePeriod, pair.Key) ||
 			bytes.Equal(types.KeyRewardDistributionWindow, pair.Key) ||
-			bytes.Equal(types.KeySlashWindow, pair.Key):
+			bytes.Equal(types.KeySlashWindow, pair.Key) ||
+			bytes.Equal(types.KeyHistoricRateRetention, pair.Key) ||
+			bytes.Equal(types.KeyPerformanceHistoryWindows, pair.Key) ||
+			bytes.Equal(types.KeyCircuitBreakerRecoveryPeriods, pair.Key):
 			require.NoError(t, pair.ValidatorFn(uint64(1)))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(uint64(0)))
@@ -98,11 +172,19 @@ func TestValidate(t *testing.T) {
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
 		case bytes.Equal(types.KeyRewardBand, pair.Key) ||
 			bytes.Equal(types.KeySlashFraction, pair.Key) ||
-			bytes.Equal(types.KeyMinValidPerWindow, pair.Key):
+			bytes.Equal(types.KeyMinValidPerWindow, pair.Key) ||
+			bytes.Equal(types.KeyWarningValidPerWindow, pair.Key) ||
+			bytes.Equal(types.KeyJailValidPerWindow, pair.Key):
 			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(7, 2)))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+		case bytes.Equal(types.KeySlashGracePeriod, pair.Key):
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Equal(types.KeyCombinedVoteEnabled, pair.Key):
+			require.NoError(t, pair.ValidatorFn(true))
+			require.Error(t, pair.ValidatorFn("invalid"))
 		case bytes.Equal(types.KeyWhitelist, pair.Key):
 			require.NoError(t, pair.ValidatorFn(types.DenomList{
 				{
@@ -129,6 +211,28 @@ func TestValidate(t *testing.T) {
 					TobinTax: sdk.NewDecWithPrec(-1, 2),
 				},
 			}))
+			require.NoError(t, pair.ValidatorFn(types.DenomList{
+				{
+					Name:                "denom",
+					TobinTax:            sdk.NewDecWithPrec(10, 2),
+					AggregationStrategy: types.AggregationStrategyTrimmedMean,
+				},
+			}))
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{
+					Name:                "denom",
+					TobinTax:            sdk.NewDecWithPrec(10, 2),
+					AggregationStrategy: types.AggregationStrategyLegacyWeightedMedian,
+				},
+			}))
+			maxDeviation := sdk.NewDecWithPrec(-1, 2)
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{
+					Name:         "denom",
+					TobinTax:     sdk.NewDecWithPrec(10, 2),
+					MaxDeviation: &maxDeviation,
+				},
+			}))
 		}
 	}
 }
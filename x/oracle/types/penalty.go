@@ -0,0 +1,37 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Penalty tiers applied to the validators at the end of a slash window
+const (
+	// PenaltyTierWarning only emits a warning for the validator
+	PenaltyTierWarning = "warning"
+	// PenaltyTierJail jails the validator without slashing
+	PenaltyTierJail = "jail"
+	// PenaltyTierSlash slashes and jails the validator
+	PenaltyTierSlash = "slash"
+	// PenaltyTierGrace spares the validator which was in the active set for less than the grace period
+	PenaltyTierGrace = "grace"
+)
+
+// NewPenaltyOutcome creates a PenaltyOutcome instance
+func NewPenaltyOutcome(validator sdk.ValAddress, window uint64, tier string, validVoteRate sdk.Dec, votePeriods, misses uint64) PenaltyOutcome {
+	return PenaltyOutcome{
+		ValidatorAddress: validator.String(),
+		Window:           window,
+		Tier:             tier,
+		ValidVoteRate:    validVoteRate,
+		VotePeriods:      votePeriods,
+		Misses:           misses,
+	}
+}
+
+// String implement stringify
+func (po PenaltyOutcome) String() string {
+	out, _ := yaml.Marshal(po)
+	return string(out)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorPerformance creates an empty ValidatorPerformance instance of the slash window
+func NewValidatorPerformance(validator sdk.ValAddress, window uint64) ValidatorPerformance {
+	return ValidatorPerformance{
+		ValidatorAddress: validator.String(),
+		Window:           window,
+		Rewards:          sdk.Coins{},
+	}
+}
+
+// String implement stringify
+func (vp ValidatorPerformance) String() string {
+	out, _ := yaml.Marshal(vp)
+	return string(out)
+}
+
+// NewValidatorPerformanceSummary sums up the performances of the validator
+func NewValidatorPerformanceSummary(validator sdk.ValAddress, performances []ValidatorPerformance) ValidatorPerformanceSummary {
+	summary := ValidatorPerformanceSummary{
+		ValidatorAddress: validator.String(),
+		Rewards:          sdk.Coins{},
+	}
+
+	for _, vp := range performances {
+		summary.WindowsServed++
+		summary.VotePeriods += vp.VotePeriods
+		summary.Votes += vp.Votes
+		summary.Abstains += vp.Abstains
+		summary.Misses += vp.Misses
+		summary.WinCount += vp.WinCount
+		summary.Rewards = summary.Rewards.Add(vp.Rewards...)
+	}
+
+	return summary
+}
+
+// String implement stringify
+func (vps ValidatorPerformanceSummary) String() string {
+	out, _ := yaml.Marshal(vps)
+	return string(out)
+}
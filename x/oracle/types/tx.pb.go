@@ -208,6 +208,101 @@ func (m *MsgAggregateExchangeRateVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAggregateExchangeRateVoteResponse proto.InternalMessageInfo
 
+// MsgAggregateExchangeRateCombinedVote represents a message to submit
+// aggregate exchange rate vote revealing the prevote of the previous vote period
+// along with the aggregate exchange rate prevote for the next vote period.
+type MsgAggregateExchangeRateCombinedVote struct {
+	Salt          string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
+	ExchangeRates string `protobuf:"bytes,2,opt,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty" yaml:"exchange_rates"`
+	Hash          string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Feeder        string `protobuf:"bytes,4,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	Validator     string `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) Reset()         { *m = MsgAggregateExchangeRateCombinedVote{} }
+func (m *MsgAggregateExchangeRateCombinedVote) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRateCombinedVote) ProtoMessage()    {}
+func (*MsgAggregateExchangeRateCombinedVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{4}
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRateCombinedVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRateCombinedVote.Merge(m, src)
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRateCombinedVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRateCombinedVote proto.InternalMessageInfo
+
+// MsgAggregateExchangeRateCombinedVoteResponse defines the Msg/AggregateExchangeRateCombinedVote response type.
+type MsgAggregateExchangeRateCombinedVoteResponse struct{}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) Reset() {
+	*m = MsgAggregateExchangeRateCombinedVoteResponse{}
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAggregateExchangeRateCombinedVoteResponse) ProtoMessage() {}
+func (*MsgAggregateExchangeRateCombinedVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{5}
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRateCombinedVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRateCombinedVoteResponse.Merge(m, src)
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRateCombinedVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRateCombinedVoteResponse proto.InternalMessageInfo
+
 // MsgDelegateFeedConsent represents a message to
 // delegate oracle voting rights to another address.
 type MsgDelegateFeedConsent struct {
@@ -219,7 +314,7 @@ func (m *MsgDelegateFeedConsent) Reset()         { *m = MsgDelegateFeedConsent{}
 func (m *MsgDelegateFeedConsent) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsent) ProtoMessage()    {}
 func (*MsgDelegateFeedConsent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ade38ec3545c6da7, []int{4}
+	return fileDescriptor_ade38ec3545c6da7, []int{6}
 }
 
 func (m *MsgDelegateFeedConsent) XXX_Unmarshal(b []byte) error {
@@ -260,7 +355,7 @@ func (m *MsgDelegateFeedConsentResponse) Reset()         { *m = MsgDelegateFeedC
 func (m *MsgDelegateFeedConsentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsentResponse) ProtoMessage()    {}
 func (*MsgDelegateFeedConsentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ade38ec3545c6da7, []int{5}
+	return fileDescriptor_ade38ec3545c6da7, []int{7}
 }
 
 func (m *MsgDelegateFeedConsentResponse) XXX_Unmarshal(b []byte) error {
@@ -294,51 +389,329 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
+// MsgRotateFeeder represents a message to schedule the rotation
+// of oracle voting rights to another address at the activation height.
+type MsgRotateFeeder struct {
+	Operator         string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Feeder           string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
+}
+
+func (m *MsgRotateFeeder) Reset()         { *m = MsgRotateFeeder{} }
+func (m *MsgRotateFeeder) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFeeder) ProtoMessage()    {}
+func (*MsgRotateFeeder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{8}
+}
+
+func (m *MsgRotateFeeder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRotateFeeder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateFeeder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRotateFeeder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFeeder.Merge(m, src)
+}
+
+func (m *MsgRotateFeeder) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRotateFeeder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFeeder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateFeeder proto.InternalMessageInfo
+
+// MsgRotateFeederResponse defines the Msg/RotateFeeder response type.
+type MsgRotateFeederResponse struct{}
+
+func (m *MsgRotateFeederResponse) Reset()         { *m = MsgRotateFeederResponse{} }
+func (m *MsgRotateFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFeederResponse) ProtoMessage()    {}
+func (*MsgRotateFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{9}
+}
+
+func (m *MsgRotateFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRotateFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRotateFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFeederResponse.Merge(m, src)
+}
+
+func (m *MsgRotateFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRotateFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateFeederResponse proto.InternalMessageInfo
+
+// MsgAddFeeder represents a message to authorize an additional
+// address to feed oracle votes along with the delegated feeder.
+type MsgAddFeeder struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Feeder   string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+}
+
+func (m *MsgAddFeeder) Reset()         { *m = MsgAddFeeder{} }
+func (m *MsgAddFeeder) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeeder) ProtoMessage()    {}
+func (*MsgAddFeeder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{10}
+}
+
+func (m *MsgAddFeeder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgAddFeeder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeeder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgAddFeeder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeeder.Merge(m, src)
+}
+
+func (m *MsgAddFeeder) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgAddFeeder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeeder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFeeder proto.InternalMessageInfo
+
+// MsgAddFeederResponse defines the Msg/AddFeeder response type.
+type MsgAddFeederResponse struct{}
+
+func (m *MsgAddFeederResponse) Reset()         { *m = MsgAddFeederResponse{} }
+func (m *MsgAddFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeederResponse) ProtoMessage()    {}
+func (*MsgAddFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{11}
+}
+
+func (m *MsgAddFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgAddFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgAddFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeederResponse.Merge(m, src)
+}
+
+func (m *MsgAddFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgAddFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFeederResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeder represents a message to revoke an additional feeder.
+type MsgRemoveFeeder struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Feeder   string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+}
+
+func (m *MsgRemoveFeeder) Reset()         { *m = MsgRemoveFeeder{} }
+func (m *MsgRemoveFeeder) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeder) ProtoMessage()    {}
+func (*MsgRemoveFeeder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{12}
+}
+
+func (m *MsgRemoveFeeder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRemoveFeeder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRemoveFeeder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeder.Merge(m, src)
+}
+
+func (m *MsgRemoveFeeder) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRemoveFeeder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeder proto.InternalMessageInfo
+
+// MsgRemoveFeederResponse defines the Msg/RemoveFeeder response type.
+type MsgRemoveFeederResponse struct{}
+
+func (m *MsgRemoveFeederResponse) Reset()         { *m = MsgRemoveFeederResponse{} }
+func (m *MsgRemoveFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeederResponse) ProtoMessage()    {}
+func (*MsgRemoveFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{13}
+}
+
+func (m *MsgRemoveFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRemoveFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRemoveFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeederResponse.Merge(m, src)
+}
+
+func (m *MsgRemoveFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRemoveFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeederResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRatePrevoteResponse")
 	proto.RegisterType((*MsgAggregateExchangeRateVote)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRateVote")
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRateVoteResponse")
+	proto.RegisterType((*MsgAggregateExchangeRateCombinedVote)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRateCombinedVote")
+	proto.RegisterType((*MsgAggregateExchangeRateCombinedVoteResponse)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRateCombinedVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "terra.oracle.v1beta1.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "terra.oracle.v1beta1.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgRotateFeeder)(nil), "terra.oracle.v1beta1.MsgRotateFeeder")
+	proto.RegisterType((*MsgRotateFeederResponse)(nil), "terra.oracle.v1beta1.MsgRotateFeederResponse")
+	proto.RegisterType((*MsgAddFeeder)(nil), "terra.oracle.v1beta1.MsgAddFeeder")
+	proto.RegisterType((*MsgAddFeederResponse)(nil), "terra.oracle.v1beta1.MsgAddFeederResponse")
+	proto.RegisterType((*MsgRemoveFeeder)(nil), "terra.oracle.v1beta1.MsgRemoveFeeder")
+	proto.RegisterType((*MsgRemoveFeederResponse)(nil), "terra.oracle.v1beta1.MsgRemoveFeederResponse")
 }
 
 func init() { proto.RegisterFile("terra/oracle/v1beta1/tx.proto", fileDescriptor_ade38ec3545c6da7) }
 
 var fileDescriptor_ade38ec3545c6da7 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0xa4, 0xaa, 0xda, 0x43, 0xa5, 0xe0, 0x16, 0x94, 0x46, 0xc5, 0xae, 0x0e, 0x04,
-	0x54, 0x2a, 0xb6, 0x1a, 0x60, 0x89, 0x84, 0x04, 0x05, 0xba, 0x45, 0x42, 0x37, 0x30, 0xb0, 0xa0,
-	0x8b, 0xf3, 0xb8, 0x44, 0x72, 0x73, 0xd1, 0xdd, 0x11, 0x25, 0x3b, 0x03, 0x12, 0x0b, 0x03, 0x1f,
-	0xa0, 0x0b, 0x33, 0x5f, 0x83, 0xb1, 0x23, 0x93, 0x85, 0x92, 0x85, 0x89, 0xc1, 0x9f, 0x00, 0xf9,
-	0xce, 0x36, 0x41, 0x24, 0xad, 0xcc, 0x66, 0xdd, 0xff, 0xf7, 0xee, 0xfd, 0xdf, 0xdf, 0x4f, 0x87,
-	0x6f, 0x6a, 0x90, 0x92, 0x85, 0x42, 0xb2, 0x28, 0x86, 0x70, 0x74, 0xd8, 0x01, 0xcd, 0x0e, 0x43,
-	0x3d, 0x0e, 0x86, 0x52, 0x68, 0xe1, 0x6e, 0x1b, 0x39, 0xb0, 0x72, 0x90, 0xcb, 0x8d, 0x6d, 0x2e,
-	0xb8, 0x30, 0x40, 0x98, 0x7d, 0x59, 0x96, 0x7c, 0x45, 0xd8, 0x6f, 0x2b, 0xfe, 0x94, 0x73, 0x09,
-	0x9c, 0x69, 0x78, 0x31, 0x8e, 0x7a, 0x6c, 0xc0, 0x81, 0x32, 0x0d, 0x2f, 0x25, 0x8c, 0x84, 0x06,
-	0xf7, 0x16, 0x5e, 0xe9, 0x31, 0xd5, 0xab, 0xa3, 0x3d, 0x74, 0x6f, 0xfd, 0x68, 0x33, 0x4d, 0xfc,
-	0xcb, 0x13, 0x76, 0x12, 0xb7, 0x48, 0x76, 0x4a, 0xa8, 0x11, 0xdd, 0x7d, 0xbc, 0xfa, 0x16, 0xa0,
-	0x0b, 0xb2, 0x7e, 0xc9, 0x60, 0xd7, 0xd2, 0xc4, 0xdf, 0xb0, 0x98, 0x3d, 0x27, 0x34, 0x07, 0xdc,
-	0x26, 0x5e, 0x1f, 0xb1, 0xb8, 0xdf, 0x65, 0x5a, 0xc8, 0x7a, 0xcd, 0xd0, 0xdb, 0x69, 0xe2, 0x5f,
-	0xb5, 0x74, 0x29, 0x11, 0xfa, 0x07, 0x6b, 0xad, 0x7d, 0x38, 0xf5, 0x9d, 0x9f, 0xa7, 0xbe, 0x43,
-	0xf6, 0xf1, 0xdd, 0x0b, 0x0c, 0x53, 0x50, 0x43, 0x31, 0x50, 0x40, 0x7e, 0x21, 0xbc, 0xbb, 0x8c,
-	0x7d, 0x95, 0x4f, 0xa6, 0x58, 0xac, 0xff, 0x9d, 0x2c, 0x3b, 0x25, 0xd4, 0x88, 0xee, 0x13, 0x7c,
-	0x05, 0xf2, 0xc2, 0x37, 0x92, 0x69, 0x50, 0xf9, 0x84, 0x3b, 0x69, 0xe2, 0x5f, 0xb7, 0xf8, 0xdf,
-	0x3a, 0xa1, 0x1b, 0x30, 0xd7, 0x49, 0xcd, 0x65, 0x53, 0xab, 0x94, 0xcd, 0x4a, 0xd5, 0x6c, 0xee,
-	0xe0, 0xdb, 0xe7, 0xcd, 0x5b, 0x06, 0xf3, 0x1e, 0xe1, 0x1b, 0x6d, 0xc5, 0x9f, 0x43, 0x6c, 0xb8,
-	0x63, 0x80, 0xee, 0xb3, 0x4c, 0x18, 0x68, 0x37, 0xc4, 0x6b, 0x62, 0x08, 0xd2, 0xf4, 0xb7, 0xb1,
-	0x6c, 0xa5, 0x89, 0xbf, 0x69, 0xfb, 0x17, 0x0a, 0xa1, 0x25, 0x94, 0x15, 0x74, 0xf3, 0x7b, 0xf2,
-	0x60, 0xe6, 0x0a, 0x0a, 0x85, 0xd0, 0x12, 0x9a, 0xb3, 0xbb, 0x87, 0xbd, 0xc5, 0x2e, 0x0a, 0xa3,
-	0xcd, 0x2f, 0x35, 0x5c, 0x6b, 0x2b, 0xee, 0x7e, 0x46, 0x78, 0xf7, 0xdc, 0x1d, 0x7d, 0x14, 0x2c,
-	0x5a, 0xfa, 0xe0, 0x82, 0x4d, 0x69, 0x3c, 0xfe, 0xaf, 0xb2, 0xc2, 0x9e, 0xfb, 0x11, 0xe1, 0x9d,
-	0xe5, 0xdb, 0xd5, 0xac, 0x76, 0x79, 0x56, 0xd3, 0x68, 0x55, 0xaf, 0x29, 0xdd, 0x4c, 0xf0, 0xd6,
-	0xa2, 0x3f, 0x7a, 0xb0, 0xf4, 0xca, 0x05, 0x74, 0xe3, 0x61, 0x15, 0xba, 0x68, 0x7d, 0x74, 0xfc,
-	0x6d, 0xea, 0xa1, 0xb3, 0xa9, 0x87, 0x7e, 0x4c, 0x3d, 0xf4, 0x69, 0xe6, 0x39, 0x67, 0x33, 0xcf,
-	0xf9, 0x3e, 0xf3, 0x9c, 0xd7, 0x07, 0xbc, 0xaf, 0x7b, 0xef, 0x3a, 0x41, 0x24, 0x4e, 0xc2, 0x28,
-	0x66, 0x4a, 0xf5, 0xa3, 0xfb, 0xf6, 0xf9, 0x8a, 0x84, 0x84, 0x70, 0x5c, 0xbc, 0x62, 0x7a, 0x32,
-	0x04, 0xd5, 0x59, 0x35, 0xaf, 0xd2, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x97, 0xa2,
-	0x10, 0xe2, 0x04, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xbf, 0x6f, 0xd3, 0x50,
+	0x10, 0xc7, 0xe3, 0x26, 0x54, 0xed, 0xd1, 0xd2, 0xd6, 0x0d, 0x25, 0xb5, 0x8a, 0x5d, 0x1e, 0x3f,
+	0x8b, 0x5a, 0x5b, 0x2d, 0xb0, 0x54, 0x42, 0x82, 0x14, 0x2a, 0x18, 0x22, 0x21, 0x0f, 0x0c, 0x30,
+	0x54, 0x2f, 0xf6, 0xe1, 0x58, 0x4a, 0xf2, 0x22, 0xbf, 0x47, 0x68, 0x77, 0x06, 0x24, 0x16, 0x06,
+	0x36, 0x96, 0x4a, 0xfc, 0x01, 0xfc, 0x0f, 0x4c, 0x8c, 0x1d, 0x99, 0x22, 0xd4, 0x2e, 0x4c, 0x0c,
+	0x11, 0x7f, 0x00, 0xf2, 0xcf, 0xba, 0x6d, 0xd2, 0xc4, 0x95, 0xe8, 0x16, 0xdd, 0x7d, 0xee, 0xdd,
+	0xf7, 0xbe, 0xb6, 0x2f, 0x0f, 0xae, 0x0a, 0xf4, 0x3c, 0x6a, 0x30, 0x8f, 0x5a, 0x75, 0x34, 0xda,
+	0xab, 0x55, 0x14, 0x74, 0xd5, 0x10, 0xdb, 0x7a, 0xcb, 0x63, 0x82, 0xc9, 0xc5, 0x20, 0xad, 0x87,
+	0x69, 0x3d, 0x4a, 0x2b, 0x45, 0x87, 0x39, 0x2c, 0x00, 0x0c, 0xff, 0x57, 0xc8, 0x92, 0x6f, 0x12,
+	0x68, 0x15, 0xee, 0x3c, 0x76, 0x1c, 0x0f, 0x1d, 0x2a, 0xf0, 0xe9, 0xb6, 0x55, 0xa3, 0x4d, 0x07,
+	0x4d, 0x2a, 0xf0, 0x85, 0x87, 0x6d, 0x26, 0x50, 0xbe, 0x0e, 0x85, 0x1a, 0xe5, 0xb5, 0x92, 0xb4,
+	0x28, 0xdd, 0x19, 0x2f, 0x4f, 0x75, 0x3b, 0xda, 0xc5, 0x1d, 0xda, 0xa8, 0xaf, 0x13, 0x3f, 0x4a,
+	0xcc, 0x20, 0x29, 0x2f, 0xc1, 0xe8, 0x1b, 0x44, 0x1b, 0xbd, 0xd2, 0x48, 0x80, 0xcd, 0x74, 0x3b,
+	0xda, 0x64, 0x88, 0x85, 0x71, 0x62, 0x46, 0x80, 0xbc, 0x06, 0xe3, 0x6d, 0x5a, 0x77, 0x6d, 0x2a,
+	0x98, 0x57, 0xca, 0x07, 0x74, 0xb1, 0xdb, 0xd1, 0xa6, 0x43, 0x3a, 0x49, 0x11, 0xf3, 0x10, 0x5b,
+	0x1f, 0xfb, 0xb0, 0xab, 0xe5, 0x7e, 0xef, 0x6a, 0x39, 0xb2, 0x04, 0xb7, 0x07, 0x08, 0x36, 0x91,
+	0xb7, 0x58, 0x93, 0x23, 0xf9, 0x23, 0xc1, 0x42, 0x3f, 0xf6, 0x65, 0x34, 0x19, 0xa7, 0x75, 0x71,
+	0x72, 0x32, 0x3f, 0x4a, 0xcc, 0x20, 0x29, 0x3f, 0x82, 0x4b, 0x18, 0x15, 0x6e, 0x79, 0x54, 0x20,
+	0x8f, 0x26, 0x9c, 0xef, 0x76, 0xb4, 0xcb, 0x21, 0x7e, 0x34, 0x4f, 0xcc, 0x49, 0x4c, 0x75, 0xe2,
+	0x29, 0x6f, 0xf2, 0x99, 0xbc, 0x29, 0x64, 0xf5, 0xe6, 0x16, 0xdc, 0x38, 0x6d, 0xde, 0xc4, 0x98,
+	0x2f, 0x23, 0xfd, 0xc1, 0x0d, 0xd6, 0xa8, 0xba, 0x4d, 0xb4, 0xcf, 0xd3, 0xa0, 0xf8, 0x0d, 0xcb,
+	0x0f, 0xf7, 0x86, 0x15, 0x32, 0xb9, 0x78, 0x21, 0xab, 0x8b, 0x3a, 0x2c, 0x0f, 0x63, 0x4e, 0xe2,
+	0xe6, 0x7b, 0x09, 0xe6, 0x2a, 0xdc, 0x79, 0x82, 0xf5, 0x80, 0xdf, 0x44, 0xb4, 0x37, 0xfc, 0x44,
+	0x53, 0xc8, 0x06, 0x8c, 0xb1, 0x16, 0x7a, 0x81, 0x8e, 0xd0, 0xc3, 0xd9, 0x6e, 0x47, 0x9b, 0x0a,
+	0x75, 0xc4, 0x19, 0x62, 0x26, 0x90, 0x5f, 0x60, 0x47, 0xe7, 0x94, 0x46, 0x8e, 0x17, 0xc4, 0x19,
+	0x62, 0x26, 0x50, 0x4a, 0xf6, 0x22, 0xa8, 0xbd, 0x55, 0x24, 0x42, 0xbf, 0x4b, 0x30, 0x55, 0xe1,
+	0x8e, 0xc9, 0x44, 0x04, 0xa0, 0x97, 0x5d, 0x61, 0x86, 0x0f, 0xfd, 0x39, 0xcc, 0x50, 0x4b, 0xb8,
+	0x6d, 0x2a, 0x5c, 0xd6, 0xdc, 0xaa, 0xa1, 0xeb, 0xd4, 0x44, 0xf0, 0x8c, 0x0b, 0xe5, 0x85, 0x6e,
+	0x47, 0x2b, 0x85, 0x55, 0x27, 0x10, 0x62, 0x4e, 0x1f, 0xc6, 0x9e, 0x05, 0xa1, 0xd4, 0x98, 0xf3,
+	0x70, 0xe5, 0xd8, 0x0c, 0xc9, 0x7c, 0x02, 0x26, 0xfc, 0x07, 0x67, 0xdb, 0xff, 0x7f, 0xb6, 0x94,
+	0xa0, 0x39, 0x28, 0xa6, 0xbb, 0x26, 0x6a, 0xde, 0x85, 0x66, 0x63, 0x83, 0xb5, 0xf1, 0x5c, 0x05,
+	0x45, 0x0e, 0xa5, 0x1a, 0xc7, 0x9a, 0xd6, 0xfe, 0x8e, 0x42, 0xbe, 0xc2, 0x1d, 0xf9, 0xb3, 0x04,
+	0x0b, 0xa7, 0xee, 0xfc, 0x07, 0x7a, 0xaf, 0x3f, 0x11, 0x7d, 0xc0, 0xe6, 0x55, 0x1e, 0x9e, 0xa9,
+	0x2c, 0x96, 0x27, 0x7f, 0x94, 0x60, 0xbe, 0xff, 0xb6, 0x5e, 0xcb, 0x76, 0xb8, 0x5f, 0xa3, 0xac,
+	0x67, 0xaf, 0x49, 0xd4, 0x7c, 0x95, 0xe0, 0xda, 0xe0, 0x15, 0x99, 0xb1, 0x43, 0xba, 0x56, 0x29,
+	0x9f, 0xbd, 0x36, 0x51, 0xb9, 0x03, 0xb3, 0xbd, 0x36, 0xcf, 0x72, 0xdf, 0xa3, 0x7b, 0xd0, 0xca,
+	0xfd, 0x2c, 0x74, 0xd2, 0xda, 0x86, 0x89, 0x23, 0xbb, 0xe4, 0x66, 0xdf, 0x53, 0xd2, 0x98, 0xb2,
+	0x32, 0x14, 0x96, 0x74, 0x79, 0x0d, 0xe3, 0x87, 0x9f, 0x34, 0xe9, 0xef, 0x58, 0xcc, 0x28, 0x77,
+	0x07, 0x33, 0x47, 0x46, 0x48, 0x7f, 0xa1, 0xa7, 0x8c, 0x90, 0xc2, 0x94, 0x95, 0xa1, 0xb0, 0xb8,
+	0x4b, 0x79, 0xf3, 0xc7, 0xbe, 0x2a, 0xed, 0xed, 0xab, 0xd2, 0xaf, 0x7d, 0x55, 0xfa, 0x74, 0xa0,
+	0xe6, 0xf6, 0x0e, 0xd4, 0xdc, 0xcf, 0x03, 0x35, 0xf7, 0x6a, 0xd9, 0x71, 0x45, 0xed, 0x6d, 0x55,
+	0xb7, 0x58, 0xc3, 0xb0, 0xea, 0x94, 0x73, 0xd7, 0x5a, 0x09, 0x6f, 0x77, 0x16, 0xf3, 0xd0, 0xd8,
+	0x8e, 0x2f, 0x79, 0x62, 0xa7, 0x85, 0xbc, 0x3a, 0x1a, 0x5c, 0xda, 0xee, 0xfd, 0x1b, 0x00, 0x46,
+	0x4c, 0x0a, 0x3e, 0x01, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -361,8 +734,17 @@ type MsgClient interface {
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
+	// AggregateExchangeRateCombinedVote defines a method for submitting
+	// aggregate exchange rate vote along with the prevote for the next vote period
+	AggregateExchangeRateCombinedVote(ctx context.Context, in *MsgAggregateExchangeRateCombinedVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateCombinedVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// RotateFeeder defines a method for scheduling the rotation of the feeder delegation
+	RotateFeeder(ctx context.Context, in *MsgRotateFeeder, opts ...grpc.CallOption) (*MsgRotateFeederResponse, error)
+	// AddFeeder defines a method for authorizing an additional feeder
+	AddFeeder(ctx context.Context, in *MsgAddFeeder, opts ...grpc.CallOption) (*MsgAddFeederResponse, error)
+	// RemoveFeeder defines a method for revoking an additional feeder
+	RemoveFeeder(ctx context.Context, in *MsgRemoveFeeder, opts ...grpc.CallOption) (*MsgRemoveFeederResponse, error)
 }
 
 type msgClient struct {
@@ -391,6 +773,15 @@ func (c *msgClient) AggregateExchangeRateVote(ctx context.Context, in *MsgAggreg
 	return out, nil
 }
 
+func (c *msgClient) AggregateExchangeRateCombinedVote(ctx context.Context, in *MsgAggregateExchangeRateCombinedVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateCombinedVoteResponse, error) {
+	out := new(MsgAggregateExchangeRateCombinedVoteResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Msg/AggregateExchangeRateCombinedVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error) {
 	out := new(MsgDelegateFeedConsentResponse)
 	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Msg/DelegateFeedConsent", in, out, opts...)
@@ -400,6 +791,33 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
+func (c *msgClient) RotateFeeder(ctx context.Context, in *MsgRotateFeeder, opts ...grpc.CallOption) (*MsgRotateFeederResponse, error) {
+	out := new(MsgRotateFeederResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Msg/RotateFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddFeeder(ctx context.Context, in *MsgAddFeeder, opts ...grpc.CallOption) (*MsgAddFeederResponse, error) {
+	out := new(MsgAddFeederResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Msg/AddFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeder(ctx context.Context, in *MsgRemoveFeeder, opts ...grpc.CallOption) (*MsgRemoveFeederResponse, error) {
+	out := new(MsgRemoveFeederResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Msg/RemoveFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -408,8 +826,17 @@ type MsgServer interface {
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
+	// AggregateExchangeRateCombinedVote defines a method for submitting
+	// aggregate exchange rate vote along with the prevote for the next vote period
+	AggregateExchangeRateCombinedVote(context.Context, *MsgAggregateExchangeRateCombinedVote) (*MsgAggregateExchangeRateCombinedVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// RotateFeeder defines a method for scheduling the rotation of the feeder delegation
+	RotateFeeder(context.Context, *MsgRotateFeeder) (*MsgRotateFeederResponse, error)
+	// AddFeeder defines a method for authorizing an additional feeder
+	AddFeeder(context.Context, *MsgAddFeeder) (*MsgAddFeederResponse, error)
+	// RemoveFeeder defines a method for revoking an additional feeder
+	RemoveFeeder(context.Context, *MsgRemoveFeeder) (*MsgRemoveFeederResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -423,10 +850,26 @@ func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, re
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVote not implemented")
 }
 
+func (*UnimplementedMsgServer) AggregateExchangeRateCombinedVote(ctx context.Context, req *MsgAggregateExchangeRateCombinedVote) (*MsgAggregateExchangeRateCombinedVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateCombinedVote not implemented")
+}
+
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
 
+func (*UnimplementedMsgServer) RotateFeeder(ctx context.Context, req *MsgRotateFeeder) (*MsgRotateFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateFeeder not implemented")
+}
+
+func (*UnimplementedMsgServer) AddFeeder(ctx context.Context, req *MsgAddFeeder) (*MsgAddFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFeeder not implemented")
+}
+
+func (*UnimplementedMsgServer) RemoveFeeder(ctx context.Context, req *MsgRemoveFeeder) (*MsgRemoveFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeder not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -467,28 +910,100 @@ func _Msg_AggregateExchangeRateVote_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateFeedConsent)
+func _Msg_AggregateExchangeRateCombinedVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAggregateExchangeRateCombinedVote)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DelegateFeedConsent(ctx, in)
+		return srv.(MsgServer).AggregateExchangeRateCombinedVote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terra.oracle.v1beta1.Msg/DelegateFeedConsent",
+		FullMethod: "/terra.oracle.v1beta1.Msg/AggregateExchangeRateCombinedVote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateFeedConsent(ctx, req.(*MsgDelegateFeedConsent))
+		return srv.(MsgServer).AggregateExchangeRateCombinedVote(ctx, req.(*MsgAggregateExchangeRateCombinedVote))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "terra.oracle.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateFeedConsent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateFeedConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Msg/DelegateFeedConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateFeedConsent(ctx, req.(*MsgDelegateFeedConsent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Msg/RotateFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateFeeder(ctx, req.(*MsgRotateFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Msg/AddFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFeeder(ctx, req.(*MsgAddFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Msg/RemoveFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeder(ctx, req.(*MsgRemoveFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.oracle.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "AggregateExchangeRatePrevote",
 			Handler:    _Msg_AggregateExchangeRatePrevote_Handler,
@@ -497,10 +1012,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AggregateExchangeRateVote",
 			Handler:    _Msg_AggregateExchangeRateVote_Handler,
 		},
+		{
+			MethodName: "AggregateExchangeRateCombinedVote",
+			Handler:    _Msg_AggregateExchangeRateCombinedVote_Handler,
+		},
 		{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
 		},
+		{
+			MethodName: "RotateFeeder",
+			Handler:    _Msg_RotateFeeder_Handler,
+		},
+		{
+			MethodName: "AddFeeder",
+			Handler:    _Msg_AddFeeder_Handler,
+		},
+		{
+			MethodName: "RemoveFeeder",
+			Handler:    _Msg_RemoveFeeder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/oracle/v1beta1/tx.proto",
@@ -647,6 +1178,87 @@ func (m *MsgAggregateExchangeRateVoteResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAggregateExchangeRateCombinedVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExchangeRates) > 0 {
+		i -= len(m.ExchangeRates)
+		copy(dAtA[i:], m.ExchangeRates)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRates)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -707,117 +1319,1060 @@ func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRotateFeeder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRotateFeeder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateFeeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRotateFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExchangeRates)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRotateFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRotateFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateFeedConsent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddFeeder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFeeder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRates)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRates)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateFeedConsent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Delegate)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateFeeder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgRotateFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFeeder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgAggregateExchangeRateCombinedVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateCombinedVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateCombinedVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *MsgAggregateExchangeRateCombinedVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,47 +2395,66 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAggregateExchangeRateCombinedVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAggregateExchangeRateCombinedVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -908,11 +2482,11 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -940,7 +2514,7 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -964,7 +2538,7 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -987,10 +2561,10 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1015,7 +2589,7 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateFeeder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1038,15 +2612,15 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateFeeder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateFeeder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1074,11 +2648,11 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1106,11 +2680,132 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgRotateFeederResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateFeederResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgAddFeeder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFeeder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFeeder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1138,11 +2833,11 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1170,7 +2865,7 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1194,7 +2889,7 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFeederResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,10 +2912,10 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFeederResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1245,7 +2940,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1268,10 +2963,10 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1308,7 +3003,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1336,7 +3031,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1360,7 +3055,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeederResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1383,10 +3078,10 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeederResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Actions of the whitelist updates
+const (
+	WhitelistUpdateActionAdd            = "add"
+	WhitelistUpdateActionRemove         = "remove"
+	WhitelistUpdateActionUpdateTobinTax = "update_tobin_tax"
+)
+
+// NewWhitelistUpdate creates a WhitelistUpdate instance
+func NewWhitelistUpdate(action string, denom Denom, activationHeight uint64) WhitelistUpdate {
+	return WhitelistUpdate{
+		Action:           action,
+		Denom:            denom,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// String implement stringify
+func (wu WhitelistUpdate) String() string {
+	out, _ := yaml.Marshal(wu)
+	return string(out)
+}
+
+// ValidateWhitelistUpdate checks the action and the denom of the whitelist update
+func ValidateWhitelistUpdate(wu WhitelistUpdate) error {
+	if wu.ActivationHeight == 0 {
+		return fmt.Errorf("activation height must be positive")
+	}
+
+	switch wu.Action {
+	case WhitelistUpdateActionAdd, WhitelistUpdateActionUpdateTobinTax:
+		return validateWhitelist(DenomList{wu.Denom})
+	case WhitelistUpdateActionRemove:
+		if len(wu.Denom.Name) == 0 {
+			return fmt.Errorf("denom must have name")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown whitelist update action: %s", wu.Action)
+	}
+}
+
+// Apply returns the whitelist with the update applied; adding a whitelisted
+// denom replaces it, and removing or updating an unknown denom is a no-op
+func (wu WhitelistUpdate) Apply(whitelist DenomList) DenomList {
+	updated := make(DenomList, 0, len(whitelist)+1)
+	found := false
+	for _, d := range whitelist {
+		if d.Name != wu.Denom.Name {
+			updated = append(updated, d)
+			continue
+		}
+
+		found = true
+		switch wu.Action {
+		case WhitelistUpdateActionAdd:
+			updated = append(updated, wu.Denom)
+		case WhitelistUpdateActionUpdateTobinTax:
+			d.TobinTax = wu.Denom.TobinTax
+			updated = append(updated, d)
+		}
+	}
+
+	if !found && wu.Action == WhitelistUpdateActionAdd {
+		updated = append(updated, wu.Denom)
+	}
+
+	return updated
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/classic-terra/core/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWhitelistUpdateApply(t *testing.T) {
+	maxDeviation := sdk.NewDecWithPrec(2, 1)
+	whitelist := types.DenomList{
+		{Name: "denom1", TobinTax: sdk.NewDecWithPrec(1, 2), MaxDeviation: &maxDeviation},
+		{Name: "denom2", TobinTax: sdk.NewDecWithPrec(2, 2)},
+	}
+
+	// add a new denom
+	update := types.NewWhitelistUpdate(types.WhitelistUpdateActionAdd, types.Denom{Name: "denom3", TobinTax: sdk.NewDecWithPrec(3, 2)}, 10)
+	updated := update.Apply(whitelist)
+	require.Len(t, updated, 3)
+	require.Equal(t, "denom3", updated[2].Name)
+	require.Len(t, whitelist, 2)
+
+	// add a whitelisted denom replaces it
+	update = types.NewWhitelistUpdate(types.WhitelistUpdateActionAdd, types.Denom{Name: "denom1", TobinTax: sdk.NewDecWithPrec(3, 2)}, 10)
+	updated = update.Apply(whitelist)
+	require.Len(t, updated, 2)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), updated[0].TobinTax)
+	require.Nil(t, updated[0].MaxDeviation)
+
+	// update the tobin tax keeps the other configurations
+	update = types.NewWhitelistUpdate(types.WhitelistUpdateActionUpdateTobinTax, types.Denom{Name: "denom1", TobinTax: sdk.NewDecWithPrec(5, 2)}, 10)
+	updated = update.Apply(whitelist)
+	require.Len(t, updated, 2)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), updated[0].TobinTax)
+	require.Equal(t, &maxDeviation, updated[0].MaxDeviation)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), whitelist[0].TobinTax)
+
+	// remove a denom
+	update = types.NewWhitelistUpdate(types.WhitelistUpdateActionRemove, types.Denom{Name: "denom1", TobinTax: sdk.ZeroDec()}, 10)
+	updated = update.Apply(whitelist)
+	require.Equal(t, types.DenomList{whitelist[1]}, updated)
+
+	// remove or update an unknown denom is a no-op
+	update = types.NewWhitelistUpdate(types.WhitelistUpdateActionRemove, types.Denom{Name: "denom3", TobinTax: sdk.ZeroDec()}, 10)
+	require.Equal(t, whitelist, update.Apply(whitelist))
+	update = types.NewWhitelistUpdate(types.WhitelistUpdateActionUpdateTobinTax, types.Denom{Name: "denom3", TobinTax: sdk.ZeroDec()}, 10)
+	require.Equal(t, whitelist, update.Apply(whitelist))
+}
+
+func TestOracleDenomProposals(t *testing.T) {
+	tobinTax := sdk.NewDecWithPrec(25, 4)
+
+	require.NoError(t, types.NewAddOracleDenomProposal("title", "description", types.Denom{Name: "denom", TobinTax: tobinTax}, 10).ValidateBasic())
+	require.Error(t, types.NewAddOracleDenomProposal("", "description", types.Denom{Name: "denom", TobinTax: tobinTax}, 10).ValidateBasic())
+	require.Error(t, types.NewAddOracleDenomProposal("title", "description", types.Denom{Name: "", TobinTax: tobinTax}, 10).ValidateBasic())
+	require.Error(t, types.NewAddOracleDenomProposal("title", "description", types.Denom{Name: "denom", TobinTax: sdk.NewDec(2)}, 10).ValidateBasic())
+	require.Error(t, types.NewAddOracleDenomProposal("title", "description", types.Denom{Name: "denom", TobinTax: tobinTax, AggregationStrategy: "mean"}, 10).ValidateBasic())
+	require.Error(t, types.NewAddOracleDenomProposal("title", "description", types.Denom{Name: "denom", TobinTax: tobinTax}, 0).ValidateBasic())
+
+	require.NoError(t, types.NewRemoveOracleDenomProposal("title", "description", "denom", 10).ValidateBasic())
+	require.Error(t, types.NewRemoveOracleDenomProposal("title", "description", "", 10).ValidateBasic())
+	require.Error(t, types.NewRemoveOracleDenomProposal("title", "description", "denom", 0).ValidateBasic())
+
+	require.NoError(t, types.NewUpdateTobinTaxProposal("title", "description", "denom", tobinTax, 10).ValidateBasic())
+	require.Error(t, types.NewUpdateTobinTaxProposal("title", "description", "", tobinTax, 10).ValidateBasic())
+	require.Error(t, types.NewUpdateTobinTaxProposal("title", "description", "denom", sdk.NewDec(-1), 10).ValidateBasic())
+	require.Error(t, types.NewUpdateTobinTaxProposal("title", "description", "denom", tobinTax, 0).ValidateBasic())
+}
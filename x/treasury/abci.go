@@ -18,6 +18,15 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Burn all coins from the burn module account
 	k.BurnCoinsFromBurnAccount(ctx)
 
+	// Drop the burn tax exemptions reaching their expiry
+	for _, address := range k.PruneExpiredBurnTaxExemptions(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeExemptionExpired,
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+			),
+		)
+	}
+
 	// Check epoch last block
 	if !core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
 		return
@@ -29,6 +38,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Compute & Update internal indicators for the current epoch
 	k.UpdateIndicators(ctx)
 
+	// Record the policy in effect during the current epoch before updating it
+	k.RecordEpochPolicy(ctx)
+
 	// Check probation period
 	if ctx.BlockHeight() < int64(core.BlocksPerWeek*k.WindowProbation(ctx)) {
 		return
@@ -60,6 +60,13 @@ func TestUpdate(t *testing.T) {
 	// zero mining rewards will increase reward weight with change max amount
 	newRewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
 	require.Equal(t, rewardWeight.Add(input.TreasuryKeeper.RewardPolicy(input.Ctx).ChangeRateMax), newRewardWeight)
+
+	// the policy in effect during the epoch is recorded before the update
+	policy, found := input.TreasuryKeeper.GetEpochPolicy(input.Ctx, targetEpoch-1)
+	require.True(t, found)
+	require.Equal(t, taxRate, policy.TaxRate)
+	require.Equal(t, rewardWeight, policy.RewardWeight)
+	require.Equal(t, input.TreasuryKeeper.TaxPolicy(input.Ctx).Cap.Amount, policy.TaxCaps.AmountOf(core.MicroSDRDenom))
 }
 
 func TestEmptyIndicator(t *testing.T) {
@@ -95,3 +102,26 @@ func TestEmptyIndicator(t *testing.T) {
 	newRewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
 	require.Equal(t, rewardWeight.Add(input.TreasuryKeeper.RewardPolicy(input.Ctx).ChangeRateMax), newRewardWeight)
 }
+
+func TestEndBlockerPruneBurnTaxExemptions(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(100)
+
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(keeper.Addrs[0].String(), 100, nil, nil))
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(keeper.Addrs[1].String(), 101, nil, nil))
+
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+
+	_, found := input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, keeper.Addrs[0].String())
+	require.False(t, found)
+	_, found = input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, keeper.Addrs[1].String())
+	require.True(t, found)
+
+	var expired []string
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExemptionExpired {
+			expired = append(expired, string(event.Attributes[0].Value))
+		}
+	}
+	require.Equal(t, []string{keeper.Addrs[0].String()}, expired)
+}
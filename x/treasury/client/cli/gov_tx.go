@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/classic-terra/core/x/treasury/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,6 +15,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagExpiryHeight = "expiry-height"
+	flagExpiryTime   = "expiry-time"
+	flagDenoms       = "denoms"
+
+	flagEnabled         = "enabled"
+	flagAddAddresses    = "add-addresses"
+	flagRemoveAddresses = "remove-addresses"
+)
+
 func ProposalAddBurnTaxExemptionAddressCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-burn-tax-exemption-address [addresses] --title [text] --description [text]",
@@ -21,7 +32,10 @@ func ProposalAddBurnTaxExemptionAddressCmd() *cobra.Command {
 		Long: fmt.Sprintf(`Submit a proposal to add addresses for burn tax exemption.
 Example:
 $ %s tx gov submit-proposal add-burn-tax-exemption-address terra1dczz24r33fwlj0q5ra7rcdryjpk9hxm8rwy39t,terra1qt8mrv72gtvmnca9z6ftzd7slqhaf8m60aa7ye --title "add burn tax exemption address" --description "add address to burn tax exemption list"
-			`, version.AppName),
+
+The exemption can expire at a height and/or time, and be limited to some denoms:
+$ %s tx gov submit-proposal add-burn-tax-exemption-address terra1dczz24r33fwlj0q5ra7rcdryjpk9hxm8rwy39t --expiry-time 2027-01-01T00:00:00Z --denoms uusd,ukrw --title "temporary burn tax exemption" --description "exempt address during the migration"
+			`, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -48,10 +62,40 @@ $ %s tx gov submit-proposal add-burn-tax-exemption-address terra1dczz24r33fwlj0q
 				return err
 			}
 
+			expiryHeight, err := cmd.Flags().GetInt64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			var expiryTime *time.Time
+			expiryTimeArg, err := cmd.Flags().GetString(flagExpiryTime)
+			if err != nil {
+				return err
+			}
+			if expiryTimeArg != "" {
+				t, err := time.Parse(time.RFC3339, expiryTimeArg)
+				if err != nil {
+					return fmt.Errorf("expiry time: %s", err)
+				}
+				expiryTime = &t
+			}
+
+			var denoms []string
+			denomsArg, err := cmd.Flags().GetString(flagDenoms)
+			if err != nil {
+				return err
+			}
+			if denomsArg != "" {
+				denoms = strings.Split(denomsArg, ",")
+			}
+
 			content := types.AddBurnTaxExemptionAddressProposal{
-				Title:       proposalTitle,
-				Description: proposalDescr,
-				Addresses:   addresses,
+				Title:        proposalTitle,
+				Description:  proposalDescr,
+				Addresses:    addresses,
+				ExpiryHeight: expiryHeight,
+				ExpiryTime:   expiryTime,
+				Denoms:       denoms,
 			}
 
 			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
@@ -70,6 +114,9 @@ $ %s tx gov submit-proposal add-burn-tax-exemption-address terra1dczz24r33fwlj0q
 	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().Int64(flagExpiryHeight, 0, "Height from which the exemption is dropped, none when zero")
+	cmd.Flags().String(flagExpiryTime, "", "Block time (RFC3339) from which the exemption is dropped, none when unset")
+	cmd.Flags().String(flagDenoms, "", "Comma separated denoms the exemption is limited to, every denom when unset")
 	return cmd
 }
 
@@ -131,3 +178,195 @@ $ %s tx gov submit-proposal remove-burn-tax-exemption-address terra1dczz24r33fwl
 	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
 	return cmd
 }
+
+func ProposalAddBurnTaxExemptionZoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-burn-tax-exemption-zone [name] [addresses] --title [text] --description [text]",
+		Short: "Submit an add burn tax exemption zone proposal",
+		Long: fmt.Sprintf(`Submit a proposal to create a burn tax exemption zone. Transfers between addresses of
+the same zone are not taxed while the zone is --enabled, transfers leaving or entering the zone always are.
+Example:
+$ %s tx gov submit-proposal add-burn-tax-exemption-zone exchange terra1dczz24r33fwlj0q5ra7rcdryjpk9hxm8rwy39t,terra1qt8mrv72gtvmnca9z6ftzd7slqhaf8m60aa7ye --enabled --title "add exchange zone" --description "exempt exchange internal transfers"
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addresses := strings.Split(args[1], ",")
+
+			enabled, err := cmd.Flags().GetBool(flagEnabled)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddBurnTaxExemptionZoneProposal(proposalTitle, proposalDescr, args[0], enabled, addresses)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().Bool(flagEnabled, false, "Exempt the transfers among the members of the zone")
+	return cmd
+}
+
+func ProposalModifyBurnTaxExemptionZoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify-burn-tax-exemption-zone [name] --title [text] --description [text]",
+		Short: "Submit a modify burn tax exemption zone proposal",
+		Long: fmt.Sprintf(`Submit a proposal to enable or disable a burn tax exemption zone and add or remove its addresses.
+Example:
+$ %s tx gov submit-proposal modify-burn-tax-exemption-zone exchange --enabled --add-addresses terra1dczz24r33fwlj0q5ra7rcdryjpk9hxm8rwy39t --remove-addresses terra1qt8mrv72gtvmnca9z6ftzd7slqhaf8m60aa7ye --title "update exchange zone" --description "rotate exchange hot wallet"
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := cmd.Flags().GetBool(flagEnabled)
+			if err != nil {
+				return err
+			}
+
+			var addAddresses, removeAddresses []string
+			addArg, err := cmd.Flags().GetString(flagAddAddresses)
+			if err != nil {
+				return err
+			}
+			if addArg != "" {
+				addAddresses = strings.Split(addArg, ",")
+			}
+			removeArg, err := cmd.Flags().GetString(flagRemoveAddresses)
+			if err != nil {
+				return err
+			}
+			if removeArg != "" {
+				removeAddresses = strings.Split(removeArg, ",")
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewModifyBurnTaxExemptionZoneProposal(proposalTitle, proposalDescr, args[0], enabled, addAddresses, removeAddresses)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().Bool(flagEnabled, false, "Exempt the transfers among the members of the zone")
+	cmd.Flags().String(flagAddAddresses, "", "Comma separated addresses to add to the zone")
+	cmd.Flags().String(flagRemoveAddresses, "", "Comma separated addresses to remove from the zone")
+	return cmd
+}
+
+func ProposalRemoveBurnTaxExemptionZoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-burn-tax-exemption-zone [name] --title [text] --description [text]",
+		Short: "Submit a remove burn tax exemption zone proposal",
+		Long: fmt.Sprintf(`Submit a proposal to remove a burn tax exemption zone with all its addresses.
+Example:
+$ %s tx gov submit-proposal remove-burn-tax-exemption-zone exchange --title "remove exchange zone" --description "exchange closed"
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveBurnTaxExemptionZoneProposal(proposalTitle, proposalDescr, args[0])
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/classic-terra/core/x/treasury/types"
@@ -25,12 +27,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTaxRate(),
 		GetCmdQueryTaxCap(),
 		GetCmdQueryTaxCaps(),
+		GetCmdQueryMsgTaxRate(),
 		GetCmdQueryRewardWeight(),
 		GetCmdQueryTaxProceeds(),
 		GetCmdQuerySeigniorageProceeds(),
 		GetCmdQueryIndicators(),
+		GetCmdQueryIndicatorHistory(),
+		GetCmdQueryPolicyDryRun(),
 		GetCmdQueryParams(),
 		GetCmdQueryExemptlist(),
+		GetCmdQueryBurnTaxExemptionZones(),
+		GetCmdQueryBurnTaxExemptionZone(),
+		GetCmdQueryBurnTaxExemptionZoneByAddress(),
 	)
 
 	return oracleQueryCmd
@@ -134,6 +142,41 @@ $ terrad query treasury tax-caps
 	return cmd
 }
 
+// GetCmdQueryMsgTaxRate implements the query msg-tax-rate command.
+func GetCmdQueryMsgTaxRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-tax-rate [msg-type-url] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the stability tax rate of a msg type in a denom asset",
+		Long: strings.TrimSpace(`
+Query the stability tax rate applied to the principal of the msg type in the denom asset,
+the tax rate or the tax rate of the denom scaled by the tax rate multiplier of the msg type.
+
+$ terrad query treasury msg-tax-rate /terra.wasm.v1beta1.MsgExecuteContract ukrw
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgTaxRate(context.Background(), &types.QueryMsgTaxRateRequest{
+				MsgTypeURL: args[0],
+				Denom:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryRewardWeight implements the query reward-weight command.
 func GetCmdQueryRewardWeight() *cobra.Command {
 	cmd := &cobra.Command{
@@ -307,3 +350,181 @@ func GetCmdQueryExemptlist() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "burn tax exemption list")
 	return cmd
 }
+
+// GetCmdQueryIndicatorHistory implements the query indicator-history command.
+func GetCmdQueryIndicatorHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indicator-history [from-epoch] [to-epoch]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Query the indicators and policy of each ended epoch",
+		Long: strings.TrimSpace(`
+Query the tax and seigniorage rewards, total staked luna, and the tax rate,
+reward weight and tax caps in effect for each ended epoch of the range.
+The range ends at the last ended epoch when to-epoch is omitted.
+
+$ terrad query treasury indicator-history 10 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIndicatorHistoryRequest{}
+			if len(args) > 0 {
+				if req.FromEpoch, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return fmt.Errorf("from epoch: %s", err)
+				}
+			}
+			if len(args) > 1 {
+				if req.ToEpoch, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+					return fmt.Errorf("to epoch: %s", err)
+				}
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			res, err := queryClient.IndicatorHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "indicator history")
+	return cmd
+}
+
+// GetCmdQueryPolicyDryRun implements the query policy-dry-run command.
+func GetCmdQueryPolicyDryRun() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy-dry-run",
+		Args:  cobra.NoArgs,
+		Short: "Query the policy the end of the current epoch would set",
+		Long: strings.TrimSpace(`
+Query the tax rate, reward weight and tax caps the end of the current epoch
+would set with the current state, along with the unclamped rates and the
+indicators they are computed from.
+
+$ terrad query treasury policy-dry-run
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PolicyDryRun(context.Background(), &types.QueryPolicyDryRunRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBurnTaxExemptionZones implements the query burn tax exemption zones command.
+func GetCmdQueryBurnTaxExemptionZones() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-tax-exemption-zones",
+		Args:  cobra.NoArgs,
+		Short: "Query all burn tax exemption zones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurnTaxExemptionZones(context.Background(), &types.QueryBurnTaxExemptionZonesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burn tax exemption zones")
+	return cmd
+}
+
+// GetCmdQueryBurnTaxExemptionZone implements the query burn tax exemption zone command.
+func GetCmdQueryBurnTaxExemptionZone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-tax-exemption-zone [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a burn tax exemption zone with its addresses",
+		Long: strings.TrimSpace(`
+Query a burn tax exemption zone with its member addresses.
+
+$ terrad query treasury burn-tax-exemption-zone exchange
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurnTaxExemptionZone(context.Background(), &types.QueryBurnTaxExemptionZoneRequest{Name: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burn tax exemption zone addresses")
+	return cmd
+}
+
+// GetCmdQueryBurnTaxExemptionZoneByAddress implements the query burn tax exemption zone by address command.
+func GetCmdQueryBurnTaxExemptionZoneByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-tax-exemption-zone-by-address [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the burn tax exemption zone of an address",
+		Long: strings.TrimSpace(`
+Query the burn tax exemption zone the address belongs to.
+
+$ terrad query treasury burn-tax-exemption-zone-by-address terra1dczz24r33fwlj0q5ra7rcdryjpk9hxm8rwy39t
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnTaxExemptionZoneByAddress(context.Background(), &types.QueryBurnTaxExemptionZoneByAddressRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
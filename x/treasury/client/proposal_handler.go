@@ -15,6 +15,9 @@ import (
 var (
 	ProposalAddBurnTaxExemptionAddressHandler    = govclient.NewProposalHandler(cli.ProposalAddBurnTaxExemptionAddressCmd, emptyRestHandler)
 	ProposalRemoveBurnTaxExemptionAddressHandler = govclient.NewProposalHandler(cli.ProposalRemoveBurnTaxExemptionAddressCmd, emptyRestHandler)
+	ProposalAddBurnTaxExemptionZoneHandler       = govclient.NewProposalHandler(cli.ProposalAddBurnTaxExemptionZoneCmd, emptyRestHandler)
+	ProposalModifyBurnTaxExemptionZoneHandler    = govclient.NewProposalHandler(cli.ProposalModifyBurnTaxExemptionZoneCmd, emptyRestHandler)
+	ProposalRemoveBurnTaxExemptionZoneHandler    = govclient.NewProposalHandler(cli.ProposalRemoveBurnTaxExemptionZoneCmd, emptyRestHandler)
 )
 
 func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
@@ -35,6 +35,15 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetTR(ctx, int64(epochState.Epoch), epochState.TaxReward)
 		keeper.SetSR(ctx, int64(epochState.Epoch), epochState.SeigniorageReward)
 		keeper.SetTSL(ctx, int64(epochState.Epoch), epochState.TotalStakedLuna)
+
+		// swap fees are unset for the epochs exported before they were recorded
+		if !epochState.SwapFee.IsNil() {
+			keeper.SetSF(ctx, int64(epochState.Epoch), epochState.SwapFee)
+		}
+
+		if epochState.Policy != nil {
+			keeper.SetEpochPolicy(ctx, int64(epochState.Epoch), *epochState.Policy)
+		}
 	}
 
 	// check if the module account exists
@@ -75,12 +84,19 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	curEpoch := keeper.GetEpoch(ctx)
 	for e := int64(0); e < curEpoch ||
 		(e == curEpoch && core.IsPeriodLastBlock(ctx, core.BlocksPerWeek)); e++ {
-		epochStates = append(epochStates, types.EpochState{
+		epochState := types.EpochState{
 			Epoch:             uint64(e),
 			TaxReward:         keeper.GetTR(ctx, e),
 			SeigniorageReward: keeper.GetSR(ctx, e),
 			TotalStakedLuna:   keeper.GetTSL(ctx, e),
-		})
+			SwapFee:           keeper.GetSF(ctx, e),
+		}
+
+		if policy, found := keeper.GetEpochPolicy(ctx, e); found {
+			epochState.Policy = &policy
+		}
+
+		epochStates = append(epochStates, epochState)
 	}
 
 	return types.NewGenesisState(params, taxRate, rewardWeight,
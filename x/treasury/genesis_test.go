@@ -9,6 +9,7 @@ import (
 
 	core "github.com/classic-terra/core/types"
 	"github.com/classic-terra/core/x/treasury/keeper"
+	"github.com/classic-terra/core/x/treasury/types"
 )
 
 func TestExportInitGenesis(t *testing.T) {
@@ -29,7 +30,17 @@ func TestExportInitGenesis(t *testing.T) {
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(0), sdk.NewInt(123))
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(1), sdk.NewInt(345))
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(2), sdk.NewInt(567))
+	input.TreasuryKeeper.SetSF(input.Ctx, int64(1), sdk.NewDec(345))
+	input.TreasuryKeeper.SetEpochPolicy(input.Ctx, int64(1), types.EpochPolicy{
+		TaxRate:      sdk.NewDecWithPrec(1, 3),
+		RewardWeight: sdk.NewDecWithPrec(5, 2),
+		TaxCaps:      sdk.NewCoins(sdk.NewInt64Coin("foo", 1234)),
+	})
 	genesis := ExportGenesis(input.Ctx, input.TreasuryKeeper)
+	require.Nil(t, genesis.EpochStates[0].Policy)
+	require.NotNil(t, genesis.EpochStates[1].Policy)
+	require.Equal(t, sdk.ZeroDec(), genesis.EpochStates[0].SwapFee)
+	require.Equal(t, sdk.NewDec(345), genesis.EpochStates[1].SwapFee)
 
 	newInput := keeper.CreateTestInput(t)
 	newInput.Ctx = newInput.Ctx.WithBlockHeight(int64(core.BlocksPerWeek) * 3)
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/treasury/types"
+)
+
+// GetBurnTaxExemptionZone returns the burn tax exemption zone of the name
+func (k Keeper) GetBurnTaxExemptionZone(ctx sdk.Context, name string) (zone types.BurnTaxExemptionZone, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBurnTaxExemptionZoneKey(name))
+	if bz == nil {
+		return types.BurnTaxExemptionZone{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &zone)
+	return zone, true
+}
+
+// SetBurnTaxExemptionZone stores the burn tax exemption zone, keeping its members
+func (k Keeper) SetBurnTaxExemptionZone(ctx sdk.Context, zone types.BurnTaxExemptionZone) {
+	ctx.KVStore(k.storeKey).Set(types.GetBurnTaxExemptionZoneKey(zone.Name), k.cdc.MustMarshal(&zone))
+}
+
+// DeleteBurnTaxExemptionZone deletes the burn tax exemption zone with all its members
+func (k Keeper) DeleteBurnTaxExemptionZone(ctx sdk.Context, name string) error {
+	if _, found := k.GetBurnTaxExemptionZone(ctx, name); !found {
+		return types.ErrNoSuchBurnTaxExemptionZone.Wrapf("zone = %s", name)
+	}
+
+	for _, address := range k.GetBurnTaxExemptionZoneAddresses(ctx, name) {
+		if err := k.RemoveBurnTaxExemptionZoneAddress(ctx, name, address); err != nil {
+			return err
+		}
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetBurnTaxExemptionZoneKey(name))
+	return nil
+}
+
+// IterateBurnTaxExemptionZones iterates over the burn tax exemption zones
+func (k Keeper) IterateBurnTaxExemptionZones(ctx sdk.Context, handler func(zone types.BurnTaxExemptionZone) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.BurnTaxExemptionZonePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var zone types.BurnTaxExemptionZone
+		k.cdc.MustUnmarshal(iter.Value(), &zone)
+		if handler(zone) {
+			break
+		}
+	}
+}
+
+// AddBurnTaxExemptionZoneAddress adds the address to the zone, an address belonging to one zone at most
+func (k Keeper) AddBurnTaxExemptionZoneAddress(ctx sdk.Context, name, address string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		panic(err)
+	}
+
+	if _, found := k.GetBurnTaxExemptionZone(ctx, name); !found {
+		return types.ErrNoSuchBurnTaxExemptionZone.Wrapf("zone = %s", name)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetBurnTaxExemptionZoneByAddressKey(address)); bz != nil {
+		return types.ErrAddressInOtherZone.Wrapf("address = %s, zone = %s", address, string(bz))
+	}
+
+	store.Set(types.GetBurnTaxExemptionZoneByAddressKey(address), []byte(name))
+	store.Set(types.GetBurnTaxExemptionZoneMemberKey(name, address), []byte{0x01})
+	k.setBurnTaxExemptionZoneMemberCount(ctx, k.getBurnTaxExemptionZoneMemberCount(ctx)+1)
+	return nil
+}
+
+// RemoveBurnTaxExemptionZoneAddress removes the address from the zone
+func (k Keeper) RemoveBurnTaxExemptionZoneAddress(ctx sdk.Context, name, address string) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetBurnTaxExemptionZoneMemberKey(name, address)) {
+		return types.ErrAddressNotInZone.Wrapf("address = %s, zone = %s", address, name)
+	}
+
+	store.Delete(types.GetBurnTaxExemptionZoneByAddressKey(address))
+	store.Delete(types.GetBurnTaxExemptionZoneMemberKey(name, address))
+	k.setBurnTaxExemptionZoneMemberCount(ctx, k.getBurnTaxExemptionZoneMemberCount(ctx)-1)
+	return nil
+}
+
+// getBurnTaxExemptionZoneMemberCount returns the number of addresses belonging to a zone
+func (k Keeper) getBurnTaxExemptionZoneMemberCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BurnTaxExemptionZoneMemberCountKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setBurnTaxExemptionZoneMemberCount stores the number of addresses belonging to a zone
+func (k Keeper) setBurnTaxExemptionZoneMemberCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.BurnTaxExemptionZoneMemberCountKey)
+		return
+	}
+
+	store.Set(types.BurnTaxExemptionZoneMemberCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// hasBurnTaxExemptionZoneMembers returns true when an address belongs to a zone. The check gates
+// the zone lookups of every taxed transfer and is not charged, so that the transfers keep their
+// former gas cost while no zone has members.
+func (k Keeper) hasBurnTaxExemptionZoneMembers(ctx sdk.Context) bool {
+	return ctx.MultiStore().GetKVStore(k.storeKey).Has(types.BurnTaxExemptionZoneMemberCountKey)
+}
+
+// GetBurnTaxExemptionZoneAddresses returns the member addresses of the zone
+func (k Keeper) GetBurnTaxExemptionZoneAddresses(ctx sdk.Context, name string) (addresses []string) {
+	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBurnTaxExemptionZoneMembersPrefix(name))
+
+	iter := sub.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addresses = append(addresses, string(iter.Key()))
+	}
+
+	return addresses
+}
+
+// GetBurnTaxExemptionZoneByAddress returns the zone the address belongs to
+func (k Keeper) GetBurnTaxExemptionZoneByAddress(ctx sdk.Context, address string) (types.BurnTaxExemptionZone, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	// flat cost presence check, most addresses belonging to no zone
+	if !store.Has(types.GetBurnTaxExemptionZoneByAddressKey(address)) {
+		return types.BurnTaxExemptionZone{}, false
+	}
+
+	return k.GetBurnTaxExemptionZone(ctx, string(store.Get(types.GetBurnTaxExemptionZoneByAddressKey(address))))
+}
+
+// IsBurnTaxExemptZoneTransfer returns true when the sender and the recipient belong to the same
+// enabled zone. A transfer with one side outside the zone is never exempted.
+func (k Keeper) IsBurnTaxExemptZoneTransfer(ctx sdk.Context, sender, recipient string) bool {
+	if !k.hasBurnTaxExemptionZoneMembers(ctx) {
+		return false
+	}
+
+	senderZone, found := k.GetBurnTaxExemptionZoneByAddress(ctx, sender)
+	if !found || !senderZone.Enabled {
+		return false
+	}
+
+	recipientZone, found := k.GetBurnTaxExemptionZoneByAddress(ctx, recipient)
+	return found && recipientZone.Name == senderZone.Name
+}
+
+// isBurnTaxExemptListTransfer returns true when the exemption list exempts the transfers among the
+// listed addresses: either none of them belongs to a zone, the list exempting its addresses with
+// each other as it always did, or all of them belong to the same enabled zone. A zone thereby
+// narrows the list exemption of its members to the transfers within the zone.
+func (k Keeper) isBurnTaxExemptListTransfer(ctx sdk.Context, addresses ...string) bool {
+	if !k.hasBurnTaxExemptionZoneMembers(ctx) {
+		return true
+	}
+
+	var zoneName string
+	members := 0
+	for _, address := range addresses {
+		zone, found := k.GetBurnTaxExemptionZoneByAddress(ctx, address)
+		if !found {
+			continue
+		}
+
+		if !zone.Enabled || (members != 0 && zone.Name != zoneName) {
+			return false
+		}
+
+		zoneName = zone.Name
+		members++
+	}
+
+	return members == 0 || members == len(addresses)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/treasury/types"
+)
+
+func TestBurnTaxExemptionZone(t *testing.T) {
+	input := CreateTestInput(t)
+	tk := input.TreasuryKeeper
+
+	exchange, other := Addrs[0].String(), Addrs[1].String()
+	require.ErrorIs(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange", exchange), types.ErrNoSuchBurnTaxExemptionZone)
+
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("exchange", false))
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("exchange2", false))
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange", exchange))
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange", other))
+
+	// an address belongs to one zone at most
+	require.ErrorIs(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange2", exchange), types.ErrAddressInOtherZone)
+	require.ElementsMatch(t, []string{exchange, other}, tk.GetBurnTaxExemptionZoneAddresses(input.Ctx, "exchange"))
+	require.Empty(t, tk.GetBurnTaxExemptionZoneAddresses(input.Ctx, "exchange2"))
+
+	zone, found := tk.GetBurnTaxExemptionZoneByAddress(input.Ctx, exchange)
+	require.True(t, found)
+	require.Equal(t, "exchange", zone.Name)
+
+	require.ErrorIs(t, tk.RemoveBurnTaxExemptionZoneAddress(input.Ctx, "exchange2", other), types.ErrAddressNotInZone)
+	require.NoError(t, tk.RemoveBurnTaxExemptionZoneAddress(input.Ctx, "exchange", other))
+	_, found = tk.GetBurnTaxExemptionZoneByAddress(input.Ctx, other)
+	require.False(t, found)
+
+	require.NoError(t, tk.DeleteBurnTaxExemptionZone(input.Ctx, "exchange"))
+	_, found = tk.GetBurnTaxExemptionZoneByAddress(input.Ctx, exchange)
+	require.False(t, found)
+	require.ErrorIs(t, tk.DeleteBurnTaxExemptionZone(input.Ctx, "exchange"), types.ErrNoSuchBurnTaxExemptionZone)
+}
+
+func TestIsBurnTaxExemptZoneTransfer(t *testing.T) {
+	input := CreateTestInput(t)
+	tk := input.TreasuryKeeper
+
+	exchangeA, exchangeB, other := Addrs[0].String(), Addrs[1].String(), Addrs[2].String()
+	outsider := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("exchange", true))
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("other", true))
+	require.False(t, tk.IsBurnTaxExemptZoneTransfer(input.Ctx, exchangeA, exchangeB))
+
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange", exchangeA))
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange", exchangeB))
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "other", other))
+
+	// only transfers within a zone are exempted
+	require.True(t, tk.IsBurnTaxExemptZoneTransfer(input.Ctx, exchangeA, exchangeB))
+	require.False(t, tk.IsBurnTaxExemptZoneTransfer(input.Ctx, exchangeA, other))
+	require.False(t, tk.IsBurnTaxExemptZoneTransfer(input.Ctx, exchangeA, outsider))
+	require.False(t, tk.IsBurnTaxExemptZoneTransfer(input.Ctx, outsider, exchangeA))
+
+	// a disabled zone exempts none of the transfers of its members
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("exchange", false))
+	require.False(t, tk.IsBurnTaxExemptZoneTransfer(input.Ctx, exchangeA, exchangeB))
+	require.False(t, tk.IsBurnTaxExemptZoneTransfer(input.Ctx, exchangeA, outsider))
+
+	// the zones are skipped again once they have no members
+	require.NoError(t, tk.DeleteBurnTaxExemptionZone(input.Ctx, "exchange"))
+	require.NoError(t, tk.DeleteBurnTaxExemptionZone(input.Ctx, "other"))
+	require.False(t, tk.hasBurnTaxExemptionZoneMembers(input.Ctx))
+}
+
+func TestBurnTaxExemptionListWithZones(t *testing.T) {
+	input := CreateTestInput(t)
+	tk := input.TreasuryKeeper
+
+	exchangeA, exchangeB, other := Addrs[0].String(), Addrs[1].String(), Addrs[2].String()
+	listed := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	for _, address := range []string{exchangeA, exchangeB, other, listed} {
+		tk.AddBurnTaxExemptionAddress(input.Ctx, address)
+	}
+
+	// the listed addresses outside any zone are exempted with each other
+	require.True(t, tk.HasBurnTaxExemptionAddress(input.Ctx, exchangeA, other, listed))
+
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("exchange", true))
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange", exchangeA))
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "exchange", exchangeB))
+	require.True(t, tk.HasBurnTaxExemptionAddress(input.Ctx, other, listed))
+
+	// listed zone members are only exempted within their zone
+	require.True(t, tk.HasBurnTaxExemptionAddress(input.Ctx, exchangeA, exchangeB))
+	require.False(t, tk.HasBurnTaxExemptionAddress(input.Ctx, exchangeA, listed))
+	require.False(t, tk.HasBurnTaxExemptionAddressForDenom(input.Ctx, "ukrw", listed, exchangeB))
+
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("other", true))
+	require.NoError(t, tk.AddBurnTaxExemptionZoneAddress(input.Ctx, "other", other))
+	require.False(t, tk.HasBurnTaxExemptionAddress(input.Ctx, exchangeA, other))
+
+	// a disabled zone exempts none of the transfers of its listed members
+	tk.SetBurnTaxExemptionZone(input.Ctx, types.NewBurnTaxExemptionZone("exchange", false))
+	require.False(t, tk.HasBurnTaxExemptionAddress(input.Ctx, exchangeA, exchangeB))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ukrw", 1000))
+	require.Equal(t, coins, tk.FilterBurnTaxExemptCoins(input.Ctx, coins, exchangeA, exchangeB))
+	require.True(t, tk.FilterBurnTaxExemptCoins(input.Ctx, coins, listed, listed).IsZero())
+}
+
+func TestBurnTaxExemptionZoneProposals(t *testing.T) {
+	input := CreateTestInput(t)
+	tk := input.TreasuryKeeper
+
+	addProposal := types.NewAddBurnTaxExemptionZoneProposal("title", "description", "exchange", true, []string{Addrs[0].String(), Addrs[1].String()})
+	require.NoError(t, addProposal.ValidateBasic())
+	require.NoError(t, HandleAddBurnTaxExemptionZoneProposal(input.Ctx, tk, addProposal.(*types.AddBurnTaxExemptionZoneProposal)))
+	require.ErrorIs(t, HandleAddBurnTaxExemptionZoneProposal(input.Ctx, tk, addProposal.(*types.AddBurnTaxExemptionZoneProposal)), types.ErrBurnTaxExemptionZoneExists)
+
+	modifyProposal := types.NewModifyBurnTaxExemptionZoneProposal("title", "description", "exchange", false, []string{Addrs[2].String()}, []string{Addrs[0].String()})
+	require.NoError(t, modifyProposal.ValidateBasic())
+	require.NoError(t, HandleModifyBurnTaxExemptionZoneProposal(input.Ctx, tk, modifyProposal.(*types.ModifyBurnTaxExemptionZoneProposal)))
+
+	zone, found := tk.GetBurnTaxExemptionZone(input.Ctx, "exchange")
+	require.True(t, found)
+	require.Equal(t, types.NewBurnTaxExemptionZone("exchange", false), zone)
+	require.ElementsMatch(t, []string{Addrs[1].String(), Addrs[2].String()}, tk.GetBurnTaxExemptionZoneAddresses(input.Ctx, "exchange"))
+
+	removeProposal := types.NewRemoveBurnTaxExemptionZoneProposal("title", "description", "exchange")
+	require.NoError(t, HandleRemoveBurnTaxExemptionZoneProposal(input.Ctx, tk, removeProposal.(*types.RemoveBurnTaxExemptionZoneProposal)))
+	_, found = tk.GetBurnTaxExemptionZone(input.Ctx, "exchange")
+	require.False(t, found)
+
+	// invalid contents
+	require.Error(t, types.NewAddBurnTaxExemptionZoneProposal("title", "description", " ", false, nil).ValidateBasic())
+	require.Error(t, types.NewAddBurnTaxExemptionZoneProposal("title", "description", "exchange", false, []string{Addrs[0].String(), Addrs[0].String()}).ValidateBasic())
+	require.Error(t, types.NewModifyBurnTaxExemptionZoneProposal("title", "description", "exchange", false, []string{Addrs[0].String()}, []string{Addrs[0].String()}).ValidateBasic())
+}
@@ -7,7 +7,12 @@ import (
 
 func HandleAddBurnTaxExemptionAddressProposal(ctx sdk.Context, k Keeper, p *types.AddBurnTaxExemptionAddressProposal) error {
 	for _, address := range p.Addresses {
-		k.AddBurnTaxExemptionAddress(ctx, address)
+		exemption := types.NewBurnTaxExemption(address, p.ExpiryHeight, p.ExpiryTime, p.Denoms)
+		if exemption.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+			return types.ErrBurnTaxExemptionExpired.Wrapf("address = %s", address)
+		}
+
+		k.SetBurnTaxExemption(ctx, exemption)
 	}
 
 	return nil
@@ -23,3 +28,45 @@ func HandleRemoveBurnTaxExemptionAddressProposal(ctx sdk.Context, k Keeper, p *t
 
 	return nil
 }
+
+func HandleAddBurnTaxExemptionZoneProposal(ctx sdk.Context, k Keeper, p *types.AddBurnTaxExemptionZoneProposal) error {
+	if _, found := k.GetBurnTaxExemptionZone(ctx, p.Name); found {
+		return types.ErrBurnTaxExemptionZoneExists.Wrapf("zone = %s", p.Name)
+	}
+
+	k.SetBurnTaxExemptionZone(ctx, types.NewBurnTaxExemptionZone(p.Name, p.Enabled))
+
+	for _, address := range p.Addresses {
+		if err := k.AddBurnTaxExemptionZoneAddress(ctx, p.Name, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func HandleModifyBurnTaxExemptionZoneProposal(ctx sdk.Context, k Keeper, p *types.ModifyBurnTaxExemptionZoneProposal) error {
+	if _, found := k.GetBurnTaxExemptionZone(ctx, p.Name); !found {
+		return types.ErrNoSuchBurnTaxExemptionZone.Wrapf("zone = %s", p.Name)
+	}
+
+	k.SetBurnTaxExemptionZone(ctx, types.NewBurnTaxExemptionZone(p.Name, p.Enabled))
+
+	for _, address := range p.RemoveAddresses {
+		if err := k.RemoveBurnTaxExemptionZoneAddress(ctx, p.Name, address); err != nil {
+			return err
+		}
+	}
+
+	for _, address := range p.AddAddresses {
+		if err := k.AddBurnTaxExemptionZoneAddress(ctx, p.Name, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func HandleRemoveBurnTaxExemptionZoneProposal(ctx sdk.Context, k Keeper, p *types.RemoveBurnTaxExemptionZoneProposal) error {
+	return k.DeleteBurnTaxExemptionZone(ctx, p.Name)
+}
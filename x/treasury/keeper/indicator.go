@@ -20,6 +20,7 @@ func (k Keeper) GetEpoch(ctx sdk.Context) int64 {
 // - TR: Computes the Tax Reward
 // - TSL: Total Staked Luna
 // - TRL: Computes the Tax Reward per unit Luna (TR/TSL)
+// - SF: Computes the Swap Fees charged by the market
 
 // alignCoins align the coins to the given denom through the market swap
 func (k Keeper) alignCoins(ctx sdk.Context, coins sdk.DecCoins, denom string) (alignedAmt sdk.Dec) {
@@ -64,6 +65,29 @@ func (k Keeper) UpdateIndicators(ctx sdk.Context) {
 	SR := k.alignCoins(ctx, seigniorageRewards, core.MicroSDRDenom)
 
 	k.SetSR(ctx, epoch, SR)
+
+	// Compute Swap Fees (SF)
+	SF := k.alignCoins(ctx, k.epochSwapFees(ctx), core.MicroSDRDenom)
+
+	k.SetSF(ctx, epoch, SF)
+}
+
+// epochSwapFees returns the swap fees charged during the epoch, from the swap statistics
+// of the market periods overlapping the epoch. The statistics cover at most the market's
+// retention, and a period straddling the epoch start counts in full.
+func (k Keeper) epochSwapFees(ctx sdk.Context) sdk.DecCoins {
+	period := k.marketKeeper.SwapStatisticsPeriod(ctx)
+	epochStart := uint64(k.GetEpoch(ctx)) * core.BlocksPerWeek
+	window := uint64(ctx.BlockHeight())/period - epochStart/period + 1
+
+	fees := sdk.DecCoins{}
+	for _, statistics := range k.marketKeeper.AggregateSwapStatistics(ctx, window, "", "") {
+		if statistics.SwapFee.IsPositive() {
+			fees = fees.Add(sdk.NewDecCoinFromDec(statistics.AskDenom, sdk.NewDecFromInt(statistics.SwapFee)))
+		}
+	}
+
+	return fees
 }
 
 // TRL returns Tax Rewards per Luna for the epoch
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	core "github.com/classic-terra/core/types"
+	marketkeeper "github.com/classic-terra/core/x/market/keeper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,6 +37,32 @@ func TestFeeRewardsForEpoch(t *testing.T) {
 	require.Equal(t, sdk.NewDec(1111).MulInt64(core.MicroUnit), TR)
 }
 
+func TestSwapFeesForEpoch(t *testing.T) {
+	input := CreateTestInput(t)
+	marketKeeper := input.MarketKeeper.(marketkeeper.Keeper)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.NewDec(1))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(10))
+
+	// swaps of the previous epoch are not counted
+	epochStart := int64(core.BlocksPerWeek) * 2
+	marketKeeper.RecordSwapStatistics(input.Ctx.WithBlockHeight(epochStart-1),
+		sdk.NewInt64Coin(core.MicroLunaDenom, 1000), sdk.NewInt64Coin(core.MicroSDRDenom, 1000), sdk.NewInt64Coin(core.MicroSDRDenom, 700))
+
+	// the swap fees of every period of the epoch are counted in their ask denom
+	marketKeeper.RecordSwapStatistics(input.Ctx.WithBlockHeight(epochStart),
+		sdk.NewInt64Coin(core.MicroLunaDenom, 1000), sdk.NewInt64Coin(core.MicroSDRDenom, 1000), sdk.NewInt64Coin(core.MicroSDRDenom, 500))
+	marketKeeper.RecordSwapStatistics(input.Ctx.WithBlockHeight(epochStart+int64(core.BlocksPerDay)*3),
+		sdk.NewInt64Coin(core.MicroSDRDenom, 1000), sdk.NewInt64Coin(core.MicroKRWDenom, 10000), sdk.NewInt64Coin(core.MicroKRWDenom, 1000))
+
+	input.Ctx = input.Ctx.WithBlockHeight(epochStart + int64(core.BlocksPerWeek) - 1)
+	input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+
+	// Get Swap Fees (SF)
+	SF := input.TreasuryKeeper.GetSF(input.Ctx, input.TreasuryKeeper.GetEpoch(input.Ctx))
+	require.Equal(t, sdk.NewDec(600), SF)
+}
+
 func TestSeigniorageRewardsForEpoch(t *testing.T) {
 	input, _ := setupValidators(t)
 
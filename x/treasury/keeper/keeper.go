@@ -101,6 +101,59 @@ func (k Keeper) SetTaxRate(ctx sdk.Context, taxRate sdk.Dec) {
 	store.Set(types.TaxRateKey, b)
 }
 
+// GetTaxRateMultiplier returns the tax rate multiplier of the msg type, one when not set
+func (k Keeper) GetTaxRateMultiplier(ctx sdk.Context, msgTypeURL string) sdk.Dec {
+	if !k.isListParamSet(ctx, types.KeyTaxRateMultipliers) {
+		return sdk.OneDec()
+	}
+
+	for _, m := range k.TaxRateMultipliers(ctx) {
+		if m.MsgTypeURL == msgTypeURL {
+			return m.Multiplier
+		}
+	}
+
+	return sdk.OneDec()
+}
+
+// GetDenomTaxRate returns the tax rate of the denom, the tax rate when not overridden
+func (k Keeper) GetDenomTaxRate(ctx sdk.Context, denom string) sdk.Dec {
+	if taxRate, found := k.GetDenomTaxRateOverride(ctx, denom); found {
+		return taxRate
+	}
+
+	return k.GetTaxRate(ctx)
+}
+
+// GetDenomTaxRateOverride returns the tax rate overriding the tax rate for the denom, if any
+func (k Keeper) GetDenomTaxRateOverride(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if !k.isListParamSet(ctx, types.KeyDenomTaxRates) {
+		return sdk.Dec{}, false
+	}
+
+	for _, r := range k.DenomTaxRates(ctx) {
+		if r.Denom == denom {
+			return r.TaxRate, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// isListParamSet returns true when the list param holds entries. The check gates the reads of the
+// tax rate schedule by every taxed msg and is not charged, so that the msgs keep their former gas
+// cost while no multiplier or denom tax rate is set.
+func (k Keeper) isListParamSet(ctx sdk.Context, key []byte) bool {
+	bz := k.paramSpace.GetRaw(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), key)
+	return len(bz) != 0 && string(bz) != "null" && string(bz) != "[]"
+}
+
+// GetMsgTaxRate returns the tax rate applied to the principal of the msg type in the denom. The params
+// do not bound the denom tax rate scaled by the multiplier, so the rate is capped at one here.
+func (k Keeper) GetMsgTaxRate(ctx sdk.Context, msgTypeURL, denom string) sdk.Dec {
+	return sdk.MinDec(k.GetDenomTaxRate(ctx, denom).Mul(k.GetTaxRateMultiplier(ctx, msgTypeURL)), sdk.OneDec())
+}
+
 // GetRewardWeight loads the reward weight
 func (k Keeper) GetRewardWeight(ctx sdk.Context) sdk.Dec {
 	store := ctx.KVStore(k.storeKey)
@@ -351,14 +404,132 @@ func (k Keeper) ClearTSLs(ctx sdk.Context) {
 	}
 }
 
+// GetSF returns the swap fees for the epoch
+func (k Keeper) GetSF(ctx sdk.Context, epoch int64) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSFKey(epoch))
+
+	dp := sdk.DecProto{}
+	if bz == nil {
+		dp.Dec = sdk.ZeroDec()
+	} else {
+		k.cdc.MustUnmarshal(bz, &dp)
+	}
+
+	return dp.Dec
+}
+
+// SetSF stores the swap fees for the epoch
+func (k Keeper) SetSF(ctx sdk.Context, epoch int64, sf sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: sf})
+	store.Set(types.GetSFKey(epoch), bz)
+}
+
+// ClearSFs delete all swap fees from the store
+func (k Keeper) ClearSFs(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.SFKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// GetEpochPolicy returns the policy recorded for the epoch
+func (k Keeper) GetEpochPolicy(ctx sdk.Context, epoch int64) (policy types.EpochPolicy, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetEpochPolicyKey(epoch))
+	if bz == nil {
+		return types.EpochPolicy{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// SetEpochPolicy stores the policy of the epoch
+func (k Keeper) SetEpochPolicy(ctx sdk.Context, epoch int64, policy types.EpochPolicy) {
+	ctx.KVStore(k.storeKey).Set(types.GetEpochPolicyKey(epoch), k.cdc.MustMarshal(&policy))
+}
+
+// RecordEpochPolicy records the tax rate, reward weight and tax caps in effect during the current epoch
+func (k Keeper) RecordEpochPolicy(ctx sdk.Context) {
+	taxCaps := sdk.Coins{}
+	k.IterateTaxCap(ctx, func(denom string, taxCap sdk.Int) bool {
+		taxCaps = append(taxCaps, sdk.NewCoin(denom, taxCap))
+		return false
+	})
+
+	// the tax policy cap applies to the denoms without a registered tax cap
+	if policyCap := k.TaxPolicy(ctx).Cap; taxCaps.AmountOf(policyCap.Denom).IsZero() {
+		taxCaps = append(taxCaps, policyCap)
+	}
+
+	k.SetEpochPolicy(ctx, k.GetEpoch(ctx), types.EpochPolicy{
+		TaxRate:      k.GetTaxRate(ctx),
+		RewardWeight: k.GetRewardWeight(ctx),
+		TaxCaps:      sdk.NewCoins(taxCaps...),
+	})
+}
+
 // Burn tax exemption list
+
+// AddBurnTaxExemptionAddress exempts the address from the burn tax permanently and for every denom
 func (k Keeper) AddBurnTaxExemptionAddress(ctx sdk.Context, address string) {
-	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+	k.SetBurnTaxExemption(ctx, types.NewBurnTaxExemption(address, 0, nil, nil))
+}
+
+// SetBurnTaxExemption stores the exemption, replacing the previous one of the address
+func (k Keeper) SetBurnTaxExemption(ctx sdk.Context, exemption types.BurnTaxExemption) {
+	if _, err := sdk.AccAddressFromBech32(exemption.Address); err != nil {
 		panic(err)
 	}
 
+	if previous, found := k.GetBurnTaxExemption(ctx, exemption.Address); found {
+		k.deleteBurnTaxExemptionExpiryQueue(ctx, previous)
+	}
+
 	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
-	sub.Set([]byte(address), []byte{0x01})
+	sub.Set([]byte(exemption.Address), k.cdc.MustMarshal(&exemption))
+	k.setBurnTaxExemptionExpiryQueue(ctx, exemption)
+}
+
+// setBurnTaxExemptionExpiryQueue indexes the exemption by its expiry height and time
+func (k Keeper) setBurnTaxExemptionExpiryQueue(ctx sdk.Context, exemption types.BurnTaxExemption) {
+	store := ctx.KVStore(k.storeKey)
+	if exemption.ExpiryHeight != 0 {
+		store.Set(types.GetBurnTaxExemptionHeightQueueKey(exemption.ExpiryHeight, exemption.Address), []byte{0x01})
+	}
+
+	if exemption.ExpiryTime != nil {
+		store.Set(types.GetBurnTaxExemptionTimeQueueKey(*exemption.ExpiryTime, exemption.Address), []byte{0x01})
+	}
+}
+
+// deleteBurnTaxExemptionExpiryQueue removes the expiry height and time index of the exemption
+func (k Keeper) deleteBurnTaxExemptionExpiryQueue(ctx sdk.Context, exemption types.BurnTaxExemption) {
+	store := ctx.KVStore(k.storeKey)
+	if exemption.ExpiryHeight != 0 {
+		store.Delete(types.GetBurnTaxExemptionHeightQueueKey(exemption.ExpiryHeight, exemption.Address))
+	}
+
+	if exemption.ExpiryTime != nil {
+		store.Delete(types.GetBurnTaxExemptionTimeQueueKey(*exemption.ExpiryTime, exemption.Address))
+	}
+}
+
+// GetBurnTaxExemption returns the stored exemption of the address, expired or not
+func (k Keeper) GetBurnTaxExemption(ctx sdk.Context, address string) (exemption types.BurnTaxExemption, found bool) {
+	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
+	bz := sub.Get([]byte(address))
+	if bz == nil {
+		return types.BurnTaxExemption{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &exemption)
+	return exemption, true
 }
 
 func (k Keeper) RemoveBurnTaxExemptionAddress(ctx sdk.Context, address string) error {
@@ -366,24 +537,134 @@ func (k Keeper) RemoveBurnTaxExemptionAddress(ctx sdk.Context, address string) e
 		panic(err)
 	}
 
-	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
-
-	if !sub.Has([]byte(address)) {
+	exemption, found := k.GetBurnTaxExemption(ctx, address)
+	if !found {
 		return types.ErrNoSuchBurnTaxExemptionAddress.Wrapf("address = %s", address)
 	}
 
+	k.deleteBurnTaxExemptionExpiryQueue(ctx, exemption)
+
+	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
 	sub.Delete([]byte(address))
 	return nil
 }
 
-func (k Keeper) HasBurnTaxExemptionAddress(ctx sdk.Context, addresses ...string) bool {
+// IterateBurnTaxExemptions iterates over the stored exemptions, expired or not
+func (k Keeper) IterateBurnTaxExemptions(ctx sdk.Context, handler func(exemption types.BurnTaxExemption) (stop bool)) {
 	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
 
+	iter := sub.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var exemption types.BurnTaxExemption
+		k.cdc.MustUnmarshal(iter.Value(), &exemption)
+		if handler(exemption) {
+			break
+		}
+	}
+}
+
+// HasBurnTaxExemptionAddress returns true when all the addresses hold an unexpired exemption
+// covering every denom and the list exempts the transfers among them
+func (k Keeper) HasBurnTaxExemptionAddress(ctx sdk.Context, addresses ...string) bool {
+	for _, address := range addresses {
+		exemption, found := k.getActiveBurnTaxExemption(ctx, address)
+		if !found || len(exemption.Denoms) != 0 {
+			return false
+		}
+	}
+
+	return k.isBurnTaxExemptListTransfer(ctx, addresses...)
+}
+
+// HasBurnTaxExemptionAddressForDenom returns true when all the addresses hold an unexpired
+// exemption covering the denom and the list exempts the transfers among them
+func (k Keeper) HasBurnTaxExemptionAddressForDenom(ctx sdk.Context, denom string, addresses ...string) bool {
 	for _, address := range addresses {
-		if !sub.Has([]byte(address)) {
+		exemption, found := k.getActiveBurnTaxExemption(ctx, address)
+		if !found || !exemption.CoversDenom(denom) {
 			return false
 		}
 	}
 
-	return true
+	return k.isBurnTaxExemptListTransfer(ctx, addresses...)
+}
+
+// FilterBurnTaxExemptCoins drops the coins of the denoms all the addresses are exempted from the
+// burn tax for. The exemption of each address is read once for all the coins.
+func (k Keeper) FilterBurnTaxExemptCoins(ctx sdk.Context, coins sdk.Coins, addresses ...string) sdk.Coins {
+	exemptions := make([]types.BurnTaxExemption, 0, len(addresses))
+	for _, address := range addresses {
+		exemption, found := k.getActiveBurnTaxExemption(ctx, address)
+		if !found {
+			return coins
+		}
+
+		exemptions = append(exemptions, exemption)
+	}
+
+	if !k.isBurnTaxExemptListTransfer(ctx, addresses...) {
+		return coins
+	}
+
+	taxable := sdk.Coins{}
+	for _, coin := range coins {
+		for _, exemption := range exemptions {
+			if !exemption.CoversDenom(coin.Denom) {
+				taxable = append(taxable, coin)
+				break
+			}
+		}
+	}
+
+	return taxable
+}
+
+// getActiveBurnTaxExemption returns the exemption of the address unless it is missing or expired.
+// The flat cost presence check avoids reading the value of the addresses without exemption.
+func (k Keeper) getActiveBurnTaxExemption(ctx sdk.Context, address string) (types.BurnTaxExemption, bool) {
+	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
+	if !sub.Has([]byte(address)) {
+		return types.BurnTaxExemption{}, false
+	}
+
+	exemption, _ := k.GetBurnTaxExemption(ctx, address)
+	if exemption.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return types.BurnTaxExemption{}, false
+	}
+
+	return exemption, true
+}
+
+// PruneExpiredBurnTaxExemptions deletes the expired exemptions and returns their addresses,
+// only walking the expiry queues up to the block height and time
+func (k Keeper) PruneExpiredBurnTaxExemptions(ctx sdk.Context) (pruned []string) {
+	store := ctx.KVStore(k.storeKey)
+	seen := make(map[string]bool)
+
+	collect := func(queuePrefix []byte, end []byte, keyLen int) {
+		iter := store.Iterator(queuePrefix, end)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			address := string(iter.Key()[keyLen:])
+			if !seen[address] {
+				seen[address] = true
+				pruned = append(pruned, address)
+			}
+		}
+	}
+
+	heightEnd := types.GetBurnTaxExemptionHeightQueuePrefix(ctx.BlockHeight() + 1)
+	collect(types.BurnTaxExemptionHeightQueuePrefix, heightEnd, len(heightEnd))
+
+	timeEnd := types.GetBurnTaxExemptionTimeQueuePrefix(ctx.BlockTime())
+	collect(types.BurnTaxExemptionTimeQueuePrefix, sdk.PrefixEndBytes(timeEnd), len(timeEnd))
+
+	for _, address := range pruned {
+		if err := k.RemoveBurnTaxExemptionAddress(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+
+	return pruned
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -181,3 +182,88 @@ func TestBurnTaxExemptionList(t *testing.T) {
 	input.TreasuryKeeper.RemoveBurnTaxExemptionAddress(input.Ctx, address.String())
 	require.False(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, address.String()))
 }
+
+func TestBurnTaxExemptionScope(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(100).WithBlockTime(time.Unix(1000, 0))
+
+	heightBound := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	timeBound := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	scoped := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	expiryTime := time.Unix(2000, 0)
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(heightBound, 200, nil, nil))
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(timeBound, 0, &expiryTime, nil))
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(scoped, 0, nil, []string{core.MicroUSDDenom}))
+
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, heightBound, timeBound))
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddressForDenom(input.Ctx, core.MicroKRWDenom, heightBound, timeBound))
+
+	// a scoped exemption only covers its denoms
+	require.False(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, scoped))
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddressForDenom(input.Ctx, core.MicroUSDDenom, scoped, heightBound))
+	require.False(t, input.TreasuryKeeper.HasBurnTaxExemptionAddressForDenom(input.Ctx, core.MicroKRWDenom, scoped, heightBound))
+
+	// expired exemptions are ignored before being pruned
+	expiredCtx := input.Ctx.WithBlockHeight(200)
+	require.False(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(expiredCtx, heightBound))
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(expiredCtx, timeBound))
+
+	expiredCtx = input.Ctx.WithBlockTime(expiryTime)
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(expiredCtx, heightBound))
+	require.False(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(expiredCtx, timeBound))
+
+	require.Equal(t, []string{heightBound}, input.TreasuryKeeper.PruneExpiredBurnTaxExemptions(input.Ctx.WithBlockHeight(200)))
+	_, found := input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, heightBound)
+	require.False(t, found)
+	_, found = input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, timeBound)
+	require.True(t, found)
+
+	// replacing an exemption drops it from the expiry queue of the previous one
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(scoped, 300, nil, nil))
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(scoped, 0, nil, nil))
+	require.Empty(t, input.TreasuryKeeper.PruneExpiredBurnTaxExemptions(input.Ctx.WithBlockHeight(300)))
+
+	require.Equal(t, []string{timeBound}, input.TreasuryKeeper.PruneExpiredBurnTaxExemptions(input.Ctx.WithBlockTime(expiryTime)))
+	require.Empty(t, input.TreasuryKeeper.PruneExpiredBurnTaxExemptions(input.Ctx.WithBlockTime(expiryTime)))
+	_, found = input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, scoped)
+	require.True(t, found)
+}
+
+func TestAddBurnTaxExemptionAddressProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(100)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	proposal := types.NewAddBurnTaxExemptionAddressProposal("title", "description", []string{address}, 200, nil, []string{core.MicroUSDDenom})
+	require.NoError(t, HandleAddBurnTaxExemptionAddressProposal(input.Ctx, input.TreasuryKeeper, proposal.(*types.AddBurnTaxExemptionAddressProposal)))
+
+	exemption, found := input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, address)
+	require.True(t, found)
+	require.Equal(t, types.NewBurnTaxExemption(address, 200, nil, []string{core.MicroUSDDenom}), exemption)
+
+	// an exemption expiring before the proposal passes is refused
+	proposal = types.NewAddBurnTaxExemptionAddressProposal("title", "description", []string{address}, 100, nil, nil)
+	require.ErrorIs(t, HandleAddBurnTaxExemptionAddressProposal(input.Ctx, input.TreasuryKeeper, proposal.(*types.AddBurnTaxExemptionAddressProposal)), types.ErrBurnTaxExemptionExpired)
+}
+
+func TestMigrate3to4BurnTaxExemptionList(t *testing.T) {
+	input := CreateTestInput(t)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	scoped := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	// legacy marker of the previous store layout
+	input.Ctx.KVStore(input.TreasuryKeeper.storeKey).Set(append(types.BurnTaxExemptionListPrefix, []byte(address)...), []byte{0x01})
+	input.TreasuryKeeper.SetBurnTaxExemption(input.Ctx, types.NewBurnTaxExemption(scoped, 10, nil, []string{core.MicroUSDDenom}))
+
+	require.NoError(t, NewMigrator(input.TreasuryKeeper).Migrate3to4(input.Ctx))
+
+	exemption, found := input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, address)
+	require.True(t, found)
+	require.Equal(t, types.NewBurnTaxExemption(address, 0, nil, nil), exemption)
+
+	exemption, found = input.TreasuryKeeper.GetBurnTaxExemption(input.Ctx, scoped)
+	require.True(t, found)
+	require.Equal(t, types.NewBurnTaxExemption(scoped, 10, nil, []string{core.MicroUSDDenom}), exemption)
+}
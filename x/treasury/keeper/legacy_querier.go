@@ -53,10 +53,18 @@ func queryBurnTaxExemptionList(ctx sdk.Context, req abci.RequestQuery, k Keeper,
 
 	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
 	var addresses []string
+	var exemptions []types.BurnTaxExemption
 
 	pageRes, err := query.FilteredPaginate(sub, params.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		address := string(key)
-		addresses = append(addresses, address)
+		var exemption types.BurnTaxExemption
+		if err := k.cdc.Unmarshal(value, &exemption); err != nil {
+			return false, err
+		}
+
+		if accumulate {
+			addresses = append(addresses, string(key))
+			exemptions = append(exemptions, exemption)
+		}
 
 		return true, nil
 	})
@@ -67,6 +75,7 @@ func queryBurnTaxExemptionList(ctx sdk.Context, req abci.RequestQuery, k Keeper,
 	burnTaxExemptionListRes := &types.QueryBurnTaxExemptionListResponse{
 		Addresses:  addresses,
 		Pagination: pageRes,
+		Exemptions: exemptions,
 	}
 
 	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, burnTaxExemptionListRes)
@@ -342,6 +342,9 @@ func TestLegacyQueryBurnTaxExemptionList(t *testing.T) {
 			NextKey: nil,
 			Total:   1,
 		},
+		Exemptions: []types.BurnTaxExemption{
+			types.NewBurnTaxExemption("terra1dczz24r33fwlj0q5ra7rcdryjpk9hxm8rwy39t", 0, nil, nil),
+		},
 	}
 
 	queriedRes := getQueriedBurnTaxExemptionList(t, input.Ctx, input.Cdc, querier)
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/classic-terra/core/x/treasury/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -81,3 +84,35 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+// The burn tax exemption list used to hold a 0x01 marker per address, which
+// becomes a permanent exemption covering every denom.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	sub := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.BurnTaxExemptionListPrefix)
+
+	var addresses []string
+	iter := sub.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if bytes.Equal(iter.Value(), []byte{0x01}) {
+			addresses = append(addresses, string(iter.Key()))
+		}
+	}
+	iter.Close()
+
+	for _, address := range addresses {
+		// drop the legacy marker first, which does not decode as an exemption
+		sub.Delete([]byte(address))
+		m.keeper.AddBurnTaxExemptionAddress(ctx, address)
+	}
+
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.SetTaxRateMultipliers(ctx, types.DefaultTaxRateMultipliers)
+	m.keeper.SetDenomTaxRates(ctx, types.DefaultDenomTaxRates)
+
+	return nil
+}
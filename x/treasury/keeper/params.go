@@ -66,6 +66,26 @@ func (k Keeper) SetMinInitialDepositRatio(ctx sdk.Context, minInitialDepositRati
 	k.paramSpace.Set(ctx, types.KeyMinInitialDepositRatio, minInitialDepositRatio)
 }
 
+// TaxRateMultipliers returns the tax rate multipliers of the msg types
+func (k Keeper) TaxRateMultipliers(ctx sdk.Context) (res []types.TaxRateMultiplier) {
+	k.paramSpace.Get(ctx, types.KeyTaxRateMultipliers, &res)
+	return
+}
+
+func (k Keeper) SetTaxRateMultipliers(ctx sdk.Context, multipliers []types.TaxRateMultiplier) {
+	k.paramSpace.Set(ctx, types.KeyTaxRateMultipliers, multipliers)
+}
+
+// DenomTaxRates returns the tax rates overriding the tax rate for some denoms
+func (k Keeper) DenomTaxRates(ctx sdk.Context) (res []types.DenomTaxRate) {
+	k.paramSpace.Get(ctx, types.KeyDenomTaxRates, &res)
+	return
+}
+
+func (k Keeper) SetDenomTaxRates(ctx sdk.Context, denomTaxRates []types.DenomTaxRate) {
+	k.paramSpace.Set(ctx, types.KeyDenomTaxRates, denomTaxRates)
+}
+
 // GetParams returns the total set of treasury parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/x/treasury/types"
 )
 
-// UpdateTaxCap updates all denom's tax cap
-func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
+// ComputeTaxCaps computes all denom's tax cap from the tax policy cap, except the sdr one
+func (k Keeper) ComputeTaxCaps(ctx sdk.Context) sdk.Coins {
 	taxPolicyCap := sdk.NewDecCoinFromCoin(k.TaxPolicy(ctx).Cap)
 	whitelist := k.oracleKeeper.Whitelist(ctx)
 
@@ -20,56 +22,77 @@ func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
 		if err == nil {
 			newCap, _ := newDecCap.TruncateDecimal()
 			newCaps = append(newCaps, newCap)
-			k.SetTaxCap(ctx, newCap.Denom, newCap.Amount)
 		}
 	}
 
 	return newCaps
 }
 
-// UpdateTaxPolicy updates tax-rate with t(t+1) = t(t) * (TL_year(t) + INC) / TL_month(t)
-func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
+// UpdateTaxCap updates all denom's tax cap
+func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
+	newCaps := k.ComputeTaxCaps(ctx)
+	for _, newCap := range newCaps {
+		k.SetTaxCap(ctx, newCap.Denom, newCap.Amount)
+	}
+
+	return newCaps
+}
+
+// ComputeTaxRateUpdate computes tax-rate with t(t+1) = t(t) * (TL_year(t) + INC) / TL_month(t)
+func (k Keeper) ComputeTaxRateUpdate(ctx sdk.Context) (update types.TaxRateUpdate) {
 	params := k.GetParams(ctx)
 
-	oldTaxRate := k.GetTaxRate(ctx)
+	update.TaxRate = k.GetTaxRate(ctx)
 	inc := params.MiningIncrement
-	tlYear := k.rollingAverageIndicator(ctx, int64(params.WindowLong), TRL)
-	tlMonth := k.rollingAverageIndicator(ctx, int64(params.WindowShort), TRL)
+	update.TRLYear = k.rollingAverageIndicator(ctx, int64(params.WindowLong), TRL)
+	update.TRLMonth = k.rollingAverageIndicator(ctx, int64(params.WindowShort), TRL)
 
 	// No revenues, hike as much as possible.
-	if tlMonth.Equal(sdk.ZeroDec()) {
-		newTaxRate = params.TaxPolicy.RateMax
+	if update.TRLMonth.Equal(sdk.ZeroDec()) {
+		update.UnclampedTaxRate = params.TaxPolicy.RateMax
 	} else {
-		newTaxRate = oldTaxRate.Mul(tlYear.Mul(inc)).Quo(tlMonth)
+		update.UnclampedTaxRate = update.TaxRate.Mul(update.TRLYear.Mul(inc)).Quo(update.TRLMonth)
 	}
 
-	newTaxRate = params.TaxPolicy.Clamp(oldTaxRate, newTaxRate)
+	update.ClampedTaxRate = params.TaxPolicy.Clamp(update.TaxRate, update.UnclampedTaxRate)
+	return
+}
+
+// UpdateTaxPolicy updates tax-rate with t(t+1) = t(t) * (TL_year(t) + INC) / TL_month(t)
+func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
+	newTaxRate = k.ComputeTaxRateUpdate(ctx).ClampedTaxRate
 
 	// Set the new tax rate to the store
 	k.SetTaxRate(ctx, newTaxRate)
 	return
 }
 
-// UpdateRewardPolicy updates reward-weight with w(t+1) = w(t)*SB_target/SB_rolling(t)
-func (k Keeper) UpdateRewardPolicy(ctx sdk.Context) (newRewardWeight sdk.Dec) {
+// ComputeRewardWeightUpdate computes reward-weight with w(t+1) = w(t)*SB_target/SB_rolling(t)
+func (k Keeper) ComputeRewardWeightUpdate(ctx sdk.Context) (update types.RewardWeightUpdate) {
 	params := k.GetParams(ctx)
 
-	oldWeight := k.GetRewardWeight(ctx)
+	update.RewardWeight = k.GetRewardWeight(ctx)
 	sbTarget := params.SeigniorageBurdenTarget
 
-	seigniorageSum := k.sumIndicator(ctx, int64(params.WindowShort), SR)
-	totalSum := k.sumIndicator(ctx, int64(params.WindowShort), MR)
+	update.SeigniorageRewardSum = k.sumIndicator(ctx, int64(params.WindowShort), SR)
+	update.MiningRewardSum = k.sumIndicator(ctx, int64(params.WindowShort), MR)
 
 	// No revenues; hike as much as possible
-	if totalSum.Equal(sdk.ZeroDec()) || seigniorageSum.Equal(sdk.ZeroDec()) {
-		newRewardWeight = params.RewardPolicy.RateMax
+	if update.MiningRewardSum.Equal(sdk.ZeroDec()) || update.SeigniorageRewardSum.Equal(sdk.ZeroDec()) {
+		update.UnclampedRewardWeight = params.RewardPolicy.RateMax
 	} else {
 		// Seigniorage burden out of total rewards
-		sb := seigniorageSum.Quo(totalSum)
-		newRewardWeight = oldWeight.Mul(sbTarget.Quo(sb))
+		sb := update.SeigniorageRewardSum.Quo(update.MiningRewardSum)
+		update.UnclampedRewardWeight = update.RewardWeight.Mul(sbTarget.Quo(sb))
 	}
 
-	newRewardWeight = params.RewardPolicy.Clamp(oldWeight, newRewardWeight)
+	update.ClampedRewardWeight = params.RewardPolicy.Clamp(update.RewardWeight, update.UnclampedRewardWeight)
+	return
+}
+
+// UpdateRewardPolicy updates reward-weight with w(t+1) = w(t)*SB_target/SB_rolling(t)
+func (k Keeper) UpdateRewardPolicy(ctx sdk.Context) (newRewardWeight sdk.Dec) {
+	newRewardWeight = k.ComputeRewardWeightUpdate(ctx).ClampedRewardWeight
 
 	// Set the new reward weight
 	k.SetRewardWeight(ctx, newRewardWeight)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 	"math"
 
 	"google.golang.org/grpc/codes"
@@ -70,6 +71,24 @@ func (q querier) TaxCaps(c context.Context, req *types.QueryTaxCapsRequest) (*ty
 	return &types.QueryTaxCapsResponse{TaxCaps: taxCaps}, nil
 }
 
+// MsgTaxRate returns the tax rate applied to the principal of a msg type in a denom
+func (q querier) MsgTaxRate(c context.Context, req *types.QueryMsgTaxRateRequest) (*types.QueryMsgTaxRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MsgTypeURL == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type url")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMsgTaxRateResponse{TaxRate: q.GetMsgTaxRate(ctx, req.MsgTypeURL, req.Denom)}, nil
+}
+
 // RewardWeight return the current reward weight
 func (q querier) RewardWeight(c context.Context, req *types.QueryRewardWeightRequest) (*types.QueryRewardWeightResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -127,14 +146,125 @@ func (q querier) Indicators(c context.Context, req *types.QueryIndicatorsRequest
 	return &res, nil
 }
 
+// IndicatorHistory returns the indicators and policy of each ended epoch over an epoch range,
+// paginated by offset or by the big endian next epoch key
+func (q querier) IndicatorHistory(c context.Context, req *types.QueryIndicatorHistoryRequest) (*types.QueryIndicatorHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ToEpoch != 0 && req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "from epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the indicators of the current epoch are recorded at its last block
+	lastEpoch := q.GetEpoch(ctx) - 1
+	if core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
+		lastEpoch++
+	}
+
+	fromEpoch, toEpoch := int64(req.FromEpoch), int64(req.ToEpoch)
+	if req.ToEpoch == 0 || toEpoch > lastEpoch {
+		toEpoch = lastEpoch
+	}
+
+	start, limit, countTotal := fromEpoch, uint64(query.DefaultLimit), false
+	if page := req.Pagination; page != nil {
+		if len(page.Key) != 0 && page.Offset != 0 {
+			return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+		}
+
+		if len(page.Key) != 0 {
+			if len(page.Key) != 8 {
+				return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+			}
+			start = int64(binary.BigEndian.Uint64(page.Key))
+		} else {
+			start += int64(page.Offset)
+		}
+
+		if page.Limit != 0 {
+			limit = page.Limit
+		}
+		countTotal = page.CountTotal
+	}
+
+	var indicators []types.EpochIndicators
+	epoch := start
+	for ; epoch <= toEpoch && uint64(len(indicators)) < limit; epoch++ {
+		indicator := types.EpochIndicators{
+			Epoch:             uint64(epoch),
+			TaxReward:         q.GetTR(ctx, epoch),
+			SeigniorageReward: q.GetSR(ctx, epoch),
+			TotalStakedLuna:   q.GetTSL(ctx, epoch),
+			SwapFee:           q.GetSF(ctx, epoch),
+		}
+
+		if policy, found := q.GetEpochPolicy(ctx, epoch); found {
+			indicator.Policy = &policy
+		}
+
+		indicators = append(indicators, indicator)
+	}
+
+	pageRes := &query.PageResponse{}
+	if epoch <= toEpoch {
+		pageRes.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(pageRes.NextKey, uint64(epoch))
+	}
+
+	if countTotal && toEpoch >= fromEpoch {
+		pageRes.Total = uint64(toEpoch - fromEpoch + 1)
+	}
+
+	return &types.QueryIndicatorHistoryResponse{Indicators: indicators, Pagination: pageRes}, nil
+}
+
+// PolicyDryRun returns the tax rate, reward weight and tax caps the end of the current epoch would set,
+// simulating the epoch end on a cached context which is never written
+func (q querier) PolicyDryRun(c context.Context, req *types.QueryPolicyDryRunRequest) (*types.QueryPolicyDryRunResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	// the current epoch already ended at its last block, the dry run simulating the next one
+	epoch := q.GetEpoch(ctx)
+	if core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
+		epoch++
+	}
+
+	ctx = ctx.WithBlockHeight((epoch+1)*int64(core.BlocksPerWeek) - 1)
+	q.UpdateIndicators(ctx)
+
+	return &types.QueryPolicyDryRunResponse{
+		Epoch:        uint64(epoch),
+		Probation:    ctx.BlockHeight() < int64(core.BlocksPerWeek*q.WindowProbation(ctx)),
+		TaxRate:      q.ComputeTaxRateUpdate(ctx),
+		RewardWeight: q.ComputeRewardWeightUpdate(ctx),
+		TaxCaps:      q.ComputeTaxCaps(ctx),
+	}, nil
+}
+
 func (q querier) BurnTaxExemptionList(c context.Context, req *types.QueryBurnTaxExemptionListRequest) (*types.QueryBurnTaxExemptionListResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	sub := prefix.NewStore(ctx.KVStore(q.storeKey), types.BurnTaxExemptionListPrefix)
 	var addresses []string
+	var exemptions []types.BurnTaxExemption
 
 	pageRes, err := query.FilteredPaginate(sub, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		address := string(key)
-		addresses = append(addresses, address)
+		var exemption types.BurnTaxExemption
+		if err := q.cdc.Unmarshal(value, &exemption); err != nil {
+			return false, err
+		}
+
+		if accumulate {
+			addresses = append(addresses, string(key))
+			exemptions = append(exemptions, exemption)
+		}
 
 		return true, nil
 	})
@@ -142,5 +272,72 @@ func (q querier) BurnTaxExemptionList(c context.Context, req *types.QueryBurnTax
 		return nil, err
 	}
 
-	return &types.QueryBurnTaxExemptionListResponse{Addresses: addresses, Pagination: pageRes}, nil
+	return &types.QueryBurnTaxExemptionListResponse{Addresses: addresses, Pagination: pageRes, Exemptions: exemptions}, nil
+}
+
+// BurnTaxExemptionZones returns all burn tax exemption zones
+func (q querier) BurnTaxExemptionZones(c context.Context, req *types.QueryBurnTaxExemptionZonesRequest) (*types.QueryBurnTaxExemptionZonesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sub := prefix.NewStore(ctx.KVStore(q.storeKey), types.BurnTaxExemptionZonePrefix)
+	var zones []types.BurnTaxExemptionZone
+
+	pageRes, err := query.Paginate(sub, req.Pagination, func(key []byte, value []byte) error {
+		var zone types.BurnTaxExemptionZone
+		if err := q.cdc.Unmarshal(value, &zone); err != nil {
+			return err
+		}
+
+		zones = append(zones, zone)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnTaxExemptionZonesResponse{Zones: zones, Pagination: pageRes}, nil
+}
+
+// BurnTaxExemptionZone returns a burn tax exemption zone with its member addresses
+func (q querier) BurnTaxExemptionZone(c context.Context, req *types.QueryBurnTaxExemptionZoneRequest) (*types.QueryBurnTaxExemptionZoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	zone, found := q.GetBurnTaxExemptionZone(ctx, req.Name)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no burn tax exemption zone %s", req.Name)
+	}
+
+	sub := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetBurnTaxExemptionZoneMembersPrefix(req.Name))
+	var addresses []string
+
+	pageRes, err := query.Paginate(sub, req.Pagination, func(key []byte, value []byte) error {
+		addresses = append(addresses, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnTaxExemptionZoneResponse{Zone: zone, Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// BurnTaxExemptionZoneByAddress returns the burn tax exemption zone of an address
+func (q querier) BurnTaxExemptionZoneByAddress(c context.Context, req *types.QueryBurnTaxExemptionZoneByAddressRequest) (*types.QueryBurnTaxExemptionZoneByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	zone, found := q.GetBurnTaxExemptionZoneByAddress(ctx, req.Address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "address %s belongs to no burn tax exemption zone", req.Address)
+	}
+
+	return &types.QueryBurnTaxExemptionZoneByAddressResponse{Zone: zone}, nil
 }
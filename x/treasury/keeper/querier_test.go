@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -164,3 +165,169 @@ func TestQueryIndicators(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, targetIndicators, res)
 }
+
+func TestQueryBurnTaxExemptionZones(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	zone := types.NewBurnTaxExemptionZone("exchange", true)
+	input.TreasuryKeeper.SetBurnTaxExemptionZone(input.Ctx, zone)
+	require.NoError(t, input.TreasuryKeeper.AddBurnTaxExemptionZoneAddress(input.Ctx, zone.Name, Addrs[0].String()))
+
+	zonesRes, err := querier.BurnTaxExemptionZones(ctx, &types.QueryBurnTaxExemptionZonesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BurnTaxExemptionZone{zone}, zonesRes.Zones)
+
+	zoneRes, err := querier.BurnTaxExemptionZone(ctx, &types.QueryBurnTaxExemptionZoneRequest{Name: zone.Name})
+	require.NoError(t, err)
+	require.Equal(t, zone, zoneRes.Zone)
+	require.Equal(t, []string{Addrs[0].String()}, zoneRes.Addresses)
+
+	_, err = querier.BurnTaxExemptionZone(ctx, &types.QueryBurnTaxExemptionZoneRequest{Name: "unknown"})
+	require.Error(t, err)
+
+	byAddressRes, err := querier.BurnTaxExemptionZoneByAddress(ctx, &types.QueryBurnTaxExemptionZoneByAddressRequest{Address: Addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, zone, byAddressRes.Zone)
+
+	_, err = querier.BurnTaxExemptionZoneByAddress(ctx, &types.QueryBurnTaxExemptionZoneByAddressRequest{Address: Addrs[1].String()})
+	require.Error(t, err)
+}
+
+func TestQueryIndicatorHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek) * 5)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	policy := types.EpochPolicy{
+		TaxRate:      sdk.NewDecWithPrec(1, 3),
+		RewardWeight: sdk.NewDecWithPrec(5, 2),
+		TaxCaps:      sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000)),
+	}
+	for epoch := int64(0); epoch < 5; epoch++ {
+		input.TreasuryKeeper.SetTR(input.Ctx, epoch, sdk.NewDec(epoch+1))
+		input.TreasuryKeeper.SetSR(input.Ctx, epoch, sdk.NewDec(epoch+2))
+		input.TreasuryKeeper.SetTSL(input.Ctx, epoch, sdk.NewInt(epoch+3))
+		input.TreasuryKeeper.SetSF(input.Ctx, epoch, sdk.NewDec(epoch+4))
+	}
+	input.TreasuryKeeper.SetEpochPolicy(input.Ctx, 2, policy)
+
+	res, err := querier.IndicatorHistory(ctx, &types.QueryIndicatorHistoryRequest{FromEpoch: 1, ToEpoch: 3})
+	require.NoError(t, err)
+	require.Len(t, res.Indicators, 3)
+	require.Equal(t, types.EpochIndicators{
+		Epoch:             2,
+		TaxReward:         sdk.NewDec(3),
+		SeigniorageReward: sdk.NewDec(4),
+		TotalStakedLuna:   sdk.NewInt(5),
+		Policy:            &policy,
+		SwapFee:           sdk.NewDec(6),
+	}, res.Indicators[1])
+	require.Nil(t, res.Indicators[0].Policy)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// the range ends at the last ended epoch by default
+	res, err = querier.IndicatorHistory(ctx, &types.QueryIndicatorHistoryRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Indicators, 3)
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = querier.IndicatorHistory(ctx, &types.QueryIndicatorHistoryRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Indicators, 2)
+	require.Equal(t, uint64(3), res.Indicators[0].Epoch)
+	require.Equal(t, uint64(4), res.Indicators[1].Epoch)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = querier.IndicatorHistory(ctx, &types.QueryIndicatorHistoryRequest{Pagination: &query.PageRequest{Offset: 4}})
+	require.NoError(t, err)
+	require.Len(t, res.Indicators, 1)
+	require.Equal(t, uint64(4), res.Indicators[0].Epoch)
+
+	_, err = querier.IndicatorHistory(ctx, &types.QueryIndicatorHistoryRequest{FromEpoch: 3, ToEpoch: 1})
+	require.Error(t, err)
+}
+
+func TestQueryPolicyDryRun(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+	sh := staking.NewHandler(input.StakingKeeper)
+
+	stakingAmt := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], stakingAmt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	// middle of the first epoch after probation
+	epoch := int64(input.TreasuryKeeper.WindowProbation(input.Ctx))
+	input.Ctx = input.Ctx.WithBlockHeight(epoch*int64(core.BlocksPerWeek) + 10)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	// zero tax proceeds hike the tax rate as much as possible
+	res, err := querier.PolicyDryRun(ctx, &types.QueryPolicyDryRunRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(epoch), res.Epoch)
+	require.False(t, res.Probation)
+	taxPolicy := input.TreasuryKeeper.TaxPolicy(input.Ctx)
+	require.Equal(t, types.DefaultTaxRate, res.TaxRate.TaxRate)
+	require.Equal(t, taxPolicy.RateMax, res.TaxRate.UnclampedTaxRate)
+	require.Equal(t, types.DefaultTaxRate.Add(taxPolicy.ChangeRateMax), res.TaxRate.ClampedTaxRate)
+
+	// the dry run writes nothing
+	require.Equal(t, types.DefaultTaxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+	require.True(t, input.TreasuryKeeper.GetTSL(input.Ctx, epoch).IsZero())
+
+	// the dry run matches the policy update at the end of the epoch
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1000000000))))
+	res, err = querier.PolicyDryRun(ctx, &types.QueryPolicyDryRunRequest{})
+	require.NoError(t, err)
+
+	endCtx := input.Ctx.WithBlockHeight((epoch+1)*int64(core.BlocksPerWeek) - 1)
+	input.TreasuryKeeper.UpdateIndicators(endCtx)
+	require.Equal(t, input.TreasuryKeeper.ComputeTaxRateUpdate(endCtx), res.TaxRate)
+	require.Equal(t, input.TreasuryKeeper.ComputeRewardWeightUpdate(endCtx), res.RewardWeight)
+	require.Equal(t, input.TreasuryKeeper.UpdateTaxPolicy(endCtx), res.TaxRate.ClampedTaxRate)
+	require.Equal(t, input.TreasuryKeeper.UpdateRewardPolicy(endCtx), res.RewardWeight.ClampedRewardWeight)
+
+	// at the last block of the epoch, the dry run simulates the next one
+	res, err = querier.PolicyDryRun(sdk.WrapSDKContext(endCtx), &types.QueryPolicyDryRunRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(epoch+1), res.Epoch)
+
+	_, err = querier.PolicyDryRun(ctx, nil)
+	require.Error(t, err)
+
+	// the epochs under probation keep the policy
+	res, err = querier.PolicyDryRun(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(10)), &types.QueryPolicyDryRunRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Probation)
+}
+
+func TestQueryMsgTaxRate(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	msgTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+	input.TreasuryKeeper.SetTaxRateMultipliers(input.Ctx, []types.TaxRateMultiplier{{MsgTypeURL: msgTypeURL, Multiplier: sdk.NewDec(2)}})
+	input.TreasuryKeeper.SetDenomTaxRates(input.Ctx, []types.DenomTaxRate{{Denom: core.MicroKRWDenom, TaxRate: sdk.NewDecWithPrec(3, 3)}})
+
+	res, err := querier.MsgTaxRate(ctx, &types.QueryMsgTaxRateRequest{MsgTypeURL: msgTypeURL, Denom: core.MicroSDRDenom})
+	require.NoError(t, err)
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx).MulInt64(2), res.TaxRate)
+
+	res, err = querier.MsgTaxRate(ctx, &types.QueryMsgTaxRateRequest{MsgTypeURL: msgTypeURL, Denom: core.MicroKRWDenom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(6, 3), res.TaxRate)
+
+	res, err = querier.MsgTaxRate(ctx, &types.QueryMsgTaxRateRequest{MsgTypeURL: "/cosmos.bank.v1beta1.MsgMultiSend", Denom: core.MicroKRWDenom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), res.TaxRate)
+
+	_, err = querier.MsgTaxRate(ctx, &types.QueryMsgTaxRateRequest{Denom: core.MicroKRWDenom})
+	require.Error(t, err)
+}
@@ -40,6 +40,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -47,6 +48,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 )
 
 const faucetAccountName = "faucet"
@@ -136,6 +139,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
+	keyTransfer := sdk.NewKVStoreKey(ibctransfertypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
 	keyTreasury := sdk.NewKVStoreKey(types.StoreKey)
 
@@ -152,6 +156,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTransfer, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
 
@@ -171,6 +176,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		authtypes.FeeCollectorName:     nil,
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		ibctransfertypes.ModuleName:    {authtypes.Burner, authtypes.Minter},
 		markettypes.ModuleName:         {authtypes.Burner, authtypes.Minter},
 		distrtypes.ModuleName:          nil,
 		oracletypes.ModuleName:         nil,
@@ -216,6 +222,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	bondPool := authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName, authtypes.Burner, authtypes.Staking)
 	distrAcc := authtypes.NewEmptyModuleAccount(distrtypes.ModuleName)
 	oracleAcc := authtypes.NewEmptyModuleAccount(oracletypes.ModuleName)
+	transferAcc := authtypes.NewEmptyModuleAccount(ibctransfertypes.ModuleName, authtypes.Burner, authtypes.Minter)
 	marketAcc := authtypes.NewEmptyModuleAccount(markettypes.ModuleName, authtypes.Burner, authtypes.Minter)
 	treasuryAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner, authtypes.Minter)
 	burnAcc := authtypes.NewEmptyModuleAccount(types.BurnModuleName, authtypes.Burner)
@@ -228,6 +235,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	accountKeeper.SetModuleAccount(ctx, notBondedPool)
 	accountKeeper.SetModuleAccount(ctx, distrAcc)
 	accountKeeper.SetModuleAccount(ctx, oracleAcc)
+	accountKeeper.SetModuleAccount(ctx, transferAcc)
 	accountKeeper.SetModuleAccount(ctx, marketAcc)
 	accountKeeper.SetModuleAccount(ctx, treasuryAcc)
 	accountKeeper.SetModuleAccount(ctx, burnAcc)
@@ -250,6 +258,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		nil,
 		distrtypes.ModuleName,
 	)
 	oracleDefaultParams := oracletypes.DefaultParams()
@@ -259,12 +268,22 @@ func CreateTestInput(t *testing.T) TestInput {
 		oracleKeeper.SetTobinTax(ctx, denom.Name, denom.TobinTax)
 	}
 
+	transferKeeper := ibctransferkeeper.NewKeeper(
+		appCodec,
+		keyTransfer, paramsKeeper.Subspace(ibctransfertypes.ModuleName),
+		nil, nil,
+		accountKeeper, bankKeeper, capabilitykeeper.ScopedKeeper{},
+	)
+
 	marketKeeper := marketkeeper.NewKeeper(
 		appCodec,
 		keyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
+		distrKeeper,
+		transferKeeper,
+		nil,
 	)
 	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
 
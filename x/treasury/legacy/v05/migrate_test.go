@@ -112,19 +112,25 @@ func TestMigrate(t *testing.T) {
 	"epoch_states": [
 		{
 			"epoch": "0",
+			"policy": null,
 			"seigniorage_reward": "100.000000000000000000",
+			"swap_fee": "0",
 			"tax_reward": "100.000000000000000000",
 			"total_staked_luna": "100"
 		},
 		{
 			"epoch": "1",
+			"policy": null,
 			"seigniorage_reward": "200.000000000000000000",
+			"swap_fee": "0",
 			"tax_reward": "200.000000000000000000",
 			"total_staked_luna": "200"
 		},
 		{
 			"epoch": "2",
+			"policy": null,
 			"seigniorage_reward": "300.000000000000000000",
+			"swap_fee": "0",
 			"tax_reward": "300.000000000000000000",
 			"total_staked_luna": "300"
 		}
@@ -154,7 +160,9 @@ func TestMigrate(t *testing.T) {
 		"window_long": "52",
 		"window_probation": "18",
 		"window_short": "4",
-		"min_initial_deposit_ratio": "0"
+		"min_initial_deposit_ratio": "0",
+		"tax_rate_multipliers": [],
+		"denom_tax_rates": []
 	},
 	"reward_weight": "1.000000000000000000",
 	"tax_caps": [
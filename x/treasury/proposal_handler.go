@@ -15,6 +15,12 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleAddBurnTaxExemptionAddressProposal(ctx, k, c)
 		case *types.RemoveBurnTaxExemptionAddressProposal:
 			return handleRemoveBurnTaxExemptionAddressProposal(ctx, k, c)
+		case *types.AddBurnTaxExemptionZoneProposal:
+			return handleAddBurnTaxExemptionZoneProposal(ctx, k, c)
+		case *types.ModifyBurnTaxExemptionZoneProposal:
+			return handleModifyBurnTaxExemptionZoneProposal(ctx, k, c)
+		case *types.RemoveBurnTaxExemptionZoneProposal:
+			return handleRemoveBurnTaxExemptionZoneProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized treasury proposal content type: %T", c)
 		}
@@ -28,3 +34,15 @@ func handleAddBurnTaxExemptionAddressProposal(ctx sdk.Context, k keeper.Keeper,
 func handleRemoveBurnTaxExemptionAddressProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveBurnTaxExemptionAddressProposal) error {
 	return keeper.HandleRemoveBurnTaxExemptionAddressProposal(ctx, k, p)
 }
+
+func handleAddBurnTaxExemptionZoneProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddBurnTaxExemptionZoneProposal) error {
+	return keeper.HandleAddBurnTaxExemptionZoneProposal(ctx, k, p)
+}
+
+func handleModifyBurnTaxExemptionZoneProposal(ctx sdk.Context, k keeper.Keeper, p *types.ModifyBurnTaxExemptionZoneProposal) error {
+	return keeper.HandleModifyBurnTaxExemptionZoneProposal(ctx, k, p)
+}
+
+func handleRemoveBurnTaxExemptionZoneProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveBurnTaxExemptionZoneProposal) error {
+	return keeper.HandleRemoveBurnTaxExemptionZoneProposal(ctx, k, p)
+}
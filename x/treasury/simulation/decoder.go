@@ -56,6 +56,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &TotalStakedLunaA)
 			cdc.MustUnmarshal(kvB.Value, &TotalStakedLunaB)
 			return fmt.Sprintf("%v\n%v", TotalStakedLunaA, TotalStakedLunaB)
+		case bytes.Equal(kvA.Key[:1], types.EpochPolicyKey):
+			var epochPolicyA, epochPolicyB types.EpochPolicy
+			cdc.MustUnmarshal(kvA.Value, &epochPolicyA)
+			cdc.MustUnmarshal(kvB.Value, &epochPolicyB)
+			return fmt.Sprintf("%v\n%v", epochPolicyA, epochPolicyB)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
@@ -27,6 +27,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	TR := sdk.NewDecWithPrec(123, 2)
 	SR := sdk.NewDecWithPrec(43523, 4)
 	TSL := sdk.NewInt(1245213)
+	epochPolicy := types.EpochPolicy{TaxRate: taxRate, RewardWeight: rewardWeight, TaxCaps: sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, taxCap))}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -38,6 +39,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.TRKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: TR})},
 			{Key: types.SRKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: SR})},
 			{Key: types.TSLKey, Value: cdc.MustMarshal(&sdk.IntProto{Int: TSL})},
+			{Key: types.EpochPolicyKey, Value: cdc.MustMarshal(&epochPolicy)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -54,6 +56,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"TR", fmt.Sprintf("%v\n%v", TR, TR)},
 		{"SR", fmt.Sprintf("%v\n%v", SR, SR)},
 		{"TSL", fmt.Sprintf("%v\n%v", TSL, TSL)},
+		{"EpochPolicy", fmt.Sprintf("%v\n%v", epochPolicy, epochPolicy)},
 		{"other", ""},
 	}
 
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewBurnTaxExemption creates a BurnTaxExemption instance, zero expiry height and
+// nil expiry time meaning no expiry and empty denoms meaning every denom
+func NewBurnTaxExemption(address string, expiryHeight int64, expiryTime *time.Time, denoms []string) BurnTaxExemption {
+	return BurnTaxExemption{
+		Address:      address,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+		Denoms:       denoms,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (e BurnTaxExemption) String() string {
+	out, _ := yaml.Marshal(e)
+	return string(out)
+}
+
+// Validate checks the address, expiry and denom scope of the exemption
+func (e BurnTaxExemption) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s: %s", err, e.Address)
+	}
+
+	return ValidateBurnTaxExemptionScope(e.ExpiryHeight, e.Denoms)
+}
+
+// ValidateBurnTaxExemptionScope checks the expiry height is not negative and
+// the denoms are valid without duplicates
+func ValidateBurnTaxExemptionScope(expiryHeight int64, denoms []string) error {
+	if expiryHeight < 0 {
+		return fmt.Errorf("burn tax exemption expiry height must be positive or zero: %d", expiryHeight)
+	}
+
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate burn tax exemption denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// IsExpired returns true when the block height or time has reached the expiry of the exemption
+func (e BurnTaxExemption) IsExpired(height int64, blockTime time.Time) bool {
+	if e.ExpiryHeight != 0 && height >= e.ExpiryHeight {
+		return true
+	}
+
+	return e.ExpiryTime != nil && !blockTime.Before(*e.ExpiryTime)
+}
+
+// CoversDenom returns true when the exemption applies to the denom
+func (e BurnTaxExemption) CoversDenom(denom string) bool {
+	if len(e.Denoms) == 0 {
+		return true
+	}
+
+	for _, d := range e.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
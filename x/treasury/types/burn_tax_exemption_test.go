@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/types"
+)
+
+func TestBurnTaxExemption(t *testing.T) {
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	expiryTime := time.Unix(2000, 0)
+
+	exemption := NewBurnTaxExemption(address, 100, &expiryTime, []string{core.MicroUSDDenom})
+	require.NoError(t, exemption.Validate())
+
+	require.False(t, exemption.IsExpired(99, time.Unix(1999, 0)))
+	require.True(t, exemption.IsExpired(100, time.Unix(1999, 0)))
+	require.True(t, exemption.IsExpired(99, expiryTime))
+
+	require.True(t, exemption.CoversDenom(core.MicroUSDDenom))
+	require.False(t, exemption.CoversDenom(core.MicroKRWDenom))
+	require.True(t, NewBurnTaxExemption(address, 0, nil, nil).CoversDenom(core.MicroKRWDenom))
+	require.False(t, NewBurnTaxExemption(address, 0, nil, nil).IsExpired(1<<40, time.Unix(1<<40, 0)))
+
+	require.Error(t, NewBurnTaxExemption("", 0, nil, nil).Validate())
+	require.Error(t, NewBurnTaxExemption(address, -1, nil, nil).Validate())
+	require.Error(t, NewBurnTaxExemption(address, 0, nil, []string{"!"}).Validate())
+	require.Error(t, NewBurnTaxExemption(address, 0, nil, []string{core.MicroUSDDenom, core.MicroUSDDenom}).Validate())
+
+	proposal := NewAddBurnTaxExemptionAddressProposal("title", "description", []string{address}, -1, nil, nil)
+	require.Error(t, proposal.ValidateBasic())
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// MaxBurnTaxExemptionZoneNameLength is the maximum length of a burn tax exemption zone name
+const MaxBurnTaxExemptionZoneNameLength = 64
+
+// NewBurnTaxExemptionZone creates a BurnTaxExemptionZone instance
+func NewBurnTaxExemptionZone(name string, enabled bool) BurnTaxExemptionZone {
+	return BurnTaxExemptionZone{
+		Name:    name,
+		Enabled: enabled,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (z BurnTaxExemptionZone) String() string {
+	out, _ := yaml.Marshal(z)
+	return string(out)
+}
+
+// Validate checks the name of the zone
+func (z BurnTaxExemptionZone) Validate() error {
+	return ValidateBurnTaxExemptionZoneName(z.Name)
+}
+
+// ValidateBurnTaxExemptionZoneName checks the zone name is not blank and fits in the store keys
+func ValidateBurnTaxExemptionZoneName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("burn tax exemption zone name cannot be blank")
+	}
+
+	if len(name) > MaxBurnTaxExemptionZoneNameLength {
+		return fmt.Errorf("burn tax exemption zone name is longer than %d: %s", MaxBurnTaxExemptionZoneNameLength, name)
+	}
+
+	return nil
+}
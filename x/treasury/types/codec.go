@@ -9,6 +9,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&AddBurnTaxExemptionAddressProposal{}, "treasury/AddBurnTaxExemptionAddressProposal", nil)
 	cdc.RegisterConcrete(&RemoveBurnTaxExemptionAddressProposal{}, "treasury/RemoveBurnTaxExemptionAddressProposal", nil)
+	cdc.RegisterConcrete(&AddBurnTaxExemptionZoneProposal{}, "treasury/AddBurnTaxExemptionZoneProposal", nil)
+	cdc.RegisterConcrete(&ModifyBurnTaxExemptionZoneProposal{}, "treasury/ModifyBurnTaxExemptionZoneProposal", nil)
+	cdc.RegisterConcrete(&RemoveBurnTaxExemptionZoneProposal{}, "treasury/RemoveBurnTaxExemptionZoneProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -16,5 +19,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&AddBurnTaxExemptionAddressProposal{},
 		&RemoveBurnTaxExemptionAddressProposal{},
+		&AddBurnTaxExemptionZoneProposal{},
+		&ModifyBurnTaxExemptionZoneProposal{},
+		&RemoveBurnTaxExemptionZoneProposal{},
 	)
 }
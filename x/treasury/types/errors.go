@@ -4,4 +4,11 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var ErrNoSuchBurnTaxExemptionAddress = sdkerrors.Register(ModuleName, 1, "no such address in extemption list")
+var (
+	ErrNoSuchBurnTaxExemptionAddress = sdkerrors.Register(ModuleName, 1, "no such address in extemption list")
+	ErrBurnTaxExemptionExpired       = sdkerrors.Register(ModuleName, 2, "burn tax exemption already expired")
+	ErrNoSuchBurnTaxExemptionZone    = sdkerrors.Register(ModuleName, 3, "no such burn tax exemption zone")
+	ErrBurnTaxExemptionZoneExists    = sdkerrors.Register(ModuleName, 4, "burn tax exemption zone already exists")
+	ErrAddressInOtherZone            = sdkerrors.Register(ModuleName, 5, "address already belongs to a burn tax exemption zone")
+	ErrAddressNotInZone              = sdkerrors.Register(ModuleName, 6, "address does not belong to the burn tax exemption zone")
+)
@@ -5,10 +5,12 @@ const (
 	EventTypePolicyUpdate       = "policy_update"
 	EventTypeTaxRateUpdate      = "tax_rate_update"
 	EventTypeRewardWeightUpdate = "reward_weight_update"
+	EventTypeExemptionExpired   = "burn_tax_exemption_expired"
 
 	AttributeKeyTaxRate      = "tax_rate"
 	AttributeKeyRewardWeight = "reward_weight"
 	AttributeKeyTaxCap       = "tax_cap"
+	AttributeKeyAddress      = "address"
 
 	AttributeValueCategory = ModuleName
 )
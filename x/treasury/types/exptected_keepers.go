@@ -5,6 +5,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	markettypes "github.com/classic-terra/core/x/market/types"
 	oracletypes "github.com/classic-terra/core/x/oracle/types"
 )
 
@@ -26,6 +27,8 @@ type BankKeeper interface {
 // MarketKeeper expected market keeper
 type MarketKeeper interface {
 	ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, error)
+	SwapStatisticsPeriod(ctx sdk.Context) uint64
+	AggregateSwapStatistics(ctx sdk.Context, window uint64, offerDenom, askDenom string) []markettypes.SwapStatistics
 }
 
 // StakingKeeper expected keeper for staking module
@@ -169,6 +169,10 @@ type EpochState struct {
 	TaxReward         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_reward,json=taxReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_reward"`
 	SeigniorageReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seigniorage_reward,json=seigniorageReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_reward"`
 	TotalStakedLuna   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_staked_luna,json=totalStakedLuna,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staked_luna"`
+	// policy is unset for the epochs ended before the policy history was recorded
+	Policy *EpochPolicy `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
+	// swap_fee is the market swap fees charged during the epoch, in SDR
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
 }
 
 func (m *EpochState) Reset()         { *m = EpochState{} }
@@ -216,6 +220,13 @@ func (m *EpochState) GetEpoch() uint64 {
 	return 0
 }
 
+func (m *EpochState) GetPolicy() *EpochPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.treasury.v1beta1.GenesisState")
 	proto.RegisterType((*TaxCap)(nil), "terra.treasury.v1beta1.TaxCap")
@@ -227,42 +238,45 @@ func init() {
 }
 
 var fileDescriptor_c440a3f50aabab34 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6a, 0x13, 0x41,
-	0x1c, 0xc6, 0xb3, 0x6d, 0x9a, 0xda, 0x69, 0x44, 0x3a, 0x84, 0xb2, 0xf6, 0xb0, 0x09, 0x41, 0x25,
-	0x97, 0xce, 0x5a, 0xbd, 0x0a, 0x42, 0xa2, 0xd4, 0xa0, 0x42, 0xd9, 0x08, 0x42, 0x41, 0xc2, 0x3f,
-	0x9b, 0x3f, 0x9b, 0xa1, 0xc9, 0xcc, 0x32, 0x33, 0xb1, 0xe9, 0xd1, 0x37, 0xf0, 0x39, 0xc4, 0x07,
-	0xe9, 0xb1, 0x47, 0xf1, 0x50, 0x25, 0xb9, 0xfb, 0x0c, 0x32, 0x33, 0xdb, 0xb4, 0x07, 0x2b, 0x12,
-	0x3c, 0x25, 0xbb, 0xfb, 0xcd, 0xef, 0xfb, 0xe6, 0x3f, 0x1f, 0x43, 0x1e, 0x18, 0x54, 0x0a, 0x62,
-	0xa3, 0x10, 0xf4, 0x54, 0x9d, 0xc5, 0x1f, 0x0f, 0x06, 0x68, 0xe0, 0x20, 0xce, 0x50, 0xa0, 0xe6,
-	0x9a, 0xe5, 0x4a, 0x1a, 0x49, 0x77, 0x9d, 0x8a, 0x5d, 0xa9, 0x58, 0xa1, 0xda, 0xab, 0x65, 0x32,
-	0x93, 0x4e, 0x12, 0xdb, 0x7f, 0x5e, 0xbd, 0xf7, 0xf0, 0x16, 0xe6, 0x72, 0xb9, 0x97, 0x45, 0xa9,
-	0xd4, 0x13, 0xa9, 0xe3, 0x01, 0x68, 0x5c, 0x6a, 0x52, 0xc9, 0x85, 0xff, 0xde, 0xfc, 0x55, 0x26,
-	0xd5, 0x43, 0x1f, 0xa3, 0x67, 0xc0, 0x20, 0x7d, 0x46, 0x2a, 0x39, 0x28, 0x98, 0xe8, 0x30, 0x68,
-	0x04, 0xad, 0xed, 0x27, 0x11, 0xfb, 0x73, 0x2c, 0x76, 0xe4, 0x54, 0xed, 0xf2, 0xf9, 0x65, 0xbd,
-	0x94, 0x14, 0x6b, 0x68, 0x97, 0xdc, 0x31, 0x30, 0xeb, 0x2b, 0x30, 0x18, 0xae, 0x35, 0x82, 0xd6,
-	0x56, 0x9b, 0xd9, 0xef, 0xdf, 0x2f, 0xeb, 0x8f, 0x32, 0x6e, 0x46, 0xd3, 0x01, 0x4b, 0xe5, 0x24,
-	0x2e, 0x32, 0xf9, 0x9f, 0x7d, 0x3d, 0x3c, 0x89, 0xcd, 0x59, 0x8e, 0x9a, 0xbd, 0xc0, 0x34, 0xd9,
-	0x34, 0x30, 0x4b, 0x6c, 0x90, 0x1e, 0xb9, 0xab, 0xf0, 0x14, 0xd4, 0xb0, 0x7f, 0x8a, 0x3c, 0x1b,
-	0x99, 0x70, 0x7d, 0x25, 0x5e, 0xd5, 0x43, 0xde, 0x3b, 0x06, 0x7d, 0xee, 0xf3, 0xa5, 0x90, 0xeb,
-	0xb0, 0xdc, 0x58, 0xff, 0xdb, 0xfe, 0xde, 0xc1, 0xac, 0x03, 0x79, 0xb1, 0x3f, 0x9b, 0xaa, 0x03,
-	0xb9, 0xa6, 0x82, 0x54, 0x2d, 0x20, 0x57, 0x32, 0x45, 0x1c, 0xea, 0x70, 0xc3, 0x41, 0xee, 0x33,
-	0xef, 0xcd, 0xec, 0x98, 0x97, 0x84, 0x8e, 0xe4, 0xa2, 0xfd, 0xd8, 0xae, 0xff, 0xf2, 0xa3, 0xde,
-	0xfa, 0x87, 0xbc, 0x76, 0x81, 0x4e, 0xb6, 0x0d, 0xcc, 0x8e, 0x0a, 0x3e, 0xfd, 0x14, 0x90, 0x5d,
-	0xcc, 0x65, 0x3a, 0xea, 0x73, 0xc1, 0x0d, 0x87, 0x71, 0x9f, 0x6b, 0x3d, 0x05, 0x91, 0x62, 0x58,
-	0xf9, 0xff, 0xd6, 0x35, 0x67, 0xd5, 0xf5, 0x4e, 0xdd, 0xc2, 0x88, 0xbe, 0x26, 0x55, 0x1f, 0x41,
-	0xdb, 0x86, 0xe8, 0x70, 0xd3, 0x19, 0x37, 0x6f, 0x1b, 0xdc, 0x4b, 0xab, 0x75, 0x65, 0x2a, 0x86,
-	0xb7, 0x8d, 0xcb, 0x37, 0xba, 0x99, 0x91, 0x8a, 0x9f, 0x2c, 0xad, 0x91, 0x8d, 0x21, 0x0a, 0x39,
-	0x71, 0x45, 0xdb, 0x4a, 0xfc, 0x03, 0x3d, 0x24, 0x9b, 0xc5, 0x09, 0xad, 0x50, 0xa0, 0xae, 0x30,
-	0x49, 0xc5, 0x1f, 0x55, 0xf3, 0xeb, 0x1a, 0x21, 0xd7, 0x51, 0xac, 0x9b, 0x8b, 0xe1, 0xdc, 0xca,
-	0x89, 0x7f, 0xa0, 0x6f, 0x09, 0x71, 0x7d, 0x75, 0x1d, 0x59, 0xb1, 0xb1, 0x5b, 0xb6, 0xb1, 0x0e,
-	0x40, 0x3f, 0x10, 0xaa, 0x91, 0x67, 0x82, 0x4b, 0x05, 0x19, 0x5e, 0x61, 0x57, 0x2b, 0xee, 0xce,
-	0x0d, 0x52, 0x81, 0x3f, 0x26, 0x3b, 0x46, 0x1a, 0x18, 0xdb, 0x83, 0x38, 0xc1, 0x61, 0x7f, 0x3c,
-	0x15, 0x10, 0x96, 0x57, 0x9a, 0xd2, 0x3d, 0x07, 0xea, 0x39, 0xce, 0x9b, 0xa9, 0x80, 0xf6, 0xab,
-	0xf3, 0x79, 0x14, 0x5c, 0xcc, 0xa3, 0xe0, 0xe7, 0x3c, 0x0a, 0x3e, 0x2f, 0xa2, 0xd2, 0xc5, 0x22,
-	0x2a, 0x7d, 0x5b, 0x44, 0xa5, 0x63, 0x76, 0x13, 0x39, 0x06, 0xad, 0x79, 0xba, 0xef, 0x2f, 0x9f,
-	0x54, 0x2a, 0x8c, 0x67, 0xd7, 0x77, 0x90, 0xc3, 0x0f, 0x2a, 0xee, 0x66, 0x79, 0xfa, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xde, 0x95, 0xa3, 0x09, 0xf6, 0x04, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0x36, 0xdd, 0xda, 0x69, 0x44, 0x3a, 0x94, 0xb2, 0xf6, 0xb0, 0x2d, 0xf1, 0x0f,
+	0xb9, 0x74, 0xd7, 0xea, 0x51, 0x41, 0x48, 0xd4, 0x1a, 0x54, 0x08, 0x1b, 0x41, 0x28, 0xc8, 0xf2,
+	0x66, 0xf3, 0xba, 0x19, 0x9a, 0xec, 0x2c, 0x33, 0x13, 0x93, 0x1c, 0xfd, 0x06, 0x7e, 0x0e, 0xcf,
+	0x7e, 0x88, 0x1e, 0x7b, 0x14, 0x0f, 0x55, 0x92, 0xbb, 0x9f, 0x41, 0x66, 0x66, 0x9b, 0xf6, 0x60,
+	0x8b, 0x2c, 0x9e, 0x92, 0xdd, 0x7d, 0xde, 0xdf, 0xfb, 0xcc, 0x3b, 0xcf, 0x0c, 0xb9, 0xa7, 0x50,
+	0x08, 0x08, 0x95, 0x40, 0x90, 0x63, 0x31, 0x0b, 0x3f, 0x1d, 0xf4, 0x50, 0xc1, 0x41, 0x98, 0x62,
+	0x86, 0x92, 0xc9, 0x20, 0x17, 0x5c, 0x71, 0xba, 0x6d, 0x54, 0xc1, 0xb9, 0x2a, 0x28, 0x54, 0x3b,
+	0x5b, 0x29, 0x4f, 0xb9, 0x91, 0x84, 0xfa, 0x9f, 0x55, 0xef, 0xdc, 0xbf, 0x82, 0xb9, 0x2c, 0xb7,
+	0x32, 0x3f, 0xe1, 0x72, 0xc4, 0x65, 0xd8, 0x03, 0x89, 0x4b, 0x4d, 0xc2, 0x59, 0x66, 0xbf, 0xd7,
+	0x7f, 0x57, 0x49, 0xed, 0xd0, 0xda, 0xe8, 0x2a, 0x50, 0x48, 0x9f, 0x12, 0x37, 0x07, 0x01, 0x23,
+	0xe9, 0x39, 0x7b, 0x4e, 0x63, 0xe3, 0x91, 0x1f, 0xfc, 0xdd, 0x56, 0xd0, 0x31, 0xaa, 0x66, 0xf5,
+	0xe4, 0x6c, 0xb7, 0x12, 0x15, 0x35, 0xb4, 0x4d, 0x6e, 0x2a, 0x98, 0xc6, 0x02, 0x14, 0x7a, 0x37,
+	0xf6, 0x9c, 0xc6, 0x7a, 0x33, 0xd0, 0xdf, 0x7f, 0x9c, 0xed, 0x3e, 0x48, 0x99, 0x1a, 0x8c, 0x7b,
+	0x41, 0xc2, 0x47, 0x61, 0xe1, 0xc9, 0xfe, 0xec, 0xcb, 0xfe, 0x71, 0xa8, 0x66, 0x39, 0xca, 0xe0,
+	0x39, 0x26, 0xd1, 0x9a, 0x82, 0x69, 0xa4, 0x8d, 0x74, 0xc9, 0x2d, 0x81, 0x13, 0x10, 0xfd, 0x78,
+	0x82, 0x2c, 0x1d, 0x28, 0x6f, 0xa5, 0x14, 0xaf, 0x66, 0x21, 0xef, 0x0d, 0x83, 0x3e, 0xb3, 0xfe,
+	0x12, 0xc8, 0xa5, 0x57, 0xdd, 0x5b, 0xb9, 0x6e, 0x7d, 0xef, 0x60, 0xda, 0x82, 0xbc, 0x58, 0x9f,
+	0x76, 0xd5, 0x82, 0x5c, 0xd2, 0x8c, 0xd4, 0x34, 0x20, 0x17, 0x3c, 0x41, 0xec, 0x4b, 0x6f, 0xd5,
+	0x40, 0xee, 0x04, 0xb6, 0x77, 0xa0, 0xc7, 0xbc, 0x24, 0xb4, 0x38, 0xcb, 0x9a, 0x0f, 0x75, 0xfd,
+	0xd7, 0x9f, 0xbb, 0x8d, 0x7f, 0xf0, 0xab, 0x0b, 0x64, 0xb4, 0xa1, 0x60, 0xda, 0x29, 0xf8, 0xf4,
+	0xb3, 0x43, 0xb6, 0x31, 0xe7, 0xc9, 0x20, 0x66, 0x19, 0x53, 0x0c, 0x86, 0x31, 0x93, 0x72, 0x0c,
+	0x59, 0x82, 0x9e, 0xfb, 0xff, 0x5b, 0x6f, 0x99, 0x56, 0x6d, 0xdb, 0xa9, 0x5d, 0x34, 0xa2, 0xaf,
+	0x49, 0xcd, 0x5a, 0x90, 0x3a, 0x21, 0xd2, 0x5b, 0x33, 0x8d, 0xeb, 0x57, 0x0d, 0xee, 0x85, 0xd6,
+	0x9a, 0x30, 0x15, 0xc3, 0xdb, 0xc0, 0xe5, 0x1b, 0x59, 0x4f, 0x89, 0x6b, 0x27, 0x4b, 0xb7, 0xc8,
+	0x6a, 0x1f, 0x33, 0x3e, 0x32, 0x41, 0x5b, 0x8f, 0xec, 0x03, 0x3d, 0x24, 0x6b, 0xc5, 0x0e, 0x95,
+	0x08, 0x50, 0x3b, 0x53, 0x91, 0x6b, 0xb7, 0xaa, 0xfe, 0x6d, 0x85, 0x90, 0x0b, 0x2b, 0xba, 0x9b,
+	0xb1, 0x61, 0xba, 0x55, 0x23, 0xfb, 0x40, 0xdf, 0x12, 0x62, 0xf2, 0x6a, 0x32, 0x52, 0x32, 0xb1,
+	0xeb, 0x3a, 0xb1, 0x06, 0x40, 0x3f, 0x10, 0x2a, 0x91, 0xa5, 0x19, 0xe3, 0x02, 0x52, 0x3c, 0xc7,
+	0x96, 0x0b, 0xee, 0xe6, 0x25, 0x52, 0x81, 0x3f, 0x22, 0x9b, 0x8a, 0x2b, 0x18, 0xea, 0x8d, 0x38,
+	0xc6, 0x7e, 0x3c, 0x1c, 0x67, 0xe0, 0x55, 0x4b, 0x4d, 0xe9, 0xb6, 0x01, 0x75, 0x0d, 0xe7, 0xcd,
+	0x38, 0x03, 0xfa, 0x84, 0xb8, 0x39, 0x1f, 0xb2, 0x64, 0xe6, 0xad, 0x9a, 0x73, 0x7f, 0xf7, 0xda,
+	0xed, 0xed, 0x18, 0x69, 0x54, 0x94, 0xe8, 0x63, 0x2f, 0x27, 0x90, 0xc7, 0x1f, 0x51, 0xc7, 0xb2,
+	0xd4, 0xb1, 0xd7, 0xf5, 0x2f, 0x11, 0x9b, 0xaf, 0x4e, 0xe6, 0xbe, 0x73, 0x3a, 0xf7, 0x9d, 0x5f,
+	0x73, 0xdf, 0xf9, 0xb2, 0xf0, 0x2b, 0xa7, 0x0b, 0xbf, 0xf2, 0x7d, 0xe1, 0x57, 0x8e, 0x82, 0xcb,
+	0xa8, 0x21, 0x48, 0xc9, 0x92, 0x7d, 0x7b, 0x09, 0x26, 0x5c, 0x60, 0x38, 0xbd, 0xb8, 0x0b, 0x0d,
+	0xb6, 0xe7, 0x9a, 0x1b, 0xee, 0xf1, 0x9f, 0x01, 0x00, 0x57, 0xb7, 0x48, 0x8d, 0x7e, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -434,6 +448,28 @@ func (m *EpochState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TotalStakedLuna.Size()
 		i -= size
@@ -553,6 +589,12 @@ func (m *EpochState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TotalStakedLuna.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1119,6 +1161,76 @@ func (m *EpochState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &EpochPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,6 +12,9 @@ import (
 const (
 	ProposalTypeAddBurnTaxExemptionAddress    = "AddBurnTaxExemptionAddress"
 	ProposalTypeRemoveBurnTaxExemptionAddress = "RemoveBurnTaxExemptionAddress"
+	ProposalTypeAddBurnTaxExemptionZone       = "AddBurnTaxExemptionZone"
+	ProposalTypeModifyBurnTaxExemptionZone    = "ModifyBurnTaxExemptionZone"
+	ProposalTypeRemoveBurnTaxExemptionZone    = "RemoveBurnTaxExemptionZone"
 )
 
 func init() {
@@ -18,20 +22,32 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&AddBurnTaxExemptionAddressProposal{}, "treasury/AddBurnTaxExemptionAddressProposal")
 	govtypes.RegisterProposalType(ProposalTypeRemoveBurnTaxExemptionAddress)
 	govtypes.RegisterProposalTypeCodec(&RemoveBurnTaxExemptionAddressProposal{}, "treasury/RemoveBurnTaxExemptionAddressProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddBurnTaxExemptionZone)
+	govtypes.RegisterProposalTypeCodec(&AddBurnTaxExemptionZoneProposal{}, "treasury/AddBurnTaxExemptionZoneProposal")
+	govtypes.RegisterProposalType(ProposalTypeModifyBurnTaxExemptionZone)
+	govtypes.RegisterProposalTypeCodec(&ModifyBurnTaxExemptionZoneProposal{}, "treasury/ModifyBurnTaxExemptionZoneProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveBurnTaxExemptionZone)
+	govtypes.RegisterProposalTypeCodec(&RemoveBurnTaxExemptionZoneProposal{}, "treasury/RemoveBurnTaxExemptionZoneProposal")
 }
 
 var (
 	_ govtypes.Content = &AddBurnTaxExemptionAddressProposal{}
 	_ govtypes.Content = &RemoveBurnTaxExemptionAddressProposal{}
+	_ govtypes.Content = &AddBurnTaxExemptionZoneProposal{}
+	_ govtypes.Content = &ModifyBurnTaxExemptionZoneProposal{}
+	_ govtypes.Content = &RemoveBurnTaxExemptionZoneProposal{}
 )
 
 // ======AddBurnTaxExemptionAddressProposal======
 
-func NewAddBurnTaxExemptionAddressProposal(title, description string, addresses []string) govtypes.Content {
+func NewAddBurnTaxExemptionAddressProposal(title, description string, addresses []string, expiryHeight int64, expiryTime *time.Time, denoms []string) govtypes.Content {
 	return &AddBurnTaxExemptionAddressProposal{
-		Title:       title,
-		Description: description,
-		Addresses:   addresses,
+		Title:        title,
+		Description:  description,
+		Addresses:    addresses,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+		Denoms:       denoms,
 	}
 }
 
@@ -47,10 +63,13 @@ func (p *AddBurnTaxExemptionAddressProposal) ProposalType() string {
 
 func (p AddBurnTaxExemptionAddressProposal) String() string {
 	return fmt.Sprintf(`AddBurnTaxExemptionAddressProposal:
-	Title:       %s
-	Description: %s
-	Addresses:   %v
-  `, p.Title, p.Description, p.Addresses)
+	Title:        %s
+	Description:  %s
+	Addresses:    %v
+	ExpiryHeight: %d
+	ExpiryTime:   %v
+	Denoms:       %v
+  `, p.Title, p.Description, p.Addresses, p.ExpiryHeight, p.ExpiryTime, p.Denoms)
 }
 
 func (p *AddBurnTaxExemptionAddressProposal) ValidateBasic() error {
@@ -66,6 +85,10 @@ func (p *AddBurnTaxExemptionAddressProposal) ValidateBasic() error {
 		}
 	}
 
+	if err := ValidateBurnTaxExemptionScope(p.ExpiryHeight, p.Denoms); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+
 	return nil
 }
 
@@ -112,3 +135,155 @@ func (p *RemoveBurnTaxExemptionAddressProposal) ValidateBasic() error {
 
 	return nil
 }
+
+// ======AddBurnTaxExemptionZoneProposal======
+
+func NewAddBurnTaxExemptionZoneProposal(title, description, name string, enabled bool, addresses []string) govtypes.Content {
+	return &AddBurnTaxExemptionZoneProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		Enabled:     enabled,
+		Addresses:   addresses,
+	}
+}
+
+func (p *AddBurnTaxExemptionZoneProposal) GetTitle() string { return p.Title }
+
+func (p *AddBurnTaxExemptionZoneProposal) GetDescription() string { return p.Description }
+
+func (p *AddBurnTaxExemptionZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddBurnTaxExemptionZoneProposal) ProposalType() string {
+	return ProposalTypeAddBurnTaxExemptionZone
+}
+
+func (p AddBurnTaxExemptionZoneProposal) String() string {
+	return fmt.Sprintf(`AddBurnTaxExemptionZoneProposal:
+	Title:       %s
+	Description: %s
+	Name:        %s
+	Enabled:     %t
+	Addresses:   %v
+  `, p.Title, p.Description, p.Name, p.Enabled, p.Addresses)
+}
+
+func (p *AddBurnTaxExemptionZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateBurnTaxExemptionZoneName(p.Name); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+
+	return validateZoneAddresses(p.Addresses)
+}
+
+// ======ModifyBurnTaxExemptionZoneProposal======
+
+func NewModifyBurnTaxExemptionZoneProposal(title, description, name string, enabled bool, addAddresses, removeAddresses []string) govtypes.Content {
+	return &ModifyBurnTaxExemptionZoneProposal{
+		Title:           title,
+		Description:     description,
+		Name:            name,
+		Enabled:         enabled,
+		AddAddresses:    addAddresses,
+		RemoveAddresses: removeAddresses,
+	}
+}
+
+func (p *ModifyBurnTaxExemptionZoneProposal) GetTitle() string { return p.Title }
+
+func (p *ModifyBurnTaxExemptionZoneProposal) GetDescription() string { return p.Description }
+
+func (p *ModifyBurnTaxExemptionZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ModifyBurnTaxExemptionZoneProposal) ProposalType() string {
+	return ProposalTypeModifyBurnTaxExemptionZone
+}
+
+func (p ModifyBurnTaxExemptionZoneProposal) String() string {
+	return fmt.Sprintf(`ModifyBurnTaxExemptionZoneProposal:
+	Title:           %s
+	Description:     %s
+	Name:            %s
+	Enabled:         %t
+	AddAddresses:    %v
+	RemoveAddresses: %v
+  `, p.Title, p.Description, p.Name, p.Enabled, p.AddAddresses, p.RemoveAddresses)
+}
+
+func (p *ModifyBurnTaxExemptionZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateBurnTaxExemptionZoneName(p.Name); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+
+	// an address cannot be both added and removed
+	return validateZoneAddresses(append(append([]string{}, p.AddAddresses...), p.RemoveAddresses...))
+}
+
+// ======RemoveBurnTaxExemptionZoneProposal======
+
+func NewRemoveBurnTaxExemptionZoneProposal(title, description, name string) govtypes.Content {
+	return &RemoveBurnTaxExemptionZoneProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+	}
+}
+
+func (p *RemoveBurnTaxExemptionZoneProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveBurnTaxExemptionZoneProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveBurnTaxExemptionZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveBurnTaxExemptionZoneProposal) ProposalType() string {
+	return ProposalTypeRemoveBurnTaxExemptionZone
+}
+
+func (p RemoveBurnTaxExemptionZoneProposal) String() string {
+	return fmt.Sprintf(`RemoveBurnTaxExemptionZoneProposal:
+	Title:       %s
+	Description: %s
+	Name:        %s
+  `, p.Title, p.Description, p.Name)
+}
+
+func (p *RemoveBurnTaxExemptionZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateBurnTaxExemptionZoneName(p.Name); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+
+	return nil
+}
+
+// validateZoneAddresses checks the addresses are valid and listed once
+func validateZoneAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		_, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s: %s", err, address)
+		}
+
+		if seen[address] {
+			return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "duplicate zone address %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
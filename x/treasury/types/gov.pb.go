@@ -8,9 +8,12 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -18,6 +21,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -31,6 +35,12 @@ type AddBurnTaxExemptionAddressProposal struct {
 	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Addresses   []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+	// expiry_height is the height from which the exemptions are dropped, zero for none
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// expiry_time is the block time from which the exemptions are dropped, unset for none
+	ExpiryTime *time.Time `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	// denoms limits the exemptions to the given denoms, empty for all denoms
+	Denoms []string `protobuf:"bytes,6,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
 }
 
 func (m *AddBurnTaxExemptionAddressProposal) Reset()      { *m = AddBurnTaxExemptionAddressProposal{} }
@@ -114,33 +124,190 @@ func (m *RemoveBurnTaxExemptionAddressProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveBurnTaxExemptionAddressProposal proto.InternalMessageInfo
 
+// proposal request structure for creating a burn tax exemption zone
+type AddBurnTaxExemptionZoneProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Enabled     bool     `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	Addresses   []string `protobuf:"bytes,5,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) Reset()      { *m = AddBurnTaxExemptionZoneProposal{} }
+func (*AddBurnTaxExemptionZoneProposal) ProtoMessage() {}
+func (*AddBurnTaxExemptionZoneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a71b37663a441645, []int{2}
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddBurnTaxExemptionZoneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBurnTaxExemptionZoneProposal.Merge(m, src)
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBurnTaxExemptionZoneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddBurnTaxExemptionZoneProposal proto.InternalMessageInfo
+
+// proposal request structure for enabling or disabling a burn tax exemption zone and updating its members
+type ModifyBurnTaxExemptionZoneProposal struct {
+	Title           string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name            string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Enabled         bool     `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	AddAddresses    []string `protobuf:"bytes,5,rep,name=add_addresses,json=addAddresses,proto3" json:"add_addresses,omitempty" yaml:"add_addresses"`
+	RemoveAddresses []string `protobuf:"bytes,6,rep,name=remove_addresses,json=removeAddresses,proto3" json:"remove_addresses,omitempty" yaml:"remove_addresses"`
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) Reset()      { *m = ModifyBurnTaxExemptionZoneProposal{} }
+func (*ModifyBurnTaxExemptionZoneProposal) ProtoMessage() {}
+func (*ModifyBurnTaxExemptionZoneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a71b37663a441645, []int{3}
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModifyBurnTaxExemptionZoneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyBurnTaxExemptionZoneProposal.Merge(m, src)
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyBurnTaxExemptionZoneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyBurnTaxExemptionZoneProposal proto.InternalMessageInfo
+
+// proposal request structure for deleting a burn tax exemption zone with all its members
+type RemoveBurnTaxExemptionZoneProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) Reset()      { *m = RemoveBurnTaxExemptionZoneProposal{} }
+func (*RemoveBurnTaxExemptionZoneProposal) ProtoMessage() {}
+func (*RemoveBurnTaxExemptionZoneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a71b37663a441645, []int{4}
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveBurnTaxExemptionZoneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveBurnTaxExemptionZoneProposal.Merge(m, src)
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveBurnTaxExemptionZoneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveBurnTaxExemptionZoneProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddBurnTaxExemptionAddressProposal)(nil), "terra.treasury.v1beta1.AddBurnTaxExemptionAddressProposal")
 	proto.RegisterType((*RemoveBurnTaxExemptionAddressProposal)(nil), "terra.treasury.v1beta1.RemoveBurnTaxExemptionAddressProposal")
+	proto.RegisterType((*AddBurnTaxExemptionZoneProposal)(nil), "terra.treasury.v1beta1.AddBurnTaxExemptionZoneProposal")
+	proto.RegisterType((*ModifyBurnTaxExemptionZoneProposal)(nil), "terra.treasury.v1beta1.ModifyBurnTaxExemptionZoneProposal")
+	proto.RegisterType((*RemoveBurnTaxExemptionZoneProposal)(nil), "terra.treasury.v1beta1.RemoveBurnTaxExemptionZoneProposal")
 }
 
 func init() { proto.RegisterFile("terra/treasury/v1beta1/gov.proto", fileDescriptor_a71b37663a441645) }
 
 var fileDescriptor_a71b37663a441645 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x49, 0x2d, 0x2a,
-	0x4a, 0xd4, 0x2f, 0x29, 0x4a, 0x4d, 0x2c, 0x2e, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x03, 0xab,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07,
-	0xb1, 0x20, 0xaa, 0x95, 0xe6, 0x31, 0x72, 0x29, 0x39, 0xa6, 0xa4, 0x38, 0x95, 0x16, 0xe5, 0x85,
-	0x24, 0x56, 0xb8, 0x56, 0xa4, 0xe6, 0x16, 0x94, 0x64, 0xe6, 0xe7, 0x39, 0xa6, 0xa4, 0x14, 0xa5,
-	0x16, 0x17, 0x07, 0x14, 0xe5, 0x17, 0xe4, 0x17, 0x27, 0xe6, 0x08, 0x89, 0x70, 0xb1, 0x96, 0x64,
-	0x96, 0xe4, 0xa4, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x38, 0x42, 0x0a, 0x5c, 0xdc,
-	0x29, 0xa9, 0xc5, 0xc9, 0x45, 0x99, 0x60, 0x3d, 0x12, 0x4c, 0x60, 0x39, 0x64, 0x21, 0x21, 0x23,
-	0x2e, 0xce, 0x44, 0x88, 0x51, 0xa9, 0xc5, 0x12, 0xcc, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x22, 0x9f,
-	0xee, 0xc9, 0x0b, 0x54, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xc1, 0xa5, 0x94, 0x82, 0x10, 0xca, 0xac,
-	0x78, 0x3a, 0x16, 0xc8, 0x33, 0xcc, 0x58, 0x20, 0xcf, 0xf0, 0x62, 0x81, 0x3c, 0xa3, 0xd2, 0x42,
-	0x46, 0x2e, 0xd5, 0xa0, 0xd4, 0xdc, 0xfc, 0xb2, 0xd4, 0x41, 0xeb, 0x46, 0x27, 0x8f, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x49, 0x2c, 0x2e, 0xce, 0x4c, 0xd6, 0x85, 0xc4, 0x60, 0x72,
-	0x7e, 0x51, 0xaa, 0x7e, 0x05, 0x22, 0x22, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xb1,
-	0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x80, 0xc3, 0x34, 0xe7, 0x01, 0x00, 0x00,
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xee, 0x6c, 0x77, 0x57, 0x3b, 0x6d, 0xdd, 0x35, 0x14, 0x0d, 0x15, 0x32, 0x61, 0x44, 0xa8,
+	0xa0, 0x09, 0xbb, 0xde, 0x16, 0xf6, 0xd0, 0x80, 0xb2, 0x17, 0x41, 0xc2, 0x82, 0xb0, 0x97, 0x65,
+	0xda, 0x99, 0x4d, 0x03, 0x49, 0x26, 0x4c, 0xa6, 0xa5, 0xfd, 0x07, 0xde, 0xdc, 0xa3, 0xc7, 0xfa,
+	0x5f, 0x3c, 0x78, 0xdc, 0xa3, 0xa7, 0xa8, 0xed, 0xc5, 0x93, 0x87, 0xfc, 0x02, 0xc9, 0x4c, 0xeb,
+	0xb6, 0x65, 0xbd, 0x28, 0x88, 0xde, 0xf2, 0xde, 0xf7, 0xbd, 0xc7, 0xfb, 0xbe, 0x79, 0x79, 0xd0,
+	0x96, 0x4c, 0x08, 0xe2, 0x4a, 0xc1, 0x48, 0x36, 0x14, 0x13, 0x77, 0x74, 0xd0, 0x63, 0x92, 0x1c,
+	0xb8, 0x01, 0x1f, 0x39, 0xa9, 0xe0, 0x92, 0x1b, 0xf7, 0x14, 0xc3, 0x59, 0x32, 0x9c, 0x05, 0xa3,
+	0xdd, 0x0a, 0x78, 0xc0, 0x15, 0xc5, 0x2d, 0xbf, 0x34, 0xbb, 0x8d, 0x02, 0xce, 0x83, 0x88, 0xb9,
+	0x2a, 0xea, 0x0d, 0x2f, 0x5c, 0x19, 0xc6, 0x2c, 0x93, 0x24, 0x4e, 0x35, 0x01, 0x7f, 0xdd, 0x82,
+	0xb8, 0x4b, 0xa9, 0x37, 0x14, 0xc9, 0x29, 0x19, 0x3f, 0x1f, 0xb3, 0x38, 0x95, 0x21, 0x4f, 0xba,
+	0x94, 0x0a, 0x96, 0x65, 0xaf, 0x04, 0x4f, 0x79, 0x46, 0x22, 0xa3, 0x05, 0x77, 0x64, 0x28, 0x23,
+	0x66, 0x02, 0x1b, 0x74, 0x6a, 0xbe, 0x0e, 0x0c, 0x1b, 0xd6, 0x29, 0xcb, 0xfa, 0x22, 0x54, 0x35,
+	0xe6, 0x96, 0xc2, 0x56, 0x53, 0xc6, 0x21, 0xac, 0x11, 0xdd, 0x8a, 0x65, 0x66, 0xd5, 0xae, 0x76,
+	0x6a, 0x5e, 0xab, 0xc8, 0xd1, 0xfe, 0x84, 0xc4, 0xd1, 0x11, 0xfe, 0x09, 0x61, 0xff, 0x9a, 0x66,
+	0x1c, 0xc3, 0x26, 0x1b, 0xa7, 0xa1, 0x98, 0x9c, 0x0f, 0x58, 0x18, 0x0c, 0xa4, 0xb9, 0x6d, 0x83,
+	0x4e, 0xd5, 0x33, 0x8b, 0x1c, 0xb5, 0x74, 0xdd, 0x1a, 0x8c, 0xfd, 0x86, 0x8e, 0x4f, 0x54, 0x68,
+	0xbc, 0x86, 0xf5, 0x05, 0x5e, 0x6a, 0x35, 0x77, 0x6c, 0xd0, 0xa9, 0x1f, 0xb6, 0x1d, 0x6d, 0x84,
+	0xb3, 0x34, 0xc2, 0x39, 0x5d, 0x1a, 0xe1, 0xb5, 0x8b, 0x1c, 0x19, 0x6b, 0x8d, 0xcb, 0x42, 0x7c,
+	0xf9, 0x19, 0x01, 0x1f, 0xea, 0x4c, 0x49, 0x36, 0x1e, 0xc3, 0x5d, 0xca, 0x12, 0x1e, 0x67, 0xe6,
+	0xae, 0x12, 0x72, 0xb7, 0xc8, 0x51, 0x53, 0xd7, 0xe9, 0x3c, 0xf6, 0x17, 0x84, 0xa3, 0xc6, 0x9b,
+	0x29, 0xaa, 0xbc, 0x9b, 0xa2, 0xca, 0xb7, 0x29, 0x02, 0xf8, 0x3d, 0x80, 0x8f, 0x7c, 0x16, 0xf3,
+	0x11, 0xfb, 0x87, 0x6c, 0xde, 0x98, 0xf1, 0x3b, 0x80, 0xe8, 0x86, 0x3d, 0x38, 0xe3, 0x09, 0xfb,
+	0xe3, 0xe9, 0x1e, 0xc2, 0xed, 0x84, 0xc4, 0xcc, 0xac, 0x96, 0x90, 0xb7, 0x57, 0xe4, 0xa8, 0xae,
+	0x07, 0x2b, 0xb3, 0xd8, 0x57, 0xa0, 0xf1, 0x04, 0xde, 0x62, 0x09, 0xe9, 0x45, 0x8c, 0xaa, 0xf7,
+	0xbe, 0xed, 0x19, 0x45, 0x8e, 0xee, 0x2c, 0x9e, 0x45, 0x03, 0xd8, 0x5f, 0x52, 0xd6, 0x05, 0xef,
+	0xfc, 0x8e, 0xe0, 0x0f, 0x5b, 0x10, 0xbf, 0xe4, 0x34, 0xbc, 0x98, 0xfc, 0x2f, 0x9a, 0x8f, 0x61,
+	0x93, 0x50, 0x7a, 0xbe, 0xa9, 0x7b, 0xe5, 0xbf, 0x58, 0x83, 0xb1, 0xdf, 0x20, 0x94, 0x76, 0x97,
+	0xa1, 0xf1, 0x02, 0xee, 0x0b, 0xb5, 0x84, 0x2b, 0x1d, 0xf4, 0x22, 0x3f, 0x28, 0x72, 0x74, 0x5f,
+	0x77, 0xd8, 0x64, 0x60, 0x7f, 0x4f, 0xa7, 0xba, 0xbf, 0xb0, 0xf1, 0x2d, 0x80, 0xf8, 0xe6, 0xdd,
+	0xfe, 0x6b, 0x36, 0xae, 0x4f, 0xe4, 0x9d, 0x7c, 0x9c, 0x59, 0xe0, 0x6a, 0x66, 0x81, 0x2f, 0x33,
+	0x0b, 0x5c, 0xce, 0xad, 0xca, 0xd5, 0xdc, 0xaa, 0x7c, 0x9a, 0x5b, 0x95, 0x33, 0x27, 0x08, 0xe5,
+	0x60, 0xd8, 0x73, 0xfa, 0x3c, 0x76, 0xfb, 0x11, 0xc9, 0xb2, 0xb0, 0xff, 0x54, 0xdf, 0xdb, 0x3e,
+	0x17, 0xcc, 0x1d, 0x5f, 0x9f, 0x5d, 0x39, 0x49, 0x59, 0xd6, 0xdb, 0x55, 0xc7, 0xe2, 0xd9, 0x8f,
+	0x01, 0x00, 0x86, 0x9f, 0xe6, 0x49, 0x95, 0x05, 0x00, 0x00,
 }
 
 func (this *AddBurnTaxExemptionAddressProposal) Equal(that interface{}) bool {
@@ -176,6 +343,24 @@ func (this *AddBurnTaxExemptionAddressProposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if that1.ExpiryTime == nil {
+		if this.ExpiryTime != nil {
+			return false
+		}
+	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
+		return false
+	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
 	return true
 }
 
@@ -215,6 +400,129 @@ func (this *RemoveBurnTaxExemptionAddressProposal) Equal(that interface{}) bool
 	return true
 }
 
+func (this *AddBurnTaxExemptionZoneProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddBurnTaxExemptionZoneProposal)
+	if !ok {
+		that2, ok := that.(AddBurnTaxExemptionZoneProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *ModifyBurnTaxExemptionZoneProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModifyBurnTaxExemptionZoneProposal)
+	if !ok {
+		that2, ok := that.(ModifyBurnTaxExemptionZoneProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if len(this.AddAddresses) != len(that1.AddAddresses) {
+		return false
+	}
+	for i := range this.AddAddresses {
+		if this.AddAddresses[i] != that1.AddAddresses[i] {
+			return false
+		}
+	}
+	if len(this.RemoveAddresses) != len(that1.RemoveAddresses) {
+		return false
+	}
+	for i := range this.RemoveAddresses {
+		if this.RemoveAddresses[i] != that1.RemoveAddresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *RemoveBurnTaxExemptionZoneProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveBurnTaxExemptionZoneProposal)
+	if !ok {
+		that2, ok := that.(RemoveBurnTaxExemptionZoneProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+
 func (m *AddBurnTaxExemptionAddressProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -235,6 +543,30 @@ func (m *AddBurnTaxExemptionAddressProposal) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Addresses) > 0 {
 		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Addresses[iNdEx])
@@ -307,73 +639,932 @@ func (m *RemoveBurnTaxExemptionAddressProposal) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AddBurnTaxExemptionZoneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *AddBurnTaxExemptionAddressProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddBurnTaxExemptionZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AddAddresses) > 0 {
+		for iNdEx := len(m.AddAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAddresses[iNdEx])
+			copy(dAtA[i:], m.AddAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AddAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *AddBurnTaxExemptionAddressProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGov(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveBurnTaxExemptionAddressProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModifyBurnTaxExemptionZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveBurnTaxExemptionZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *AddBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddBurnTaxExemptionAddressProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddBurnTaxExemptionAddressProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RemoveBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveBurnTaxExemptionAddressProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveBurnTaxExemptionAddressProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AddBurnTaxExemptionZoneProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddBurnTaxExemptionZoneProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddBurnTaxExemptionZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *RemoveBurnTaxExemptionAddressProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *AddBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
+func (m *ModifyBurnTaxExemptionZoneProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,10 +1587,10 @@ func (m *AddBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddBurnTaxExemptionAddressProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyBurnTaxExemptionZoneProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddBurnTaxExemptionAddressProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyBurnTaxExemptionZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -468,7 +1659,7 @@ func (m *AddBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -496,7 +1687,91 @@ func (m *AddBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAddresses = append(m.AddAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -520,7 +1795,7 @@ func (m *AddBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *RemoveBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveBurnTaxExemptionZoneProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -543,10 +1818,10 @@ func (m *RemoveBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveBurnTaxExemptionAddressProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveBurnTaxExemptionZoneProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveBurnTaxExemptionAddressProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveBurnTaxExemptionZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -615,7 +1890,7 @@ func (m *RemoveBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -643,7 +1918,7 @@ func (m *RemoveBurnTaxExemptionAddressProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -42,6 +45,24 @@ const BurnModuleName = "burn"
 // - 0x08<epoch_Bytes>: sdk.Int
 //
 // - 0x09: int64
+//
+// - 0x0A<epoch_Bytes>: EpochPolicy
+//
+// - 0x0B<epoch_Bytes>: sdk.Dec
+//
+// - 0x20<address_Bytes>: BurnTaxExemption
+//
+// - 0x21<name_Bytes>: BurnTaxExemptionZone
+//
+// - 0x22<address_Bytes>: zone name
+//
+// - 0x23<name_len (1 Byte)><name_Bytes><address_Bytes>: []byte{0x01}
+//
+// - 0x24<expiry_height (8 Bytes)><address_Bytes>: []byte{0x01}
+//
+// - 0x25<expiry_time_Bytes><address_Bytes>: []byte{0x01}
+//
+// - 0x26: uint64
 var (
 	// Keys for store prefixes
 	TaxRateKey                 = []byte{0x01} // a key for a tax-rate
@@ -52,10 +73,21 @@ var (
 	CumulativeHeightKey        = []byte{0x09} // a key for a cumulated height
 	BurnTaxExemptionListPrefix = []byte{0x20} // prefix for burn tax exemption list
 
+	BurnTaxExemptionZonePrefix          = []byte{0x21} // prefix for each key to a burn tax exemption zone
+	BurnTaxExemptionZoneByAddressPrefix = []byte{0x22} // prefix for each key to the zone name of an address
+	BurnTaxExemptionZoneMemberPrefix    = []byte{0x23} // prefix for each key to a member address of a zone
+	BurnTaxExemptionZoneMemberCountKey  = []byte{0x26} // a key for the number of zone member addresses
+
+	BurnTaxExemptionHeightQueuePrefix = []byte{0x24} // prefix for each key to an exemption expiring at a height
+	BurnTaxExemptionTimeQueuePrefix   = []byte{0x25} // prefix for each key to an exemption expiring at a time
+
 	// Keys for store prefixes of internal purpose variables
 	TRKey  = []byte{0x06} // prefix for each key to a TR
 	SRKey  = []byte{0x07} // prefix for each key to a SR
 	TSLKey = []byte{0x08} // prefix for each key to a TSL
+	SFKey  = []byte{0x0B} // prefix for each key to a SF
+
+	EpochPolicyKey = []byte{0x0A} // prefix for each key to an epoch policy
 )
 
 // GetTaxCapKey - stored by *denom*
@@ -78,9 +110,61 @@ func GetTSLKey(epoch int64) []byte {
 	return GetSubkeyByEpoch(TSLKey, epoch)
 }
 
+// GetSFKey - stored by *epoch*
+func GetSFKey(epoch int64) []byte {
+	return GetSubkeyByEpoch(SFKey, epoch)
+}
+
 // GetSubkeyByEpoch - stored by *epoch*
 func GetSubkeyByEpoch(prefix []byte, epoch int64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, uint64(epoch))
 	return append(prefix, b...)
 }
+
+// GetEpochPolicyKey - stored by *epoch*
+func GetEpochPolicyKey(epoch int64) []byte {
+	return GetSubkeyByEpoch(EpochPolicyKey, epoch)
+}
+
+// GetBurnTaxExemptionZoneKey - stored by *name*
+func GetBurnTaxExemptionZoneKey(name string) []byte {
+	return append(BurnTaxExemptionZonePrefix, []byte(name)...)
+}
+
+// GetBurnTaxExemptionZoneByAddressKey - stored by *address*
+func GetBurnTaxExemptionZoneByAddressKey(address string) []byte {
+	return append(BurnTaxExemptionZoneByAddressPrefix, []byte(address)...)
+}
+
+// GetBurnTaxExemptionZoneMembersPrefix - stored by *name*
+func GetBurnTaxExemptionZoneMembersPrefix(name string) []byte {
+	return append(append(BurnTaxExemptionZoneMemberPrefix, byte(len(name))), []byte(name)...)
+}
+
+// GetBurnTaxExemptionZoneMemberKey - stored by *name* and *address*
+func GetBurnTaxExemptionZoneMemberKey(name, address string) []byte {
+	return append(GetBurnTaxExemptionZoneMembersPrefix(name), []byte(address)...)
+}
+
+// GetBurnTaxExemptionHeightQueuePrefix - stored by *expiry height*
+func GetBurnTaxExemptionHeightQueuePrefix(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(BurnTaxExemptionHeightQueuePrefix, b...)
+}
+
+// GetBurnTaxExemptionHeightQueueKey - stored by *expiry height* and *address*
+func GetBurnTaxExemptionHeightQueueKey(height int64, address string) []byte {
+	return append(GetBurnTaxExemptionHeightQueuePrefix(height), []byte(address)...)
+}
+
+// GetBurnTaxExemptionTimeQueuePrefix - stored by *expiry time*
+func GetBurnTaxExemptionTimeQueuePrefix(expiryTime time.Time) []byte {
+	return append(BurnTaxExemptionTimeQueuePrefix, sdk.FormatTimeBytes(expiryTime)...)
+}
+
+// GetBurnTaxExemptionTimeQueueKey - stored by *expiry time* and *address*
+func GetBurnTaxExemptionTimeQueueKey(expiryTime time.Time, address string) []byte {
+	return append(GetBurnTaxExemptionTimeQueuePrefix(expiryTime), []byte(address)...)
+}
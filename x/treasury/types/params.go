@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
@@ -22,6 +23,8 @@ var (
 	KeyWindowProbation         = []byte("WindowProbation")
 	KeyBurnTaxSplit            = []byte("BurnTaxSplit")
 	KeyMinInitialDepositRatio  = []byte("MinInitialDepositRatio")
+	KeyTaxRateMultipliers      = []byte("TaxRateMultipliers")
+	KeyDenomTaxRates           = []byte("DenomTaxRates")
 )
 
 // Default parameter values
@@ -47,6 +50,8 @@ var (
 	DefaultRewardWeight            = sdk.NewDecWithPrec(5, 2)   // 5%
 	DefaultBurnTaxSplit            = sdk.NewDecWithPrec(1, 1)   // 10% goes to community pool, 90% burn
 	DefaultMinInitialDepositRatio  = sdk.ZeroDec()              // 0% min initial deposit
+	DefaultTaxRateMultipliers      = []TaxRateMultiplier(nil)   // every msg type taxed at the tax rate
+	DefaultDenomTaxRates           = []DenomTaxRate(nil)        // every denom taxed at the tax rate
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -63,6 +68,8 @@ func DefaultParams() Params {
 		WindowProbation:         DefaultWindowProbation,
 		BurnTaxSplit:            DefaultBurnTaxSplit,
 		MinInitialDepositRatio:  DefaultMinInitialDepositRatio,
+		TaxRateMultipliers:      DefaultTaxRateMultipliers,
+		DenomTaxRates:           DefaultDenomTaxRates,
 	}
 }
 
@@ -90,6 +97,8 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWindowProbation, &p.WindowProbation, validateWindowProbation),
 		paramstypes.NewParamSetPair(KeyBurnTaxSplit, &p.BurnTaxSplit, validateBurnTaxSplit),
 		paramstypes.NewParamSetPair(KeyMinInitialDepositRatio, &p.MinInitialDepositRatio, validateMinInitialDepositRatio),
+		paramstypes.NewParamSetPair(KeyTaxRateMultipliers, &p.TaxRateMultipliers, validateTaxRateMultipliers),
+		paramstypes.NewParamSetPair(KeyDenomTaxRates, &p.DenomTaxRates, validateDenomTaxRates),
 	}
 }
 
@@ -137,6 +146,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("treasury parameter WindowLong must be bigger than WindowShort: (%d, %d)", p.WindowLong, p.WindowShort)
 	}
 
+	if err := validateTaxRateMultipliers(p.TaxRateMultipliers); err != nil {
+		return fmt.Errorf("treasury parameter TaxRateMultipliers is invalid: %s", err)
+	}
+
+	if err := validateDenomTaxRates(p.DenomTaxRates); err != nil {
+		return fmt.Errorf("treasury parameter DenomTaxRates is invalid: %s", err)
+	}
+
 	return nil
 }
 
@@ -268,3 +285,53 @@ func validateMinInitialDepositRatio(i interface{}) error {
 
 	return nil
 }
+
+func validateTaxRateMultipliers(i interface{}) error {
+	v, ok := i.([]TaxRateMultiplier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, m := range v {
+		if !strings.HasPrefix(m.MsgTypeURL, "/") {
+			return fmt.Errorf("msg type url must start with /: %s", m.MsgTypeURL)
+		}
+
+		if seen[m.MsgTypeURL] {
+			return fmt.Errorf("duplicate tax rate multiplier of %s", m.MsgTypeURL)
+		}
+		seen[m.MsgTypeURL] = true
+
+		if m.Multiplier.IsNil() || m.Multiplier.IsNegative() {
+			return fmt.Errorf("tax rate multiplier of %s must be positive or zero: %s", m.MsgTypeURL, m.Multiplier)
+		}
+	}
+
+	return nil
+}
+
+func validateDenomTaxRates(i interface{}) error {
+	v, ok := i.([]DenomTaxRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, r := range v {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return err
+		}
+
+		if seen[r.Denom] {
+			return fmt.Errorf("duplicate tax rate of %s", r.Denom)
+		}
+		seen[r.Denom] = true
+
+		if r.TaxRate.IsNil() || r.TaxRate.IsNegative() || r.TaxRate.GT(sdk.OneDec()) {
+			return fmt.Errorf("tax rate of %s must be between 0 and 1: %s", r.Denom, r.TaxRate)
+		}
+	}
+
+	return nil
+}
@@ -52,6 +52,32 @@ func TestParams(t *testing.T) {
 	params.RewardPolicy.RateMin = sdk.NewDec(-1)
 	require.Error(t, params.Validate())
 
+	params = DefaultParams()
+	params.TaxRateMultipliers = []TaxRateMultiplier{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Multiplier: sdk.NewDecWithPrec(5, 1)}}
+	params.DenomTaxRates = []DenomTaxRate{{Denom: "uusd", TaxRate: sdk.NewDecWithPrec(2, 3)}}
+	require.NoError(t, params.Validate())
+
+	params.TaxRateMultipliers = append(params.TaxRateMultipliers, params.TaxRateMultipliers[0])
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.TaxRateMultipliers = []TaxRateMultiplier{{MsgTypeURL: "cosmos.bank.v1beta1.MsgSend", Multiplier: sdk.OneDec()}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.TaxRateMultipliers = []TaxRateMultiplier{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Multiplier: sdk.NewDec(-1)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.DenomTaxRates = []DenomTaxRate{{Denom: "uusd", TaxRate: sdk.NewDec(2)}}
+	require.Error(t, params.Validate())
+
+	// scaled rates above one are capped when the tax is computed
+	params = DefaultParams()
+	params.TaxRateMultipliers = []TaxRateMultiplier{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Multiplier: sdk.NewDec(200)}}
+	params.DenomTaxRates = []DenomTaxRate{{Denom: "uusd", TaxRate: sdk.NewDecWithPrec(5, 2)}}
+	require.NoError(t, params.Validate())
+
 	require.NotNil(t, params.ParamSetPairs())
 	require.NotNil(t, params.String())
 }
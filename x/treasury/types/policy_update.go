@@ -0,0 +1,17 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+)
+
+// String implements fmt.Stringer interface
+func (u TaxRateUpdate) String() string {
+	out, _ := yaml.Marshal(u)
+	return string(out)
+}
+
+// String implements fmt.Stringer interface
+func (u RewardWeightUpdate) String() string {
+	out, _ := yaml.Marshal(u)
+	return string(out)
+}
@@ -352,6 +352,94 @@ func (m *QueryTaxCapsResponse) GetTaxCaps() []QueryTaxCapsResponseItem {
 	return nil
 }
 
+// QueryMsgTaxRateRequest is the request type for the Query/MsgTaxRate RPC method.
+type QueryMsgTaxRateRequest struct {
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryMsgTaxRateRequest) Reset()         { *m = QueryMsgTaxRateRequest{} }
+func (m *QueryMsgTaxRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTaxRateRequest) ProtoMessage()    {}
+func (*QueryMsgTaxRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{7}
+}
+
+func (m *QueryMsgTaxRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMsgTaxRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTaxRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMsgTaxRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTaxRateRequest.Merge(m, src)
+}
+
+func (m *QueryMsgTaxRateRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMsgTaxRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTaxRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTaxRateRequest proto.InternalMessageInfo
+
+// QueryMsgTaxRateResponse is response type for the
+// Query/MsgTaxRate RPC method.
+type QueryMsgTaxRateResponse struct {
+	TaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+}
+
+func (m *QueryMsgTaxRateResponse) Reset()         { *m = QueryMsgTaxRateResponse{} }
+func (m *QueryMsgTaxRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTaxRateResponse) ProtoMessage()    {}
+func (*QueryMsgTaxRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{8}
+}
+
+func (m *QueryMsgTaxRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMsgTaxRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTaxRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMsgTaxRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTaxRateResponse.Merge(m, src)
+}
+
+func (m *QueryMsgTaxRateResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMsgTaxRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTaxRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTaxRateResponse proto.InternalMessageInfo
+
 // QueryRewardWeightRequest is the request type for the Query/RewardWeight RPC method.
 type QueryRewardWeightRequest struct{}
 
@@ -359,7 +447,7 @@ func (m *QueryRewardWeightRequest) Reset()         { *m = QueryRewardWeightReque
 func (m *QueryRewardWeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardWeightRequest) ProtoMessage()    {}
 func (*QueryRewardWeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{7}
+	return fileDescriptor_699c8c29293c9a9b, []int{9}
 }
 
 func (m *QueryRewardWeightRequest) XXX_Unmarshal(b []byte) error {
@@ -403,7 +491,7 @@ func (m *QueryRewardWeightResponse) Reset()         { *m = QueryRewardWeightResp
 func (m *QueryRewardWeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardWeightResponse) ProtoMessage()    {}
 func (*QueryRewardWeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{8}
+	return fileDescriptor_699c8c29293c9a9b, []int{10}
 }
 
 func (m *QueryRewardWeightResponse) XXX_Unmarshal(b []byte) error {
@@ -444,7 +532,7 @@ func (m *QueryTaxProceedsRequest) Reset()         { *m = QueryTaxProceedsRequest
 func (m *QueryTaxProceedsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTaxProceedsRequest) ProtoMessage()    {}
 func (*QueryTaxProceedsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{9}
+	return fileDescriptor_699c8c29293c9a9b, []int{11}
 }
 
 func (m *QueryTaxProceedsRequest) XXX_Unmarshal(b []byte) error {
@@ -488,7 +576,7 @@ func (m *QueryTaxProceedsResponse) Reset()         { *m = QueryTaxProceedsRespon
 func (m *QueryTaxProceedsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTaxProceedsResponse) ProtoMessage()    {}
 func (*QueryTaxProceedsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{10}
+	return fileDescriptor_699c8c29293c9a9b, []int{12}
 }
 
 func (m *QueryTaxProceedsResponse) XXX_Unmarshal(b []byte) error {
@@ -536,7 +624,7 @@ func (m *QuerySeigniorageProceedsRequest) Reset()         { *m = QuerySeigniorag
 func (m *QuerySeigniorageProceedsRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySeigniorageProceedsRequest) ProtoMessage()    {}
 func (*QuerySeigniorageProceedsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{11}
+	return fileDescriptor_699c8c29293c9a9b, []int{13}
 }
 
 func (m *QuerySeigniorageProceedsRequest) XXX_Unmarshal(b []byte) error {
@@ -580,7 +668,7 @@ func (m *QuerySeigniorageProceedsResponse) Reset()         { *m = QuerySeigniora
 func (m *QuerySeigniorageProceedsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySeigniorageProceedsResponse) ProtoMessage()    {}
 func (*QuerySeigniorageProceedsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{12}
+	return fileDescriptor_699c8c29293c9a9b, []int{14}
 }
 
 func (m *QuerySeigniorageProceedsResponse) XXX_Unmarshal(b []byte) error {
@@ -621,7 +709,7 @@ func (m *QueryIndicatorsRequest) Reset()         { *m = QueryIndicatorsRequest{}
 func (m *QueryIndicatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIndicatorsRequest) ProtoMessage()    {}
 func (*QueryIndicatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{13}
+	return fileDescriptor_699c8c29293c9a9b, []int{15}
 }
 
 func (m *QueryIndicatorsRequest) XXX_Unmarshal(b []byte) error {
@@ -666,7 +754,7 @@ func (m *QueryIndicatorsResponse) Reset()         { *m = QueryIndicatorsResponse
 func (m *QueryIndicatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIndicatorsResponse) ProtoMessage()    {}
 func (*QueryIndicatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{14}
+	return fileDescriptor_699c8c29293c9a9b, []int{16}
 }
 
 func (m *QueryIndicatorsResponse) XXX_Unmarshal(b []byte) error {
@@ -707,7 +795,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{15}
+	return fileDescriptor_699c8c29293c9a9b, []int{17}
 }
 
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
@@ -751,7 +839,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{16}
+	return fileDescriptor_699c8c29293c9a9b, []int{18}
 }
 
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
@@ -792,25 +880,29 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-// QueryBurnTaxExemptionListRequest is the request type for the Query/BurnTaxExemptionList RPC method.
-type QueryBurnTaxExemptionListRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryIndicatorHistoryRequest is the request type for the Query/IndicatorHistory RPC method.
+type QueryIndicatorHistoryRequest struct {
+	// from_epoch is the first epoch of the range
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// to_epoch is the last epoch of the range, the last ended epoch when zero
+	ToEpoch    uint64             `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBurnTaxExemptionListRequest) Reset()         { *m = QueryBurnTaxExemptionListRequest{} }
-func (m *QueryBurnTaxExemptionListRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBurnTaxExemptionListRequest) ProtoMessage()    {}
-func (*QueryBurnTaxExemptionListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{17}
+func (m *QueryIndicatorHistoryRequest) Reset()         { *m = QueryIndicatorHistoryRequest{} }
+func (m *QueryIndicatorHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIndicatorHistoryRequest) ProtoMessage()    {}
+func (*QueryIndicatorHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{19}
 }
 
-func (m *QueryBurnTaxExemptionListRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryIndicatorHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *QueryBurnTaxExemptionListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIndicatorHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBurnTaxExemptionListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIndicatorHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -821,47 +913,67 @@ func (m *QueryBurnTaxExemptionListRequest) XXX_Marshal(b []byte, deterministic b
 	}
 }
 
-func (m *QueryBurnTaxExemptionListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBurnTaxExemptionListRequest.Merge(m, src)
+func (m *QueryIndicatorHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndicatorHistoryRequest.Merge(m, src)
 }
 
-func (m *QueryBurnTaxExemptionListRequest) XXX_Size() int {
+func (m *QueryIndicatorHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *QueryBurnTaxExemptionListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBurnTaxExemptionListRequest.DiscardUnknown(m)
+func (m *QueryIndicatorHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndicatorHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBurnTaxExemptionListRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryIndicatorHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryBurnTaxExemptionListRequest) GetPagination() *query.PageRequest {
+func (m *QueryIndicatorHistoryRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryIndicatorHistoryRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *QueryIndicatorHistoryRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryBurnTaxExemptionListResponse is response type for the Query/BurnTaxExemptionList RPC method.
-type QueryBurnTaxExemptionListResponse struct {
-	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// EpochIndicators is the record of the indicators and policy of an epoch
+type EpochIndicators struct {
+	Epoch             uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TaxReward         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_reward,json=taxReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_reward"`
+	SeigniorageReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seigniorage_reward,json=seigniorageReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_reward"`
+	TotalStakedLuna   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_staked_luna,json=totalStakedLuna,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staked_luna"`
+	// policy is unset for the epochs ended before the policy history was recorded
+	Policy *EpochPolicy `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
+	// swap_fee is the market swap fees charged during the epoch, in SDR
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
 }
 
-func (m *QueryBurnTaxExemptionListResponse) Reset()         { *m = QueryBurnTaxExemptionListResponse{} }
-func (m *QueryBurnTaxExemptionListResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBurnTaxExemptionListResponse) ProtoMessage()    {}
-func (*QueryBurnTaxExemptionListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{18}
+func (m *EpochIndicators) Reset()         { *m = EpochIndicators{} }
+func (m *EpochIndicators) String() string { return proto.CompactTextString(m) }
+func (*EpochIndicators) ProtoMessage()    {}
+func (*EpochIndicators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{20}
 }
 
-func (m *QueryBurnTaxExemptionListResponse) XXX_Unmarshal(b []byte) error {
+func (m *EpochIndicators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *QueryBurnTaxExemptionListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EpochIndicators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBurnTaxExemptionListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EpochIndicators.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,1358 +984,4584 @@ func (m *QueryBurnTaxExemptionListResponse) XXX_Marshal(b []byte, deterministic
 	}
 }
 
-func (m *QueryBurnTaxExemptionListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBurnTaxExemptionListResponse.Merge(m, src)
+func (m *EpochIndicators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochIndicators.Merge(m, src)
 }
 
-func (m *QueryBurnTaxExemptionListResponse) XXX_Size() int {
+func (m *EpochIndicators) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *QueryBurnTaxExemptionListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBurnTaxExemptionListResponse.DiscardUnknown(m)
+func (m *EpochIndicators) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochIndicators.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBurnTaxExemptionListResponse proto.InternalMessageInfo
+var xxx_messageInfo_EpochIndicators proto.InternalMessageInfo
 
-func (m *QueryBurnTaxExemptionListResponse) GetAddresses() []string {
+func (m *EpochIndicators) GetEpoch() uint64 {
 	if m != nil {
-		return m.Addresses
+		return m.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryBurnTaxExemptionListResponse) GetPagination() *query.PageResponse {
+func (m *EpochIndicators) GetPolicy() *EpochPolicy {
 	if m != nil {
-		return m.Pagination
+		return m.Policy
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryTaxRateRequest)(nil), "terra.treasury.v1beta1.QueryTaxRateRequest")
-	proto.RegisterType((*QueryTaxRateResponse)(nil), "terra.treasury.v1beta1.QueryTaxRateResponse")
-	proto.RegisterType((*QueryTaxCapRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapRequest")
-	proto.RegisterType((*QueryTaxCapResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapResponse")
-	proto.RegisterType((*QueryTaxCapsRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapsRequest")
-	proto.RegisterType((*QueryTaxCapsResponseItem)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponseItem")
-	proto.RegisterType((*QueryTaxCapsResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponse")
-	proto.RegisterType((*QueryRewardWeightRequest)(nil), "terra.treasury.v1beta1.QueryRewardWeightRequest")
-	proto.RegisterType((*QueryRewardWeightResponse)(nil), "terra.treasury.v1beta1.QueryRewardWeightResponse")
-	proto.RegisterType((*QueryTaxProceedsRequest)(nil), "terra.treasury.v1beta1.QueryTaxProceedsRequest")
-	proto.RegisterType((*QueryTaxProceedsResponse)(nil), "terra.treasury.v1beta1.QueryTaxProceedsResponse")
-	proto.RegisterType((*QuerySeigniorageProceedsRequest)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsRequest")
-	proto.RegisterType((*QuerySeigniorageProceedsResponse)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsResponse")
-	proto.RegisterType((*QueryIndicatorsRequest)(nil), "terra.treasury.v1beta1.QueryIndicatorsRequest")
-	proto.RegisterType((*QueryIndicatorsResponse)(nil), "terra.treasury.v1beta1.QueryIndicatorsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "terra.treasury.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "terra.treasury.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryBurnTaxExemptionListRequest)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionListRequest")
-	proto.RegisterType((*QueryBurnTaxExemptionListResponse)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionListResponse")
+// QueryIndicatorHistoryResponse is response type for the Query/IndicatorHistory RPC method.
+type QueryIndicatorHistoryResponse struct {
+	Indicators []EpochIndicators   `protobuf:"bytes,1,rep,name=indicators,proto3" json:"indicators"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("terra/treasury/v1beta1/query.proto", fileDescriptor_699c8c29293c9a9b)
+func (m *QueryIndicatorHistoryResponse) Reset()         { *m = QueryIndicatorHistoryResponse{} }
+func (m *QueryIndicatorHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndicatorHistoryResponse) ProtoMessage()    {}
+func (*QueryIndicatorHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{21}
 }
 
-var fileDescriptor_699c8c29293c9a9b = []byte{
-	// 1037 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcb, 0x6f, 0x1b, 0x45,
-	0x1c, 0xc7, 0xbd, 0x85, 0x3a, 0xc9, 0x38, 0x5c, 0x26, 0xa6, 0x24, 0x56, 0x65, 0xbb, 0xa3, 0x36,
-	0xb5, 0xf2, 0xd8, 0x4d, 0x42, 0xa5, 0xd2, 0x8a, 0x93, 0x0b, 0x94, 0x48, 0x41, 0x6a, 0x37, 0x96,
-	0x2a, 0x38, 0x60, 0x8d, 0xd7, 0xa3, 0xcd, 0x82, 0xbd, 0xb3, 0x9d, 0x19, 0x53, 0x5b, 0x88, 0x0b,
-	0x12, 0x12, 0x70, 0x40, 0x48, 0x3d, 0x71, 0x41, 0x15, 0x47, 0xee, 0x5c, 0x39, 0x71, 0xc8, 0xb1,
-	0x12, 0x17, 0xc4, 0x21, 0xa0, 0x84, 0x03, 0x7f, 0x06, 0x9a, 0xc7, 0x7a, 0xd7, 0xad, 0xd7, 0xde,
-	0x84, 0x53, 0x36, 0x33, 0xbf, 0xc7, 0x67, 0x5e, 0xdf, 0xaf, 0x0c, 0x90, 0x20, 0x8c, 0x61, 0x47,
-	0x30, 0x82, 0xf9, 0x80, 0x8d, 0x9c, 0xcf, 0x76, 0x3b, 0x44, 0xe0, 0x5d, 0xe7, 0xf1, 0x80, 0xb0,
-	0x91, 0x1d, 0x31, 0x2a, 0x28, 0xbc, 0xa2, 0x62, 0xec, 0x38, 0xc6, 0x36, 0x31, 0x95, 0xb2, 0x4f,
-	0x7d, 0xaa, 0x42, 0x1c, 0xf9, 0xa5, 0xa3, 0x2b, 0x57, 0x7d, 0x4a, 0xfd, 0x1e, 0x71, 0x70, 0x14,
-	0x38, 0x38, 0x0c, 0xa9, 0xc0, 0x22, 0xa0, 0x21, 0x37, 0xb3, 0x1b, 0x1e, 0xe5, 0x7d, 0xca, 0x9d,
-	0x0e, 0xe6, 0x44, 0x37, 0x19, 0xb7, 0x8c, 0xb0, 0x1f, 0x84, 0x2a, 0xd8, 0xc4, 0xde, 0xc8, 0x60,
-	0x1b, 0x83, 0xe8, 0xb0, 0x6a, 0xba, 0x64, 0x1c, 0xe3, 0xd1, 0xc0, 0x94, 0x41, 0xaf, 0x83, 0x95,
-	0x87, 0xb2, 0x51, 0x0b, 0x0f, 0x5d, 0x2c, 0x88, 0x4b, 0x1e, 0x0f, 0x08, 0x17, 0x08, 0x83, 0xf2,
-	0xe4, 0x30, 0x8f, 0x68, 0xc8, 0x09, 0xdc, 0x07, 0x8b, 0x02, 0x0f, 0xdb, 0x0c, 0x0b, 0xb2, 0x6a,
-	0xd5, 0xad, 0xc6, 0x52, 0xd3, 0x3e, 0x3e, 0xa9, 0x15, 0xfe, 0x3c, 0xa9, 0xad, 0xfb, 0x81, 0x38,
-	0x1a, 0x74, 0x6c, 0x8f, 0xf6, 0x1d, 0xd3, 0x53, 0xff, 0xd9, 0xe6, 0xdd, 0x4f, 0x1d, 0x31, 0x8a,
-	0x08, 0xb7, 0xdf, 0x21, 0x9e, 0xbb, 0x20, 0x74, 0x49, 0x74, 0x0b, 0xc0, 0xb8, 0xc5, 0x3d, 0x1c,
-	0x99, 0xc6, 0xb0, 0x0c, 0x2e, 0x77, 0x49, 0x48, 0xfb, 0xba, 0xba, 0xab, 0xff, 0xb9, 0xbb, 0xf8,
-	0xf5, 0xb3, 0x5a, 0xe1, 0xdf, 0x67, 0xb5, 0x02, 0xfa, 0x38, 0xe1, 0x55, 0x59, 0x86, 0xeb, 0x3e,
-	0x90, 0x75, 0xdb, 0x1e, 0x8e, 0x2e, 0x80, 0xb5, 0x1f, 0x0a, 0xb7, 0x28, 0x54, 0x41, 0x54, 0x9b,
-	0xa8, 0xcf, 0x0d, 0x56, 0x0a, 0x60, 0x04, 0x56, 0x27, 0x03, 0x34, 0xc1, 0xbe, 0x20, 0xfd, 0xe9,
-	0xf0, 0x69, 0xb6, 0x4b, 0xff, 0x8b, 0x2d, 0x48, 0x0e, 0x25, 0xdd, 0x1a, 0x3e, 0xd4, 0x87, 0xe2,
-	0xe1, 0x88, 0xaf, 0x5a, 0xf5, 0x57, 0x1a, 0xa5, 0xbd, 0x1d, 0x7b, 0xfa, 0xad, 0xb4, 0xb3, 0xd0,
-	0x9b, 0xaf, 0x4a, 0x26, 0x75, 0x38, 0x72, 0x0a, 0x55, 0xcc, 0x2a, 0x5d, 0xf2, 0x04, 0xb3, 0xee,
-	0x23, 0x12, 0xf8, 0x47, 0x22, 0xbe, 0x1b, 0x11, 0x58, 0x9b, 0x32, 0x67, 0x58, 0x0e, 0xc1, 0x6b,
-	0x4c, 0x8d, 0xb7, 0x9f, 0xa8, 0x89, 0x0b, 0xde, 0x92, 0x65, 0x96, 0x2a, 0x8e, 0xd6, 0xc0, 0x1b,
-	0x31, 0xf8, 0x03, 0x46, 0x3d, 0x42, 0xba, 0xf1, 0xc1, 0xa0, 0x6f, 0xad, 0xe4, 0x3c, 0x92, 0x39,
-	0x03, 0x13, 0x82, 0x65, 0xb9, 0x31, 0x91, 0x19, 0x37, 0x9b, 0xb3, 0x66, 0xeb, 0x96, 0xb6, 0x7c,
-	0x13, 0xe3, 0x9d, 0xb9, 0x47, 0x83, 0xb0, 0xb9, 0x23, 0x31, 0x7f, 0xfe, 0xab, 0xd6, 0xc8, 0x81,
-	0x29, 0x13, 0xb8, 0x5b, 0x12, 0x49, 0x5f, 0x74, 0x0d, 0xd4, 0x14, 0xcb, 0x21, 0x09, 0xfc, 0x30,
-	0xa0, 0x0c, 0xfb, 0xe4, 0x45, 0xde, 0xaf, 0x2c, 0x50, 0xcf, 0x8e, 0x31, 0xdc, 0x18, 0x94, 0x79,
-	0x32, 0x9d, 0xe6, 0xbf, 0xc8, 0xf5, 0x59, 0xe1, 0x2f, 0xb7, 0x42, 0xab, 0xe0, 0x8a, 0xc2, 0xd8,
-	0x0f, 0xbb, 0x81, 0x87, 0x05, 0x65, 0x63, 0xc2, 0x63, 0xcb, 0xec, 0x76, 0x7a, 0xca, 0x80, 0xb5,
-	0xc0, 0xa2, 0x60, 0xbd, 0xf6, 0x88, 0x60, 0x66, 0x60, 0xee, 0x9c, 0xef, 0x60, 0x4f, 0x4f, 0x6a,
-	0x0b, 0x2d, 0xf7, 0xe0, 0x43, 0x82, 0x99, 0xbb, 0x20, 0x58, 0x4f, 0x7e, 0xc0, 0x47, 0x60, 0x49,
-	0x56, 0xed, 0xd3, 0x50, 0x1c, 0x99, 0x27, 0x72, 0xf7, 0xdc, 0x65, 0x17, 0x5b, 0xee, 0xc1, 0x07,
-	0xb2, 0x82, 0x2b, 0x11, 0xd5, 0x17, 0x2a, 0x1b, 0x89, 0x79, 0x80, 0x19, 0xee, 0x8f, 0x17, 0x78,
-	0x68, 0x9e, 0x78, 0x3c, 0x6a, 0xd6, 0xf6, 0x36, 0x28, 0x46, 0x6a, 0x44, 0xad, 0xac, 0xb4, 0x57,
-	0xcd, 0x7a, 0x43, 0x3a, 0xcf, 0xbc, 0x18, 0x93, 0x83, 0x3e, 0x31, 0xc7, 0xda, 0x1c, 0xb0, 0xb0,
-	0x85, 0x87, 0xef, 0x0e, 0x49, 0x3f, 0x92, 0x6a, 0x7d, 0x10, 0xf0, 0xf8, 0xe1, 0xc0, 0xf7, 0x00,
-	0x48, 0x64, 0x5c, 0x2d, 0xb4, 0xb4, 0xb7, 0x3e, 0x71, 0x19, 0xb5, 0xb1, 0x24, 0x8d, 0xfc, 0x58,
-	0x90, 0xdd, 0x54, 0xa6, 0xbc, 0xf3, 0xd7, 0x66, 0x34, 0x33, 0xeb, 0xb9, 0x0a, 0x96, 0x70, 0xb7,
-	0xcb, 0x08, 0xe7, 0x44, 0xdf, 0xfc, 0x25, 0x37, 0x19, 0x80, 0xf7, 0xa7, 0xb0, 0xdc, 0x9c, 0xcb,
-	0xa2, 0x4b, 0xa7, 0x61, 0xf6, 0x7e, 0x29, 0x81, 0xcb, 0x0a, 0x06, 0x7e, 0x67, 0x81, 0x05, 0xe3,
-	0x17, 0x70, 0x73, 0x9e, 0x00, 0xa5, 0xcc, 0xa6, 0xb2, 0x95, 0x2f, 0x58, 0x37, 0x47, 0x8d, 0x2f,
-	0x7f, 0xff, 0xe7, 0xe9, 0x25, 0x04, 0xeb, 0x4e, 0x96, 0x03, 0x1a, 0x83, 0x82, 0x4f, 0x2d, 0x50,
-	0xd4, 0x5a, 0x07, 0x37, 0x72, 0x08, 0x62, 0x8c, 0xb3, 0x99, 0x2b, 0xd6, 0xd0, 0xec, 0x28, 0x9a,
-	0x0d, 0xd8, 0x98, 0x45, 0x23, 0x95, 0xd9, 0xf9, 0x5c, 0xb9, 0xc1, 0x17, 0xf1, 0x36, 0x49, 0x99,
-	0x85, 0x9b, 0xf9, 0x74, 0x3a, 0xe7, 0x36, 0xa5, 0x45, 0x3d, 0xdf, 0x36, 0x49, 0x30, 0xf8, 0x93,
-	0x05, 0x96, 0xd3, 0x5a, 0x0e, 0x67, 0xbb, 0xc7, 0x14, 0x4b, 0xa8, 0xec, 0x9e, 0x23, 0xc3, 0xf0,
-	0x6d, 0x2b, 0xbe, 0x9b, 0xf0, 0x46, 0x16, 0xdf, 0x84, 0x8d, 0xc0, 0x5f, 0x2d, 0xb0, 0x32, 0x45,
-	0x32, 0xe1, 0xed, 0x99, 0x9d, 0xb3, 0x85, 0xb8, 0xf2, 0xd6, 0xf9, 0x13, 0x0d, 0xf9, 0x2d, 0x45,
-	0x6e, 0xc3, 0xad, 0x2c, 0xf2, 0x69, 0xda, 0x0d, 0x7f, 0xb4, 0x40, 0x29, 0xe5, 0x51, 0xd0, 0x99,
-	0x77, 0x9a, 0x2f, 0x02, 0xef, 0xe4, 0x4f, 0x30, 0xa0, 0x5b, 0x0a, 0x74, 0x1d, 0x5e, 0x9f, 0x75,
-	0x05, 0xc6, 0x80, 0x3f, 0x58, 0x00, 0x24, 0x92, 0x0f, 0xed, 0x99, 0xed, 0x5e, 0xb2, 0x8d, 0x8a,
-	0x93, 0x3b, 0xde, 0xd0, 0x6d, 0x28, 0xba, 0xeb, 0x10, 0x65, 0xd1, 0x05, 0x09, 0xcc, 0x6f, 0x16,
-	0x28, 0x4f, 0x13, 0x3b, 0x38, 0xfb, 0x14, 0x67, 0x88, 0x71, 0xe5, 0xce, 0x05, 0x32, 0x0d, 0xf9,
-	0x6d, 0x45, 0xbe, 0x0b, 0x9d, 0x2c, 0xf2, 0xce, 0x80, 0x85, 0x6d, 0xb9, 0xb9, 0x24, 0xce, 0x6f,
-	0xf7, 0x24, 0xed, 0x37, 0x16, 0x28, 0x6a, 0xf7, 0x98, 0x23, 0x48, 0x13, 0x86, 0x35, 0x47, 0x90,
-	0x26, 0x6d, 0x0c, 0xad, 0x2b, 0xb8, 0x3a, 0xac, 0x66, 0xc1, 0x69, 0xc3, 0x6a, 0xbe, 0x7f, 0x7c,
-	0x5a, 0xb5, 0x9e, 0x9f, 0x56, 0xad, 0xbf, 0x4f, 0xab, 0xd6, 0xf7, 0x67, 0xd5, 0xc2, 0xf3, 0xb3,
-	0x6a, 0xe1, 0x8f, 0xb3, 0x6a, 0xe1, 0x23, 0x3b, 0xed, 0xb9, 0x3d, 0xcc, 0x79, 0xe0, 0x6d, 0xeb,
-	0x5a, 0x1e, 0x65, 0xc4, 0x19, 0x26, 0x25, 0x95, 0xff, 0x76, 0x8a, 0xea, 0x97, 0xc4, 0x9b, 0xff,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xe4, 0x0b, 0xdc, 0x2e, 0x0d, 0x00, 0x00,
+func (m *QueryIndicatorHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ context.Context
-	_ grpc.ClientConn
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryIndicatorHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndicatorHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TaxRate return the current tax rate
-	TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error)
-	// TaxCap returns the tax cap of a denom
-	TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error)
-	// TaxCaps returns the all tax caps
-	TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error)
-	// RewardWeight return the current reward weight
-	RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error)
-	// SeigniorageProceeds return the current seigniorage proceeds
-	SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error)
-	// TaxProceeds return the current tax proceeds
-	TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error)
-	// Indicators return the current trl informations
-	Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error)
-	// BurnTaxExemptionList returns all registered burn tax exemption addresses
-	BurnTaxExemptionList(ctx context.Context, in *QueryBurnTaxExemptionListRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionListResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryIndicatorHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndicatorHistoryResponse.Merge(m, src)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryIndicatorHistoryResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryIndicatorHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndicatorHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error) {
-	out := new(QueryTaxRateResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIndicatorHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryIndicatorHistoryResponse) GetIndicators() []EpochIndicators {
+	if m != nil {
+		return m.Indicators
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error) {
-	out := new(QueryTaxCapResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIndicatorHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error) {
-	out := new(QueryTaxCapsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCaps", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryPolicyDryRunRequest is the request type for the Query/PolicyDryRun RPC method.
+type QueryPolicyDryRunRequest struct{}
+
+func (m *QueryPolicyDryRunRequest) Reset()         { *m = QueryPolicyDryRunRequest{} }
+func (m *QueryPolicyDryRunRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicyDryRunRequest) ProtoMessage()    {}
+func (*QueryPolicyDryRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{22}
 }
 
-func (c *queryClient) RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error) {
-	out := new(QueryRewardWeightResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/RewardWeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPolicyDryRunRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (c *queryClient) SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error) {
-	out := new(QuerySeigniorageProceedsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/SeigniorageProceeds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPolicyDryRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicyDryRunRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-func (c *queryClient) TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error) {
-	out := new(QueryTaxProceedsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxProceeds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPolicyDryRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicyDryRunRequest.Merge(m, src)
 }
 
-func (c *queryClient) Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error) {
-	out := new(QueryIndicatorsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Indicators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPolicyDryRunRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (c *queryClient) BurnTaxExemptionList(ctx context.Context, in *QueryBurnTaxExemptionListRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionListResponse, error) {
-	out := new(QueryBurnTaxExemptionListResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/BurnTaxExemptionList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPolicyDryRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicyDryRunRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryPolicyDryRunRequest proto.InternalMessageInfo
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TaxRate return the current tax rate
-	TaxRate(context.Context, *QueryTaxRateRequest) (*QueryTaxRateResponse, error)
-	// TaxCap returns the tax cap of a denom
-	TaxCap(context.Context, *QueryTaxCapRequest) (*QueryTaxCapResponse, error)
-	// TaxCaps returns the all tax caps
-	TaxCaps(context.Context, *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error)
-	// RewardWeight return the current reward weight
-	RewardWeight(context.Context, *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error)
-	// SeigniorageProceeds return the current seigniorage proceeds
-	SeigniorageProceeds(context.Context, *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error)
-	// TaxProceeds return the current tax proceeds
-	TaxProceeds(context.Context, *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error)
-	// Indicators return the current trl informations
-	Indicators(context.Context, *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error)
-	// BurnTaxExemptionList returns all registered burn tax exemption addresses
-	BurnTaxExemptionList(context.Context, *QueryBurnTaxExemptionListRequest) (*QueryBurnTaxExemptionListResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryPolicyDryRunResponse is response type for the Query/PolicyDryRun RPC method.
+type QueryPolicyDryRunResponse struct {
+	// epoch is the epoch whose end is simulated
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// probation is true when the epoch ends under probation, keeping the current policy
+	Probation    bool               `protobuf:"varint,2,opt,name=probation,proto3" json:"probation,omitempty"`
+	TaxRate      TaxRateUpdate      `protobuf:"bytes,3,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate"`
+	RewardWeight RewardWeightUpdate `protobuf:"bytes,4,opt,name=reward_weight,json=rewardWeight,proto3" json:"reward_weight"`
+	// tax_caps are the caps of the whitelisted denoms, the SDR cap staying the tax policy cap
+	TaxCaps github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tax_caps,json=taxCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_caps"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
-
-func (*UnimplementedQueryServer) TaxRate(ctx context.Context, req *QueryTaxRateRequest) (*QueryTaxRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxRate not implemented")
+func (m *QueryPolicyDryRunResponse) Reset()         { *m = QueryPolicyDryRunResponse{} }
+func (m *QueryPolicyDryRunResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicyDryRunResponse) ProtoMessage()    {}
+func (*QueryPolicyDryRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{23}
 }
 
-func (*UnimplementedQueryServer) TaxCap(ctx context.Context, req *QueryTaxCapRequest) (*QueryTaxCapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxCap not implemented")
+func (m *QueryPolicyDryRunResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (*UnimplementedQueryServer) TaxCaps(ctx context.Context, req *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxCaps not implemented")
+func (m *QueryPolicyDryRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicyDryRunResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func (*UnimplementedQueryServer) RewardWeight(ctx context.Context, req *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardWeight not implemented")
+func (m *QueryPolicyDryRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicyDryRunResponse.Merge(m, src)
 }
 
-func (*UnimplementedQueryServer) SeigniorageProceeds(ctx context.Context, req *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SeigniorageProceeds not implemented")
+func (m *QueryPolicyDryRunResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (*UnimplementedQueryServer) TaxProceeds(ctx context.Context, req *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxProceeds not implemented")
+func (m *QueryPolicyDryRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicyDryRunResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Indicators(ctx context.Context, req *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Indicators not implemented")
-}
+var xxx_messageInfo_QueryPolicyDryRunResponse proto.InternalMessageInfo
 
-func (*UnimplementedQueryServer) BurnTaxExemptionList(ctx context.Context, req *QueryBurnTaxExemptionListRequest) (*QueryBurnTaxExemptionListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxExemptionList not implemented")
+func (m *QueryPolicyDryRunResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPolicyDryRunResponse) GetProbation() bool {
+	if m != nil {
+		return m.Probation
+	}
+	return false
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPolicyDryRunResponse) GetTaxRate() TaxRateUpdate {
+	if m != nil {
+		return m.TaxRate
+	}
+	return TaxRateUpdate{}
 }
 
-func _Query_TaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxRate(ctx, req.(*QueryTaxRateRequest))
+func (m *QueryPolicyDryRunResponse) GetRewardWeight() RewardWeightUpdate {
+	if m != nil {
+		return m.RewardWeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return RewardWeightUpdate{}
 }
 
-func _Query_TaxCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxCapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxCap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxCap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxCap(ctx, req.(*QueryTaxCapRequest))
+func (m *QueryPolicyDryRunResponse) GetTaxCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxCaps
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TaxCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxCapsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxCaps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxCaps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxCaps(ctx, req.(*QueryTaxCapsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryBurnTaxExemptionListRequest is the request type for the Query/BurnTaxExemptionList RPC method.
+type QueryBurnTaxExemptionListRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_RewardWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardWeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardWeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/RewardWeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardWeight(ctx, req.(*QueryRewardWeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryBurnTaxExemptionListRequest) Reset()         { *m = QueryBurnTaxExemptionListRequest{} }
+func (m *QueryBurnTaxExemptionListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnTaxExemptionListRequest) ProtoMessage()    {}
+func (*QueryBurnTaxExemptionListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{24}
 }
 
-func _Query_SeigniorageProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySeigniorageProceedsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SeigniorageProceeds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/SeigniorageProceeds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SeigniorageProceeds(ctx, req.(*QuerySeigniorageProceedsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryBurnTaxExemptionListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func _Query_TaxProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxProceedsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxProceeds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxProceeds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxProceeds(ctx, req.(*QueryTaxProceedsRequest))
+func (m *QueryBurnTaxExemptionListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Indicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIndicatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Indicators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/Indicators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Indicators(ctx, req.(*QueryIndicatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryBurnTaxExemptionListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionListRequest.Merge(m, src)
 }
 
-func _Query_BurnTaxExemptionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBurnTaxExemptionListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BurnTaxExemptionList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/BurnTaxExemptionList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BurnTaxExemptionList(ctx, req.(*QueryBurnTaxExemptionListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryBurnTaxExemptionListRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryBurnTaxExemptionListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnTaxExemptionListRequest proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "terra.treasury.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TaxRate",
-			Handler:    _Query_TaxRate_Handler,
-		},
-		{
-			MethodName: "TaxCap",
-			Handler:    _Query_TaxCap_Handler,
-		},
-		{
-			MethodName: "TaxCaps",
-			Handler:    _Query_TaxCaps_Handler,
-		},
-		{
-			MethodName: "RewardWeight",
-			Handler:    _Query_RewardWeight_Handler,
-		},
-		{
-			MethodName: "SeigniorageProceeds",
-			Handler:    _Query_SeigniorageProceeds_Handler,
-		},
-		{
-			MethodName: "TaxProceeds",
-			Handler:    _Query_TaxProceeds_Handler,
-		},
-		{
-			MethodName: "Indicators",
-			Handler:    _Query_Indicators_Handler,
-		},
-		{
-			MethodName: "BurnTaxExemptionList",
-			Handler:    _Query_BurnTaxExemptionList_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "terra/treasury/v1beta1/query.proto",
+// QueryBurnTaxExemptionListResponse is response type for the Query/BurnTaxExemptionList RPC method.
+type QueryBurnTaxExemptionListResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// exemptions holds the expiry and denom scope of each listed address
+	Exemptions []BurnTaxExemption `protobuf:"bytes,3,rep,name=exemptions,proto3" json:"exemptions"`
 }
 
-func (m *QueryTaxRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnTaxExemptionListResponse) Reset()         { *m = QueryBurnTaxExemptionListResponse{} }
+func (m *QueryBurnTaxExemptionListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnTaxExemptionListResponse) ProtoMessage()    {}
+func (*QueryBurnTaxExemptionListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{25}
 }
 
-func (m *QueryTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnTaxExemptionListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func (m *QueryTaxRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnTaxExemptionListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionListResponse.Merge(m, src)
 }
 
-func (m *QueryTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionListResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxRate.Size()
-		i -= size
-		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryBurnTaxExemptionListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionListResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTaxCapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnTaxExemptionListResponse proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionListResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTaxCapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTaxCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryBurnTaxExemptionListResponse) GetExemptions() []BurnTaxExemption {
+	if m != nil {
+		return m.Exemptions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTaxCapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryBurnTaxExemptionZonesRequest is the request type for the Query/BurnTaxExemptionZones RPC method.
+type QueryBurnTaxExemptionZonesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTaxCapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZonesRequest) Reset()         { *m = QueryBurnTaxExemptionZonesRequest{} }
+func (m *QueryBurnTaxExemptionZonesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnTaxExemptionZonesRequest) ProtoMessage()    {}
+func (*QueryBurnTaxExemptionZonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{26}
 }
 
-func (m *QueryTaxCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxCap.Size()
-		i -= size
-		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryBurnTaxExemptionZonesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBurnTaxExemptionZonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionZonesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryTaxCapsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnTaxExemptionZonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionZonesRequest.Merge(m, src)
 }
 
-func (m *QueryTaxCapsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZonesRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryTaxCapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnTaxExemptionZonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionZonesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTaxCapsResponseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnTaxExemptionZonesRequest proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionZonesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTaxCapsResponseItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnTaxExemptionZonesResponse is response type for the Query/BurnTaxExemptionZones RPC method.
+type QueryBurnTaxExemptionZonesResponse struct {
+	Zones      []BurnTaxExemptionZone `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTaxCapsResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxCap.Size()
-		i -= size
-		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryBurnTaxExemptionZonesResponse) Reset()         { *m = QueryBurnTaxExemptionZonesResponse{} }
+func (m *QueryBurnTaxExemptionZonesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnTaxExemptionZonesResponse) ProtoMessage()    {}
+func (*QueryBurnTaxExemptionZonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{27}
+}
+
+func (m *QueryBurnTaxExemptionZonesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBurnTaxExemptionZonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionZonesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryTaxCapsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnTaxExemptionZonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionZonesResponse.Merge(m, src)
 }
 
-func (m *QueryTaxCapsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZonesResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryTaxCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaxCaps) > 0 {
-		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryBurnTaxExemptionZonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionZonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnTaxExemptionZonesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionZonesResponse) GetZones() []BurnTaxExemptionZone {
+	if m != nil {
+		return m.Zones
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRewardWeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurnTaxExemptionZonesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRewardWeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnTaxExemptionZoneRequest is the request type for the Query/BurnTaxExemptionZone RPC method.
+type QueryBurnTaxExemptionZoneRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination of the member addresses
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRewardWeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnTaxExemptionZoneRequest) Reset()         { *m = QueryBurnTaxExemptionZoneRequest{} }
+func (m *QueryBurnTaxExemptionZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnTaxExemptionZoneRequest) ProtoMessage()    {}
+func (*QueryBurnTaxExemptionZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{28}
 }
 
-func (m *QueryRewardWeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurnTaxExemptionZoneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBurnTaxExemptionZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionZoneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *QueryRewardWeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneRequest.Merge(m, src)
 }
 
-func (m *QueryRewardWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardWeight.Size()
-		i -= size
-		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBurnTaxExemptionZoneRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBurnTaxExemptionZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnTaxExemptionZoneRequest proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionZoneRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTaxProceedsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurnTaxExemptionZoneRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTaxProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnTaxExemptionZoneResponse is response type for the Query/BurnTaxExemptionZone RPC method.
+type QueryBurnTaxExemptionZoneResponse struct {
+	Zone       BurnTaxExemptionZone `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone"`
+	Addresses  []string             `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTaxProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnTaxExemptionZoneResponse) Reset()         { *m = QueryBurnTaxExemptionZoneResponse{} }
+func (m *QueryBurnTaxExemptionZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnTaxExemptionZoneResponse) ProtoMessage()    {}
+func (*QueryBurnTaxExemptionZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{29}
 }
 
-func (m *QueryTaxProceedsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurnTaxExemptionZoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBurnTaxExemptionZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionZoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *QueryTaxProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneResponse.Merge(m, src)
 }
 
-func (m *QueryTaxProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaxProceeds) > 0 {
-		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryBurnTaxExemptionZoneResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBurnTaxExemptionZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnTaxExemptionZoneResponse proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionZoneResponse) GetZone() BurnTaxExemptionZone {
+	if m != nil {
+		return m.Zone
 	}
-	return len(dAtA) - i, nil
+	return BurnTaxExemptionZone{}
 }
 
-func (m *QuerySeigniorageProceedsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurnTaxExemptionZoneResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySeigniorageProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZoneResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySeigniorageProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryBurnTaxExemptionZoneByAddressRequest is the request type for the Query/BurnTaxExemptionZoneByAddress RPC method.
+type QueryBurnTaxExemptionZoneByAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QuerySeigniorageProceedsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) Reset() {
+	*m = QueryBurnTaxExemptionZoneByAddressRequest{}
 }
 
-func (m *QuerySeigniorageProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBurnTaxExemptionZoneByAddressRequest) ProtoMessage() {}
+func (*QueryBurnTaxExemptionZoneByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{30}
 }
 
-func (m *QuerySeigniorageProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SeigniorageProceeds.Size()
-		i -= size
-		if _, err := m.SeigniorageProceeds.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryIndicatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressRequest.Merge(m, src)
 }
 
-func (m *QueryIndicatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryIndicatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIndicatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryIndicatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnTaxExemptionZoneByAddressResponse is response type for the Query/BurnTaxExemptionZoneByAddress RPC method.
+type QueryBurnTaxExemptionZoneByAddressResponse struct {
+	Zone BurnTaxExemptionZone `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone"`
 }
 
-func (m *QueryIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TRLMonth.Size()
-		i -= size
-		if _, err := m.TRLMonth.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TRLYear.Size()
-		i -= size
-		if _, err := m.TRLYear.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) Reset() {
+	*m = QueryBurnTaxExemptionZoneByAddressResponse{}
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBurnTaxExemptionZoneByAddressResponse) ProtoMessage() {}
+func (*QueryBurnTaxExemptionZoneByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{31}
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressResponse.Merge(m, src)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnTaxExemptionZoneByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) GetZone() BurnTaxExemptionZone {
+	if m != nil {
+		return m.Zone
 	}
-	return dAtA[:n], nil
+	return BurnTaxExemptionZone{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryTaxRateRequest)(nil), "terra.treasury.v1beta1.QueryTaxRateRequest")
+	proto.RegisterType((*QueryTaxRateResponse)(nil), "terra.treasury.v1beta1.QueryTaxRateResponse")
+	proto.RegisterType((*QueryTaxCapRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapRequest")
+	proto.RegisterType((*QueryTaxCapResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapResponse")
+	proto.RegisterType((*QueryTaxCapsRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapsRequest")
+	proto.RegisterType((*QueryTaxCapsResponseItem)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponseItem")
+	proto.RegisterType((*QueryTaxCapsResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponse")
+	proto.RegisterType((*QueryMsgTaxRateRequest)(nil), "terra.treasury.v1beta1.QueryMsgTaxRateRequest")
+	proto.RegisterType((*QueryMsgTaxRateResponse)(nil), "terra.treasury.v1beta1.QueryMsgTaxRateResponse")
+	proto.RegisterType((*QueryRewardWeightRequest)(nil), "terra.treasury.v1beta1.QueryRewardWeightRequest")
+	proto.RegisterType((*QueryRewardWeightResponse)(nil), "terra.treasury.v1beta1.QueryRewardWeightResponse")
+	proto.RegisterType((*QueryTaxProceedsRequest)(nil), "terra.treasury.v1beta1.QueryTaxProceedsRequest")
+	proto.RegisterType((*QueryTaxProceedsResponse)(nil), "terra.treasury.v1beta1.QueryTaxProceedsResponse")
+	proto.RegisterType((*QuerySeigniorageProceedsRequest)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsRequest")
+	proto.RegisterType((*QuerySeigniorageProceedsResponse)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsResponse")
+	proto.RegisterType((*QueryIndicatorsRequest)(nil), "terra.treasury.v1beta1.QueryIndicatorsRequest")
+	proto.RegisterType((*QueryIndicatorsResponse)(nil), "terra.treasury.v1beta1.QueryIndicatorsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "terra.treasury.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "terra.treasury.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryIndicatorHistoryRequest)(nil), "terra.treasury.v1beta1.QueryIndicatorHistoryRequest")
+	proto.RegisterType((*EpochIndicators)(nil), "terra.treasury.v1beta1.EpochIndicators")
+	proto.RegisterType((*QueryIndicatorHistoryResponse)(nil), "terra.treasury.v1beta1.QueryIndicatorHistoryResponse")
+	proto.RegisterType((*QueryPolicyDryRunRequest)(nil), "terra.treasury.v1beta1.QueryPolicyDryRunRequest")
+	proto.RegisterType((*QueryPolicyDryRunResponse)(nil), "terra.treasury.v1beta1.QueryPolicyDryRunResponse")
+	proto.RegisterType((*QueryBurnTaxExemptionListRequest)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionListRequest")
+	proto.RegisterType((*QueryBurnTaxExemptionListResponse)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionListResponse")
+	proto.RegisterType((*QueryBurnTaxExemptionZonesRequest)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionZonesRequest")
+	proto.RegisterType((*QueryBurnTaxExemptionZonesResponse)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionZonesResponse")
+	proto.RegisterType((*QueryBurnTaxExemptionZoneRequest)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionZoneRequest")
+	proto.RegisterType((*QueryBurnTaxExemptionZoneResponse)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionZoneResponse")
+	proto.RegisterType((*QueryBurnTaxExemptionZoneByAddressRequest)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionZoneByAddressRequest")
+	proto.RegisterType((*QueryBurnTaxExemptionZoneByAddressResponse)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionZoneByAddressResponse")
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("terra/treasury/v1beta1/query.proto", fileDescriptor_699c8c29293c9a9b)
 }
 
-func (m *QueryBurnTaxExemptionListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_699c8c29293c9a9b = []byte{
+	// 1725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc0, 0xb3, 0xce, 0xef, 0x97, 0x7c, 0xbf, 0xfd, 0x76, 0xe2, 0xb6, 0x8e, 0x95, 0xda, 0xe9,
+	0x7e, 0xdb, 0x34, 0x4d, 0x52, 0x6f, 0x12, 0x8a, 0xda, 0x14, 0x24, 0xd4, 0xb4, 0x4d, 0x1b, 0x29,
+	0x41, 0xed, 0x26, 0x55, 0x45, 0x25, 0x58, 0x4d, 0xec, 0xa9, 0xb3, 0xd4, 0xde, 0xd9, 0xce, 0x8e,
+	0x69, 0x4c, 0x55, 0x0e, 0x48, 0x48, 0xc0, 0x01, 0x21, 0xf5, 0x84, 0x90, 0x50, 0x05, 0x37, 0x8e,
+	0x5c, 0x2a, 0x2e, 0x70, 0xe1, 0x90, 0x03, 0x87, 0x0a, 0x24, 0x84, 0x7a, 0x08, 0x28, 0xe5, 0xc0,
+	0x1f, 0xc0, 0x1f, 0x80, 0x66, 0x76, 0x36, 0xde, 0x4d, 0xbc, 0x8e, 0xed, 0xe6, 0xd4, 0xf5, 0xcc,
+	0x9b, 0xf7, 0x3e, 0xef, 0xcd, 0x9b, 0x99, 0xf7, 0x52, 0xd0, 0x39, 0x61, 0x0c, 0x1b, 0x9c, 0x11,
+	0xec, 0x55, 0x58, 0xd5, 0x78, 0x6f, 0x66, 0x8d, 0x70, 0x3c, 0x63, 0xdc, 0xaf, 0x10, 0x56, 0xcd,
+	0xb9, 0x8c, 0x72, 0x8a, 0x8e, 0x4a, 0x99, 0x5c, 0x20, 0x93, 0x53, 0x32, 0xe9, 0x64, 0x91, 0x16,
+	0xa9, 0x14, 0x31, 0xc4, 0x97, 0x2f, 0x9d, 0x1e, 0x29, 0x52, 0x5a, 0x2c, 0x11, 0x03, 0xbb, 0xb6,
+	0x81, 0x1d, 0x87, 0x72, 0xcc, 0x6d, 0xea, 0x78, 0x6a, 0x76, 0x22, 0x4f, 0xbd, 0x32, 0xf5, 0x8c,
+	0x35, 0xec, 0x11, 0xdf, 0xc8, 0x8e, 0x49, 0x17, 0x17, 0x6d, 0x47, 0x0a, 0x2b, 0xd9, 0x53, 0x31,
+	0x6c, 0x3b, 0x20, 0xbe, 0x58, 0x26, 0xac, 0x32, 0x90, 0xc9, 0x53, 0x5b, 0xa9, 0xd1, 0x8f, 0xc0,
+	0xd0, 0x4d, 0x61, 0x68, 0x15, 0x6f, 0x98, 0x98, 0x13, 0x93, 0xdc, 0xaf, 0x10, 0x8f, 0xeb, 0x18,
+	0x92, 0xd1, 0x61, 0xcf, 0xa5, 0x8e, 0x47, 0xd0, 0x22, 0xf4, 0x71, 0xbc, 0x61, 0x31, 0xcc, 0x49,
+	0x4a, 0x1b, 0xd5, 0xc6, 0xfb, 0xe7, 0x73, 0x9b, 0x5b, 0xd9, 0x8e, 0xe7, 0x5b, 0xd9, 0xb1, 0xa2,
+	0xcd, 0xd7, 0x2b, 0x6b, 0xb9, 0x3c, 0x2d, 0x1b, 0xca, 0xa6, 0xff, 0xcf, 0x59, 0xaf, 0x70, 0xcf,
+	0xe0, 0x55, 0x97, 0x78, 0xb9, 0x2b, 0x24, 0x6f, 0xf6, 0x72, 0x5f, 0xa5, 0x7e, 0x0e, 0x50, 0x60,
+	0xe2, 0x32, 0x76, 0x95, 0x61, 0x94, 0x84, 0xee, 0x02, 0x71, 0x68, 0xd9, 0xd7, 0x6e, 0xfa, 0x3f,
+	0x2e, 0xf6, 0x7d, 0xfc, 0x24, 0xdb, 0xf1, 0xf7, 0x93, 0x6c, 0x87, 0xfe, 0x0e, 0x0c, 0x45, 0x56,
+	0x29, 0xae, 0x6b, 0x20, 0xf4, 0x5a, 0x79, 0xec, 0xb6, 0x81, 0xb5, 0xe8, 0x70, 0xb3, 0x87, 0x4b,
+	0x85, 0x7a, 0x36, 0xa2, 0xdf, 0x53, 0x58, 0x21, 0x80, 0x2a, 0xa4, 0xa2, 0x02, 0x3e, 0xc1, 0x22,
+	0x27, 0xe5, 0xfa, 0xf0, 0x61, 0xb6, 0xc4, 0x4b, 0xb1, 0xd9, 0x90, 0xac, 0x67, 0x1a, 0xdd, 0xf4,
+	0x37, 0x25, 0x8f, 0x5d, 0x2f, 0xa5, 0x8d, 0x76, 0x8e, 0x0f, 0xcc, 0x4e, 0xe7, 0xea, 0x67, 0x65,
+	0x2e, 0x0e, 0x7d, 0xbe, 0x4b, 0x30, 0xc9, 0xcd, 0x11, 0x53, 0xfa, 0xbb, 0x70, 0x54, 0x8a, 0x2e,
+	0x7b, 0xc5, 0x68, 0x66, 0xa0, 0x69, 0x18, 0x2c, 0x7b, 0x45, 0x4b, 0xd0, 0x59, 0x15, 0x56, 0x52,
+	0xe1, 0xfe, 0xef, 0xf6, 0x56, 0x16, 0x84, 0x70, 0xd5, 0x25, 0xb7, 0xcc, 0x25, 0x13, 0xca, 0xea,
+	0x9b, 0x95, 0x6a, 0x51, 0x49, 0xd4, 0xdf, 0xd2, 0x02, 0x1c, 0xdb, 0x63, 0xeb, 0xe0, 0xd3, 0x2d,
+	0xad, 0xf6, 0xcd, 0x24, 0x0f, 0x30, 0x2b, 0xdc, 0x26, 0x76, 0x71, 0x9d, 0x07, 0xd9, 0xee, 0xc2,
+	0x70, 0x9d, 0x39, 0xc5, 0xb0, 0x02, 0xff, 0x61, 0x72, 0xdc, 0x7a, 0x20, 0x27, 0xda, 0x04, 0x19,
+	0x64, 0x21, 0xe5, 0xfa, 0xb0, 0xf2, 0x79, 0x15, 0x6f, 0xdc, 0x60, 0x34, 0x4f, 0x48, 0x21, 0x48,
+	0x35, 0xfd, 0x53, 0x0d, 0x52, 0x7b, 0xe7, 0x14, 0x8c, 0x03, 0x83, 0x22, 0x20, 0xae, 0x1a, 0x57,
+	0xdb, 0x3d, 0x9c, 0xf3, 0x4d, 0xe6, 0xc4, 0x29, 0xdf, 0xd9, 0xeb, 0xcb, 0xd4, 0x76, 0xe6, 0xa7,
+	0x05, 0xe6, 0xb7, 0x7f, 0x64, 0xc7, 0x9b, 0xc0, 0x14, 0x0b, 0x3c, 0x73, 0x80, 0xd7, 0xec, 0xea,
+	0x27, 0x20, 0x2b, 0x59, 0x56, 0x88, 0x5d, 0x74, 0x6c, 0xca, 0x70, 0x91, 0xec, 0xe6, 0xfd, 0x48,
+	0x83, 0xd1, 0x78, 0x19, 0xc5, 0x8d, 0x21, 0xe9, 0xd5, 0xa6, 0xc3, 0xfc, 0xed, 0x1c, 0x88, 0x21,
+	0x6f, 0xaf, 0x29, 0x3d, 0xa5, 0x52, 0x76, 0xd1, 0x29, 0xd8, 0x79, 0xcc, 0x29, 0xdb, 0x21, 0xdc,
+	0xd4, 0xe0, 0xd8, 0x9e, 0x29, 0x05, 0xb6, 0x0a, 0x7d, 0x9c, 0x95, 0xac, 0x2a, 0xc1, 0x4c, 0xc1,
+	0xcc, 0xb5, 0xb6, 0xb1, 0xdb, 0x5b, 0xd9, 0xde, 0x55, 0x73, 0xe9, 0x2d, 0x82, 0x99, 0xd9, 0xcb,
+	0x59, 0x49, 0x7c, 0xa0, 0xdb, 0xd0, 0x2f, 0xb4, 0x96, 0xa9, 0xc3, 0xd7, 0xd5, 0xa1, 0xbf, 0xd8,
+	0xb2, 0xda, 0xbe, 0x55, 0x73, 0x69, 0x59, 0x68, 0x30, 0x05, 0xa2, 0xfc, 0xd2, 0x93, 0xea, 0xd2,
+	0xbc, 0x81, 0x19, 0x2e, 0xef, 0x38, 0xb8, 0x02, 0x43, 0x91, 0x51, 0xe5, 0xdb, 0xeb, 0xd0, 0xe3,
+	0xca, 0x11, 0xe9, 0xd9, 0xc0, 0x6c, 0x26, 0xee, 0x56, 0xf0, 0xd7, 0xa9, 0x3b, 0x40, 0xad, 0xd1,
+	0x9f, 0x68, 0x30, 0x12, 0x8d, 0xda, 0x75, 0xdb, 0xe3, 0x94, 0x55, 0x95, 0x55, 0x74, 0x1c, 0xe0,
+	0x2e, 0xa3, 0x65, 0x8b, 0xb8, 0x34, 0xbf, 0x2e, 0x4d, 0x74, 0x99, 0xfd, 0x62, 0xe4, 0xaa, 0x18,
+	0x40, 0xc3, 0xd0, 0xc7, 0xa9, 0x9a, 0x4c, 0xc8, 0xc9, 0x5e, 0x4e, 0xfd, 0xa9, 0x05, 0x80, 0xda,
+	0x7b, 0x96, 0xea, 0x94, 0x70, 0x63, 0x91, 0x1c, 0xf6, 0x5f, 0xd8, 0x1a, 0x5f, 0x31, 0xb8, 0x7f,
+	0xcc, 0xd0, 0x4a, 0xfd, 0xfb, 0x4e, 0x38, 0x24, 0x35, 0xd6, 0x36, 0x56, 0xdc, 0x36, 0x61, 0x20,
+	0xff, 0x07, 0x5a, 0x06, 0x90, 0x17, 0x89, 0x3c, 0x83, 0xa9, 0x44, 0x5b, 0x27, 0xb8, 0x5f, 0x5c,
+	0x25, 0x52, 0x01, 0x7a, 0x1b, 0x50, 0x38, 0x9d, 0x95, 0xda, 0xce, 0xb6, 0xd4, 0x1e, 0x0e, 0x69,
+	0x52, 0xea, 0xef, 0xc0, 0x61, 0x4e, 0x39, 0x2e, 0x59, 0x1e, 0xc7, 0xf7, 0x48, 0xc1, 0x2a, 0x55,
+	0x1c, 0x9c, 0xea, 0x6a, 0xeb, 0xa8, 0x1c, 0x92, 0x8a, 0x56, 0xa4, 0x9e, 0xa5, 0x8a, 0x83, 0xd1,
+	0x6b, 0xd0, 0xe3, 0xd2, 0x92, 0x9d, 0xaf, 0xa6, 0xba, 0x65, 0xdc, 0xff, 0x1f, 0x97, 0x14, 0x32,
+	0xb0, 0x37, 0xa4, 0xa8, 0xa9, 0x96, 0x88, 0xfb, 0xd8, 0x7b, 0x80, 0x5d, 0xeb, 0x2e, 0x21, 0xa9,
+	0x9e, 0xf6, 0xee, 0x63, 0xb1, 0x7e, 0x81, 0x10, 0xfd, 0xa9, 0x06, 0xc7, 0x63, 0xd2, 0x4b, 0xa5,
+	0xef, 0x32, 0x80, 0x1d, 0xcc, 0x05, 0x37, 0xdd, 0xe9, 0x86, 0xb4, 0xb5, 0x34, 0x50, 0xb9, 0x1c,
+	0x52, 0x80, 0xae, 0x45, 0x92, 0x2e, 0x21, 0x9d, 0x3f, 0xbd, 0x6f, 0xd2, 0xf9, 0x2c, 0x91, 0xac,
+	0x0b, 0x5e, 0x12, 0x3f, 0x36, 0x57, 0x58, 0xd5, 0xac, 0x38, 0xc1, 0x49, 0xfc, 0x2d, 0x01, 0xc3,
+	0x75, 0x26, 0x95, 0x47, 0xf5, 0x73, 0x73, 0x04, 0xfa, 0x5d, 0x46, 0xd7, 0x6a, 0x5c, 0x7d, 0x66,
+	0x6d, 0x00, 0x2d, 0x84, 0x9e, 0x40, 0xff, 0xa4, 0x9c, 0x8a, 0x8b, 0x81, 0x7a, 0x3d, 0x6f, 0xb9,
+	0x05, 0xcc, 0x49, 0xe8, 0x45, 0x17, 0x83, 0xe8, 0xd6, 0xee, 0x67, 0xac, 0x4b, 0x2a, 0x9b, 0x88,
+	0x53, 0x16, 0x7e, 0x0b, 0x23, 0x1a, 0x23, 0x0f, 0x19, 0xba, 0x1b, 0xaa, 0x3d, 0xba, 0x0f, 0xfe,
+	0x31, 0x0a, 0x15, 0x24, 0xfe, 0x23, 0x33, 0x5f, 0x61, 0xce, 0x2a, 0xde, 0xb8, 0xba, 0x41, 0xca,
+	0xae, 0x88, 0xcf, 0x92, 0xed, 0x05, 0xcf, 0xf8, 0xae, 0x6b, 0x25, 0xd1, 0xf6, 0xb5, 0xf2, 0x5c,
+	0x83, 0x13, 0x0d, 0x8c, 0xa9, 0xcd, 0x1c, 0x81, 0x7e, 0x5c, 0x28, 0x30, 0xe2, 0x79, 0xc4, 0xcf,
+	0xce, 0x7e, 0xb3, 0x36, 0x70, 0x60, 0xd9, 0x86, 0xde, 0x04, 0x20, 0x81, 0x7d, 0x2f, 0xd5, 0x29,
+	0x43, 0x3c, 0x1e, 0xb7, 0x69, 0xbb, 0x81, 0x83, 0x63, 0x50, 0xd3, 0xa0, 0xdf, 0x8b, 0xf1, 0xed,
+	0x0e, 0x75, 0x88, 0x57, 0x3f, 0x92, 0x5a, 0xdb, 0x91, 0x7c, 0xaa, 0x81, 0xde, 0xc8, 0x9a, 0x0a,
+	0xe5, 0x75, 0xe8, 0x7e, 0x9f, 0x3a, 0x2a, 0x8c, 0x03, 0xb3, 0x53, 0xcd, 0xba, 0x27, 0xb4, 0x28,
+	0x17, 0x7d, 0x05, 0x07, 0x77, 0xc8, 0x3f, 0x88, 0xc9, 0x37, 0x61, 0x32, 0x88, 0x12, 0x82, 0x2e,
+	0x07, 0x97, 0x55, 0x65, 0x6a, 0xca, 0xef, 0x03, 0xcb, 0xc1, 0x5f, 0xb4, 0x06, 0xfb, 0xb4, 0x13,
+	0xb8, 0x05, 0xe8, 0x12, 0x7e, 0xab, 0x1d, 0x6a, 0x27, 0x6e, 0x72, 0x7d, 0x34, 0x97, 0x13, 0x8d,
+	0x73, 0xb9, 0xb3, 0xfd, 0xa0, 0x5e, 0x85, 0x33, 0xb1, 0x3e, 0xcd, 0x57, 0x2f, 0xf9, 0x06, 0x83,
+	0xe8, 0xa6, 0xa0, 0x57, 0x21, 0xa8, 0x00, 0x07, 0x3f, 0x75, 0x0e, 0x13, 0xcd, 0xa8, 0x39, 0xd8,
+	0x18, 0xcd, 0xfe, 0x98, 0x84, 0x6e, 0x69, 0x16, 0x7d, 0xa6, 0x41, 0xaf, 0xba, 0x6b, 0xd1, 0xe4,
+	0x7e, 0x9d, 0x56, 0xa8, 0x77, 0x4a, 0x4f, 0x35, 0x27, 0xec, 0x83, 0xeb, 0xe3, 0x1f, 0xfe, 0xfa,
+	0xd7, 0xe3, 0x84, 0x8e, 0x46, 0x8d, 0xb8, 0x56, 0x5f, 0xbd, 0x0b, 0xe8, 0xb1, 0x06, 0x3d, 0x7e,
+	0x53, 0x87, 0x26, 0x9a, 0xe8, 0xfc, 0x02, 0x9c, 0xc9, 0xa6, 0x64, 0x15, 0xcd, 0xb4, 0xa4, 0x99,
+	0x40, 0xe3, 0x8d, 0x68, 0xc4, 0x33, 0x60, 0x3c, 0x94, 0x0d, 0xde, 0xa3, 0x20, 0x4c, 0xe2, 0xfa,
+	0x46, 0x93, 0xcd, 0x35, 0xa4, 0x4d, 0x86, 0x29, 0xdc, 0xbd, 0x36, 0x17, 0x26, 0x01, 0x86, 0xbe,
+	0xd4, 0x00, 0x6a, 0x4d, 0x26, 0xca, 0x35, 0x34, 0xb3, 0xa7, 0xf3, 0x4d, 0x1b, 0x4d, 0xcb, 0x2b,
+	0xb2, 0x29, 0x49, 0x36, 0x86, 0x4e, 0xc6, 0x91, 0xc9, 0x46, 0x3a, 0xd8, 0xc4, 0xaf, 0x35, 0x18,
+	0x0c, 0x3f, 0xba, 0xa8, 0x71, 0x13, 0x5f, 0xa7, 0x8f, 0x4d, 0xcf, 0xb4, 0xb0, 0x42, 0x31, 0x9e,
+	0x95, 0x8c, 0xa7, 0xd1, 0xa9, 0x38, 0xc6, 0x48, 0xd1, 0x80, 0x7e, 0xd0, 0x60, 0xa8, 0x4e, 0x9f,
+	0x87, 0xce, 0x37, 0xb4, 0x1c, 0xdf, 0x3d, 0xa6, 0x2f, 0xb4, 0xbe, 0x50, 0x91, 0x9f, 0x93, 0xe4,
+	0x39, 0x34, 0x15, 0x47, 0x5e, 0xaf, 0xe1, 0x44, 0x5f, 0x69, 0x30, 0x10, 0x6a, 0xac, 0x91, 0xb1,
+	0x5f, 0xae, 0xed, 0x06, 0x9e, 0x6e, 0x7e, 0x41, 0xb3, 0x69, 0x10, 0xee, 0xe8, 0xd1, 0x17, 0x1a,
+	0x40, 0xa8, 0x9d, 0x69, 0x9c, 0xa4, 0x7b, 0x7a, 0xdd, 0xb4, 0xd1, 0xb4, 0xbc, 0xa2, 0x9b, 0x90,
+	0x74, 0x27, 0x91, 0x1e, 0x47, 0x17, 0x2a, 0xa1, 0xbf, 0xd3, 0xe0, 0x7f, 0xbb, 0xcb, 0x75, 0x74,
+	0xae, 0x39, 0x8b, 0xd1, 0xe6, 0x31, 0xfd, 0x6a, 0x8b, 0xab, 0x14, 0xed, 0x8c, 0xa4, 0x9d, 0x44,
+	0x67, 0xf6, 0xa5, 0xb5, 0xd6, 0x15, 0xdf, 0x37, 0x1a, 0x0c, 0x86, 0xab, 0xf1, 0x7d, 0xce, 0x55,
+	0x9d, 0xaa, 0x3e, 0x3d, 0xd3, 0xc2, 0x0a, 0x05, 0x9a, 0x93, 0xa0, 0xe3, 0x68, 0x2c, 0x0e, 0xd4,
+	0xef, 0xa8, 0xac, 0x02, 0xab, 0x5a, 0xac, 0xe2, 0xa0, 0x9f, 0x34, 0x48, 0xd6, 0x2b, 0x37, 0x51,
+	0xe3, 0x03, 0xd2, 0xa0, 0x1c, 0x4e, 0xcf, 0xb5, 0xb1, 0x52, 0xd1, 0x9f, 0x97, 0xf4, 0x33, 0xc8,
+	0x88, 0xa3, 0x5f, 0xab, 0x30, 0x47, 0x5e, 0x5d, 0x3b, 0x95, 0xa5, 0x55, 0x12, 0xb4, 0x9b, 0x1a,
+	0x1c, 0xa9, 0x5b, 0xeb, 0xa1, 0xd6, 0x68, 0xc2, 0xd5, 0x68, 0xfa, 0x62, 0x3b, 0x4b, 0x95, 0x27,
+	0x17, 0xa4, 0x27, 0xb3, 0x68, 0xba, 0x05, 0x4f, 0xfc, 0x52, 0xf2, 0xe7, 0x3a, 0x3b, 0x22, 0x74,
+	0xb7, 0xb8, 0x23, 0xa1, 0x82, 0x31, 0x3d, 0xd7, 0xc6, 0x4a, 0xe5, 0xc7, 0x1b, 0xd2, 0x8f, 0x39,
+	0x74, 0xbe, 0x55, 0x3f, 0x8c, 0x87, 0xa2, 0x2e, 0x7d, 0x84, 0xfe, 0xd1, 0xe0, 0x78, 0xc3, 0x82,
+	0x09, 0x5d, 0x6a, 0x99, 0x6e, 0x77, 0xcd, 0x96, 0x9e, 0x7f, 0x19, 0x15, 0xca, 0xd3, 0x25, 0xe9,
+	0xe9, 0x02, 0xba, 0xd2, 0xa2, 0xa7, 0xd6, 0x5a, 0xd5, 0x52, 0x15, 0xa2, 0xf1, 0x50, 0x7d, 0x3c,
+	0x42, 0x9f, 0x68, 0xd0, 0xe3, 0xff, 0x79, 0x6b, 0x9f, 0xd2, 0x28, 0xf2, 0x17, 0xb5, 0xf4, 0x64,
+	0x53, 0xb2, 0x8a, 0x78, 0x4c, 0x12, 0x8f, 0xa2, 0x4c, 0xec, 0x59, 0x97, 0xf2, 0xf3, 0xd7, 0x37,
+	0xb7, 0x33, 0xda, 0xb3, 0xed, 0x8c, 0xf6, 0xe7, 0x76, 0x46, 0xfb, 0xfc, 0x45, 0xa6, 0xe3, 0xd9,
+	0x8b, 0x4c, 0xc7, 0xef, 0x2f, 0x32, 0x1d, 0x77, 0x72, 0xe1, 0x86, 0xb8, 0x84, 0x3d, 0xcf, 0xce,
+	0x9f, 0xf5, 0x75, 0xe5, 0x29, 0x23, 0xc6, 0x46, 0x4d, 0xa5, 0x6c, 0x8e, 0xd7, 0x7a, 0xe4, 0x7f,
+	0xde, 0xbc, 0xf2, 0xef, 0x00, 0x4f, 0x71, 0x0a, 0x39, 0xa1, 0x1a, 0x00, 0x00,
 }
 
-func (m *QueryBurnTaxExemptionListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TaxRate return the current tax rate
+	TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error)
+	// TaxCap returns the tax cap of a denom
+	TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error)
+	// TaxCaps returns the all tax caps
+	TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error)
+	// MsgTaxRate returns the tax rate applied to the principal of a msg type in a denom
+	MsgTaxRate(ctx context.Context, in *QueryMsgTaxRateRequest, opts ...grpc.CallOption) (*QueryMsgTaxRateResponse, error)
+	// RewardWeight return the current reward weight
+	RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error)
+	// SeigniorageProceeds return the current seigniorage proceeds
+	SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error)
+	// TaxProceeds return the current tax proceeds
+	TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error)
+	// Indicators return the current trl informations
+	Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error)
+	// IndicatorHistory returns the indicators and policy of each ended epoch over an epoch range
+	IndicatorHistory(ctx context.Context, in *QueryIndicatorHistoryRequest, opts ...grpc.CallOption) (*QueryIndicatorHistoryResponse, error)
+	// PolicyDryRun returns the tax rate, reward weight and tax caps the end of the current epoch
+	// would set, without changing the state
+	PolicyDryRun(ctx context.Context, in *QueryPolicyDryRunRequest, opts ...grpc.CallOption) (*QueryPolicyDryRunResponse, error)
+	// BurnTaxExemptionList returns all registered burn tax exemption addresses
+	BurnTaxExemptionList(ctx context.Context, in *QueryBurnTaxExemptionListRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionListResponse, error)
+	// BurnTaxExemptionZones returns all burn tax exemption zones
+	BurnTaxExemptionZones(ctx context.Context, in *QueryBurnTaxExemptionZonesRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionZonesResponse, error)
+	// BurnTaxExemptionZone returns a burn tax exemption zone with its member addresses
+	BurnTaxExemptionZone(ctx context.Context, in *QueryBurnTaxExemptionZoneRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionZoneResponse, error)
+	// BurnTaxExemptionZoneByAddress returns the burn tax exemption zone of an address
+	BurnTaxExemptionZoneByAddress(ctx context.Context, in *QueryBurnTaxExemptionZoneByAddressRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionZoneByAddressResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryBurnTaxExemptionListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryBurnTaxExemptionListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error) {
+	out := new(QueryTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBurnTaxExemptionListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error) {
+	out := new(QueryTaxCapResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBurnTaxExemptionListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error) {
+	out := new(QueryTaxCapsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCaps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) MsgTaxRate(ctx context.Context, in *QueryMsgTaxRateRequest, opts ...grpc.CallOption) (*QueryMsgTaxRateResponse, error) {
+	out := new(QueryMsgTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/MsgTaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
 
-func (m *QueryTaxRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error) {
+	out := new(QueryRewardWeightResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/RewardWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error) {
+	out := new(QuerySeigniorageProceedsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/SeigniorageProceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxCapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error) {
+	out := new(QueryTaxProceedsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxProceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxCapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error) {
+	out := new(QueryIndicatorsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Indicators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TaxCap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxCapsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) IndicatorHistory(ctx context.Context, in *QueryIndicatorHistoryRequest, opts ...grpc.CallOption) (*QueryIndicatorHistoryResponse, error) {
+	out := new(QueryIndicatorHistoryResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/IndicatorHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxCapsResponseItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PolicyDryRun(ctx context.Context, in *QueryPolicyDryRunRequest, opts ...grpc.CallOption) (*QueryPolicyDryRunResponse, error) {
+	out := new(QueryPolicyDryRunResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/PolicyDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.TaxCap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxCapsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TaxCaps) > 0 {
-		for _, e := range m.TaxCaps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) BurnTaxExemptionList(ctx context.Context, in *QueryBurnTaxExemptionListRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionListResponse, error) {
+	out := new(QueryBurnTaxExemptionListResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/BurnTaxExemptionList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardWeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BurnTaxExemptionZones(ctx context.Context, in *QueryBurnTaxExemptionZonesRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionZonesResponse, error) {
+	out := new(QueryBurnTaxExemptionZonesResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/BurnTaxExemptionZones", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardWeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BurnTaxExemptionZone(ctx context.Context, in *QueryBurnTaxExemptionZoneRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionZoneResponse, error) {
+	out := new(QueryBurnTaxExemptionZoneResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/BurnTaxExemptionZone", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.RewardWeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxProceedsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BurnTaxExemptionZoneByAddress(ctx context.Context, in *QueryBurnTaxExemptionZoneByAddressRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionZoneByAddressResponse, error) {
+	out := new(QueryBurnTaxExemptionZoneByAddressResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/BurnTaxExemptionZoneByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryTaxProceedsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TaxProceeds) > 0 {
-		for _, e := range m.TaxProceeds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QuerySeigniorageProceedsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TaxRate return the current tax rate
+	TaxRate(context.Context, *QueryTaxRateRequest) (*QueryTaxRateResponse, error)
+	// TaxCap returns the tax cap of a denom
+	TaxCap(context.Context, *QueryTaxCapRequest) (*QueryTaxCapResponse, error)
+	// TaxCaps returns the all tax caps
+	TaxCaps(context.Context, *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error)
+	// MsgTaxRate returns the tax rate applied to the principal of a msg type in a denom
+	MsgTaxRate(context.Context, *QueryMsgTaxRateRequest) (*QueryMsgTaxRateResponse, error)
+	// RewardWeight return the current reward weight
+	RewardWeight(context.Context, *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error)
+	// SeigniorageProceeds return the current seigniorage proceeds
+	SeigniorageProceeds(context.Context, *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error)
+	// TaxProceeds return the current tax proceeds
+	TaxProceeds(context.Context, *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error)
+	// Indicators return the current trl informations
+	Indicators(context.Context, *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error)
+	// IndicatorHistory returns the indicators and policy of each ended epoch over an epoch range
+	IndicatorHistory(context.Context, *QueryIndicatorHistoryRequest) (*QueryIndicatorHistoryResponse, error)
+	// PolicyDryRun returns the tax rate, reward weight and tax caps the end of the current epoch
+	// would set, without changing the state
+	PolicyDryRun(context.Context, *QueryPolicyDryRunRequest) (*QueryPolicyDryRunResponse, error)
+	// BurnTaxExemptionList returns all registered burn tax exemption addresses
+	BurnTaxExemptionList(context.Context, *QueryBurnTaxExemptionListRequest) (*QueryBurnTaxExemptionListResponse, error)
+	// BurnTaxExemptionZones returns all burn tax exemption zones
+	BurnTaxExemptionZones(context.Context, *QueryBurnTaxExemptionZonesRequest) (*QueryBurnTaxExemptionZonesResponse, error)
+	// BurnTaxExemptionZone returns a burn tax exemption zone with its member addresses
+	BurnTaxExemptionZone(context.Context, *QueryBurnTaxExemptionZoneRequest) (*QueryBurnTaxExemptionZoneResponse, error)
+	// BurnTaxExemptionZoneByAddress returns the burn tax exemption zone of an address
+	BurnTaxExemptionZoneByAddress(context.Context, *QueryBurnTaxExemptionZoneByAddressRequest) (*QueryBurnTaxExemptionZoneByAddressResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QuerySeigniorageProceedsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SeigniorageProceeds.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) TaxRate(ctx context.Context, req *QueryTaxRateRequest) (*QueryTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxRate not implemented")
 }
 
-func (m *QueryIndicatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) TaxCap(ctx context.Context, req *QueryTaxCapRequest) (*QueryTaxCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxCap not implemented")
 }
 
-func (m *QueryIndicatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TRLYear.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TRLMonth.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) TaxCaps(ctx context.Context, req *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxCaps not implemented")
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) MsgTaxRate(ctx context.Context, req *QueryMsgTaxRateRequest) (*QueryMsgTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTaxRate not implemented")
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) RewardWeight(ctx context.Context, req *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardWeight not implemented")
 }
 
-func (m *QueryBurnTaxExemptionListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) SeigniorageProceeds(ctx context.Context, req *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeigniorageProceeds not implemented")
 }
 
-func (m *QueryBurnTaxExemptionListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) TaxProceeds(ctx context.Context, req *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxProceeds not implemented")
+}
+
+func (*UnimplementedQueryServer) Indicators(ctx context.Context, req *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Indicators not implemented")
+}
+
+func (*UnimplementedQueryServer) IndicatorHistory(ctx context.Context, req *QueryIndicatorHistoryRequest) (*QueryIndicatorHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndicatorHistory not implemented")
+}
+
+func (*UnimplementedQueryServer) PolicyDryRun(ctx context.Context, req *QueryPolicyDryRunRequest) (*QueryPolicyDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyDryRun not implemented")
+}
+
+func (*UnimplementedQueryServer) BurnTaxExemptionList(ctx context.Context, req *QueryBurnTaxExemptionListRequest) (*QueryBurnTaxExemptionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxExemptionList not implemented")
+}
+
+func (*UnimplementedQueryServer) BurnTaxExemptionZones(ctx context.Context, req *QueryBurnTaxExemptionZonesRequest) (*QueryBurnTaxExemptionZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxExemptionZones not implemented")
+}
+
+func (*UnimplementedQueryServer) BurnTaxExemptionZone(ctx context.Context, req *QueryBurnTaxExemptionZoneRequest) (*QueryBurnTaxExemptionZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxExemptionZone not implemented")
+}
+
+func (*UnimplementedQueryServer) BurnTaxExemptionZoneByAddress(ctx context.Context, req *QueryBurnTaxExemptionZoneByAddressRequest) (*QueryBurnTaxExemptionZoneByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxExemptionZoneByAddress not implemented")
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxRate(ctx, req.(*QueryTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxCap(ctx, req.(*QueryTaxCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxCapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxCaps(ctx, req.(*QueryTaxCapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/MsgTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgTaxRate(ctx, req.(*QueryMsgTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/RewardWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardWeight(ctx, req.(*QueryRewardWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SeigniorageProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySeigniorageProceedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SeigniorageProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/SeigniorageProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SeigniorageProceeds(ctx, req.(*QuerySeigniorageProceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxProceedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxProceeds(ctx, req.(*QueryTaxProceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Indicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndicatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Indicators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/Indicators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Indicators(ctx, req.(*QueryIndicatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndicatorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndicatorHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndicatorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/IndicatorHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndicatorHistory(ctx, req.(*QueryIndicatorHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PolicyDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PolicyDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/PolicyDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PolicyDryRun(ctx, req.(*QueryPolicyDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnTaxExemptionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnTaxExemptionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnTaxExemptionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/BurnTaxExemptionList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnTaxExemptionList(ctx, req.(*QueryBurnTaxExemptionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnTaxExemptionZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnTaxExemptionZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnTaxExemptionZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/BurnTaxExemptionZones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnTaxExemptionZones(ctx, req.(*QueryBurnTaxExemptionZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnTaxExemptionZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnTaxExemptionZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnTaxExemptionZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/BurnTaxExemptionZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnTaxExemptionZone(ctx, req.(*QueryBurnTaxExemptionZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnTaxExemptionZoneByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnTaxExemptionZoneByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnTaxExemptionZoneByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/BurnTaxExemptionZoneByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnTaxExemptionZoneByAddress(ctx, req.(*QueryBurnTaxExemptionZoneByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.treasury.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TaxRate",
+			Handler:    _Query_TaxRate_Handler,
+		},
+		{
+			MethodName: "TaxCap",
+			Handler:    _Query_TaxCap_Handler,
+		},
+		{
+			MethodName: "TaxCaps",
+			Handler:    _Query_TaxCaps_Handler,
+		},
+		{
+			MethodName: "MsgTaxRate",
+			Handler:    _Query_MsgTaxRate_Handler,
+		},
+		{
+			MethodName: "RewardWeight",
+			Handler:    _Query_RewardWeight_Handler,
+		},
+		{
+			MethodName: "SeigniorageProceeds",
+			Handler:    _Query_SeigniorageProceeds_Handler,
+		},
+		{
+			MethodName: "TaxProceeds",
+			Handler:    _Query_TaxProceeds_Handler,
+		},
+		{
+			MethodName: "Indicators",
+			Handler:    _Query_Indicators_Handler,
+		},
+		{
+			MethodName: "IndicatorHistory",
+			Handler:    _Query_IndicatorHistory_Handler,
+		},
+		{
+			MethodName: "PolicyDryRun",
+			Handler:    _Query_PolicyDryRun_Handler,
+		},
+		{
+			MethodName: "BurnTaxExemptionList",
+			Handler:    _Query_BurnTaxExemptionList_Handler,
+		},
+		{
+			MethodName: "BurnTaxExemptionZones",
+			Handler:    _Query_BurnTaxExemptionZones_Handler,
+		},
+		{
+			MethodName: "BurnTaxExemptionZone",
+			Handler:    _Query_BurnTaxExemptionZone_Handler,
+		},
+		{
+			MethodName: "BurnTaxExemptionZoneByAddress",
+			Handler:    _Query_BurnTaxExemptionZoneByAddress_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terra/treasury/v1beta1/query.proto",
+}
+
+func (m *QueryTaxRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxCap.Size()
+		i -= size
+		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapsResponseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsResponseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxCap.Size()
+		i -= size
+		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTaxRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTaxRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardWeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardWeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardWeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeigniorageProceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeigniorageProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeigniorageProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeigniorageProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeigniorageProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeigniorageProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SeigniorageProceeds.Size()
+		i -= size
+		if _, err := m.SeigniorageProceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TRLMonth.Size()
+		i -= size
+		if _, err := m.TRLMonth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TRLYear.Size()
+		i -= size
+		if _, err := m.TRLYear.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochIndicators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochIndicators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochIndicators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TotalStakedLuna.Size()
+		i -= size
+		if _, err := m.TotalStakedLuna.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SeigniorageReward.Size()
+		i -= size
+		if _, err := m.SeigniorageReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TaxReward.Size()
+		i -= size
+		if _, err := m.TaxReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Indicators) > 0 {
+		for iNdEx := len(m.Indicators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indicators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicyDryRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyDryRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyDryRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicyDryRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyDryRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyDryRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.RewardWeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TaxRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Probation {
+		i--
+		if m.Probation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exemptions) > 0 {
+		for iNdEx := len(m.Exemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionZonesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionZonesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionZonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionZonesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionZonesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionZonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Zone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Zone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryTaxRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxCapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxCapsResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TaxCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMsgTaxRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgTaxRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardWeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxProceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for _, e := range m.TaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySeigniorageProceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySeigniorageProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SeigniorageProceeds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIndicatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIndicatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TRLYear.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TRLMonth.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIndicatorHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochIndicators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = m.TaxReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SeigniorageReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalStakedLuna.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIndicatorHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indicators) > 0 {
+		for _, e := range m.Indicators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyDryRunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPolicyDryRunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Probation {
+		n += 2
+	}
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Exemptions) > 0 {
+		for _, e := range m.Exemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionZonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionZonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Zone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Zone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsResponseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxCapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCaps = append(m.TaxCaps, QueryTaxCapsResponseItem{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMsgTaxRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTaxRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMsgTaxRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTaxRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryRewardWeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardWeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardWeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySeigniorageProceedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageProceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeigniorageProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndicatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndicatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndicatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndicatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TRLYear", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TRLYear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TRLMonth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TRLMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIndicatorHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2246,12 +5584,86 @@ func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndicatorHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndicatorHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2274,7 +5686,7 @@ func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
+func (m *EpochIndicators) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2293,19 +5705,176 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxRateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochIndicators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochIndicators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeigniorageReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakedLuna", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStakedLuna.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &EpochPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2333,7 +5902,7 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2359,7 +5928,7 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIndicatorHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2382,17 +5951,17 @@ func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndicatorHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndicatorHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indicators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2402,80 +5971,31 @@ func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Indicators = append(m.Indicators, EpochIndicators{})
+			if err := m.Indicators[len(m.Indicators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2485,23 +6005,25 @@ func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2527,7 +6049,7 @@ func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyDryRunRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2550,10 +6072,10 @@ func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyDryRunRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyDryRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2578,7 +6100,7 @@ func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyDryRunResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2601,17 +6123,17 @@ func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapsResponseItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyDryRunResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapsResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyDryRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2621,29 +6143,36 @@ func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probation", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Probation = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2653,80 +6182,28 @@ func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryTaxCapsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2753,62 +6230,44 @@ func (m *QueryTaxCapsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxCaps = append(m.TaxCaps, QueryTaxCapsResponseItem{})
-			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryRewardWeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TaxCaps = append(m.TaxCaps, types.Coin{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardWeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardWeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2831,7 +6290,7 @@ func (m *QueryRewardWeightRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2854,17 +6313,17 @@ func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardWeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2874,23 +6333,25 @@ func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2916,7 +6377,7 @@ func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2939,66 +6400,47 @@ func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxProceedsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxProceedsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3025,62 +6467,47 @@ func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
-			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemptions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QuerySeigniorageProceedsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Exemptions = append(m.Exemptions, BurnTaxExemption{})
+			if err := m.Exemptions[len(m.Exemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3103,7 +6530,7 @@ func (m *QuerySeigniorageProceedsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionZonesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3126,17 +6553,17 @@ func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZonesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageProceeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3146,77 +6573,28 @@ func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SeigniorageProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndicatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndicatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3239,7 +6617,7 @@ func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3262,17 +6640,17 @@ func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndicatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZonesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndicatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TRLYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3282,31 +6660,31 @@ func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TRLYear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Zones = append(m.Zones, BurnTaxExemptionZone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TRLMonth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3316,23 +6694,25 @@ func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TRLMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3358,7 +6738,7 @@ func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionZoneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3381,12 +6761,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3409,7 +6857,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3432,15 +6880,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3467,7 +6915,75 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Zone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3493,7 +7009,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryBurnTaxExemptionListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionZoneByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3516,17 +7032,17 @@ func (m *QueryBurnTaxExemptionListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnTaxExemptionListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnTaxExemptionListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3536,27 +7052,23 @@ func (m *QueryBurnTaxExemptionListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3580,7 +7092,7 @@ func (m *QueryBurnTaxExemptionListRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryBurnTaxExemptionListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnTaxExemptionZoneByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3603,47 +7115,15 @@ func (m *QueryBurnTaxExemptionListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnTaxExemptionListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnTaxExemptionListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnTaxExemptionZoneByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3670,10 +7150,7 @@ func (m *QueryBurnTaxExemptionListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Zone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8,11 +8,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -20,6 +23,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -39,6 +43,8 @@ type Params struct {
 	WindowProbation         uint64                                 `protobuf:"varint,7,opt,name=window_probation,json=windowProbation,proto3" json:"window_probation,omitempty" yaml:"window_probation"`
 	BurnTaxSplit            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=burn_tax_split,json=burnTaxSplit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_tax_split" yaml:"burn_tax_split"`
 	MinInitialDepositRatio  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_initial_deposit_ratio" yaml:"min_initial_deposit_ratio"`
+	TaxRateMultipliers      []TaxRateMultiplier                    `protobuf:"bytes,10,rep,name=tax_rate_multipliers,json=taxRateMultipliers,proto3" json:"tax_rate_multipliers" yaml:"tax_rate_multipliers"`
+	DenomTaxRates           []DenomTaxRate                         `protobuf:"bytes,11,rep,name=denom_tax_rates,json=denomTaxRates,proto3" json:"denom_tax_rates" yaml:"denom_tax_rates"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -113,6 +119,122 @@ func (m *Params) GetWindowProbation() uint64 {
 	return 0
 }
 
+func (m *Params) GetTaxRateMultipliers() []TaxRateMultiplier {
+	if m != nil {
+		return m.TaxRateMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetDenomTaxRates() []DenomTaxRate {
+	if m != nil {
+		return m.DenomTaxRates
+	}
+	return nil
+}
+
+// TaxRateMultiplier scales the tax rate applied to the principal of a msg type
+type TaxRateMultiplier struct {
+	MsgTypeURL string                                 `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier" yaml:"multiplier"`
+}
+
+func (m *TaxRateMultiplier) Reset()         { *m = TaxRateMultiplier{} }
+func (m *TaxRateMultiplier) String() string { return proto.CompactTextString(m) }
+func (*TaxRateMultiplier) ProtoMessage()    {}
+func (*TaxRateMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{1}
+}
+
+func (m *TaxRateMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TaxRateMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxRateMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TaxRateMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxRateMultiplier.Merge(m, src)
+}
+
+func (m *TaxRateMultiplier) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TaxRateMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxRateMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxRateMultiplier proto.InternalMessageInfo
+
+func (m *TaxRateMultiplier) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
+// DenomTaxRate overrides the tax rate applied to the principal of a denom
+type DenomTaxRate struct {
+	Denom   string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	TaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate" yaml:"tax_rate"`
+}
+
+func (m *DenomTaxRate) Reset()         { *m = DenomTaxRate{} }
+func (m *DenomTaxRate) String() string { return proto.CompactTextString(m) }
+func (*DenomTaxRate) ProtoMessage()    {}
+func (*DenomTaxRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{2}
+}
+
+func (m *DenomTaxRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DenomTaxRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTaxRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DenomTaxRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTaxRate.Merge(m, src)
+}
+
+func (m *DenomTaxRate) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DenomTaxRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTaxRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTaxRate proto.InternalMessageInfo
+
+func (m *DenomTaxRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // PolicyConstraints - defines policy constraints can be applied in tax & reward policies
 type PolicyConstraints struct {
 	RateMin       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate_min,json=rateMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_min" yaml:"rate_min"`
@@ -124,7 +246,7 @@ type PolicyConstraints struct {
 func (m *PolicyConstraints) Reset()      { *m = PolicyConstraints{} }
 func (*PolicyConstraints) ProtoMessage() {}
 func (*PolicyConstraints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{1}
+	return fileDescriptor_353bb3a9c554268e, []int{3}
 }
 
 func (m *PolicyConstraints) XXX_Unmarshal(b []byte) error {
@@ -175,7 +297,7 @@ func (m *EpochTaxProceeds) Reset()         { *m = EpochTaxProceeds{} }
 func (m *EpochTaxProceeds) String() string { return proto.CompactTextString(m) }
 func (*EpochTaxProceeds) ProtoMessage()    {}
 func (*EpochTaxProceeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{2}
+	return fileDescriptor_353bb3a9c554268e, []int{4}
 }
 
 func (m *EpochTaxProceeds) XXX_Unmarshal(b []byte) error {
@@ -226,7 +348,7 @@ func (m *EpochInitialIssuance) Reset()         { *m = EpochInitialIssuance{} }
 func (m *EpochInitialIssuance) String() string { return proto.CompactTextString(m) }
 func (*EpochInitialIssuance) ProtoMessage()    {}
 func (*EpochInitialIssuance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{3}
+	return fileDescriptor_353bb3a9c554268e, []int{5}
 }
 
 func (m *EpochInitialIssuance) XXX_Unmarshal(b []byte) error {
@@ -267,11 +389,300 @@ func (m *EpochInitialIssuance) GetIssuance() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+// BurnTaxExemption defines an address exempted from the burn tax,
+// optionally until an expiry height and/or time and only for some denoms
+type BurnTaxExemption struct {
+	Address      string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	ExpiryHeight int64      `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	ExpiryTime   *time.Time `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	Denoms       []string   `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+}
+
+func (m *BurnTaxExemption) Reset()      { *m = BurnTaxExemption{} }
+func (*BurnTaxExemption) ProtoMessage() {}
+func (*BurnTaxExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{6}
+}
+
+func (m *BurnTaxExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BurnTaxExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnTaxExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *BurnTaxExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnTaxExemption.Merge(m, src)
+}
+
+func (m *BurnTaxExemption) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BurnTaxExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnTaxExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnTaxExemption proto.InternalMessageInfo
+
+func (m *BurnTaxExemption) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BurnTaxExemption) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *BurnTaxExemption) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *BurnTaxExemption) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// BurnTaxExemptionZone defines a named group of addresses whose transfers among
+// each other are exempted from the burn tax
+type BurnTaxExemptionZone struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// enabled exempts the transfers among the members, a disabled zone exempting none of them
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *BurnTaxExemptionZone) Reset()      { *m = BurnTaxExemptionZone{} }
+func (*BurnTaxExemptionZone) ProtoMessage() {}
+func (*BurnTaxExemptionZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{7}
+}
+
+func (m *BurnTaxExemptionZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BurnTaxExemptionZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnTaxExemptionZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *BurnTaxExemptionZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnTaxExemptionZone.Merge(m, src)
+}
+
+func (m *BurnTaxExemptionZone) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BurnTaxExemptionZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnTaxExemptionZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnTaxExemptionZone proto.InternalMessageInfo
+
+func (m *BurnTaxExemptionZone) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BurnTaxExemptionZone) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// EpochPolicy represents the tax rate, reward weight
+// and tax caps in effect during an epoch
+type EpochPolicy struct {
+	TaxRate      github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,1,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate" yaml:"tax_rate"`
+	RewardWeight github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight" yaml:"reward_weight"`
+	TaxCaps      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tax_caps,json=taxCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_caps" yaml:"tax_caps"`
+}
+
+func (m *EpochPolicy) Reset()         { *m = EpochPolicy{} }
+func (m *EpochPolicy) String() string { return proto.CompactTextString(m) }
+func (*EpochPolicy) ProtoMessage()    {}
+func (*EpochPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{8}
+}
+
+func (m *EpochPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EpochPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EpochPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochPolicy.Merge(m, src)
+}
+
+func (m *EpochPolicy) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EpochPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochPolicy proto.InternalMessageInfo
+
+func (m *EpochPolicy) GetTaxCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxCaps
+	}
+	return nil
+}
+
+// TaxRateUpdate represents the computation of the tax rate of the next epoch
+// from the rolling averages of the tax rewards per unit Luna
+type TaxRateUpdate struct {
+	// tax_rate is the current tax rate
+	TaxRate          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate" yaml:"tax_rate"`
+	UnclampedTaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=unclamped_tax_rate,json=unclampedTaxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unclamped_tax_rate" yaml:"unclamped_tax_rate"`
+	ClampedTaxRate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=clamped_tax_rate,json=clampedTaxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clamped_tax_rate" yaml:"clamped_tax_rate"`
+	TRLYear          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=trl_year,json=trlYear,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trl_year" yaml:"trl_year"`
+	TRLMonth         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trl_month,json=trlMonth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trl_month" yaml:"trl_month"`
+}
+
+func (m *TaxRateUpdate) Reset()      { *m = TaxRateUpdate{} }
+func (*TaxRateUpdate) ProtoMessage() {}
+func (*TaxRateUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{9}
+}
+
+func (m *TaxRateUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TaxRateUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxRateUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TaxRateUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxRateUpdate.Merge(m, src)
+}
+
+func (m *TaxRateUpdate) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TaxRateUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxRateUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxRateUpdate proto.InternalMessageInfo
+
+// RewardWeightUpdate represents the computation of the reward weight of the next epoch
+// from the seigniorage burden over the short window
+type RewardWeightUpdate struct {
+	// reward_weight is the current reward weight
+	RewardWeight          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight" yaml:"reward_weight"`
+	UnclampedRewardWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=unclamped_reward_weight,json=unclampedRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unclamped_reward_weight" yaml:"unclamped_reward_weight"`
+	ClampedRewardWeight   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=clamped_reward_weight,json=clampedRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clamped_reward_weight" yaml:"clamped_reward_weight"`
+	SeigniorageRewardSum  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=seigniorage_reward_sum,json=seigniorageRewardSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_reward_sum" yaml:"seigniorage_reward_sum"`
+	MiningRewardSum       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=mining_reward_sum,json=miningRewardSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mining_reward_sum" yaml:"mining_reward_sum"`
+}
+
+func (m *RewardWeightUpdate) Reset()      { *m = RewardWeightUpdate{} }
+func (*RewardWeightUpdate) ProtoMessage() {}
+func (*RewardWeightUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{10}
+}
+
+func (m *RewardWeightUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RewardWeightUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWeightUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RewardWeightUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWeightUpdate.Merge(m, src)
+}
+
+func (m *RewardWeightUpdate) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RewardWeightUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWeightUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWeightUpdate proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.treasury.v1beta1.Params")
+	proto.RegisterType((*TaxRateMultiplier)(nil), "terra.treasury.v1beta1.TaxRateMultiplier")
+	proto.RegisterType((*DenomTaxRate)(nil), "terra.treasury.v1beta1.DenomTaxRate")
 	proto.RegisterType((*PolicyConstraints)(nil), "terra.treasury.v1beta1.PolicyConstraints")
 	proto.RegisterType((*EpochTaxProceeds)(nil), "terra.treasury.v1beta1.EpochTaxProceeds")
 	proto.RegisterType((*EpochInitialIssuance)(nil), "terra.treasury.v1beta1.EpochInitialIssuance")
+	proto.RegisterType((*BurnTaxExemption)(nil), "terra.treasury.v1beta1.BurnTaxExemption")
+	proto.RegisterType((*BurnTaxExemptionZone)(nil), "terra.treasury.v1beta1.BurnTaxExemptionZone")
+	proto.RegisterType((*EpochPolicy)(nil), "terra.treasury.v1beta1.EpochPolicy")
+	proto.RegisterType((*TaxRateUpdate)(nil), "terra.treasury.v1beta1.TaxRateUpdate")
+	proto.RegisterType((*RewardWeightUpdate)(nil), "terra.treasury.v1beta1.RewardWeightUpdate")
 }
 
 func init() {
@@ -279,56 +690,99 @@ func init() {
 }
 
 var fileDescriptor_353bb3a9c554268e = []byte{
-	// 772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4d, 0x8f, 0x1b, 0x35,
-	0x18, 0xc7, 0x33, 0xa4, 0xec, 0x26, 0x4e, 0x4a, 0xb6, 0xee, 0x92, 0x9d, 0x14, 0x94, 0x89, 0x2c,
-	0x81, 0xc2, 0xa1, 0x13, 0xb5, 0x1c, 0x90, 0xf6, 0x82, 0xc8, 0x16, 0xda, 0x48, 0x20, 0x45, 0xee,
-	0x9e, 0x10, 0xd2, 0xc8, 0x99, 0x58, 0x13, 0x8b, 0x8c, 0x3d, 0xb2, 0x1d, 0x76, 0xc2, 0x9d, 0x1b,
-	0x20, 0xc4, 0x09, 0x71, 0xea, 0x11, 0xf1, 0x49, 0x7a, 0xec, 0x11, 0x71, 0x08, 0x68, 0xf7, 0xc2,
-	0x39, 0x9f, 0x00, 0x8d, 0xed, 0xbc, 0x15, 0x16, 0x1a, 0xed, 0x29, 0x7e, 0xde, 0x7e, 0xcf, 0xdf,
-	0xce, 0x3c, 0x36, 0x78, 0x47, 0x53, 0x29, 0x49, 0x4f, 0x4b, 0x4a, 0xd4, 0x4c, 0xce, 0x7b, 0x5f,
-	0x3d, 0x18, 0x51, 0x4d, 0x1e, 0xac, 0x1d, 0x61, 0x26, 0x85, 0x16, 0xb0, 0x69, 0xd2, 0xc2, 0xb5,
-	0xd7, 0xa5, 0xdd, 0x3b, 0x4e, 0x44, 0x22, 0x4c, 0x4a, 0xaf, 0x58, 0xd9, 0xec, 0x7b, 0xed, 0x58,
-	0xa8, 0x54, 0xa8, 0xde, 0x88, 0x28, 0xba, 0x26, 0xc6, 0x82, 0x71, 0x1b, 0x47, 0xbf, 0x1c, 0x82,
-	0x83, 0x21, 0x91, 0x24, 0x55, 0x30, 0x06, 0x40, 0x93, 0x3c, 0xca, 0xc4, 0x94, 0xc5, 0x73, 0xdf,
-	0xeb, 0x78, 0xdd, 0xda, 0xc3, 0xf7, 0xc2, 0x7f, 0xef, 0x16, 0x0e, 0x4d, 0xd6, 0x99, 0xe0, 0x4a,
-	0x4b, 0xc2, 0xb8, 0x56, 0xfd, 0xd6, 0xf3, 0x45, 0x50, 0x5a, 0x2e, 0x82, 0x3b, 0x73, 0x92, 0x4e,
-	0x4f, 0xd1, 0x06, 0x85, 0x70, 0x55, 0x93, 0xdc, 0x16, 0xc0, 0x29, 0xb8, 0x2d, 0xe9, 0x05, 0x91,
-	0xe3, 0x55, 0x9f, 0xd7, 0xf6, 0xed, 0xf3, 0xb6, 0xeb, 0x73, 0x6c, 0xfb, 0xec, 0xd0, 0x10, 0xae,
-	0x5b, 0xdb, 0x75, 0xfb, 0xde, 0x03, 0x2d, 0x45, 0x59, 0xc2, 0x99, 0x90, 0x24, 0xa1, 0xd1, 0x68,
-	0x26, 0xc7, 0x94, 0x47, 0x9a, 0xc8, 0x84, 0x6a, 0xbf, 0xdc, 0xf1, 0xba, 0xd5, 0x3e, 0x2e, 0x78,
-	0xbf, 0x2f, 0x82, 0x77, 0x13, 0xa6, 0x27, 0xb3, 0x51, 0x18, 0x8b, 0xb4, 0xe7, 0x0e, 0xcd, 0xfe,
-	0xdc, 0x57, 0xe3, 0x2f, 0x7b, 0x7a, 0x9e, 0x51, 0x15, 0x3e, 0xa2, 0xf1, 0x72, 0x11, 0x74, 0x6c,
-	0xe7, 0x6b, 0xc1, 0x08, 0x9f, 0x6c, 0xc5, 0xfa, 0x26, 0x74, 0x6e, 0x22, 0x50, 0x83, 0xa3, 0x94,
-	0x71, 0xc6, 0x93, 0x88, 0xf1, 0x58, 0xd2, 0x94, 0x72, 0xed, 0xdf, 0x32, 0x32, 0x06, 0x7b, 0xcb,
-	0x38, 0xb1, 0x32, 0x5e, 0xe6, 0x21, 0xdc, 0xb0, 0xae, 0xc1, 0xca, 0x03, 0x4f, 0x41, 0xfd, 0x82,
-	0xf1, 0xb1, 0xb8, 0x88, 0xd4, 0x44, 0x48, 0xed, 0xbf, 0xde, 0xf1, 0xba, 0xb7, 0xfa, 0x27, 0xcb,
-	0x45, 0x70, 0xd7, 0x32, 0xb6, 0xa3, 0x08, 0xd7, 0xac, 0xf9, 0xb4, 0xb0, 0xe0, 0x07, 0xc0, 0x99,
-	0xd1, 0x54, 0xf0, 0xc4, 0x3f, 0x30, 0xa5, 0xcd, 0xe5, 0x22, 0x80, 0x3b, 0xa5, 0x45, 0x10, 0x61,
-	0x60, 0xad, 0x4f, 0x05, 0x4f, 0xe0, 0x27, 0xe0, 0xc8, 0xc5, 0x32, 0x29, 0x46, 0x44, 0x33, 0xc1,
-	0xfd, 0x43, 0x53, 0xfd, 0xd6, 0x46, 0xfc, 0xcb, 0x19, 0x08, 0x37, 0xac, 0x6b, 0xb8, 0xf2, 0xc0,
-	0x14, 0xbc, 0x31, 0x9a, 0xc9, 0xe2, 0x6c, 0xf3, 0x48, 0x65, 0x53, 0xa6, 0xfd, 0x8a, 0x39, 0xb0,
-	0xc7, 0x7b, 0x1f, 0xd8, 0x9b, 0xb6, 0xe7, 0x2e, 0x0d, 0xe1, 0x7a, 0xe1, 0x38, 0x27, 0xf9, 0xd3,
-	0xc2, 0x84, 0xdf, 0x79, 0xa0, 0x95, 0x32, 0x1e, 0x31, 0xce, 0x34, 0x23, 0xd3, 0x68, 0x4c, 0x33,
-	0xa1, 0x98, 0x8e, 0x64, 0xa1, 0xc6, 0xaf, 0xde, 0xec, 0x93, 0xb9, 0x16, 0x8c, 0x70, 0x33, 0x65,
-	0x7c, 0x60, 0x43, 0x8f, 0x6c, 0x04, 0x17, 0x81, 0xd3, 0xca, 0x4f, 0xcf, 0x82, 0xd2, 0x5f, 0xcf,
-	0x02, 0x0f, 0x7d, 0x5b, 0x06, 0x77, 0xfe, 0x31, 0x0e, 0xf0, 0x0b, 0x50, 0x91, 0x44, 0xd3, 0x28,
-	0x65, 0xdc, 0xcc, 0x6c, 0xb5, 0xff, 0xd1, 0xde, 0xea, 0x1a, 0x6e, 0x94, 0x1c, 0x07, 0xe1, 0xc3,
-	0x62, 0xf9, 0x19, 0xe3, 0x1b, 0x3a, 0xc9, 0xcd, 0xa4, 0xde, 0x98, 0x4e, 0xf2, 0x15, 0x9d, 0xe4,
-	0xf0, 0x43, 0x50, 0x8e, 0x49, 0x66, 0xe6, 0xb0, 0xf6, 0xb0, 0x15, 0xda, 0xfa, 0xb0, 0xb8, 0xaa,
-	0xd6, 0xf3, 0x7f, 0x26, 0x18, 0xef, 0x43, 0x37, 0xf2, 0xc0, 0x92, 0x62, 0x92, 0x21, 0x5c, 0x54,
-	0xc2, 0x0c, 0x34, 0xe2, 0x09, 0xe1, 0x09, 0x8d, 0xd6, 0x2a, 0xed, 0x34, 0x3d, 0xd9, 0x5b, 0x65,
-	0xd3, 0xb1, 0x77, 0x71, 0x08, 0xdf, 0xb6, 0x1e, 0x6c, 0x25, 0x6f, 0xfd, 0x1d, 0x3f, 0x7b, 0xe0,
-	0xe8, 0xe3, 0x4c, 0xc4, 0x93, 0x73, 0x92, 0x0f, 0xa5, 0x88, 0x29, 0x1d, 0x2b, 0xf8, 0x8d, 0x07,
-	0xea, 0xe6, 0xe6, 0x73, 0x0e, 0xdf, 0xeb, 0x94, 0xff, 0x7b, 0x6f, 0x8f, 0xdd, 0xde, 0xee, 0x6e,
-	0x5d, 0x9b, 0xae, 0x18, 0xfd, 0xfa, 0x47, 0xd0, 0x7d, 0x85, 0x0d, 0x14, 0x1c, 0x85, 0x6b, 0x7a,
-	0xa3, 0x03, 0xfd, 0xe8, 0x81, 0x63, 0x23, 0xce, 0x7d, 0x52, 0x03, 0xa5, 0x66, 0x84, 0xc7, 0x14,
-	0x7e, 0x0d, 0x2a, 0xcc, 0xad, 0xff, 0x5f, 0xdb, 0x99, 0xd3, 0xe6, 0xfe, 0xc1, 0x55, 0xe1, 0x7e,
-	0xba, 0xd6, 0xfd, 0xfa, 0x4f, 0x9e, 0x5f, 0xb6, 0xbd, 0x17, 0x97, 0x6d, 0xef, 0xcf, 0xcb, 0xb6,
-	0xf7, 0xc3, 0x55, 0xbb, 0xf4, 0xe2, 0xaa, 0x5d, 0xfa, 0xed, 0xaa, 0x5d, 0xfa, 0x3c, 0xdc, 0xa6,
-	0x4d, 0x89, 0x52, 0x2c, 0xbe, 0x6f, 0x5f, 0xc3, 0x58, 0x48, 0xda, 0xcb, 0x37, 0x8f, 0xa2, 0x21,
-	0x8f, 0x0e, 0xcc, 0xe3, 0xf5, 0xfe, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x5b, 0xd0, 0x11,
-	0x33, 0x07, 0x00, 0x00,
+	// 1463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x23, 0xc7, 0xb6, 0x46, 0x72, 0x2c, 0x4f, 0x1c, 0x9b, 0x76, 0xb2, 0xa2, 0x31, 0xd9,
+	0x04, 0x0e, 0xb0, 0x91, 0x90, 0xec, 0x61, 0x01, 0x03, 0x8b, 0xc5, 0xd2, 0xf9, 0x72, 0x6b, 0xb7,
+	0xc6, 0x44, 0x41, 0xd0, 0xa0, 0x00, 0x31, 0xa2, 0xa6, 0x34, 0x11, 0x7e, 0x81, 0x43, 0xc5, 0x52,
+	0x4f, 0x45, 0x81, 0x00, 0x3d, 0x34, 0x45, 0xd0, 0x53, 0x9b, 0x5e, 0x72, 0xee, 0xff, 0xd0, 0x73,
+	0x73, 0x0c, 0xd0, 0x4b, 0xd1, 0x83, 0x52, 0x38, 0x97, 0x9e, 0xf5, 0x17, 0x14, 0xf3, 0x41, 0x91,
+	0x92, 0x9d, 0xb4, 0xac, 0x9b, 0x93, 0x35, 0xef, 0xeb, 0xf7, 0x7b, 0x6f, 0xde, 0xbc, 0x19, 0x1a,
+	0x5c, 0x4a, 0x68, 0x1c, 0x93, 0x66, 0x12, 0x53, 0xc2, 0xba, 0x71, 0xbf, 0xf9, 0xe8, 0x5a, 0x9b,
+	0x26, 0xe4, 0xda, 0x48, 0xd0, 0x88, 0xe2, 0x30, 0x09, 0xe1, 0xb2, 0x30, 0x6b, 0x8c, 0xa4, 0xca,
+	0x6c, 0x6d, 0xc9, 0x09, 0x9d, 0x50, 0x98, 0x34, 0xf9, 0x2f, 0x69, 0xbd, 0x56, 0xb7, 0x43, 0xe6,
+	0x87, 0xac, 0xd9, 0x26, 0x8c, 0x8e, 0x22, 0xda, 0xa1, 0x1b, 0x28, 0xbd, 0xe1, 0x84, 0xa1, 0xe3,
+	0xd1, 0xa6, 0x58, 0xb5, 0xbb, 0x9f, 0x34, 0x13, 0xd7, 0xa7, 0x2c, 0x21, 0x7e, 0x24, 0x0d, 0xd0,
+	0xb7, 0x65, 0x30, 0xb3, 0x47, 0x62, 0xe2, 0x33, 0x68, 0x03, 0x90, 0x90, 0x9e, 0x15, 0x85, 0x9e,
+	0x6b, 0xf7, 0x75, 0x6d, 0x5d, 0xdb, 0xa8, 0x5c, 0xbf, 0xd2, 0x38, 0x9e, 0x4e, 0x63, 0x4f, 0x58,
+	0x6d, 0x85, 0x01, 0x4b, 0x62, 0xe2, 0x06, 0x09, 0x33, 0x57, 0x5f, 0x0c, 0x8c, 0xa9, 0xe1, 0xc0,
+	0x58, 0xec, 0x13, 0xdf, 0xdb, 0x44, 0x59, 0x28, 0x84, 0xcb, 0x09, 0xe9, 0x49, 0x07, 0xe8, 0x81,
+	0xf9, 0x98, 0x1e, 0x90, 0xb8, 0x93, 0xe2, 0x9c, 0x2a, 0x8a, 0x73, 0x41, 0xe1, 0x2c, 0x49, 0x9c,
+	0xb1, 0x68, 0x08, 0x57, 0xe5, 0x5a, 0xa1, 0x7d, 0xa5, 0x81, 0x55, 0x46, 0x5d, 0x27, 0x70, 0xc3,
+	0x98, 0x38, 0xd4, 0x6a, 0x77, 0xe3, 0x0e, 0x0d, 0xac, 0x84, 0xc4, 0x0e, 0x4d, 0xf4, 0xd2, 0xba,
+	0xb6, 0x51, 0x36, 0x31, 0x8f, 0xf7, 0xcb, 0xc0, 0xb8, 0xec, 0xb8, 0xc9, 0x7e, 0xb7, 0xdd, 0xb0,
+	0x43, 0xbf, 0xa9, 0xaa, 0x2a, 0xff, 0x5c, 0x65, 0x9d, 0x87, 0xcd, 0xa4, 0x1f, 0x51, 0xd6, 0xb8,
+	0x41, 0xed, 0xe1, 0xc0, 0x58, 0x97, 0xc8, 0x6f, 0x0c, 0x8c, 0xf0, 0x4a, 0x4e, 0x67, 0x0a, 0x55,
+	0x4b, 0x68, 0x60, 0x02, 0x6a, 0xbe, 0x1b, 0xb8, 0x81, 0x63, 0xb9, 0x81, 0x1d, 0x53, 0x9f, 0x06,
+	0x89, 0x3e, 0x2d, 0x68, 0x6c, 0x17, 0xa6, 0xb1, 0x22, 0x69, 0x4c, 0xc6, 0x43, 0x78, 0x41, 0x8a,
+	0xb6, 0x53, 0x09, 0xdc, 0x04, 0xd5, 0x03, 0x37, 0xe8, 0x84, 0x07, 0x16, 0xdb, 0x0f, 0xe3, 0x44,
+	0x3f, 0xbd, 0xae, 0x6d, 0x4c, 0x9b, 0x2b, 0xc3, 0x81, 0x71, 0x56, 0xc6, 0xc8, 0x6b, 0x11, 0xae,
+	0xc8, 0xe5, 0x5d, 0xbe, 0x82, 0xff, 0x01, 0x6a, 0x69, 0x79, 0x61, 0xe0, 0xe8, 0x33, 0xc2, 0x75,
+	0x79, 0x38, 0x30, 0xe0, 0x98, 0x2b, 0x57, 0x22, 0x0c, 0xe4, 0x6a, 0x27, 0x0c, 0x1c, 0x78, 0x0b,
+	0xd4, 0x94, 0x2e, 0x8a, 0xc3, 0x36, 0x49, 0xdc, 0x30, 0xd0, 0x67, 0x85, 0xf7, 0xf9, 0x8c, 0xfc,
+	0xa4, 0x05, 0xc2, 0x0b, 0x52, 0xb4, 0x97, 0x4a, 0xa0, 0x0f, 0xce, 0xb4, 0xbb, 0x31, 0xaf, 0x6d,
+	0xcf, 0x62, 0x91, 0xe7, 0x26, 0xfa, 0x9c, 0x28, 0xd8, 0xed, 0xc2, 0x05, 0x3b, 0x27, 0x31, 0xc7,
+	0xa3, 0x21, 0x5c, 0xe5, 0x82, 0x16, 0xe9, 0xdd, 0xe5, 0x4b, 0xf8, 0x44, 0x03, 0xab, 0xbe, 0x1b,
+	0x58, 0x6e, 0xe0, 0x26, 0x2e, 0xf1, 0xac, 0x0e, 0x8d, 0x42, 0xe6, 0x26, 0x56, 0xcc, 0xd9, 0xe8,
+	0xe5, 0x93, 0xb5, 0xcc, 0x1b, 0x03, 0x23, 0xbc, 0xec, 0xbb, 0xc1, 0xb6, 0x54, 0xdd, 0x90, 0x1a,
+	0xcc, 0x15, 0xf0, 0x33, 0x0d, 0x2c, 0x71, 0xb2, 0x31, 0x49, 0xa8, 0xe5, 0x77, 0xbd, 0xc4, 0x8d,
+	0x3c, 0x97, 0xc6, 0x4c, 0x07, 0xeb, 0xa5, 0xb7, 0x1d, 0x9c, 0x16, 0xe9, 0x61, 0x92, 0xd0, 0xdd,
+	0x91, 0x87, 0x79, 0x51, 0x1d, 0x9c, 0xf3, 0xd9, 0x01, 0x9d, 0x0c, 0x8a, 0x30, 0x4c, 0x26, 0xfd,
+	0x18, 0xf4, 0xc0, 0x42, 0x87, 0x06, 0xa1, 0x6f, 0xa5, 0x2e, 0x4c, 0xaf, 0x08, 0xf0, 0x7f, 0xbe,
+	0x09, 0xfc, 0x06, 0x37, 0x57, 0x0c, 0xcc, 0xba, 0xc2, 0x5d, 0x96, 0xb8, 0x13, 0xa1, 0x10, 0x9e,
+	0xef, 0xe4, 0xac, 0xd9, 0xe6, 0xdc, 0x37, 0xcf, 0x8d, 0xa9, 0xdf, 0x9e, 0x1b, 0x1a, 0xfa, 0x51,
+	0x03, 0x8b, 0x47, 0xd2, 0x80, 0xb7, 0x41, 0xd5, 0x67, 0x8e, 0xc5, 0xab, 0x6b, 0x75, 0x63, 0x4f,
+	0x0c, 0xaa, 0xb2, 0x79, 0xe9, 0x70, 0x60, 0x80, 0x5d, 0xe6, 0xb4, 0xfa, 0x11, 0xbd, 0x87, 0x77,
+	0xb2, 0xd6, 0xce, 0xdb, 0x22, 0x0c, 0x7c, 0x65, 0x12, 0x7b, 0x7c, 0xde, 0x65, 0xa9, 0x8b, 0x39,
+	0x54, 0x36, 0xb7, 0x0a, 0xef, 0xac, 0x1a, 0x77, 0x59, 0x24, 0x0e, 0x32, 0x5a, 0x6c, 0x4e, 0x8b,
+	0x4c, 0x9e, 0x69, 0xa0, 0x9a, 0xaf, 0x09, 0xbc, 0x0c, 0x4e, 0x8b, 0xac, 0x15, 0xfb, 0xda, 0x70,
+	0x60, 0x54, 0x73, 0xe5, 0x41, 0x58, 0xaa, 0xe1, 0xc7, 0x60, 0x2e, 0xad, 0x94, 0x62, 0xf8, 0xff,
+	0xc2, 0x0c, 0x17, 0xc6, 0xf7, 0x1b, 0xe1, 0x59, 0xb5, 0xc7, 0x8a, 0xdc, 0x97, 0x25, 0xb0, 0x78,
+	0x64, 0xcc, 0x72, 0x64, 0xd9, 0x1d, 0x6e, 0xa0, 0x6b, 0x27, 0x43, 0x4e, 0xe3, 0x20, 0x3c, 0xcb,
+	0x7f, 0xee, 0xba, 0x41, 0x16, 0x9d, 0xf4, 0x4e, 0x9a, 0x57, 0x1a, 0x27, 0x8d, 0x4e, 0x7a, 0xf0,
+	0x7f, 0xa0, 0x64, 0x93, 0x48, 0xcc, 0xf7, 0xca, 0xf5, 0xd5, 0x86, 0xf4, 0x6f, 0xf0, 0x3b, 0x72,
+	0xd4, 0xa1, 0x5b, 0xa1, 0x1b, 0x98, 0x50, 0x75, 0x26, 0x90, 0x91, 0x6c, 0x12, 0x21, 0xcc, 0x3d,
+	0x61, 0x04, 0x16, 0xec, 0x7d, 0x12, 0x38, 0xd4, 0x1a, 0xb1, 0x94, 0x53, 0xfa, 0x4e, 0x61, 0x96,
+	0xaa, 0xeb, 0x27, 0xc2, 0x21, 0x3c, 0x2f, 0x25, 0x58, 0x52, 0xce, 0x75, 0xfd, 0x33, 0x0d, 0xd4,
+	0x6e, 0x46, 0xa1, 0xbd, 0xdf, 0x22, 0xbd, 0xbd, 0x38, 0xb4, 0x29, 0xed, 0x30, 0xf8, 0x58, 0x03,
+	0x55, 0x71, 0xa3, 0x2a, 0x81, 0xae, 0xad, 0x97, 0xde, 0x9e, 0xdb, 0x6d, 0x95, 0xdb, 0xd9, 0xdc,
+	0x75, 0xac, 0x9c, 0xd1, 0xf7, 0xaf, 0x8c, 0x8d, 0x3f, 0x91, 0x00, 0x8f, 0xc3, 0x70, 0x25, 0xc9,
+	0x78, 0xa0, 0xaf, 0x35, 0xb0, 0x24, 0xc8, 0xa9, 0x51, 0xb5, 0xcd, 0x58, 0x97, 0x04, 0x36, 0x85,
+	0x9f, 0x82, 0x39, 0x57, 0xfd, 0xfe, 0x63, 0x6e, 0x5b, 0x8a, 0x9b, 0xda, 0xc1, 0xd4, 0xb1, 0x18,
+	0xaf, 0x11, 0x1e, 0x7a, 0x72, 0x0a, 0xd4, 0x4c, 0x39, 0xc3, 0x6f, 0xf6, 0xa8, 0x1f, 0x89, 0x6b,
+	0xe3, 0x5f, 0x60, 0x96, 0x74, 0x3a, 0x31, 0x65, 0x4c, 0xb5, 0x2f, 0x1c, 0x0e, 0x8c, 0x33, 0x12,
+	0x50, 0x29, 0x10, 0x4e, 0x4d, 0xe0, 0x7f, 0xc1, 0x3c, 0xed, 0x45, 0x6e, 0xdc, 0xb7, 0xf6, 0xa9,
+	0xeb, 0xec, 0x27, 0xa2, 0x29, 0x4b, 0xa6, 0x9e, 0xbd, 0x33, 0xc6, 0xd4, 0x08, 0x57, 0xe5, 0xfa,
+	0x8e, 0x58, 0xc2, 0xfb, 0xa0, 0xa2, 0xf4, 0xfc, 0x7d, 0xa5, 0x1a, 0x6f, 0xad, 0x21, 0x1f, 0x5f,
+	0x8d, 0xf4, 0xf1, 0xd5, 0x68, 0xa5, 0x8f, 0x2f, 0x73, 0x2d, 0xbb, 0x40, 0x73, 0x8e, 0xe8, 0xe9,
+	0x2b, 0x43, 0xc3, 0x40, 0x4a, 0xb8, 0x31, 0xbc, 0x02, 0x66, 0xc4, 0x20, 0x60, 0xfa, 0xf4, 0x7a,
+	0x69, 0xa3, 0x6c, 0x2e, 0x0e, 0x07, 0xc6, 0x7c, 0x6e, 0x50, 0x30, 0x84, 0x95, 0x41, 0xae, 0x83,
+	0x18, 0x58, 0x9a, 0x2c, 0xc7, 0x83, 0x30, 0xa0, 0xf0, 0x22, 0x98, 0x0e, 0x88, 0x4f, 0x55, 0x3d,
+	0x16, 0x86, 0x03, 0xa3, 0x22, 0x43, 0x71, 0x29, 0xc2, 0x42, 0xc9, 0xeb, 0x46, 0x03, 0xd2, 0xf6,
+	0x68, 0x47, 0xd4, 0x60, 0x2e, 0x5f, 0x37, 0xa5, 0x40, 0x38, 0x35, 0xc9, 0x81, 0xfe, 0x74, 0x0a,
+	0x54, 0x44, 0x67, 0xa8, 0xa7, 0x57, 0x7e, 0x72, 0x69, 0x7f, 0xf7, 0xe4, 0x82, 0x0f, 0x47, 0xcf,
+	0xc8, 0x83, 0x6c, 0xbf, 0xca, 0xe6, 0xad, 0xc2, 0x10, 0xe3, 0xaf, 0xc8, 0x83, 0x74, 0x77, 0xe5,
+	0xfa, 0xbe, 0xdc, 0xdd, 0xbe, 0x4c, 0xc5, 0x26, 0x11, 0xd3, 0x4b, 0x05, 0x7b, 0x3b, 0x75, 0x2c,
+	0xd6, 0xdb, 0x3c, 0xcf, 0x2d, 0xee, 0xf5, 0xc3, 0x34, 0x98, 0x57, 0x77, 0xc6, 0xbd, 0xa8, 0xc3,
+	0x33, 0x7f, 0xb7, 0x75, 0xed, 0x03, 0xd8, 0x0d, 0x6c, 0x8f, 0xf8, 0x11, 0xed, 0x58, 0x13, 0x37,
+	0xcf, 0xfb, 0x85, 0x71, 0x56, 0x25, 0xce, 0xd1, 0x88, 0x08, 0xd7, 0x46, 0xc2, 0xf4, 0x4a, 0x64,
+	0xa0, 0x76, 0x04, 0xb8, 0x74, 0xb2, 0xa7, 0xf1, 0x51, 0xd8, 0x33, 0x13, 0xa0, 0x0e, 0x98, 0x4b,
+	0x62, 0xcf, 0xea, 0x53, 0x12, 0xab, 0x09, 0xbf, 0x53, 0x0c, 0xec, 0x70, 0x60, 0xcc, 0xb6, 0xf0,
+	0xce, 0x47, 0x94, 0xc4, 0xb9, 0xc2, 0xaa, 0x90, 0xbc, 0xb0, 0xb1, 0xc7, 0x75, 0xf0, 0x21, 0x28,
+	0x73, 0xa9, 0x1f, 0x06, 0xc9, 0xbe, 0x78, 0x7f, 0x97, 0xcd, 0x0f, 0x0a, 0x23, 0xcd, 0xb5, 0xf0,
+	0xce, 0x2e, 0x8f, 0x30, 0x1c, 0x18, 0xb5, 0x0c, 0x4a, 0x04, 0x45, 0x98, 0x67, 0x22, 0xb4, 0xb9,
+	0x53, 0xf9, 0xdd, 0x69, 0x00, 0x71, 0xae, 0x97, 0x55, 0x13, 0x1d, 0x39, 0x3e, 0xda, 0x3b, 0x3c,
+	0x3e, 0x5f, 0x68, 0x60, 0x25, 0x6b, 0x81, 0xe3, 0x8e, 0xed, 0x5e, 0x61, 0xdc, 0xfa, 0x64, 0x67,
+	0x4d, 0x30, 0x38, 0x37, 0xd2, 0xe4, 0xb3, 0x87, 0x9f, 0x6b, 0xe0, 0xdc, 0xf1, 0x44, 0x4a, 0x7f,
+	0x65, 0x4b, 0x86, 0x03, 0xe3, 0xc2, 0x78, 0xa7, 0x4d, 0xd0, 0x38, 0x7b, 0x1c, 0x89, 0xc7, 0x1a,
+	0x58, 0xce, 0x7f, 0x3b, 0x2a, 0x1f, 0xd6, 0xf5, 0x55, 0x0b, 0x7e, 0x58, 0x98, 0xc5, 0x3f, 0x8e,
+	0x7e, 0x91, 0x66, 0x51, 0x11, 0x5e, 0xca, 0x29, 0x24, 0x95, 0xbb, 0x5d, 0x1f, 0x3e, 0x02, 0x8b,
+	0xea, 0xdb, 0x31, 0xc7, 0x40, 0xb6, 0xe6, 0x7b, 0x85, 0x19, 0xe8, 0x63, 0x1f, 0xa3, 0x79, 0x70,
+	0xf5, 0x35, 0x3a, 0xc2, 0xcd, 0xba, 0xd3, 0xbc, 0xf3, 0xe2, 0xb0, 0xae, 0xbd, 0x3c, 0xac, 0x6b,
+	0xbf, 0x1e, 0xd6, 0xb5, 0xa7, 0xaf, 0xeb, 0x53, 0x2f, 0x5f, 0xd7, 0xa7, 0x7e, 0x7e, 0x5d, 0x9f,
+	0x7a, 0xd0, 0xc8, 0x03, 0x7b, 0x84, 0x31, 0xd7, 0xbe, 0x2a, 0xff, 0x7f, 0x62, 0x87, 0x31, 0x6d,
+	0xf6, 0xb2, 0x7f, 0xa3, 0x08, 0x12, 0xed, 0x19, 0x71, 0xc7, 0xfe, 0xfb, 0xf7, 0x01, 0x00, 0xaa,
+	0x2a, 0x70, 0x76, 0x65, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -377,17 +831,33 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinInitialDepositRatio.Equal(that1.MinInitialDepositRatio) {
 		return false
 	}
+	if len(this.TaxRateMultipliers) != len(that1.TaxRateMultipliers) {
+		return false
+	}
+	for i := range this.TaxRateMultipliers {
+		if !this.TaxRateMultipliers[i].Equal(&that1.TaxRateMultipliers[i]) {
+			return false
+		}
+	}
+	if len(this.DenomTaxRates) != len(that1.DenomTaxRates) {
+		return false
+	}
+	for i := range this.DenomTaxRates {
+		if !this.DenomTaxRates[i].Equal(&that1.DenomTaxRates[i]) {
+			return false
+		}
+	}
 	return true
 }
 
-func (this *PolicyConstraints) Equal(that interface{}) bool {
+func (this *TaxRateMultiplier) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PolicyConstraints)
+	that1, ok := that.(*TaxRateMultiplier)
 	if !ok {
-		that2, ok := that.(PolicyConstraints)
+		that2, ok := that.(TaxRateMultiplier)
 		if ok {
 			that1 = &that2
 		} else {
@@ -399,13 +869,69 @@ func (this *PolicyConstraints) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RateMin.Equal(that1.RateMin) {
-		return false
-	}
-	if !this.RateMax.Equal(that1.RateMax) {
+	if this.MsgTypeURL != that1.MsgTypeURL {
 		return false
 	}
-	if !this.Cap.Equal(&that1.Cap) {
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
+	return true
+}
+
+func (this *DenomTaxRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTaxRate)
+	if !ok {
+		that2, ok := that.(DenomTaxRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.TaxRate.Equal(that1.TaxRate) {
+		return false
+	}
+	return true
+}
+
+func (this *PolicyConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PolicyConstraints)
+	if !ok {
+		that2, ok := that.(PolicyConstraints)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RateMin.Equal(that1.RateMin) {
+		return false
+	}
+	if !this.RateMax.Equal(that1.RateMax) {
+		return false
+	}
+	if !this.Cap.Equal(&that1.Cap) {
 		return false
 	}
 	if !this.ChangeRateMax.Equal(that1.ChangeRateMax) {
@@ -414,6 +940,151 @@ func (this *PolicyConstraints) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *BurnTaxExemption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BurnTaxExemption)
+	if !ok {
+		that2, ok := that.(BurnTaxExemption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if that1.ExpiryTime == nil {
+		if this.ExpiryTime != nil {
+			return false
+		}
+	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
+		return false
+	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *BurnTaxExemptionZone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BurnTaxExemptionZone)
+	if !ok {
+		that2, ok := that.(BurnTaxExemptionZone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+
+func (this *TaxRateUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaxRateUpdate)
+	if !ok {
+		that2, ok := that.(TaxRateUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TaxRate.Equal(that1.TaxRate) {
+		return false
+	}
+	if !this.UnclampedTaxRate.Equal(that1.UnclampedTaxRate) {
+		return false
+	}
+	if !this.ClampedTaxRate.Equal(that1.ClampedTaxRate) {
+		return false
+	}
+	if !this.TRLYear.Equal(that1.TRLYear) {
+		return false
+	}
+	if !this.TRLMonth.Equal(that1.TRLMonth) {
+		return false
+	}
+	return true
+}
+
+func (this *RewardWeightUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardWeightUpdate)
+	if !ok {
+		that2, ok := that.(RewardWeightUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RewardWeight.Equal(that1.RewardWeight) {
+		return false
+	}
+	if !this.UnclampedRewardWeight.Equal(that1.UnclampedRewardWeight) {
+		return false
+	}
+	if !this.ClampedRewardWeight.Equal(that1.ClampedRewardWeight) {
+		return false
+	}
+	if !this.SeigniorageRewardSum.Equal(that1.SeigniorageRewardSum) {
+		return false
+	}
+	if !this.MiningRewardSum.Equal(that1.MiningRewardSum) {
+		return false
+	}
+	return true
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -434,6 +1105,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomTaxRates) > 0 {
+		for iNdEx := len(m.DenomTaxRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTaxRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TaxRateMultipliers) > 0 {
+		for iNdEx := len(m.TaxRateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxRateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size := m.MinInitialDepositRatio.Size()
 		i -= size
@@ -512,6 +1211,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaxRateMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxRateMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxRateMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTaxRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTaxRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTaxRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PolicyConstraints) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -649,15 +1428,312 @@ func (m *EpochInitialIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTreasury(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *BurnTaxExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnTaxExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnTaxExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTreasury(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExpiryTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTreasury(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnTaxExemptionZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnTaxExemptionZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnTaxExemptionZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxRateUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxRateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxRateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TRLMonth.Size()
+		i -= size
+		if _, err := m.TRLMonth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TRLYear.Size()
+		i -= size
+		if _, err := m.TRLYear.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ClampedTaxRate.Size()
+		i -= size
+		if _, err := m.ClampedTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UnclampedTaxRate.Size()
+		i -= size
+		if _, err := m.UnclampedTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWeightUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWeightUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWeightUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MiningRewardSum.Size()
+		i -= size
+		if _, err := m.MiningRewardSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SeigniorageRewardSum.Size()
+		i -= size
+		if _, err := m.SeigniorageRewardSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ClampedRewardWeight.Size()
+		i -= size
+		if _, err := m.ClampedRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UnclampedRewardWeight.Size()
+		i -= size
+		if _, err := m.UnclampedRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTreasury(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 
@@ -688,65 +1764,1089 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovTreasury(uint64(l))
 	l = m.MinInitialDepositRatio.Size()
 	n += 1 + l + sovTreasury(uint64(l))
+	if len(m.TaxRateMultipliers) > 0 {
+		for _, e := range m.TaxRateMultipliers {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if len(m.DenomTaxRates) > 0 {
+		for _, e := range m.DenomTaxRates {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *PolicyConstraints) Size() (n int) {
+func (m *TaxRateMultiplier) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RateMin.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	l = m.RateMax.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	l = m.Cap.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	l = m.ChangeRateMax.Size()
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = m.Multiplier.Size()
 	n += 1 + l + sovTreasury(uint64(l))
 	return n
 }
 
-func (m *EpochTaxProceeds) Size() (n int) {
+func (m *DenomTaxRate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TaxProceeds) > 0 {
-		for _, e := range m.TaxProceeds {
-			l = e.Size()
-			n += 1 + l + sovTreasury(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = m.TaxRate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *PolicyConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateMin.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.RateMax.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ChangeRateMax.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *EpochTaxProceeds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for _, e := range m.TaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochInitialIssuance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuance) > 0 {
+		for _, e := range m.Issuance {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurnTaxExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTreasury(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurnTaxExemptionZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EpochPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaxRateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.UnclampedTaxRate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ClampedTaxRate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.TRLYear.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.TRLMonth.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *RewardWeightUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.UnclampedRewardWeight.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ClampedRewardWeight.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.SeigniorageRewardSum.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.MiningRewardSum.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func sovTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTreasury(x uint64) (n int) {
+	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageBurdenTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeigniorageBurdenTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningIncrement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MiningIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowShort", wireType)
+			}
+			m.WindowShort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowShort |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowLong", wireType)
+			}
+			m.WindowLong = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowLong |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProbation", wireType)
+			}
+			m.WindowProbation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowProbation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnTaxSplit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnTaxSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialDepositRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRateMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxRateMultipliers = append(m.TaxRateMultipliers, TaxRateMultiplier{})
+			if err := m.TaxRateMultipliers[len(m.TaxRateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTaxRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTaxRates = append(m.DenomTaxRates, DenomTaxRate{})
+			if err := m.DenomTaxRates[len(m.DenomTaxRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TaxRateMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxRateMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxRateMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DenomTaxRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTaxRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTaxRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyConstraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRateMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChangeRateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochTaxProceeds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochTaxProceeds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *EpochInitialIssuance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Issuance) > 0 {
-		for _, e := range m.Issuance {
-			l = e.Size()
-			n += 1 + l + sovTreasury(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTreasury(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTreasury(x uint64) (n int) {
-	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EpochInitialIssuance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -769,15 +2869,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochInitialIssuance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochInitialIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -804,15 +2904,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Issuance = append(m.Issuance, types.Coin{})
+			if err := m.Issuance[len(m.Issuance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BurnTaxExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnTaxExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnTaxExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -822,30 +2974,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageBurdenTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -855,29 +3025,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SeigniorageBurdenTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MiningIncrement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -905,15 +3077,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MiningIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowShort", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
 			}
-			m.WindowShort = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BurnTaxExemptionZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnTaxExemptionZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnTaxExemptionZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -923,16 +3144,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowShort |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowLong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.WindowLong = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -942,16 +3176,68 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowLong |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProbation", wireType)
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EpochPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
 			}
-			m.WindowProbation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -961,14 +3247,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowProbation |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnTaxSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -996,15 +3297,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnTaxSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -1014,23 +3315,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitialDepositRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaxCaps = append(m.TaxCaps, types.Coin{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1056,7 +3357,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
+func (m *TaxRateUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1079,15 +3380,15 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PolicyConstraints: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaxRateUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PolicyConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaxRateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1115,13 +3416,13 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclampedTaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1149,15 +3450,15 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnclampedTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampedTaxRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -1167,28 +3468,29 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClampedTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRateMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TRLYear", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1216,7 +3518,41 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChangeRateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TRLYear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TRLMonth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TRLMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1242,7 +3578,7 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
+func (m *RewardWeightUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1265,17 +3601,17 @@ func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochTaxProceeds: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardWeightUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochTaxProceeds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardWeightUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -1285,82 +3621,133 @@ func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
-			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTreasury(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclampedRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnclampedRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampedRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *EpochInitialIssuance) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTreasury
+			if err := m.ClampedRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageRewardSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SeigniorageRewardSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EpochInitialIssuance: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochInitialIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningRewardSum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -1370,23 +3757,23 @@ func (m *EpochInitialIssuance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuance = append(m.Issuance, types.Coin{})
-			if err := m.Issuance[len(m.Issuance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MiningRewardSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19,6 +19,8 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/modules/core"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -35,6 +37,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -161,6 +164,7 @@ func CreateTestInput(t testing.TB, wasmConfig *config.Config) TestInput {
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
+	keyTransfer := sdk.NewKVStoreKey(ibctransfertypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
 	keyTreasury := sdk.NewKVStoreKey(treasurytypes.StoreKey)
 
@@ -178,6 +182,7 @@ func CreateTestInput(t testing.TB, wasmConfig *config.Config) TestInput {
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTransfer, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
 
@@ -200,6 +205,7 @@ func CreateTestInput(t testing.TB, wasmConfig *config.Config) TestInput {
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		distrtypes.ModuleName:          nil,
 		oracletypes.ModuleName:         nil,
+		ibctransfertypes.ModuleName:    {authtypes.Burner, authtypes.Minter},
 		markettypes.ModuleName:         {authtypes.Burner, authtypes.Minter},
 		treasurytypes.ModuleName:       {authtypes.Minter},
 		treasurytypes.BurnModuleName:   {authtypes.Burner},
@@ -246,6 +252,7 @@ func CreateTestInput(t testing.TB, wasmConfig *config.Config) TestInput {
 	bondPool := authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName, authtypes.Burner, authtypes.Staking)
 	distrAcc := authtypes.NewEmptyModuleAccount(distrtypes.ModuleName)
 	oracleAcc := authtypes.NewEmptyModuleAccount(oracletypes.ModuleName)
+	transferAcc := authtypes.NewEmptyModuleAccount(ibctransfertypes.ModuleName, authtypes.Burner, authtypes.Minter)
 	marketAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner, authtypes.Minter)
 
 	err = bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, InitTokens.MulRaw(int64(len(Addrs))))))
@@ -256,6 +263,7 @@ func CreateTestInput(t testing.TB, wasmConfig *config.Config) TestInput {
 	accountKeeper.SetModuleAccount(ctx, notBondedPool)
 	accountKeeper.SetModuleAccount(ctx, distrAcc)
 	accountKeeper.SetModuleAccount(ctx, oracleAcc)
+	accountKeeper.SetModuleAccount(ctx, transferAcc)
 	accountKeeper.SetModuleAccount(ctx, marketAcc)
 
 	for _, addr := range Addrs {
@@ -272,6 +280,7 @@ func CreateTestInput(t testing.TB, wasmConfig *config.Config) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		nil,
 		distrtypes.ModuleName,
 	)
 	oracleDefaultParams := oracletypes.DefaultParams()
@@ -281,10 +290,17 @@ func CreateTestInput(t testing.TB, wasmConfig *config.Config) TestInput {
 		oracleKeeper.SetTobinTax(ctx, denom.Name, denom.TobinTax)
 	}
 
+	transferKeeper := ibctransferkeeper.NewKeeper(
+		appCodec,
+		keyTransfer, paramsKeeper.Subspace(ibctransfertypes.ModuleName),
+		nil, nil,
+		accountKeeper, bankKeeper, capabilitykeeper.ScopedKeeper{},
+	)
+
 	marketKeeper := marketkeeper.NewKeeper(
 		appCodec,
 		keyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
-		accountKeeper, bankKeeper, oracleKeeper,
+		accountKeeper, bankKeeper, oracleKeeper, distrKeeper, transferKeeper, nil,
 	)
 	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
 